@@ -11,19 +11,27 @@ import (
 
 // ApproveReleaseInput represents the input for the ApproveRelease use case.
 type ApproveReleaseInput struct {
-	ReleaseID   release.ReleaseID
-	ApprovedBy  string
+	ReleaseID  release.ReleaseID
+	ApprovedBy string
+	// Operator is the account running the approval. A policy counts one
+	// approval per operator.
+	Operator    string
 	AutoApprove bool
 	// EditedNotes contains user-edited release notes. If non-nil, the notes
 	// will be updated before approval.
 	EditedNotes *string
+	// Policy is the approval policy to enforce. Nil requires a single approval.
+	Policy *release.ApprovalPolicy
 }
 
 // ApproveReleaseOutput represents the output of the ApproveRelease use case.
 type ApproveReleaseOutput struct {
+	// Approved is true once the approval policy is satisfied.
 	Approved    bool
 	ApprovedBy  string
 	ReleasePlan *release.ReleasePlan
+	// Status reports the approvals recorded so far and who is still required.
+	Status release.ApprovalStatus
 }
 
 // ApproveReleaseUseCase implements the approve release use case.
@@ -68,8 +76,14 @@ func (uc *ApproveReleaseUseCase) Execute(ctx context.Context, input ApproveRelea
 			"notes_length", len(*input.EditedNotes))
 	}
 
-	// Approve the release
-	if err := rel.Approve(input.ApprovedBy, input.AutoApprove); err != nil {
+	policy := release.DefaultApprovalPolicy()
+	if input.Policy != nil {
+		policy = *input.Policy
+	}
+
+	// Record the approval; the release is approved once the policy is satisfied
+	status, err := rel.AddApproval(input.ApprovedBy, input.Operator, input.AutoApprove, policy)
+	if err != nil {
 		return nil, fmt.Errorf("failed to approve release: %w", err)
 	}
 
@@ -88,10 +102,18 @@ func (uc *ApproveReleaseUseCase) Execute(ctx context.Context, input ApproveRelea
 		rel.ClearDomainEvents()
 	}
 
+	if !status.Satisfied {
+		uc.logger.Info("approval recorded, policy not yet satisfied",
+			"release_id", rel.ID(),
+			"approved_by", input.ApprovedBy,
+			"missing", status.String())
+	}
+
 	return &ApproveReleaseOutput{
-		Approved:    true,
+		Approved:    status.Satisfied,
 		ApprovedBy:  input.ApprovedBy,
 		ReleasePlan: rel.Plan(),
+		Status:      status,
 	}, nil
 }
 
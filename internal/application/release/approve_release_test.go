@@ -389,6 +389,47 @@ func TestApproveReleaseUseCase_DomainEventsPublished(t *testing.T) {
 	}
 }
 
+func TestApproveReleaseUseCase_ApprovalPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	releaseRepo := newMockReleaseRepository()
+	releaseRepo.releases["release-123"] = createReleaseWithNotes("release-123", "main", "/path/to/repo")
+
+	uc := NewApproveReleaseUseCase(releaseRepo, &mockEventPublisher{})
+	policy := &release.ApprovalPolicy{
+		RequiredApprovals: 2,
+		Groups: []release.ApprovalGroup{
+			{Name: "release-managers", Members: []string{"alice"}, MinApprovals: 1},
+		},
+	}
+
+	output, err := uc.Execute(ctx, ApproveReleaseInput{ReleaseID: "release-123", ApprovedBy: "bob", Policy: policy})
+	if err != nil {
+		t.Fatalf("Execute(bob) error = %v", err)
+	}
+	if output.Approved {
+		t.Error("release should not be approved after the first approval")
+	}
+	if got := releaseRepo.releases["release-123"].State(); got != release.StateNotesGenerated {
+		t.Errorf("State = %s, want %s", got, release.StateNotesGenerated)
+	}
+	if len(output.Status.Groups) != 1 || output.Status.Groups[0].Satisfied() {
+		t.Errorf("release-managers should still be required: %+v", output.Status.Groups)
+	}
+
+	if _, err := uc.Execute(ctx, ApproveReleaseInput{ReleaseID: "release-123", ApprovedBy: "bob", Policy: policy}); !errors.Is(err, release.ErrAlreadyApprovedBy) {
+		t.Errorf("Execute(bob) again error = %v, want ErrAlreadyApprovedBy", err)
+	}
+
+	output, err = uc.Execute(ctx, ApproveReleaseInput{ReleaseID: "release-123", ApprovedBy: "alice", Policy: policy})
+	if err != nil {
+		t.Fatalf("Execute(alice) error = %v", err)
+	}
+	if !output.Approved || releaseRepo.releases["release-123"].State() != release.StateApproved {
+		t.Errorf("release should be approved, status: %s", output.Status)
+	}
+}
+
 func TestApproveReleaseUseCase_EditedNotesUpdated(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,288 @@
+// Package release provides application use cases for release management.
+package release
+
+import (
+	"context"
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/sourcecontrol"
+)
+
+// Classification sources.
+const (
+	ClassificationSourceAI        = "ai"
+	ClassificationSourceHeuristic = "heuristic"
+	ClassificationSourceOverride  = "override"
+)
+
+// UnconventionalCommit is a commit whose message does not follow the
+// Conventional Commits format.
+type UnconventionalCommit struct {
+	Hash    string
+	Message string
+	// Files lists the paths the commit touched, if they are known.
+	Files []string
+}
+
+// Subject returns the first line of the commit message.
+func (c UnconventionalCommit) Subject() string {
+	subject, _, _ := strings.Cut(strings.TrimSpace(c.Message), "\n")
+	return strings.TrimSpace(subject)
+}
+
+// CommitClassification is the type, scope and breaking-ness inferred for an
+// unconventional commit.
+type CommitClassification struct {
+	Type     changes.CommitType
+	Scope    string
+	Breaking bool
+	// Source names what produced the classification: "ai", "heuristic"
+	// or "override".
+	Source string
+}
+
+// InferredCommit pairs an unconventional commit with its classification.
+type InferredCommit struct {
+	Commit         UnconventionalCommit
+	Classification CommitClassification
+}
+
+// CommitClassifier infers a classification for unconventional commits.
+// It returns nil when it cannot tell, leaving the commit out of the plan.
+type CommitClassifier interface {
+	Classify(ctx context.Context, commit UnconventionalCommit) (*CommitClassification, error)
+}
+
+// ClassificationReviewer lets the user accept, override or reject inferred
+// classifications before the version bump is computed. It returns the
+// commits to keep; rejected commits are left out of the plan.
+type ClassificationReviewer func(ctx context.Context, inferred []InferredCommit) ([]InferredCommit, error)
+
+// HeuristicCommitClassifier classifies commits locally from keywords in the
+// subject and the paths the commit touched.
+type HeuristicCommitClassifier struct{}
+
+// NewHeuristicCommitClassifier creates a new HeuristicCommitClassifier.
+func NewHeuristicCommitClassifier() *HeuristicCommitClassifier {
+	return &HeuristicCommitClassifier{}
+}
+
+// heuristicKeywords maps subject words to commit types, in priority order.
+var heuristicKeywords = []struct {
+	commitType changes.CommitType
+	words      []string
+}{
+	{changes.CommitTypeRevert, []string{"revert", "reverts", "reverted"}},
+	{changes.CommitTypePerf, []string{"perf", "performance", "optimize", "optimise", "optimized", "optimised", "faster", "speedup", "speed"}},
+	{changes.CommitTypeFix, []string{"fix", "fixes", "fixed", "fixing", "bug", "bugfix", "hotfix", "crash", "resolve", "resolves", "resolved", "prevent", "prevents", "correct", "corrects", "corrected", "repair", "handle"}},
+	{changes.CommitTypeFeat, []string{"add", "adds", "added", "implement", "implements", "implemented", "introduce", "introduces", "introduced", "support", "supports", "new", "allow", "allows", "enable", "enables"}},
+	{changes.CommitTypeRefactor, []string{"refactor", "refactored", "restructure", "rename", "renamed", "cleanup", "simplify", "simplified", "extract", "move", "moved", "remove", "removed", "delete", "deleted", "drop", "dropped"}},
+	{changes.CommitTypeDocs, []string{"doc", "docs", "documentation", "readme", "typo"}},
+	{changes.CommitTypeTest, []string{"test", "tests", "testing"}},
+	{changes.CommitTypeBuild, []string{"bump", "upgrade", "dependency", "dependencies", "deps"}},
+	{changes.CommitTypeChore, []string{"chore", "update", "updated", "tweak", "wip"}},
+}
+
+// breakingKeywords are phrases that mark a commit as a breaking change.
+var breakingKeywords = []string{
+	"breaking change", "breaking:", "backwards incompatible", "backward incompatible",
+	"drop support", "dropped support", "remove support", "removed support",
+}
+
+// genericPathRoots are top-level directories skipped when inferring a scope.
+var genericPathRoots = map[string]bool{
+	"internal": true, "pkg": true, "src": true, "lib": true, "cmd": true, "app": true,
+}
+
+// Classify infers a classification from the commit subject and files.
+// Merge commits and subjects without any recognizable keyword are left
+// unclassified.
+func (h *HeuristicCommitClassifier) Classify(ctx context.Context, commit UnconventionalCommit) (*CommitClassification, error) {
+	subject := strings.ToLower(commit.Subject())
+	if subject == "" || strings.HasPrefix(subject, "merge ") {
+		return nil, nil
+	}
+
+	commitType := typeFromPaths(commit.Files)
+	if commitType == "" {
+		commitType = typeFromKeywords(subject)
+	}
+	if commitType == "" {
+		return nil, nil
+	}
+
+	message := strings.ToLower(commit.Message)
+	breaking := false
+	for _, k := range breakingKeywords {
+		if strings.Contains(message, k) {
+			breaking = true
+			break
+		}
+	}
+
+	return &CommitClassification{
+		Type:     commitType,
+		Scope:    scopeFromPaths(commit.Files),
+		Breaking: breaking,
+		Source:   ClassificationSourceHeuristic,
+	}, nil
+}
+
+// typeFromKeywords returns the type of the subject's first word if it is a
+// keyword, or else the highest priority keyword found anywhere.
+func typeFromKeywords(subject string) changes.CommitType {
+	words := strings.FieldsFunc(subject, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return ""
+	}
+
+	for _, k := range heuristicKeywords {
+		for _, w := range k.words {
+			if words[0] == w {
+				return k.commitType
+			}
+		}
+	}
+	for _, k := range heuristicKeywords {
+		for _, w := range k.words {
+			for _, word := range words {
+				if word == w {
+					return k.commitType
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// typeFromPaths returns a type when every touched file is documentation,
+// tests, CI configuration or build files.
+func typeFromPaths(files []string) changes.CommitType {
+	if len(files) == 0 {
+		return ""
+	}
+
+	matchAll := func(match func(string) bool) bool {
+		for _, f := range files {
+			if !match(strings.ToLower(f)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch {
+	case matchAll(isDocsPath):
+		return changes.CommitTypeDocs
+	case matchAll(isTestPath):
+		return changes.CommitTypeTest
+	case matchAll(isCIPath):
+		return changes.CommitTypeCI
+	case matchAll(isBuildPath):
+		return changes.CommitTypeBuild
+	default:
+		return ""
+	}
+}
+
+func isDocsPath(p string) bool {
+	base := path.Base(p)
+	return strings.HasPrefix(p, "docs/") || strings.HasSuffix(p, ".md") ||
+		strings.HasSuffix(p, ".rst") || strings.HasPrefix(base, "license")
+}
+
+func isTestPath(p string) bool {
+	return strings.HasSuffix(p, "_test.go") || strings.Contains(p, "/testdata/") ||
+		strings.HasPrefix(p, "test/") || strings.HasPrefix(p, "tests/") ||
+		strings.Contains(p, ".test.") || strings.Contains(p, ".spec.")
+}
+
+func isCIPath(p string) bool {
+	return strings.HasPrefix(p, ".github/workflows/") || strings.HasPrefix(p, ".circleci/") ||
+		p == ".gitlab-ci.yml" || p == "jenkinsfile" || p == ".travis.yml"
+}
+
+func isBuildPath(p string) bool {
+	switch path.Base(p) {
+	case "go.mod", "go.sum", "makefile", "dockerfile", "package.json", "package-lock.json",
+		"yarn.lock", "pnpm-lock.yaml", "cargo.toml", "cargo.lock", ".goreleaser.yml", ".goreleaser.yaml":
+		return true
+	}
+	return false
+}
+
+// scopeFromPaths returns the directory all touched files share, skipping
+// generic roots such as internal/ or pkg/, e.g. "cli" for files under
+// internal/cli. It returns "" when the files do not share one.
+func scopeFromPaths(files []string) string {
+	scope := ""
+	for _, f := range files {
+		parts := strings.Split(path.Clean(f), "/")
+		if len(parts) > 1 && genericPathRoots[parts[0]] {
+			parts = parts[1:]
+		}
+		if len(parts) < 2 || strings.HasPrefix(parts[0], ".") {
+			return "" // files in the root or in tool directories have no scope
+		}
+		if scope != "" && parts[0] != scope {
+			return ""
+		}
+		scope = parts[0]
+	}
+	return scope
+}
+
+// classifyCommits classifies the commits that are not conventional commits,
+// in order, with the files they touched if a file reader is available.
+func classifyCommits(ctx context.Context, classifier CommitClassifier, files sourcecontrol.CommitFileReader, commits []*sourcecontrol.Commit) ([]InferredCommit, error) {
+	var inferred []InferredCommit
+	for _, commit := range commits {
+		if changes.ParseConventionalCommit(string(commit.Hash()), commit.Message()) != nil {
+			continue
+		}
+
+		unconventional := UnconventionalCommit{Hash: string(commit.Hash()), Message: commit.Message()}
+		if files != nil {
+			paths, err := files.GetCommitFiles(ctx, commit.Hash())
+			if err != nil {
+				return nil, err
+			}
+			unconventional.Files = paths
+		}
+
+		classification, err := classifier.Classify(ctx, unconventional)
+		if err != nil {
+			return nil, err
+		}
+		if classification == nil || !classification.Type.IsValid() {
+			continue
+		}
+		inferred = append(inferred, InferredCommit{Commit: unconventional, Classification: *classification})
+	}
+	return inferred, nil
+}
+
+// inferredConventionalCommit builds a conventional commit for an accepted
+// inferred classification.
+func inferredConventionalCommit(commit *sourcecontrol.Commit, c CommitClassification) *changes.ConventionalCommit {
+	subject, body, _ := strings.Cut(strings.TrimSpace(commit.Message()), "\n")
+	subject = strings.TrimSpace(subject)
+
+	opts := []changes.ConventionalCommitOption{
+		changes.WithScope(c.Scope),
+		changes.WithBody(strings.TrimSpace(body)),
+		changes.WithAuthor(commit.Author().Name, commit.Author().Email),
+		changes.WithDate(commit.Date()),
+		changes.WithRawMessage(commit.Message()),
+		changes.WithInferred(),
+	}
+	if c.Breaking {
+		opts = append(opts, changes.WithBreaking(subject))
+	}
+	return changes.NewConventionalCommit(string(commit.Hash()), c.Type, subject, opts...)
+}
@@ -0,0 +1,228 @@
+// Package release provides application use cases for release management.
+package release
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/sourcecontrol"
+)
+
+// mockCommitClassifier classifies commits from a fixed map of hashes.
+type mockCommitClassifier struct {
+	classifications map[string]*CommitClassification
+	seen            []UnconventionalCommit
+	err             error
+}
+
+func (m *mockCommitClassifier) Classify(ctx context.Context, commit UnconventionalCommit) (*CommitClassification, error) {
+	m.seen = append(m.seen, commit)
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.classifications[commit.Hash], nil
+}
+
+func TestHeuristicCommitClassifier_Classify(t *testing.T) {
+	tests := []struct {
+		name         string
+		message      string
+		files        []string
+		wantType     changes.CommitType
+		wantScope    string
+		wantBreaking bool
+	}{
+		{name: "fix keyword", message: "Fix crash when config is missing", wantType: changes.CommitTypeFix},
+		{name: "feature keyword", message: "Add support for GitLab", wantType: changes.CommitTypeFeat},
+		{name: "first word wins", message: "Implement retry to fix flaky uploads", wantType: changes.CommitTypeFeat},
+		{name: "perf keyword", message: "Make tag lookup faster", wantType: changes.CommitTypePerf},
+		{name: "docs by paths", message: "Update guide", files: []string{"README.md", "docs/PLUGINS.md"}, wantType: changes.CommitTypeDocs},
+		{name: "tests by paths", message: "More cases", files: []string{"internal/cli/plan_test.go"}, wantType: changes.CommitTypeTest, wantScope: "cli"},
+		{name: "ci by paths", message: "Tweak pipeline", files: []string{".github/workflows/ci.yml"}, wantType: changes.CommitTypeCI},
+		{name: "build by paths", message: "Update modules", files: []string{"go.mod", "go.sum"}, wantType: changes.CommitTypeBuild},
+		{
+			name:      "scope from shared directory",
+			message:   "Handle empty plans",
+			files:     []string{"internal/cli/plan.go", "internal/cli/notes.go"},
+			wantType:  changes.CommitTypeFix,
+			wantScope: "cli",
+		},
+		{
+			name:     "no scope across directories",
+			message:  "Add config validation",
+			files:    []string{"internal/cli/plan.go", "internal/config/validate.go"},
+			wantType: changes.CommitTypeFeat,
+		},
+		{
+			name:         "breaking keyword in body",
+			message:      "Remove the legacy API\n\nBREAKING CHANGE: the v1 endpoints are gone",
+			wantType:     changes.CommitTypeRefactor,
+			wantBreaking: true,
+		},
+		{name: "merge commit", message: "Merge branch 'main' into feature"},
+		{name: "no keyword", message: "Misc"},
+	}
+
+	classifier := NewHeuristicCommitClassifier()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := classifier.Classify(context.Background(), UnconventionalCommit{Hash: "abc123", Message: tt.message, Files: tt.files})
+			if err != nil {
+				t.Fatalf("Classify() error = %v", err)
+			}
+			if tt.wantType == "" {
+				if got != nil {
+					t.Errorf("Classify() = %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("Classify() = nil, want %s", tt.wantType)
+			}
+			if got.Type != tt.wantType || got.Scope != tt.wantScope || got.Breaking != tt.wantBreaking {
+				t.Errorf("Classify() = %+v, want type %s, scope %q, breaking %v", got, tt.wantType, tt.wantScope, tt.wantBreaking)
+			}
+			if got.Source != ClassificationSourceHeuristic {
+				t.Errorf("Source = %q, want heuristic", got.Source)
+			}
+		})
+	}
+}
+
+func TestPlanReleaseUseCase_Execute_ClassifiesUnconventionalCommits(t *testing.T) {
+	gitRepo := &mockGitRepository{
+		info: &sourcecontrol.RepositoryInfo{Name: "test-repo", CurrentBranch: "main"},
+		commits: []*sourcecontrol.Commit{
+			createTestCommit("abc123", "fix: handle nil config"),
+			createTestCommit("def456", "Add GitLab support"),
+			createTestCommit("ghi789", "Whatever"),
+		},
+		commitFiles:  map[sourcecontrol.CommitHash][]string{"def456": {"internal/gitlab/client.go"}},
+		latestTagErr: errors.New("no tags found"),
+	}
+	classifier := &mockCommitClassifier{classifications: map[string]*CommitClassification{
+		"def456": {Type: changes.CommitTypeFeat, Scope: "gitlab", Source: ClassificationSourceAI},
+	}}
+
+	uc := NewPlanReleaseUseCase(newMockReleaseRepository(), gitRepo, &mockVersionCalculator{}, &mockEventPublisher{},
+		WithCommitClassifier(classifier, gitRepo))
+	output, err := uc.Execute(context.Background(), PlanReleaseInput{DryRun: true})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if len(classifier.seen) != 2 || classifier.seen[0].Files[0] != "internal/gitlab/client.go" {
+		t.Errorf("classifier saw %+v, want the two unconventional commits with files", classifier.seen)
+	}
+	if output.ReleaseType != changes.ReleaseTypeMinor {
+		t.Errorf("ReleaseType = %s, want minor from the inferred feature", output.ReleaseType)
+	}
+	if len(output.Inferred) != 1 || output.Inferred[0].Commit.Hash != "def456" {
+		t.Errorf("Inferred = %+v, want def456", output.Inferred)
+	}
+
+	commits := output.ChangeSet.Commits()
+	if len(commits) != 2 {
+		t.Fatalf("changeset has %d commits, want 2", len(commits))
+	}
+	if commits[0].IsInferred() || !commits[1].IsInferred() {
+		t.Errorf("only the classified commit should be marked inferred")
+	}
+	if commits[1].Subject() != "Add GitLab support" || commits[1].Scope() != "gitlab" {
+		t.Errorf("inferred commit = %s", commits[1])
+	}
+}
+
+func TestPlanReleaseUseCase_Execute_ReviewClassifications(t *testing.T) {
+	newGitRepo := func() *mockGitRepository {
+		return &mockGitRepository{
+			info: &sourcecontrol.RepositoryInfo{Name: "test-repo", CurrentBranch: "main"},
+			commits: []*sourcecontrol.Commit{
+				createTestCommit("abc123", "docs: explain plugins"),
+				createTestCommit("def456", "Rework the plugin API"),
+			},
+			latestTagErr: errors.New("no tags found"),
+		}
+	}
+	classifier := &mockCommitClassifier{classifications: map[string]*CommitClassification{
+		"def456": {Type: changes.CommitTypeRefactor, Source: ClassificationSourceAI},
+	}}
+
+	tests := []struct {
+		name     string
+		review   ClassificationReviewer
+		wantType changes.ReleaseType
+		wantErr  string
+	}{
+		{
+			name: "accept",
+			review: func(ctx context.Context, inferred []InferredCommit) ([]InferredCommit, error) {
+				return inferred, nil
+			},
+			wantType: changes.ReleaseTypeNone,
+		},
+		{
+			name: "override before the bump",
+			review: func(ctx context.Context, inferred []InferredCommit) ([]InferredCommit, error) {
+				inferred[0].Classification = CommitClassification{Type: changes.CommitTypeFeat, Breaking: true, Source: ClassificationSourceOverride}
+				return inferred, nil
+			},
+			wantType: changes.ReleaseTypeMajor,
+		},
+		{
+			name: "reject",
+			review: func(ctx context.Context, inferred []InferredCommit) ([]InferredCommit, error) {
+				return nil, nil
+			},
+			wantType: changes.ReleaseTypeNone,
+		},
+		{
+			name: "review fails",
+			review: func(ctx context.Context, inferred []InferredCommit) ([]InferredCommit, error) {
+				return nil, errors.New("aborted")
+			},
+			wantErr: "failed to review commit classifications: aborted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gitRepo := newGitRepo()
+			uc := NewPlanReleaseUseCase(newMockReleaseRepository(), gitRepo, &mockVersionCalculator{}, &mockEventPublisher{},
+				WithCommitClassifier(classifier, nil))
+
+			output, err := uc.Execute(context.Background(), PlanReleaseInput{DryRun: true, ReviewClassifications: tt.review})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Execute() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if output.ReleaseType != tt.wantType {
+				t.Errorf("ReleaseType = %s, want %s", output.ReleaseType, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestPlanReleaseUseCase_Execute_ClassifierError(t *testing.T) {
+	gitRepo := &mockGitRepository{
+		info:         &sourcecontrol.RepositoryInfo{Name: "test-repo", CurrentBranch: "main"},
+		commits:      []*sourcecontrol.Commit{createTestCommit("def456", "Rework the plugin API")},
+		latestTagErr: errors.New("no tags found"),
+	}
+	classifier := &mockCommitClassifier{err: errors.New("provider down")}
+
+	uc := NewPlanReleaseUseCase(newMockReleaseRepository(), gitRepo, &mockVersionCalculator{}, &mockEventPublisher{},
+		WithCommitClassifier(classifier, nil))
+	_, err := uc.Execute(context.Background(), PlanReleaseInput{DryRun: true})
+	if err == nil || !strings.Contains(err.Error(), "failed to classify commits: provider down") {
+		t.Errorf("Execute() error = %v", err)
+	}
+}
@@ -0,0 +1,352 @@
+// Package release provides application use cases for release management.
+package release
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/sourcecontrol"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+)
+
+const (
+	// maxExampleLines caps the before/after examples taken from a diff.
+	maxExampleLines = 12
+	// maxAPIDiffBytes caps the API diff passed to the migration advisor per
+	// commit, so a large refactoring does not exhaust the model's context.
+	maxAPIDiffBytes = 8000
+)
+
+// GenerateMigrationGuideInput represents the input for the
+// GenerateMigrationGuide use case.
+type GenerateMigrationGuideInput struct {
+	ReleaseID release.ReleaseID
+	UseAI     bool
+	// APIPaths are glob patterns of the public API files whose diffs are
+	// used for before/after examples. A pattern ending in /** matches a
+	// directory tree. Empty uses IsPublicAPIPath.
+	APIPaths []string
+	Scheme   version.Scheme // Formats versions; defaults to semver
+}
+
+// GenerateMigrationGuideOutput represents the output of the
+// GenerateMigrationGuide use case.
+type GenerateMigrationGuideOutput struct {
+	// Guide is nil when the release has no breaking changes.
+	Guide *release.MigrationGuide
+	// FallbackReason explains why the template guide was used although
+	// AI was requested.
+	FallbackReason string
+}
+
+// BreakingCommit is a breaking commit with the diff of the public API files
+// it touched.
+type BreakingCommit struct {
+	Hash        string
+	Scope       string
+	Subject     string
+	Description string // BREAKING CHANGE footer
+	Diff        string // unified diff of the public API files
+}
+
+// MigrationAdviceInput is the input for a MigrationAdvisor.
+type MigrationAdviceInput struct {
+	VersionLabel string
+	Commits      []BreakingCommit
+	// Template holds the entries derived without AI, in commit order.
+	Template []release.MigrationEntry
+}
+
+// MigrationAdvisor writes migration guidance for breaking commits, e.g.
+// with AI. It returns a guide without Markdown; rendering is left to the
+// MigrationGuideRenderer.
+type MigrationAdvisor interface {
+	AdviseMigration(ctx context.Context, input MigrationAdviceInput) (*release.MigrationGuide, error)
+}
+
+// MigrationGuideRenderInput is the input for a MigrationGuideRenderer.
+type MigrationGuideRenderInput struct {
+	VersionLabel         string
+	PreviousVersionLabel string
+	Guide                *release.MigrationGuide
+}
+
+// MigrationGuideRenderer renders a migration guide as a Markdown section
+// for MIGRATION.md.
+type MigrationGuideRenderer interface {
+	RenderMigrationGuide(ctx context.Context, input MigrationGuideRenderInput) (string, error)
+}
+
+// GenerateMigrationGuideUseCase implements the generate migration guide use
+// case. It writes one entry per breaking commit from the BREAKING CHANGE
+// footer and the diff of the public API files, and lets the advisor
+// improve on it when AI is enabled.
+type GenerateMigrationGuideUseCase struct {
+	releaseRepo    release.Repository
+	diffs          sourcecontrol.CommitDiffReader
+	advisor        MigrationAdvisor
+	renderer       MigrationGuideRenderer
+	eventPublisher release.EventPublisher
+	logger         *slog.Logger
+}
+
+// NewGenerateMigrationGuideUseCase creates a new GenerateMigrationGuideUseCase.
+// diffs and advisor are optional: without diffs there are no before/after
+// examples, and without an advisor the template guide is used.
+func NewGenerateMigrationGuideUseCase(
+	releaseRepo release.Repository,
+	diffs sourcecontrol.CommitDiffReader,
+	advisor MigrationAdvisor,
+	renderer MigrationGuideRenderer,
+	eventPublisher release.EventPublisher,
+) *GenerateMigrationGuideUseCase {
+	return &GenerateMigrationGuideUseCase{
+		releaseRepo:    releaseRepo,
+		diffs:          diffs,
+		advisor:        advisor,
+		renderer:       renderer,
+		eventPublisher: eventPublisher,
+		logger:         slog.Default().With("usecase", "generate_migration_guide"),
+	}
+}
+
+// Execute executes the generate migration guide use case.
+func (uc *GenerateMigrationGuideUseCase) Execute(ctx context.Context, input GenerateMigrationGuideInput) (*GenerateMigrationGuideOutput, error) {
+	// Retrieve release
+	rel, err := uc.releaseRepo.FindByID(ctx, input.ReleaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find release: %w", err)
+	}
+
+	plan := rel.Plan()
+	if plan == nil {
+		return nil, release.ErrNilPlan
+	}
+	changeSet := plan.GetChangeSet()
+	if changeSet == nil || !changeSet.HasBreakingChanges() {
+		return &GenerateMigrationGuideOutput{}, nil
+	}
+
+	commits, err := uc.breakingCommits(ctx, changeSet, input.APIPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := version.SchemeOf(input.Scheme)
+	label := scheme.Format(plan.NextVersion)
+
+	output := &GenerateMigrationGuideOutput{}
+	entries := templateMigrationEntries(commits)
+	guide := &release.MigrationGuide{Entries: entries, Provider: "template"}
+	if input.UseAI && uc.advisor != nil {
+		advised, err := uc.advisor.AdviseMigration(ctx, MigrationAdviceInput{
+			VersionLabel: label,
+			Commits:      commits,
+			Template:     entries,
+		})
+		if err != nil {
+			uc.logger.Warn("AI migration guide failed, falling back to the template",
+				"error", err,
+				"release_id", rel.ID())
+			output.FallbackReason = err.Error()
+		} else {
+			guide = advised
+		}
+	}
+
+	previous := ""
+	if !plan.CurrentVersion.IsZero() {
+		previous = scheme.Format(plan.CurrentVersion)
+	}
+	guide.Markdown, err = uc.renderer.RenderMigrationGuide(ctx, MigrationGuideRenderInput{
+		VersionLabel:         label,
+		PreviousVersionLabel: previous,
+		Guide:                guide,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render migration guide: %w", err)
+	}
+
+	if err := rel.SetMigrationGuide(guide); err != nil {
+		return nil, fmt.Errorf("failed to set migration guide: %w", err)
+	}
+
+	// Save release
+	if err := uc.releaseRepo.Save(ctx, rel); err != nil {
+		return nil, fmt.Errorf("failed to save release: %w", err)
+	}
+
+	// Publish domain events
+	if uc.eventPublisher != nil {
+		if err := uc.eventPublisher.Publish(ctx, rel.DomainEvents()...); err != nil {
+			uc.logger.Warn("failed to publish domain events",
+				"error", err,
+				"release_id", rel.ID())
+		}
+		rel.ClearDomainEvents()
+	}
+
+	uc.logger.Info("migration guide generated",
+		"release_id", rel.ID(),
+		"entries", len(guide.Entries),
+		"provider", guide.Provider)
+
+	output.Guide = guide
+	return output, nil
+}
+
+// breakingCommits collects the breaking commits of a changeset, in order,
+// with the diff of the public API files they touched.
+func (uc *GenerateMigrationGuideUseCase) breakingCommits(ctx context.Context, changeSet *changes.ChangeSet, apiPaths []string) ([]BreakingCommit, error) {
+	var commits []BreakingCommit
+	for _, c := range changeSet.Commits() {
+		if !c.IsBreaking() {
+			continue
+		}
+
+		commit := BreakingCommit{
+			Hash:        c.Hash(),
+			Scope:       c.Scope(),
+			Subject:     c.Subject(),
+			Description: c.BreakingMessage(),
+		}
+		if uc.diffs != nil {
+			diff, err := uc.apiDiff(ctx, sourcecontrol.CommitHash(c.Hash()), apiPaths)
+			if err != nil {
+				return nil, fmt.Errorf("failed to diff commit %s: %w", c.ShortHash(), err)
+			}
+			commit.Diff = diff
+		}
+		commits = append(commits, commit)
+	}
+	return commits, nil
+}
+
+// apiDiff returns the diff of the public API files a commit touched, or ""
+// if it touched none.
+func (uc *GenerateMigrationGuideUseCase) apiDiff(ctx context.Context, hash sourcecontrol.CommitHash, apiPaths []string) (string, error) {
+	files, err := uc.diffs.GetCommitFiles(ctx, hash)
+	if err != nil {
+		return "", err
+	}
+
+	var api []string
+	for _, f := range files {
+		if matchesAPIPath(f, apiPaths) {
+			api = append(api, f)
+		}
+	}
+	if len(api) == 0 {
+		return "", nil
+	}
+
+	diff, err := uc.diffs.GetCommitDiff(ctx, hash, api)
+	if err != nil {
+		return "", err
+	}
+	if len(diff) > maxAPIDiffBytes {
+		diff = diff[:maxAPIDiffBytes] + "\n... (diff truncated)"
+	}
+	return diff, nil
+}
+
+// matchesAPIPath reports whether a file is part of the public API, by the
+// configured patterns or else by IsPublicAPIPath.
+func matchesAPIPath(file string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return IsPublicAPIPath(file)
+	}
+	for _, pattern := range patterns {
+		if dir, ok := strings.CutSuffix(pattern, "/**"); ok {
+			if strings.HasPrefix(file, dir+"/") {
+				return true
+			}
+			continue
+		}
+		if ok, _ := path.Match(pattern, file); ok {
+			return true
+		}
+		// Patterns without a directory match the file name anywhere
+		if !strings.Contains(pattern, "/") {
+			if ok, _ := path.Match(pattern, path.Base(file)); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsPublicAPIPath reports whether a file likely defines public API: Go
+// files outside internal packages and tests, protobuf and GraphQL schemas,
+// OpenAPI specs and TypeScript declarations.
+func IsPublicAPIPath(file string) bool {
+	p := strings.ToLower(file)
+	for _, dir := range strings.Split(path.Dir(p), "/") {
+		if dir == "internal" || dir == "testdata" || dir == "vendor" || dir == "examples" {
+			return false
+		}
+	}
+
+	base := path.Base(p)
+	switch {
+	case strings.HasSuffix(base, "_test.go"):
+		return false
+	case strings.HasSuffix(base, ".go"), strings.HasSuffix(base, ".proto"),
+		strings.HasSuffix(base, ".graphql"), strings.HasSuffix(base, ".d.ts"):
+		return true
+	case strings.HasPrefix(base, "openapi.") || strings.HasPrefix(base, "swagger."):
+		return true
+	}
+	return false
+}
+
+// templateMigrationEntries derives one entry per breaking commit without AI:
+// the subject says what changed, the BREAKING CHANGE footer how to migrate,
+// and the removed and added lines of the API diff give before and after.
+func templateMigrationEntries(commits []BreakingCommit) []release.MigrationEntry {
+	entries := make([]release.MigrationEntry, 0, len(commits))
+	for _, c := range commits {
+		before, after := diffExamples(c.Diff)
+		entries = append(entries, release.MigrationEntry{
+			CommitHash: c.Hash,
+			Scope:      c.Scope,
+			Change:     c.Subject,
+			Before:     before,
+			After:      after,
+			Migration:  c.Description,
+		})
+	}
+	return entries
+}
+
+// diffExamples returns the removed and added lines of a unified diff,
+// skipping blank lines and capped at maxExampleLines each.
+func diffExamples(diff string) (before, after string) {
+	var removed, added []string
+	for _, line := range strings.Split(diff, "\n") {
+		if strings.HasPrefix(line, "---") || strings.HasPrefix(line, "+++") {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(line, "-") && strings.TrimSpace(line[1:]) != "":
+			removed = append(removed, line[1:])
+		case strings.HasPrefix(line, "+") && strings.TrimSpace(line[1:]) != "":
+			added = append(added, line[1:])
+		}
+	}
+	return exampleLines(removed), exampleLines(added)
+}
+
+// exampleLines joins up to maxExampleLines lines, noting any it left out.
+func exampleLines(lines []string) string {
+	if len(lines) > maxExampleLines {
+		omitted := len(lines) - maxExampleLines
+		lines = append(lines[:maxExampleLines:maxExampleLines], fmt.Sprintf("// ... %d more lines", omitted))
+	}
+	return strings.Join(lines, "\n")
+}
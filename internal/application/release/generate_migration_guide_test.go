@@ -0,0 +1,213 @@
+// Package release provides application use cases for release management.
+package release
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/sourcecontrol"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+)
+
+// mockMigrationAdvisor returns a fixed guide or error.
+type mockMigrationAdvisor struct {
+	input MigrationAdviceInput
+	guide *release.MigrationGuide
+	err   error
+}
+
+func (m *mockMigrationAdvisor) AdviseMigration(ctx context.Context, input MigrationAdviceInput) (*release.MigrationGuide, error) {
+	m.input = input
+	return m.guide, m.err
+}
+
+// mockMigrationGuideRenderer renders the entry changes as a list.
+type mockMigrationGuideRenderer struct {
+	input MigrationGuideRenderInput
+}
+
+func (m *mockMigrationGuideRenderer) RenderMigrationGuide(ctx context.Context, input MigrationGuideRenderInput) (string, error) {
+	m.input = input
+	var b strings.Builder
+	b.WriteString("## Migrating to " + input.VersionLabel + "\n")
+	for _, e := range input.Guide.Entries {
+		b.WriteString("- " + e.Change + "\n")
+	}
+	return b.String(), nil
+}
+
+// createMajorRelease creates a release with notes whose changeset holds a
+// breaking commit.
+func createMajorRelease(id release.ReleaseID) *release.Release {
+	r := release.NewRelease(id, "main", "/repo")
+	cs := changes.NewChangeSet("cs-test", "v1.4.0", "HEAD")
+	cs.AddCommit(changes.ParseConventionalCommit("abc1234", "feat(api)!: rename Execute to Run\n\nBREAKING CHANGE: Execute was renamed to Run"))
+	cs.AddCommit(changes.NewConventionalCommit("def5678", changes.CommitTypeFix, "handle empty config"))
+
+	nextVersion := version.MustParse("2.0.0")
+	_ = r.SetPlan(release.NewReleasePlan(version.MustParse("1.4.0"), nextVersion, changes.ReleaseTypeMajor, cs, false))
+	_ = r.SetVersion(nextVersion, "v2.0.0")
+	_ = r.SetNotes(&release.ReleaseNotes{Changelog: "## [2.0.0]", GeneratedAt: time.Now()})
+	return r
+}
+
+func TestGenerateMigrationGuideUseCase_Execute_Template(t *testing.T) {
+	releaseRepo := newMockReleaseRepository()
+	releaseRepo.releases["release-123"] = createMajorRelease("release-123")
+	gitRepo := &mockGitRepository{
+		commitFiles: map[sourcecontrol.CommitHash][]string{
+			"abc1234": {"pkg/client/client.go", "pkg/client/client_test.go", "internal/cli/run.go", "README.md"},
+		},
+		commitDiffs: map[sourcecontrol.CommitHash]string{
+			"abc1234": "--- a/pkg/client/client.go\n+++ b/pkg/client/client.go\n@@ -1,3 +1,3 @@\n-func (c *Client) Execute() error\n+func (c *Client) Run() error\n",
+		},
+	}
+	renderer := &mockMigrationGuideRenderer{}
+	eventPublisher := &mockEventPublisher{}
+
+	uc := NewGenerateMigrationGuideUseCase(releaseRepo, gitRepo, &mockMigrationAdvisor{err: errors.New("unused")}, renderer, eventPublisher)
+	output, err := uc.Execute(context.Background(), GenerateMigrationGuideInput{ReleaseID: "release-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	guide := output.Guide
+	if guide == nil || len(guide.Entries) != 1 {
+		t.Fatalf("Guide = %+v, want one entry for the breaking commit", guide)
+	}
+	want := release.MigrationEntry{
+		CommitHash: "abc1234",
+		Scope:      "api",
+		Change:     "rename Execute to Run",
+		Before:     "func (c *Client) Execute() error",
+		After:      "func (c *Client) Run() error",
+		Migration:  "Execute was renamed to Run",
+	}
+	if guide.Entries[0] != want {
+		t.Errorf("entry = %+v, want %+v", guide.Entries[0], want)
+	}
+	if guide.AIGenerated || guide.Provider != "template" {
+		t.Errorf("Guide = %+v, want a template guide", guide)
+	}
+	if len(gitRepo.diffPaths) != 1 || strings.Join(gitRepo.diffPaths[0], ",") != "pkg/client/client.go" {
+		t.Errorf("diffed paths = %v, want only the public API file", gitRepo.diffPaths)
+	}
+	if renderer.input.VersionLabel != "2.0.0" || renderer.input.PreviousVersionLabel != "1.4.0" {
+		t.Errorf("render input = %+v", renderer.input)
+	}
+	if guide.Markdown != "## Migrating to 2.0.0\n- rename Execute to Run\n" {
+		t.Errorf("Markdown = %q", guide.Markdown)
+	}
+
+	saved := releaseRepo.releases["release-123"]
+	if saved.Notes().MigrationGuide != guide {
+		t.Error("the guide should be saved with the release notes")
+	}
+	found := false
+	for _, e := range eventPublisher.published {
+		if e.EventName() == "release.migration_guide_generated" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("release.migration_guide_generated should be published")
+	}
+}
+
+func TestGenerateMigrationGuideUseCase_Execute_AI(t *testing.T) {
+	aiGuide := &release.MigrationGuide{
+		Entries:     []release.MigrationEntry{{CommitHash: "abc1234", Change: "Run replaces Execute", Migration: "Rename the calls."}},
+		AIGenerated: true,
+		Provider:    "anthropic",
+	}
+
+	tests := []struct {
+		name         string
+		advisor      *mockMigrationAdvisor
+		wantProvider string
+		wantFallback string
+	}{
+		{name: "advisor succeeds", advisor: &mockMigrationAdvisor{guide: aiGuide}, wantProvider: "anthropic"},
+		{name: "advisor fails", advisor: &mockMigrationAdvisor{err: errors.New("provider down")}, wantProvider: "template", wantFallback: "provider down"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			releaseRepo := newMockReleaseRepository()
+			releaseRepo.releases["release-123"] = createMajorRelease("release-123")
+
+			uc := NewGenerateMigrationGuideUseCase(releaseRepo, nil, tt.advisor, &mockMigrationGuideRenderer{}, nil)
+			output, err := uc.Execute(context.Background(), GenerateMigrationGuideInput{ReleaseID: "release-123", UseAI: true})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			if output.Guide.Provider != tt.wantProvider || output.FallbackReason != tt.wantFallback {
+				t.Errorf("Guide provider = %s, fallback = %q", output.Guide.Provider, output.FallbackReason)
+			}
+			if in := tt.advisor.input; in.VersionLabel != "2.0.0" || len(in.Commits) != 1 || in.Template[0].Migration != "Execute was renamed to Run" {
+				t.Errorf("advice input = %+v", in)
+			}
+		})
+	}
+}
+
+func TestGenerateMigrationGuideUseCase_Execute_NoBreakingChanges(t *testing.T) {
+	releaseRepo := newMockReleaseRepository()
+	releaseRepo.releases["release-123"] = createApprovedRelease("release-123", "main", "/repo")
+
+	uc := NewGenerateMigrationGuideUseCase(releaseRepo, nil, nil, &mockMigrationGuideRenderer{}, nil)
+	output, err := uc.Execute(context.Background(), GenerateMigrationGuideInput{ReleaseID: "release-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.Guide != nil {
+		t.Errorf("Guide = %+v, want nil without breaking changes", output.Guide)
+	}
+}
+
+func TestMatchesAPIPath(t *testing.T) {
+	tests := []struct {
+		file     string
+		patterns []string
+		want     bool
+	}{
+		{file: "pkg/plugin/interface.go", want: true},
+		{file: "pkg/plugin/interface_test.go", want: false},
+		{file: "internal/cli/plan.go", want: false},
+		{file: "api/v1/service.proto", want: true},
+		{file: "docs/openapi.yaml", want: true},
+		{file: "types/index.d.ts", want: true},
+		{file: "README.md", want: false},
+		{file: "internal/cli/plan.go", patterns: []string{"internal/cli/**"}, want: true},
+		{file: "api/v1/service.proto", patterns: []string{"*.proto"}, want: true},
+		{file: "pkg/plugin/interface.go", patterns: []string{"api/*"}, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := matchesAPIPath(tt.file, tt.patterns); got != tt.want {
+			t.Errorf("matchesAPIPath(%q, %v) = %v, want %v", tt.file, tt.patterns, got, tt.want)
+		}
+	}
+}
+
+func TestDiffExamples_Truncates(t *testing.T) {
+	var diff strings.Builder
+	for i := 0; i < maxExampleLines+3; i++ {
+		diff.WriteString("+line\n")
+	}
+
+	before, after := diffExamples(diff.String())
+	if before != "" {
+		t.Errorf("before = %q, want empty", before)
+	}
+	lines := strings.Split(after, "\n")
+	if len(lines) != maxExampleLines+1 || lines[maxExampleLines] != "// ... 3 more lines" {
+		t.Errorf("after = %q", after)
+	}
+}
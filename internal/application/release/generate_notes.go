@@ -3,11 +3,13 @@ package release
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
 	"github.com/felixgeelhaar/release-pilot/internal/domain/communication"
 	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
 )
 
 // GenerateNotesInput represents the input for the GenerateNotes use case.
@@ -18,12 +20,14 @@ type GenerateNotesInput struct {
 	Audience         communication.NoteAudience
 	IncludeChangelog bool
 	RepositoryURL    string
+	Scheme           version.Scheme // Formats version headers; defaults to semver
 }
 
 // GenerateNotesOutput represents the output of the GenerateNotes use case.
 type GenerateNotesOutput struct {
 	ReleaseNotes *communication.ReleaseNotes
 	Changelog    *communication.Changelog
+	Fidelity     *release.FidelityReport // Set for AI-generated notes
 }
 
 // AINotesGenerator defines the interface for AI-based notes generation.
@@ -36,6 +40,24 @@ type AIGenerateInput struct {
 	ReleaseContext *release.Release
 	Tone           communication.NoteTone
 	Audience       communication.NoteAudience
+	VersionLabel   string
+}
+
+// AIGenerationError is returned by an AINotesGenerator when no provider could
+// generate the notes. Fallbacks lists the providers that were tried.
+type AIGenerationError struct {
+	Fallbacks []communication.ProviderFallback
+	Err       error
+}
+
+// Error implements the error interface.
+func (e *AIGenerationError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *AIGenerationError) Unwrap() error {
+	return e.Err
 }
 
 // GenerateNotesUseCase implements the generate notes use case.
@@ -74,6 +96,7 @@ func (uc *GenerateNotesUseCase) Execute(ctx context.Context, input GenerateNotes
 
 	plan := rel.Plan()
 	changeSet := plan.GetChangeSet()
+	label := version.SchemeOf(input.Scheme).Format(plan.NextVersion)
 
 	var notes *communication.ReleaseNotes
 	var changelog *communication.Changelog
@@ -85,24 +108,32 @@ func (uc *GenerateNotesUseCase) Execute(ctx context.Context, input GenerateNotes
 			ReleaseContext: rel,
 			Tone:           input.Tone,
 			Audience:       input.Audience,
+			VersionLabel:   label,
 		}
 		notes, err = uc.aiGenerator.GenerateReleaseNotes(ctx, aiInput)
 		if err != nil {
 			uc.logger.Warn("AI generation failed, falling back to standard generation",
 				"error", err,
 				"release_id", rel.ID())
-			// Fall back to standard generation
-			notes = communication.CreateFromChangeSet(plan.NextVersion, changeSet)
+			// Fall back to standard generation, keeping the failed attempts
+			fallbacks := []communication.ProviderFallback{{Provider: "ai", Reason: err.Error()}}
+			var genErr *AIGenerationError
+			if errors.As(err, &genErr) {
+				fallbacks = genErr.Fallbacks
+			}
+			notes = communication.CreateFromChangeSet(plan.NextVersion, changeSet,
+				communication.WithVersionLabel(label),
+				func(b *communication.ReleaseNotesBuilder) { b.WithFallbacks(fallbacks) })
 		}
 	} else {
 		// Standard generation from changeset
-		notes = communication.CreateFromChangeSet(plan.NextVersion, changeSet)
+		notes = communication.CreateFromChangeSet(plan.NextVersion, changeSet, communication.WithVersionLabel(label))
 	}
 
 	// Generate changelog if requested
 	if input.IncludeChangelog {
 		changelog = communication.NewChangelog("Changelog", communication.FormatKeepAChangelog)
-		entry := communication.CreateEntryFromChangeSet(plan.NextVersion, changeSet, input.RepositoryURL)
+		entry := communication.CreateEntryFromChangeSet(plan.NextVersion, changeSet, input.RepositoryURL, communication.WithEntryLabel(label))
 		changelog.AddEntry(entry)
 	}
 
@@ -118,7 +149,26 @@ func (uc *GenerateNotesUseCase) Execute(ctx context.Context, input GenerateNotes
 		Changelog:   changelogContent,
 		Summary:     notes.Summary(),
 		AIGenerated: notes.IsAIGenerated(),
+		Provider:    notes.Provider(),
 		GeneratedAt: notes.GeneratedAt(),
+		Title:       notes.Title(),
+		Highlights:  notes.Highlights(),
+	}
+	for _, s := range notes.Sections() {
+		releaseNotes.Sections = append(releaseNotes.Sections, release.NotesSection{Title: s.Title, Items: s.Items})
+	}
+	for _, m := range notes.MigrationNotes() {
+		releaseNotes.MigrationNotes = append(releaseNotes.MigrationNotes, release.MigrationNote{Change: m.Change, Migration: m.Migration})
+	}
+
+	// Cross-check AI output against the commits it was generated from
+	if releaseNotes.AIGenerated {
+		releaseNotes.Fidelity = release.VerifyNotes(releaseNotes, changeSet)
+		if !releaseNotes.Fidelity.IsClean() {
+			uc.logger.Warn("AI release notes need review",
+				"fidelity", releaseNotes.Fidelity.String(),
+				"release_id", rel.ID())
+		}
 	}
 
 	if err := rel.SetNotes(releaseNotes); err != nil {
@@ -143,5 +193,6 @@ func (uc *GenerateNotesUseCase) Execute(ctx context.Context, input GenerateNotes
 	return &GenerateNotesOutput{
 		ReleaseNotes: notes,
 		Changelog:    changelog,
+		Fidelity:     releaseNotes.Fidelity,
 	}, nil
 }
@@ -4,6 +4,7 @@ package release
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
@@ -362,6 +363,71 @@ func (c *customAIGenerator) GenerateReleaseNotes(ctx context.Context, input AIGe
 	return c.notes, nil
 }
 
+func TestGenerateNotesUseCase_RecordsProvider(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		aiGenerator   AINotesGenerator
+		wantProvider  string
+		wantFallbacks []string
+	}{
+		{
+			name: "fallback provider produced the notes",
+			aiGenerator: &mockAINotesGenerator{
+				notes: communication.NewReleaseNotesBuilder(version.MustParse("1.1.0")).
+					AIGenerated().
+					WithProvider("openai").
+					WithFallbacks([]communication.ProviderFallback{{Provider: "anthropic", Reason: "circuit breaker is open"}}).
+					Build(),
+			},
+			wantProvider:  "openai",
+			wantFallbacks: []string{"anthropic"},
+		},
+		{
+			name: "all providers failed",
+			aiGenerator: &mockAINotesGenerator{
+				err: &AIGenerationError{
+					Fallbacks: []communication.ProviderFallback{
+						{Provider: "anthropic", Reason: "circuit breaker is open"},
+						{Provider: "ollama", Reason: "connection refused"},
+					},
+					Err: errors.New("all AI providers failed"),
+				},
+			},
+			wantProvider:  communication.ProviderTemplate,
+			wantFallbacks: []string{"anthropic", "ollama"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			releaseRepo := newMockReleaseRepository()
+			releaseRepo.releases["release-123"] = createReleaseWithPlan("release-123", "main", "/path/to/repo")
+
+			uc := NewGenerateNotesUseCase(releaseRepo, tt.aiGenerator, &mockEventPublisher{})
+			output, err := uc.Execute(ctx, GenerateNotesInput{ReleaseID: "release-123", UseAI: true})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			if got := output.ReleaseNotes.Provider(); got != tt.wantProvider {
+				t.Errorf("Provider() = %q, want %q", got, tt.wantProvider)
+			}
+			var fallbacks []string
+			for _, f := range output.ReleaseNotes.Fallbacks() {
+				fallbacks = append(fallbacks, f.Provider)
+			}
+			if strings.Join(fallbacks, ",") != strings.Join(tt.wantFallbacks, ",") {
+				t.Errorf("Fallbacks() = %v, want %v", fallbacks, tt.wantFallbacks)
+			}
+			if got := releaseRepo.releases["release-123"].Notes().Provider; got != tt.wantProvider {
+				t.Errorf("saved notes Provider = %q, want %q", got, tt.wantProvider)
+			}
+		})
+	}
+}
+
 func TestGenerateNotesUseCase_ChangelogGeneration(t *testing.T) {
 	ctx := context.Background()
 
@@ -451,3 +517,75 @@ func TestGenerateNotesUseCase_NotesContentValidation(t *testing.T) {
 		t.Error("expected GeneratedAt to be set")
 	}
 }
+
+func TestGenerateNotesUseCase_PersistsStructuredNotes(t *testing.T) {
+	releaseRepo := newMockReleaseRepository()
+	releaseRepo.releases["release-123"] = createReleaseWithPlan("release-123", "main", "/path/to/repo")
+
+	aiGenerator := &mockAINotesGenerator{
+		notes: communication.NewReleaseNotesBuilder(version.MustParse("1.1.0")).
+			WithTitle("Faster search").
+			WithSummary("Search got faster.").
+			WithHighlights([]string{"2x faster queries"}).
+			AddSection(communication.NotesSection{Title: "Performance", Items: []string{"Cache query plans"}}).
+			WithMigrationNotes([]communication.MigrationNote{{Change: "Index format", Migration: "Run `reindex` once."}}).
+			AIGenerated().
+			Build(),
+	}
+
+	uc := NewGenerateNotesUseCase(releaseRepo, aiGenerator, &mockEventPublisher{})
+	if _, err := uc.Execute(context.Background(), GenerateNotesInput{ReleaseID: "release-123", UseAI: true}); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	saved := releaseRepo.releases["release-123"].Notes()
+	if saved.Title != "Faster search" || len(saved.Highlights) != 1 {
+		t.Errorf("saved title/highlights = %q/%v", saved.Title, saved.Highlights)
+	}
+	if len(saved.Sections) != 1 || saved.Sections[0].Title != "Performance" || saved.Sections[0].Items[0] != "Cache query plans" {
+		t.Errorf("saved Sections = %+v", saved.Sections)
+	}
+	if len(saved.MigrationNotes) != 1 || saved.MigrationNotes[0].Migration != "Run `reindex` once." {
+		t.Errorf("saved MigrationNotes = %+v", saved.MigrationNotes)
+	}
+}
+
+func TestGenerateNotesUseCase_VerifiesAINotes(t *testing.T) {
+	releaseRepo := newMockReleaseRepository()
+	releaseRepo.releases["release-123"] = createReleaseWithPlan("release-123", "main", "/path/to/repo")
+
+	aiGenerator := &mockAINotesGenerator{
+		notes: communication.NewReleaseNotesBuilder(version.MustParse("1.1.0")).
+			WithSummary("Login no longer fails.").
+			WithHighlights([]string{"Dark mode for every dashboard widget"}).
+			AIGenerated().
+			Build(),
+	}
+
+	uc := NewGenerateNotesUseCase(releaseRepo, aiGenerator, &mockEventPublisher{})
+	output, err := uc.Execute(context.Background(), GenerateNotesInput{ReleaseID: "release-123", UseAI: true})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	report := output.Fidelity
+	if report == nil {
+		t.Fatal("Fidelity should be set for AI-generated notes")
+	}
+	if report.Claims != 2 || len(report.Unsupported) != 1 || report.Unsupported[0].Text != "Dark mode for every dashboard widget" {
+		t.Errorf("Fidelity = %+v, want the dark mode highlight unsupported", report)
+	}
+	if releaseRepo.releases["release-123"].Notes().Fidelity != report {
+		t.Error("the fidelity report should be saved with the notes")
+	}
+
+	// Template notes are built from the commits and need no verification
+	releaseRepo.releases["release-123"] = createReleaseWithPlan("release-123", "main", "/path/to/repo")
+	output, err = uc.Execute(context.Background(), GenerateNotesInput{ReleaseID: "release-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.Fidelity != nil {
+		t.Errorf("Fidelity = %+v, want nil for template notes", output.Fidelity)
+	}
+}
@@ -0,0 +1,427 @@
+// Package release provides application use cases for release management.
+package release
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/sourcecontrol"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+)
+
+// packageNamePattern restricts package names to characters that are safe in
+// tag names and release IDs.
+var packageNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// PlanPackagesInput represents the input for the PlanPackages use case.
+type PlanPackagesInput struct {
+	RepositoryPath string
+	Branch         string
+	ToRef          string
+	DryRun         bool
+	// Packages are the monorepo packages to plan independently.
+	Packages []release.PackageRef
+	// Dependencies maps a package name to the names of the packages it depends on.
+	Dependencies map[string][]string
+	// Cascade gives the dependents of every released package at least a patch release.
+	Cascade bool
+}
+
+// Validate validates the PlanPackagesInput.
+func (i *PlanPackagesInput) Validate() error {
+	if i.RepositoryPath != "" && strings.Contains(filepath.Clean(i.RepositoryPath), "..") {
+		return fmt.Errorf("repository path contains invalid traversal: %s", i.RepositoryPath)
+	}
+
+	if i.ToRef != "" && strings.ContainsAny(i.ToRef, ":?*[\\ ") {
+		return fmt.Errorf("invalid to reference: %s", i.ToRef)
+	}
+
+	if len(i.Packages) == 0 {
+		return fmt.Errorf("no packages to plan")
+	}
+
+	seen := make(map[string]bool, len(i.Packages))
+	for _, pkg := range i.Packages {
+		if !packageNamePattern.MatchString(pkg.Name) {
+			return fmt.Errorf("invalid package name: %q", pkg.Name)
+		}
+		if seen[pkg.Name] {
+			return fmt.Errorf("duplicate package name: %s", pkg.Name)
+		}
+		seen[pkg.Name] = true
+
+		if pkg.Path == "" || filepath.IsAbs(pkg.Path) || strings.Contains(filepath.Clean(pkg.Path), "..") {
+			return fmt.Errorf("invalid path for package %s: %q", pkg.Name, pkg.Path)
+		}
+		if pkg.TagPrefix == "" {
+			return fmt.Errorf("tag prefix required for package %s", pkg.Name)
+		}
+		if strings.ContainsAny(pkg.TagPrefix, "~^:?*[\\ ") {
+			return fmt.Errorf("tag prefix contains invalid characters: %s", pkg.TagPrefix)
+		}
+	}
+
+	return nil
+}
+
+// PackagePlanOutput describes the planned release of a single package.
+type PackagePlanOutput struct {
+	Package        release.PackageRef
+	ReleaseID      release.ReleaseID
+	CurrentVersion version.SemanticVersion
+	NextVersion    version.SemanticVersion
+	ReleaseType    changes.ReleaseType
+	ChangeSet      *changes.ChangeSet
+	TagName        string
+	// CascadedFrom lists the released dependencies that caused this package to be bumped.
+	CascadedFrom []CascadeReason
+}
+
+// CascadeReason explains why a package was bumped because of one of its dependencies.
+type CascadeReason struct {
+	Dependency string
+	Version    version.SemanticVersion
+}
+
+// PlanPackagesOutput represents the output of the PlanPackages use case.
+type PlanPackagesOutput struct {
+	GroupID        release.ReleaseGroupID
+	Plans          []PackagePlanOutput
+	Unchanged      []release.PackageRef
+	RepositoryName string
+	Branch         string
+}
+
+// PlanPackagesUseCase plans independent releases for each package of a monorepo.
+// Commits are attributed to a package by the files they touch, and each package
+// is versioned from its own prefixed tags (e.g., "api/v1.2.0").
+type PlanPackagesUseCase struct {
+	releaseRepo    release.Repository
+	gitRepo        sourcecontrol.GitRepository
+	fileReader     sourcecontrol.CommitFileReader
+	versionCalc    version.VersionCalculator
+	eventPublisher release.EventPublisher
+	logger         *slog.Logger
+}
+
+// NewPlanPackagesUseCase creates a new PlanPackagesUseCase.
+func NewPlanPackagesUseCase(
+	releaseRepo release.Repository,
+	gitRepo sourcecontrol.GitRepository,
+	fileReader sourcecontrol.CommitFileReader,
+	versionCalc version.VersionCalculator,
+	eventPublisher release.EventPublisher,
+) *PlanPackagesUseCase {
+	return &PlanPackagesUseCase{
+		releaseRepo:    releaseRepo,
+		gitRepo:        gitRepo,
+		fileReader:     fileReader,
+		versionCalc:    versionCalc,
+		eventPublisher: eventPublisher,
+		logger:         slog.Default().With("usecase", "plan_packages"),
+	}
+}
+
+// Execute executes the plan packages use case.
+// Packages without relevant commits are reported as unchanged and no release is created for them.
+func (uc *PlanPackagesUseCase) Execute(ctx context.Context, input PlanPackagesInput) (*PlanPackagesOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+
+	repoInfo, err := uc.gitRepo.GetInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository info: %w", err)
+	}
+
+	if repoInfo.IsDirty && !input.DryRun {
+		return nil, sourcecontrol.ErrWorkingTreeDirty
+	}
+
+	branch := input.Branch
+	if branch == "" {
+		branch = repoInfo.CurrentBranch
+	}
+
+	now := time.Now().UnixNano()
+	output := &PlanPackagesOutput{
+		GroupID:        release.ReleaseGroupID(fmt.Sprintf("grp-%d", now)),
+		RepositoryName: repoInfo.Name,
+		Branch:         branch,
+	}
+
+	// Commit file lists are shared between packages, so cache them per hash
+	fileCache := make(map[sourcecontrol.CommitHash][]string)
+	releases := make([]*release.Release, 0, len(input.Packages))
+
+	planFn := func(pkg release.PackageRef, minType changes.ReleaseType) (*PackagePlanOutput, *release.Release, error) {
+		plan, rel, err := uc.planPackage(ctx, input, pkg, minType, branch, repoInfo.Name, output.GroupID, now, fileCache)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to plan package %s: %w", pkg.Name, err)
+		}
+		return plan, rel, nil
+	}
+
+	for _, pkg := range input.Packages {
+		plan, rel, err := planFn(pkg, changes.ReleaseTypeNone)
+		if err != nil {
+			return nil, err
+		}
+		if plan == nil {
+			output.Unchanged = append(output.Unchanged, pkg)
+			continue
+		}
+		output.Plans = append(output.Plans, *plan)
+		releases = append(releases, rel)
+	}
+
+	if input.Cascade {
+		var err error
+		if releases, err = cascadeBumps(input, output, releases, planFn); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.DryRun {
+		return output, nil
+	}
+
+	for _, rel := range releases {
+		if err := uc.releaseRepo.Save(ctx, rel); err != nil {
+			return nil, fmt.Errorf("failed to save release %s: %w", rel.ID(), err)
+		}
+
+		if uc.eventPublisher != nil {
+			if err := uc.eventPublisher.Publish(ctx, rel.DomainEvents()...); err != nil {
+				uc.logger.Warn("failed to publish domain events",
+					"error", err,
+					"release_id", rel.ID())
+			}
+			rel.ClearDomainEvents()
+		}
+	}
+
+	return output, nil
+}
+
+// planPackage plans the release of a single package with at least a minType release.
+// It returns a nil plan if the package has no changes and no minimum release type.
+func (uc *PlanPackagesUseCase) planPackage(
+	ctx context.Context,
+	input PlanPackagesInput,
+	pkg release.PackageRef,
+	minType changes.ReleaseType,
+	branch, repoName string,
+	groupID release.ReleaseGroupID,
+	now int64,
+	fileCache map[sourcecontrol.CommitHash][]string,
+) (*PackagePlanOutput, *release.Release, error) {
+	scheme := version.SchemeOf(uc.versionCalc)
+	versionDiscovery := sourcecontrol.NewVersionDiscovery(pkg.TagPrefix).WithScheme(scheme)
+	currentVersion, err := versionDiscovery.DiscoverCurrentVersion(ctx, uc.gitRepo)
+	if err != nil {
+		// If no version found, start with initial
+		currentVersion = version.Initial
+	}
+
+	var fromRef string
+	if latestTag, tagErr := versionDiscovery.LatestTag(ctx, uc.gitRepo); tagErr == nil && latestTag != nil {
+		fromRef = latestTag.Name()
+	}
+
+	var commits []*sourcecontrol.Commit
+	if fromRef != "" {
+		toRef := input.ToRef
+		if toRef == "" {
+			toRef = "HEAD"
+		}
+		commits, err = uc.gitRepo.GetCommitsBetween(ctx, fromRef, toRef)
+	} else {
+		commits, err = uc.gitRepo.GetCommitsSince(ctx, "")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get commits: %w", err)
+	}
+
+	scoped, err := uc.commitsInPackage(ctx, commits, pkg.Path, fileCache)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changeSetID := changes.ChangeSetID(fmt.Sprintf("cs-%d-%s", now, pkg.Name))
+	changeSet := buildChangeSet(changeSetID, scoped, fromRef, input.ToRef)
+	if changeSet.IsEmpty() && minType == changes.ReleaseTypeNone {
+		return nil, nil, nil
+	}
+
+	releaseType := changes.MaxReleaseType(changeSet.ReleaseType(), minType)
+	nextVersion := uc.versionCalc.CalculateNextVersion(currentVersion, releaseType.ToBumpType())
+
+	releaseID := release.ReleaseID(fmt.Sprintf("rel-%d-%s", now, pkg.Name))
+	rel := release.NewRelease(releaseID, branch, input.RepositoryPath)
+	rel.SetRepositoryName(repoName)
+	if err := rel.AssignPackage(pkg, groupID); err != nil {
+		return nil, nil, err
+	}
+
+	plan := release.NewReleasePlan(currentVersion, nextVersion, releaseType, changeSet, input.DryRun)
+	if err := rel.SetPlan(plan); err != nil {
+		return nil, nil, fmt.Errorf("failed to set release plan: %w", err)
+	}
+
+	return &PackagePlanOutput{
+		Package:        pkg,
+		ReleaseID:      releaseID,
+		CurrentVersion: currentVersion,
+		NextVersion:    nextVersion,
+		ReleaseType:    releaseType,
+		ChangeSet:      changeSet,
+		TagName:        pkg.TagPrefix + scheme.Format(nextVersion),
+	}, rel, nil
+}
+
+// commitsInPackage returns the commits that touch at least one file under pkgPath.
+func (uc *PlanPackagesUseCase) commitsInPackage(
+	ctx context.Context,
+	commits []*sourcecontrol.Commit,
+	pkgPath string,
+	fileCache map[sourcecontrol.CommitHash][]string,
+) ([]*sourcecontrol.Commit, error) {
+	pkgPath = path.Clean(filepath.ToSlash(pkgPath))
+	if pkgPath == "." {
+		return commits, nil
+	}
+
+	scoped := make([]*sourcecontrol.Commit, 0, len(commits))
+	for _, commit := range commits {
+		files, ok := fileCache[commit.Hash()]
+		if !ok {
+			var err error
+			files, err = uc.fileReader.GetCommitFiles(ctx, commit.Hash())
+			if err != nil {
+				return nil, fmt.Errorf("failed to get files for commit %s: %w", commit.ShortHash(), err)
+			}
+			fileCache[commit.Hash()] = files
+		}
+
+		for _, file := range files {
+			if file == pkgPath || strings.HasPrefix(file, pkgPath+"/") {
+				scoped = append(scoped, commit)
+				break
+			}
+		}
+	}
+
+	return scoped, nil
+}
+
+// cascadeBumps gives every dependent of a package that gets a new version at least a
+// patch release, following the dependency graph transitively. Packages released
+// without a version bump (e.g., only chores) do not cascade. Plans and releases are
+// kept in step.
+func cascadeBumps(
+	input PlanPackagesInput,
+	output *PlanPackagesOutput,
+	releases []*release.Release,
+	planFn func(release.PackageRef, changes.ReleaseType) (*PackagePlanOutput, *release.Release, error),
+) ([]*release.Release, error) {
+	packages := make(map[string]release.PackageRef, len(input.Packages))
+	for _, pkg := range input.Packages {
+		packages[pkg.Name] = pkg
+	}
+
+	dependents := make(map[string][]string)
+	for name, deps := range input.Dependencies {
+		for _, dep := range deps {
+			dependents[dep] = append(dependents[dep], name)
+		}
+	}
+	for _, names := range dependents {
+		sort.Strings(names)
+	}
+
+	planned := make(map[string]int, len(output.Plans))
+	queue := make([]string, 0, len(output.Plans))
+	for i, plan := range output.Plans {
+		planned[plan.Package.Name] = i
+		if plan.ReleaseType != changes.ReleaseTypeNone {
+			queue = append(queue, plan.Package.Name)
+		}
+	}
+
+	for len(queue) > 0 {
+		dep := queue[0]
+		queue = queue[1:]
+		reason := CascadeReason{Dependency: dep, Version: output.Plans[planned[dep]].NextVersion}
+
+		for _, name := range dependents[dep] {
+			if i, ok := planned[name]; ok {
+				// Already released on its own; a release without a version bump
+				// (e.g., only chores) still needs a patch to pick up the dependency.
+				if output.Plans[i].ReleaseType == changes.ReleaseTypeNone {
+					plan, rel, err := planFn(output.Plans[i].Package, changes.ReleaseTypePatch)
+					if err != nil {
+						return nil, err
+					}
+					plan.CascadedFrom = output.Plans[i].CascadedFrom
+					output.Plans[i], releases[i] = *plan, rel
+					queue = append(queue, name)
+				}
+				if !hasCascadeReason(output.Plans[i].CascadedFrom, dep) {
+					output.Plans[i].CascadedFrom = append(output.Plans[i].CascadedFrom, reason)
+				}
+				continue
+			}
+
+			pkg, ok := packages[name]
+			if !ok {
+				continue
+			}
+
+			plan, rel, err := planFn(pkg, changes.ReleaseTypePatch)
+			if err != nil {
+				return nil, err
+			}
+			plan.CascadedFrom = []CascadeReason{reason}
+
+			planned[name] = len(output.Plans)
+			output.Plans = append(output.Plans, *plan)
+			releases = append(releases, rel)
+			output.Unchanged = removePackage(output.Unchanged, name)
+			queue = append(queue, name)
+		}
+	}
+
+	return releases, nil
+}
+
+// hasCascadeReason returns true if reasons already mention the dependency.
+func hasCascadeReason(reasons []CascadeReason, dependency string) bool {
+	for _, r := range reasons {
+		if r.Dependency == dependency {
+			return true
+		}
+	}
+	return false
+}
+
+// removePackage removes the named package from pkgs.
+func removePackage(pkgs []release.PackageRef, name string) []release.PackageRef {
+	result := pkgs[:0]
+	for _, pkg := range pkgs {
+		if pkg.Name != name {
+			result = append(result, pkg)
+		}
+	}
+	return result
+}
@@ -0,0 +1,346 @@
+// Package release provides application use cases for release management.
+package release
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/sourcecontrol"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+)
+
+func TestPlanPackagesInput_Validate(t *testing.T) {
+	api := release.PackageRef{Name: "api", Path: "services/api", TagPrefix: "api/v"}
+
+	tests := []struct {
+		name    string
+		input   PlanPackagesInput
+		wantErr string
+	}{
+		{name: "valid", input: PlanPackagesInput{Packages: []release.PackageRef{api}}},
+		{name: "no packages", input: PlanPackagesInput{}, wantErr: "no packages"},
+		{
+			name:    "invalid name",
+			input:   PlanPackagesInput{Packages: []release.PackageRef{{Name: "../api", Path: "api", TagPrefix: "api/v"}}},
+			wantErr: "invalid package name",
+		},
+		{
+			name:    "duplicate name",
+			input:   PlanPackagesInput{Packages: []release.PackageRef{api, api}},
+			wantErr: "duplicate package name",
+		},
+		{
+			name:    "path traversal",
+			input:   PlanPackagesInput{Packages: []release.PackageRef{{Name: "api", Path: "../api", TagPrefix: "api/v"}}},
+			wantErr: "invalid path",
+		},
+		{
+			name:    "missing tag prefix",
+			input:   PlanPackagesInput{Packages: []release.PackageRef{{Name: "api", Path: "services/api"}}},
+			wantErr: "tag prefix required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPlanPackagesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	gitRepo := &mockGitRepository{
+		info: &sourcecontrol.RepositoryInfo{Name: "mono", CurrentBranch: "main"},
+		commits: []*sourcecontrol.Commit{
+			createTestCommit("c1", "feat(api): add endpoint"),
+			createTestCommit("c2", "fix(web): fix layout"),
+			createTestCommit("c3", "chore: update root tooling"),
+			createTestCommit("c4", "feat!: drop legacy auth"),
+		},
+		latestTagErr: errors.New("no tags found"),
+		commitFiles: map[sourcecontrol.CommitHash][]string{
+			"c1": {"services/api/handler.go"},
+			"c2": {"apps/web/layout.tsx"},
+			"c3": {"Makefile"},
+			"c4": {"services/api/auth.go", "apps/web/auth.ts"},
+		},
+	}
+	releaseRepo := newMockReleaseRepository()
+
+	uc := NewPlanPackagesUseCase(releaseRepo, gitRepo, gitRepo, &mockVersionCalculator{}, &mockEventPublisher{})
+
+	output, err := uc.Execute(ctx, PlanPackagesInput{
+		RepositoryPath: "/repo",
+		Packages: []release.PackageRef{
+			{Name: "api", Path: "services/api", TagPrefix: "api/v"},
+			{Name: "web", Path: "apps/web", TagPrefix: "web/v"},
+			{Name: "docs", Path: "docs", TagPrefix: "docs/v"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if len(output.Plans) != 2 {
+		t.Fatalf("len(Plans) = %d, want 2", len(output.Plans))
+	}
+	if len(output.Unchanged) != 1 || output.Unchanged[0].Name != "docs" {
+		t.Errorf("Unchanged = %v, want [docs]", output.Unchanged)
+	}
+
+	api, web := output.Plans[0], output.Plans[1]
+	if api.ChangeSet.CommitCount() != 2 {
+		t.Errorf("api commit count = %d, want 2", api.ChangeSet.CommitCount())
+	}
+	if api.ReleaseType != changes.ReleaseTypeMajor {
+		t.Errorf("api release type = %s, want major", api.ReleaseType)
+	}
+	if api.TagName != "api/v1.0.0" {
+		t.Errorf("api tag = %s, want api/v1.0.0", api.TagName)
+	}
+	if web.ChangeSet.CommitCount() != 2 {
+		t.Errorf("web commit count = %d, want 2", web.ChangeSet.CommitCount())
+	}
+
+	// One release per package, all in the same group
+	grouped, _ := releaseRepo.FindByGroup(ctx, output.GroupID)
+	if len(grouped) != 2 {
+		t.Fatalf("saved releases in group = %d, want 2", len(grouped))
+	}
+	for _, rel := range grouped {
+		if !rel.IsPackageRelease() {
+			t.Errorf("release %s should be scoped to a package", rel.ID())
+		}
+		if rel.State() != release.StatePlanned {
+			t.Errorf("release %s state = %s, want planned", rel.ID(), rel.State())
+		}
+	}
+}
+
+func TestPlanPackagesUseCase_Execute_DryRun(t *testing.T) {
+	gitRepo := &mockGitRepository{
+		info:         &sourcecontrol.RepositoryInfo{Name: "mono", CurrentBranch: "main", IsDirty: true},
+		commits:      []*sourcecontrol.Commit{createTestCommit("c1", "feat: root feature")},
+		latestTagErr: errors.New("no tags found"),
+	}
+	releaseRepo := newMockReleaseRepository()
+
+	uc := NewPlanPackagesUseCase(releaseRepo, gitRepo, gitRepo, &mockVersionCalculator{}, &mockEventPublisher{})
+
+	// A root package ("." path) sees every commit
+	output, err := uc.Execute(context.Background(), PlanPackagesInput{
+		DryRun:   true,
+		Packages: []release.PackageRef{{Name: "root", Path: ".", TagPrefix: "v"}},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if len(output.Plans) != 1 {
+		t.Fatalf("len(Plans) = %d, want 1", len(output.Plans))
+	}
+	if releaseRepo.saveCalled {
+		t.Error("dry run should not save releases")
+	}
+}
+
+func TestPlanPackagesUseCase_Execute_Cascade(t *testing.T) {
+	ctx := context.Background()
+
+	gitRepo := &mockGitRepository{
+		info: &sourcecontrol.RepositoryInfo{Name: "mono", CurrentBranch: "main"},
+		commits: []*sourcecontrol.Commit{
+			createTestCommit("c1", "feat(core): add option"),
+		},
+		latestTagErr: errors.New("no tags found"),
+		commitFiles: map[sourcecontrol.CommitHash][]string{
+			"c1": {"libs/core/option.go"},
+		},
+	}
+
+	input := PlanPackagesInput{
+		RepositoryPath: "/repo",
+		Packages: []release.PackageRef{
+			{Name: "core", Path: "libs/core", TagPrefix: "core/v"},
+			{Name: "api", Path: "services/api", TagPrefix: "api/v"},
+			{Name: "web", Path: "apps/web", TagPrefix: "web/v"},
+			{Name: "docs", Path: "docs", TagPrefix: "docs/v"},
+		},
+		// web -> api -> core
+		Dependencies: map[string][]string{
+			"api": {"core"},
+			"web": {"api"},
+		},
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		uc := NewPlanPackagesUseCase(newMockReleaseRepository(), gitRepo, gitRepo, &mockVersionCalculator{}, nil)
+		output, err := uc.Execute(ctx, input)
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if len(output.Plans) != 1 {
+			t.Errorf("len(Plans) = %d, want 1", len(output.Plans))
+		}
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		releaseRepo := newMockReleaseRepository()
+		uc := NewPlanPackagesUseCase(releaseRepo, gitRepo, gitRepo, &mockVersionCalculator{}, nil)
+
+		cascading := input
+		cascading.Cascade = true
+		output, err := uc.Execute(ctx, cascading)
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		if len(output.Plans) != 3 {
+			t.Fatalf("len(Plans) = %d, want 3", len(output.Plans))
+		}
+		if len(output.Unchanged) != 1 || output.Unchanged[0].Name != "docs" {
+			t.Errorf("Unchanged = %v, want [docs]", output.Unchanged)
+		}
+
+		byName := make(map[string]PackagePlanOutput)
+		for _, plan := range output.Plans {
+			byName[plan.Package.Name] = plan
+		}
+
+		if len(byName["core"].CascadedFrom) != 0 {
+			t.Errorf("core should not be cascaded, got %v", byName["core"].CascadedFrom)
+		}
+		for name, dep := range map[string]string{"api": "core", "web": "api"} {
+			plan := byName[name]
+			if plan.ReleaseType != changes.ReleaseTypePatch {
+				t.Errorf("%s release type = %s, want patch", name, plan.ReleaseType)
+			}
+			if len(plan.CascadedFrom) != 1 || plan.CascadedFrom[0].Dependency != dep {
+				t.Errorf("%s CascadedFrom = %v, want [%s]", name, plan.CascadedFrom, dep)
+			}
+			if plan.ChangeSet.CommitCount() != 0 {
+				t.Errorf("%s commit count = %d, want 0", name, plan.ChangeSet.CommitCount())
+			}
+		}
+
+		grouped, _ := releaseRepo.FindByGroup(ctx, output.GroupID)
+		if len(grouped) != 3 {
+			t.Errorf("saved releases in group = %d, want 3", len(grouped))
+		}
+	})
+
+	t.Run("unchanged version", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			commits map[string]string // message -> changed file
+			want    map[string]changes.ReleaseType
+		}{
+			{
+				name:    "chore-only dependency",
+				commits: map[string]string{"chore(core): tidy": "libs/core/option.go"},
+				want:    map[string]changes.ReleaseType{"core": changes.ReleaseTypeNone},
+			},
+			{
+				name: "chore-only dependent of a bumped package",
+				commits: map[string]string{
+					"feat(core): add option": "libs/core/option.go",
+					"chore(api): tidy":       "services/api/main.go",
+				},
+				want: map[string]changes.ReleaseType{
+					"core": changes.ReleaseTypeMinor,
+					"api":  changes.ReleaseTypePatch,
+					"web":  changes.ReleaseTypePatch,
+				},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := &mockGitRepository{
+					info:         &sourcecontrol.RepositoryInfo{Name: "mono", CurrentBranch: "main"},
+					latestTagErr: errors.New("no tags found"),
+					commitFiles:  make(map[sourcecontrol.CommitHash][]string),
+				}
+				i := 0
+				for message, file := range tt.commits {
+					hash := fmt.Sprintf("c%d", i)
+					repo.commits = append(repo.commits, createTestCommit(hash, message))
+					repo.commitFiles[sourcecontrol.CommitHash(hash)] = []string{file}
+					i++
+				}
+
+				uc := NewPlanPackagesUseCase(newMockReleaseRepository(), repo, repo, &mockVersionCalculator{}, nil)
+				cascading := input
+				cascading.Cascade = true
+				cascading.DryRun = true
+				output, err := uc.Execute(ctx, cascading)
+				if err != nil {
+					t.Fatalf("Execute() error = %v", err)
+				}
+
+				got := make(map[string]changes.ReleaseType, len(output.Plans))
+				for _, plan := range output.Plans {
+					got[plan.Package.Name] = plan.ReleaseType
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("planned release types = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	})
+}
+
+func TestPlanPackagesUseCase_Execute_CalVer(t *testing.T) {
+	gitRepo := &mockGitRepository{
+		info:             &sourcecontrol.RepositoryInfo{Name: "mono", CurrentBranch: "main"},
+		commits:          []*sourcecontrol.Commit{createTestCommit("c1", "fix(api): handle timeouts")},
+		latestVersionTag: sourcecontrol.NewTag("api/2025.07.0", "abc123"),
+		commitFiles: map[sourcecontrol.CommitHash][]string{
+			"c1": {"services/api/client.go"},
+		},
+	}
+
+	scheme, err := version.NewCalVerScheme("YYYY.0M.MICRO", version.WithCalVerClock(func() time.Time {
+		return time.Date(2025, time.July, 20, 0, 0, 0, 0, time.UTC)
+	}))
+	if err != nil {
+		t.Fatalf("NewCalVerScheme() error = %v", err)
+	}
+
+	uc := NewPlanPackagesUseCase(newMockReleaseRepository(), gitRepo, gitRepo, scheme, nil)
+	output, err := uc.Execute(context.Background(), PlanPackagesInput{
+		DryRun:   true,
+		Packages: []release.PackageRef{{Name: "api", Path: "services/api", TagPrefix: "api/"}},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if len(output.Plans) != 1 {
+		t.Fatalf("len(Plans) = %d, want 1", len(output.Plans))
+	}
+
+	plan := output.Plans[0]
+	if plan.CurrentVersion.String() != "2025.7.0" {
+		t.Errorf("current version = %s, want 2025.7.0", plan.CurrentVersion.String())
+	}
+	if plan.TagName != "api/2025.07.1" {
+		t.Errorf("tag = %s, want api/2025.07.1", plan.TagName)
+	}
+}
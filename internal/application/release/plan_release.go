@@ -23,6 +23,9 @@ type PlanReleaseInput struct {
 	ToRef          string
 	DryRun         bool
 	TagPrefix      string
+	// ReviewClassifications, if set, is called with the classifications
+	// inferred for unconventional commits before the bump is computed.
+	ReviewClassifications ClassificationReviewer
 }
 
 // Validate validates the PlanReleaseInput.
@@ -84,6 +87,9 @@ type PlanReleaseOutput struct {
 	ChangeSet      *changes.ChangeSet
 	RepositoryName string
 	Branch         string
+	// Inferred lists the unconventional commits that were classified and
+	// accepted into the plan.
+	Inferred []InferredCommit
 }
 
 // PlanReleaseUseCase implements the plan release use case.
@@ -92,23 +98,43 @@ type PlanReleaseUseCase struct {
 	gitRepo        sourcecontrol.GitRepository
 	versionCalc    version.VersionCalculator
 	eventPublisher release.EventPublisher
+	classifier     CommitClassifier
+	commitFiles    sourcecontrol.CommitFileReader
 	logger         *slog.Logger
 }
 
+// PlanReleaseOption configures optional PlanReleaseUseCase behavior.
+type PlanReleaseOption func(*PlanReleaseUseCase)
+
+// WithCommitClassifier classifies commits that are not conventional commits
+// instead of ignoring them. The classifier is given the paths each commit
+// touched if files is non-nil.
+func WithCommitClassifier(classifier CommitClassifier, files sourcecontrol.CommitFileReader) PlanReleaseOption {
+	return func(uc *PlanReleaseUseCase) {
+		uc.classifier = classifier
+		uc.commitFiles = files
+	}
+}
+
 // NewPlanReleaseUseCase creates a new PlanReleaseUseCase.
 func NewPlanReleaseUseCase(
 	releaseRepo release.Repository,
 	gitRepo sourcecontrol.GitRepository,
 	versionCalc version.VersionCalculator,
 	eventPublisher release.EventPublisher,
+	opts ...PlanReleaseOption,
 ) *PlanReleaseUseCase {
-	return &PlanReleaseUseCase{
+	uc := &PlanReleaseUseCase{
 		releaseRepo:    releaseRepo,
 		gitRepo:        gitRepo,
 		versionCalc:    versionCalc,
 		eventPublisher: eventPublisher,
 		logger:         slog.Default().With("usecase", "plan_release"),
 	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
 }
 
 // Execute executes the plan release use case.
@@ -135,7 +161,7 @@ func (uc *PlanReleaseUseCase) Execute(ctx context.Context, input PlanReleaseInpu
 		tagPrefix = "v"
 	}
 
-	versionDiscovery := sourcecontrol.NewVersionDiscovery(tagPrefix)
+	versionDiscovery := sourcecontrol.NewVersionDiscovery(tagPrefix).WithScheme(version.SchemeOf(uc.versionCalc))
 	currentVersion, err := versionDiscovery.DiscoverCurrentVersion(ctx, uc.gitRepo)
 	if err != nil {
 		// If no version found, start with initial
@@ -146,7 +172,7 @@ func (uc *PlanReleaseUseCase) Execute(ctx context.Context, input PlanReleaseInpu
 	fromRef := input.FromRef
 	if fromRef == "" {
 		// Use latest version tag
-		latestTag, tagErr := uc.gitRepo.GetLatestVersionTag(ctx, tagPrefix)
+		latestTag, tagErr := versionDiscovery.LatestTag(ctx, uc.gitRepo)
 		if tagErr == nil && latestTag != nil {
 			fromRef = latestTag.Name()
 		}
@@ -172,21 +198,16 @@ func (uc *PlanReleaseUseCase) Execute(ctx context.Context, input PlanReleaseInpu
 		return nil, changes.ErrNoCommitsFound
 	}
 
+	// Classify unconventional commits and let the user review them before
+	// they count towards the bump
+	inferred, err := uc.inferCommits(ctx, commits, input.ReviewClassifications)
+	if err != nil {
+		return nil, err
+	}
+
 	// Parse commits as conventional commits and build changeset
 	changeSetID := changes.ChangeSetID(fmt.Sprintf("cs-%d", time.Now().UnixNano()))
-	changeSet := changes.NewChangeSet(changeSetID, fromRef, input.ToRef)
-
-	for _, commit := range commits {
-		conventionalCommit := changes.ParseConventionalCommit(
-			string(commit.Hash()),
-			commit.Message(),
-			changes.WithAuthor(commit.Author().Name, commit.Author().Email),
-			changes.WithDate(commit.Date()),
-		)
-		if conventionalCommit != nil {
-			changeSet.AddCommit(conventionalCommit)
-		}
-	}
+	changeSet := buildChangeSetWithInferred(changeSetID, commits, inferred, fromRef, input.ToRef)
 
 	if changeSet.IsEmpty() {
 		return nil, changes.ErrEmptyChangeSet
@@ -244,5 +265,72 @@ func (uc *PlanReleaseUseCase) Execute(ctx context.Context, input PlanReleaseInpu
 		ChangeSet:      plan.GetChangeSet(),
 		RepositoryName: repoInfo.Name,
 		Branch:         branch,
+		Inferred:       inferred,
 	}, nil
 }
+
+// inferCommits classifies the unconventional commits if a classifier is
+// configured, and returns the classifications the reviewer accepted.
+func (uc *PlanReleaseUseCase) inferCommits(ctx context.Context, commits []*sourcecontrol.Commit, review ClassificationReviewer) ([]InferredCommit, error) {
+	if uc.classifier == nil {
+		return nil, nil
+	}
+
+	inferred, err := classifyCommits(ctx, uc.classifier, uc.commitFiles, commits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to classify commits: %w", err)
+	}
+	if len(inferred) == 0 || review == nil {
+		return inferred, nil
+	}
+
+	accepted, err := review(ctx, inferred)
+	if err != nil {
+		return nil, fmt.Errorf("failed to review commit classifications: %w", err)
+	}
+
+	valid := accepted[:0]
+	for _, c := range accepted {
+		if c.Classification.Type.IsValid() {
+			valid = append(valid, c)
+		}
+	}
+	uc.logger.Info("inferred commit classifications",
+		"classified", len(inferred),
+		"accepted", len(valid))
+	return valid, nil
+}
+
+// buildChangeSet parses commits as conventional commits and collects them in a changeset.
+func buildChangeSet(id changes.ChangeSetID, commits []*sourcecontrol.Commit, fromRef, toRef string) *changes.ChangeSet {
+	return buildChangeSetWithInferred(id, commits, nil, fromRef, toRef)
+}
+
+// buildChangeSetWithInferred builds a changeset like buildChangeSet, keeping
+// the unconventional commits that have an inferred classification.
+func buildChangeSetWithInferred(id changes.ChangeSetID, commits []*sourcecontrol.Commit, inferred []InferredCommit, fromRef, toRef string) *changes.ChangeSet {
+	classifications := make(map[string]CommitClassification, len(inferred))
+	for _, c := range inferred {
+		classifications[c.Commit.Hash] = c.Classification
+	}
+
+	changeSet := changes.NewChangeSet(id, fromRef, toRef)
+	for _, commit := range commits {
+		conventionalCommit := changes.ParseConventionalCommit(
+			string(commit.Hash()),
+			commit.Message(),
+			changes.WithAuthor(commit.Author().Name, commit.Author().Email),
+			changes.WithDate(commit.Date()),
+		)
+		if conventionalCommit == nil {
+			c, ok := classifications[string(commit.Hash())]
+			if !ok {
+				continue
+			}
+			conventionalCommit = inferredConventionalCommit(commit, c)
+		}
+		changeSet.AddCommit(conventionalCommit)
+	}
+
+	return changeSet
+}
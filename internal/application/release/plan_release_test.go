@@ -26,6 +26,15 @@ type mockGitRepository struct {
 	latestCommit     *sourcecontrol.Commit
 	latestCommitErr  error
 	pushTagErr       error
+	commitFiles      map[sourcecontrol.CommitHash][]string
+	commitDiffs      map[sourcecontrol.CommitHash]string
+	diffPaths        [][]string
+	createTagCalls   int
+	pushTagCalls     int
+	deletedTags      []string
+	deletedRemote    []string
+	deleteTagErr     error
+	deleteRemoteErr  error
 }
 
 func (m *mockGitRepository) GetInfo(ctx context.Context) (*sourcecontrol.RepositoryInfo, error) {
@@ -59,12 +68,24 @@ func (m *mockGitRepository) GetCommitsSince(ctx context.Context, ref string) ([]
 	return m.commits, m.commitsErr
 }
 
+func (m *mockGitRepository) GetCommitFiles(ctx context.Context, hash sourcecontrol.CommitHash) ([]string, error) {
+	return m.commitFiles[hash], nil
+}
+
+func (m *mockGitRepository) GetCommitDiff(ctx context.Context, hash sourcecontrol.CommitHash, paths []string) (string, error) {
+	m.diffPaths = append(m.diffPaths, paths)
+	return m.commitDiffs[hash], nil
+}
+
 func (m *mockGitRepository) GetLatestCommit(ctx context.Context, branch string) (*sourcecontrol.Commit, error) {
 	return m.latestCommit, m.latestCommitErr
 }
 
 func (m *mockGitRepository) GetTags(ctx context.Context) (sourcecontrol.TagList, error) {
-	return nil, nil
+	if m.latestVersionTag == nil {
+		return nil, nil
+	}
+	return sourcecontrol.TagList{m.latestVersionTag}, nil
 }
 
 func (m *mockGitRepository) GetTag(ctx context.Context, name string) (*sourcecontrol.Tag, error) {
@@ -76,17 +97,25 @@ func (m *mockGitRepository) GetLatestVersionTag(ctx context.Context, prefix stri
 }
 
 func (m *mockGitRepository) CreateTag(ctx context.Context, name string, hash sourcecontrol.CommitHash, message string) (*sourcecontrol.Tag, error) {
+	m.createTagCalls++
 	return m.tagCreated, m.tagCreateErr
 }
 
 func (m *mockGitRepository) DeleteTag(ctx context.Context, name string) error {
-	return nil
+	m.deletedTags = append(m.deletedTags, name)
+	return m.deleteTagErr
 }
 
 func (m *mockGitRepository) PushTag(ctx context.Context, name string, remote string) error {
+	m.pushTagCalls++
 	return m.pushTagErr
 }
 
+func (m *mockGitRepository) DeleteRemoteTag(ctx context.Context, name string, remote string) error {
+	m.deletedRemote = append(m.deletedRemote, name)
+	return m.deleteRemoteErr
+}
+
 func (m *mockGitRepository) IsDirty(ctx context.Context) (bool, error) {
 	if m.info != nil {
 		return m.info.IsDirty, nil
@@ -145,7 +174,13 @@ func (m *mockReleaseRepository) FindByID(ctx context.Context, id release.Release
 }
 
 func (m *mockReleaseRepository) FindByState(ctx context.Context, state release.ReleaseState) ([]*release.Release, error) {
-	return nil, nil
+	var releases []*release.Release
+	for _, rel := range m.releases {
+		if rel.State() == state {
+			releases = append(releases, rel)
+		}
+	}
+	return releases, nil
 }
 
 func (m *mockReleaseRepository) FindLatest(ctx context.Context, repoPath string) (*release.Release, error) {
@@ -156,6 +191,16 @@ func (m *mockReleaseRepository) FindActive(ctx context.Context) ([]*release.Rele
 	return nil, nil
 }
 
+func (m *mockReleaseRepository) FindByGroup(ctx context.Context, groupID release.ReleaseGroupID) ([]*release.Release, error) {
+	var result []*release.Release
+	for _, r := range m.releases {
+		if r.GroupID() == groupID {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
 func (m *mockReleaseRepository) Delete(ctx context.Context, id release.ReleaseID) error {
 	return nil
 }
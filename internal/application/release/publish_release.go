@@ -11,6 +11,7 @@ import (
 	"github.com/felixgeelhaar/release-pilot/internal/domain/integration"
 	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
 	"github.com/felixgeelhaar/release-pilot/internal/domain/sourcecontrol"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
 )
 
 // PublishReleaseInput represents the input for the PublishRelease use case.
@@ -21,6 +22,16 @@ type PublishReleaseInput struct {
 	PushTag   bool
 	TagPrefix string
 	Remote    string
+	Scheme    version.Scheme // Formats the tag version; defaults to semver
+	Resume    bool           // Resume an interrupted publish, skipping completed steps
+	// ApprovalPolicy, when set, must be satisfied by the recorded approvals.
+	ApprovalPolicy *release.ApprovalPolicy
+	// FreezeWindows block publishing while one of them is active.
+	FreezeWindows []release.FreezeWindow
+	// FreezeOverrideReason allows publishing during a freeze window. The
+	// reason is recorded on the release together with FreezeOverriddenBy.
+	FreezeOverrideReason string
+	FreezeOverriddenBy   string
 }
 
 // Validate validates the PublishReleaseInput.
@@ -58,6 +69,7 @@ type PublishReleaseOutput struct {
 	TagName       string
 	ReleaseURL    string
 	PluginResults []PluginResult
+	SkippedSteps  []string // Steps skipped because an earlier run completed them
 }
 
 // PluginResult represents the result of a plugin execution.
@@ -76,6 +88,7 @@ type PublishReleaseUseCase struct {
 	pluginExecutor integration.PluginExecutor
 	eventPublisher release.EventPublisher
 	logger         *slog.Logger
+	now            func() time.Time
 }
 
 // NewPublishReleaseUseCase creates a new PublishReleaseUseCase.
@@ -91,6 +104,7 @@ func NewPublishReleaseUseCase(
 		pluginExecutor: pluginExecutor,
 		eventPublisher: eventPublisher,
 		logger:         slog.Default().With("usecase", "publish_release"),
+		now:            time.Now,
 	}
 }
 
@@ -105,45 +119,97 @@ func (uc *PublishReleaseUseCase) Execute(ctx context.Context, input PublishRelea
 		return nil, fmt.Errorf("failed to find release: %w", err)
 	}
 
-	if !rel.CanProceedToPublish() {
+	if input.Resume {
+		if err := rel.ResumePublishing(); err != nil {
+			return nil, fmt.Errorf("cannot resume publish: %w", err)
+		}
+	} else if !rel.CanProceedToPublish() {
 		return nil, fmt.Errorf("release is not ready for publishing: current state is %s", rel.State())
 	}
 
-	tagName := uc.buildTagName(input.TagPrefix, rel.Plan().NextVersion.String())
+	// The policy may have been tightened since the release was approved,
+	// or since the publish was interrupted
+	if input.ApprovalPolicy != nil {
+		if status := rel.ApprovalStatus(*input.ApprovalPolicy); !status.Satisfied {
+			return nil, fmt.Errorf("%w: %s", release.ErrApprovalPolicyNotSatisfied, status)
+		}
+	}
+
+	if err := uc.checkFreezeWindows(rel, input); err != nil {
+		return nil, err
+	}
+
+	// A published release is only resumed to re-run plugins that failed
+	alreadyPublished := rel.State() == release.StatePublished
+
+	// Package releases in a monorepo carry their own tag prefix
+	tagPrefix := input.TagPrefix
+	if pkg := rel.Package(); pkg != nil {
+		tagPrefix = pkg.TagPrefix
+	}
+
+	tagName := uc.buildTagName(tagPrefix, version.SchemeOf(input.Scheme).Format(rel.Plan().NextVersion))
 	output := &PublishReleaseOutput{
 		TagName:       tagName,
 		PluginResults: make([]PluginResult, 0),
 	}
 
-	releaseCtx := uc.buildReleaseContext(rel, tagName, input.DryRun)
+	releaseCtx := buildReleaseContext(rel, tagName, input.DryRun)
 
-	if err := uc.executePrePublishPhase(ctx, rel, releaseCtx, output); err != nil {
+	if err := uc.executePrePublishPhase(ctx, rel, releaseCtx, input, output); err != nil {
 		return nil, err
 	}
 
-	if err := uc.executeGitTagPhase(ctx, rel, tagName, input); err != nil {
-		return nil, err
+	if !alreadyPublished {
+		if err := uc.executeGitTagPhase(ctx, rel, tagName, input, output); err != nil {
+			return nil, err
+		}
 	}
 
-	uc.executePostPublishPhase(ctx, rel, releaseCtx, tagName, output)
+	uc.executePostPublishPhase(ctx, rel, releaseCtx, tagName, input, output)
 
-	if err := uc.finalizePublish(ctx, rel, releaseCtx, tagName, input.DryRun, output); err != nil {
+	if err := uc.finalizePublish(ctx, rel, releaseCtx, tagName, input, output); err != nil {
 		return nil, err
 	}
 
 	return output, nil
 }
 
+// checkFreezeWindows blocks publishing during an active freeze window unless
+// the freeze is overridden, in which case the override is recorded.
+func (uc *PublishReleaseUseCase) checkFreezeWindows(rel *release.Release, input PublishReleaseInput) error {
+	now := uc.now()
+	window, frozen := release.ActiveFreezeWindow(input.FreezeWindows, now)
+	if !frozen {
+		return nil
+	}
+
+	if input.FreezeOverrideReason == "" {
+		until, _ := window.Until(now)
+		return fmt.Errorf("%w: %s until %s", release.ErrReleaseFrozen, window, until.Format(time.RFC3339))
+	}
+
+	if err := rel.OverrideFreeze(window, input.FreezeOverrideReason, input.FreezeOverriddenBy); err != nil {
+		return err
+	}
+	uc.logger.Warn("publishing during release freeze",
+		"release_id", rel.ID(),
+		"window", window.String(),
+		"reason", input.FreezeOverrideReason,
+		"overridden_by", input.FreezeOverriddenBy)
+	return nil
+}
+
 // buildTagName constructs the tag name from prefix and version.
-func (uc *PublishReleaseUseCase) buildTagName(prefix, version string) string {
+func (uc *PublishReleaseUseCase) buildTagName(prefix, ver string) string {
 	if prefix == "" {
 		prefix = "v"
 	}
-	return prefix + version
+	return prefix + ver
 }
 
 // buildReleaseContext creates the integration context for plugins.
-func (uc *PublishReleaseUseCase) buildReleaseContext(rel *release.Release, tagName string, dryRun bool) integration.ReleaseContext {
+func buildReleaseContext(rel *release.Release, tagName string, dryRun bool) integration.ReleaseContext {
 	plan := rel.Plan()
 	ctx := integration.ReleaseContext{
 		Version:         plan.NextVersion,
@@ -154,6 +220,7 @@ func (uc *PublishReleaseUseCase) buildReleaseContext(rel *release.Release, tagNa
 		Branch:          rel.Branch(),
 		TagName:         tagName,
 		Changes:         plan.GetChangeSet(),
+		PluginOutputs:   pluginOutputs(rel),
 		DryRun:          dryRun,
 		Timestamp:       time.Now(),
 	}
@@ -161,87 +228,179 @@ func (uc *PublishReleaseUseCase) buildReleaseContext(rel *release.Release, tagNa
 	if rel.Notes() != nil {
 		ctx.Changelog = rel.Notes().Changelog
 		ctx.ReleaseNotes = rel.Notes().Summary
+		ctx.Notes = structuredNotes(rel.Notes())
+		for _, l := range rel.Notes().Localized {
+			ctx.LocalizedNotes = append(ctx.LocalizedNotes, integration.LocalizedNotes{
+				Locale:       l.Locale,
+				Changelog:    l.Changelog,
+				ReleaseNotes: l.Summary,
+			})
+		}
+		if guide := rel.Notes().MigrationGuide; guide != nil {
+			ctx.MigrationGuide = guide.Markdown
+		}
 	}
 
 	return ctx
 }
 
+// structuredNotes converts release notes to the typed form passed to plugins.
+func structuredNotes(notes *release.ReleaseNotes) *integration.StructuredNotes {
+	result := &integration.StructuredNotes{
+		Title:      notes.Title,
+		Summary:    notes.Summary,
+		Highlights: notes.Highlights,
+	}
+	for _, s := range notes.Sections {
+		result.Sections = append(result.Sections, integration.NotesSection{Title: s.Title, Items: s.Items})
+	}
+	for _, m := range notes.MigrationNotes {
+		result.MigrationNotes = append(result.MigrationNotes, integration.MigrationNote{Change: m.Change, Migration: m.Migration})
+	}
+	return result
+}
+
+// pluginOutputs converts the plugin outputs recorded on a release to the
+// form passed to plugins.
+func pluginOutputs(rel *release.Release) map[string]integration.PluginOutput {
+	recorded := rel.PluginOutputs()
+	if recorded == nil {
+		return nil
+	}
+	outputs := make(map[string]integration.PluginOutput, len(recorded))
+	for name, o := range recorded {
+		output := integration.PluginOutput{Outputs: o.Outputs}
+		for _, a := range o.Artifacts {
+			output.Artifacts = append(output.Artifacts, integration.Artifact{
+				Name: a.Name,
+				Path: a.Path,
+				Type: a.Type,
+				Size: a.Size,
+				URL:  a.URL,
+			})
+		}
+		outputs[name] = output
+	}
+	return outputs
+}
+
+// recordPluginOutput records the outputs and artifacts of a plugin response
+// on the release, so later hooks and resumed runs can use them.
+func recordPluginOutput(rel *release.Release, name string, resp integration.ExecuteResponse) {
+	output := release.PluginOutput{Outputs: resp.Outputs}
+	for _, a := range resp.Artifacts {
+		output.Artifacts = append(output.Artifacts, release.PluginArtifact{
+			Name: a.Name,
+			Path: a.Path,
+			Type: a.Type,
+			Size: a.Size,
+			URL:  a.URL,
+		})
+	}
+	rel.RecordPluginOutput(name, output)
+}
+
 // executePrePublishPhase runs pre-publish hooks and starts publishing.
 func (uc *PublishReleaseUseCase) executePrePublishPhase(
 	ctx context.Context,
 	rel *release.Release,
 	releaseCtx integration.ReleaseContext,
+	input PublishReleaseInput,
 	output *PublishReleaseOutput,
 ) error {
 	if uc.pluginExecutor != nil {
-		preResults, err := uc.executeHook(ctx, rel, integration.HookPrePublish, releaseCtx)
+		preResults, err := uc.executeHook(ctx, rel, integration.HookPrePublish, releaseCtx, input.Resume, output)
 		if err != nil {
+			if rel.State() == release.StatePublishing {
+				uc.markReleaseFailed(ctx, rel, fmt.Sprintf("pre-publish hook failed: %v", err), input.DryRun)
+			}
 			return fmt.Errorf("pre-publish hook failed: %w", err)
 		}
 		output.PluginResults = append(output.PluginResults, preResults...)
 	}
 
+	// A resumed publish has already started
+	if rel.State() != release.StateApproved {
+		return nil
+	}
+
 	var pluginNames []string
 	if err := rel.StartPublishing(pluginNames); err != nil {
 		return fmt.Errorf("failed to start publishing: %w", err)
 	}
+	uc.saveProgress(ctx, rel, input.DryRun)
 
 	return nil
 }
 
 // executeGitTagPhase creates and optionally pushes the git tag.
+// Steps recorded as checkpoints by an earlier run are skipped.
 func (uc *PublishReleaseUseCase) executeGitTagPhase(
 	ctx context.Context,
 	rel *release.Release,
 	tagName string,
 	input PublishReleaseInput,
+	output *PublishReleaseOutput,
 ) error {
 	if !input.CreateTag || input.DryRun {
 		return nil
 	}
 
+	if rel.HasCheckpoint(release.StepTagCreated, "", "") {
+		output.SkippedSteps = append(output.SkippedSteps, fmt.Sprintf("create tag %s", tagName))
+	} else if err := uc.createTag(ctx, rel, tagName, input); err != nil {
+		return err
+	}
+
+	if !input.PushTag {
+		return nil
+	}
+
+	if rel.HasCheckpoint(release.StepTagPushed, "", "") {
+		output.SkippedSteps = append(output.SkippedSteps, fmt.Sprintf("push tag %s", tagName))
+		return nil
+	}
+	return uc.pushTag(ctx, rel, tagName, input.Remote)
+}
+
+// createTag creates the release tag unless it already exists.
+func (uc *PublishReleaseUseCase) createTag(
+	ctx context.Context,
+	rel *release.Release,
+	tagName string,
+	input PublishReleaseInput,
+) error {
 	// Check if tag already exists (may have been created by bump command)
 	existingTag, _ := uc.gitRepo.GetTag(ctx, tagName)
 	if existingTag != nil {
 		uc.logger.Info("tag already exists, skipping creation",
 			"tag", tagName,
 			"release_id", rel.ID())
-		// Tag exists, just push if needed
-		if input.PushTag {
-			if err := uc.pushTag(ctx, rel, tagName, input.Remote); err != nil {
-				return err
-			}
+	} else {
+		latestCommit, err := uc.gitRepo.GetLatestCommit(ctx, rel.Branch())
+		if err != nil {
+			uc.markReleaseFailed(ctx, rel, fmt.Sprintf("failed to get latest commit: %v", err), input.DryRun)
+			return fmt.Errorf("failed to get latest commit: %w", err)
 		}
-		return nil
-	}
-
-	latestCommit, err := uc.gitRepo.GetLatestCommit(ctx, rel.Branch())
-	if err != nil {
-		uc.markReleaseFailed(rel, fmt.Sprintf("failed to get latest commit: %v", err))
-		return fmt.Errorf("failed to get latest commit: %w", err)
-	}
 
-	tagMessage := uc.buildTagMessage(rel)
-	if _, err = uc.gitRepo.CreateTag(ctx, tagName, latestCommit.Hash(), tagMessage); err != nil {
-		uc.markReleaseFailed(rel, fmt.Sprintf("failed to create tag: %v", err))
-		return fmt.Errorf("failed to create tag: %w", err)
-	}
-
-	if input.PushTag {
-		if err := uc.pushTag(ctx, rel, tagName, input.Remote); err != nil {
-			return err
+		tagMessage := uc.buildTagMessage(rel, input.Scheme)
+		if _, err = uc.gitRepo.CreateTag(ctx, tagName, latestCommit.Hash(), tagMessage); err != nil {
+			uc.markReleaseFailed(ctx, rel, fmt.Sprintf("failed to create tag: %v", err), input.DryRun)
+			return fmt.Errorf("failed to create tag: %w", err)
 		}
 	}
 
+	rel.RecordCheckpoint(release.Checkpoint{Step: release.StepTagCreated})
+	uc.saveProgress(ctx, rel, input.DryRun)
 	return nil
 }
 
 // buildTagMessage creates the tag message from release notes or default.
-func (uc *PublishReleaseUseCase) buildTagMessage(rel *release.Release) string {
+func (uc *PublishReleaseUseCase) buildTagMessage(rel *release.Release, scheme version.Scheme) string {
 	if rel.Notes() != nil && rel.Notes().Summary != "" {
 		return rel.Notes().Summary
 	}
-	return fmt.Sprintf("Release %s", rel.Plan().NextVersion.String())
+	return fmt.Sprintf("Release %s", version.SchemeOf(scheme).Format(rel.Plan().NextVersion))
 }
 
 // pushTag pushes the tag to the remote repository.
@@ -250,19 +409,42 @@ func (uc *PublishReleaseUseCase) pushTag(ctx context.Context, rel *release.Relea
 		remote = "origin"
 	}
 	if err := uc.gitRepo.PushTag(ctx, tagName, remote); err != nil {
-		uc.markReleaseFailed(rel, fmt.Sprintf("failed to push tag: %v", err))
+		uc.markReleaseFailed(ctx, rel, fmt.Sprintf("failed to push tag: %v", err), false)
 		return fmt.Errorf("failed to push tag: %w", err)
 	}
+
+	rel.RecordCheckpoint(release.Checkpoint{Step: release.StepTagPushed})
+	uc.saveProgress(ctx, rel, false)
 	return nil
 }
 
-// markReleaseFailed marks the release as failed and logs any errors.
-func (uc *PublishReleaseUseCase) markReleaseFailed(rel *release.Release, reason string) {
+// markReleaseFailed marks the release as failed and saves it so the
+// publish can be resumed. Errors are logged.
+func (uc *PublishReleaseUseCase) markReleaseFailed(ctx context.Context, rel *release.Release, reason string, dryRun bool) {
 	if markErr := rel.MarkFailed(reason, true); markErr != nil {
 		uc.logger.Warn("failed to mark release as failed",
 			"error", markErr,
 			"release_id", rel.ID(),
 			"reason", reason)
+		return
+	}
+	uc.saveProgress(ctx, rel, dryRun)
+	if !dryRun {
+		// Failures belong in the event log as much as successes
+		uc.publishDomainEvents(ctx, rel)
+	}
+}
+
+// saveProgress persists the release so completed steps survive an
+// interrupted publish (errors are non-fatal).
+func (uc *PublishReleaseUseCase) saveProgress(ctx context.Context, rel *release.Release, dryRun bool) {
+	if dryRun {
+		return
+	}
+	if err := uc.releaseRepo.Save(ctx, rel); err != nil {
+		uc.logger.Warn("failed to save publish progress",
+			"error", err,
+			"release_id", rel.ID())
 	}
 }
 
@@ -272,13 +454,14 @@ func (uc *PublishReleaseUseCase) executePostPublishPhase(
 	rel *release.Release,
 	releaseCtx integration.ReleaseContext,
 	tagName string,
+	input PublishReleaseInput,
 	output *PublishReleaseOutput,
 ) {
 	if uc.pluginExecutor == nil {
 		return
 	}
 
-	postResults, err := uc.executeHook(ctx, rel, integration.HookPostPublish, releaseCtx)
+	postResults, err := uc.executeHook(ctx, rel, integration.HookPostPublish, releaseCtx, input.Resume, output)
 	if err != nil {
 		uc.logger.Warn("post-publish plugin hook failed",
 			"error", err,
@@ -286,6 +469,7 @@ func (uc *PublishReleaseUseCase) executePostPublishPhase(
 			"tag", tagName)
 	}
 	output.PluginResults = append(output.PluginResults, postResults...)
+	uc.saveProgress(ctx, rel, input.DryRun)
 }
 
 // finalizePublish marks release as published and executes success hooks.
@@ -294,18 +478,20 @@ func (uc *PublishReleaseUseCase) finalizePublish(
 	rel *release.Release,
 	releaseCtx integration.ReleaseContext,
 	tagName string,
-	dryRun bool,
+	input PublishReleaseInput,
 	output *PublishReleaseOutput,
 ) error {
-	if dryRun {
+	if input.DryRun {
 		return nil
 	}
 
-	if err := rel.MarkPublished(output.ReleaseURL); err != nil {
-		return fmt.Errorf("failed to mark release as published: %w", err)
+	if rel.State() != release.StatePublished {
+		if err := rel.MarkPublished(output.ReleaseURL); err != nil {
+			return fmt.Errorf("failed to mark release as published: %w", err)
+		}
 	}
 
-	uc.executeSuccessHooks(ctx, rel, releaseCtx, tagName, output)
+	uc.executeSuccessHooks(ctx, rel, releaseCtx, tagName, input.Resume, output)
 
 	if err := uc.releaseRepo.Save(ctx, rel); err != nil {
 		return fmt.Errorf("failed to save release: %w", err)
@@ -322,13 +508,14 @@ func (uc *PublishReleaseUseCase) executeSuccessHooks(
 	rel *release.Release,
 	releaseCtx integration.ReleaseContext,
 	tagName string,
+	resume bool,
 	output *PublishReleaseOutput,
 ) {
 	if uc.pluginExecutor == nil {
 		return
 	}
 
-	successResults, err := uc.executeHook(ctx, rel, integration.HookOnSuccess, releaseCtx)
+	successResults, err := uc.executeHook(ctx, rel, integration.HookOnSuccess, releaseCtx, resume, output)
 	if err != nil {
 		uc.logger.Warn("on-success plugin hook failed",
 			"error", err,
@@ -353,29 +540,141 @@ func (uc *PublishReleaseUseCase) publishDomainEvents(ctx context.Context, rel *r
 }
 
 // executeHook executes plugins for a hook and records results.
+// When resuming a hook that already ran, only the plugins that failed are re-run.
 func (uc *PublishReleaseUseCase) executeHook(
 	ctx context.Context,
 	rel *release.Release,
 	hook integration.Hook,
 	releaseCtx integration.ReleaseContext,
+	resume bool,
+	output *PublishReleaseOutput,
 ) ([]PluginResult, error) {
+	// Plugins see what the plugins of earlier hooks and runs reported
+	releaseCtx.PluginOutputs = pluginOutputs(rel)
+
+	if resume && hookStarted(rel, hook) {
+		return uc.rerunFailedPlugins(ctx, rel, hook, releaseCtx, output), nil
+	}
+
 	start := time.Now()
 	responses, err := uc.pluginExecutor.ExecuteHook(ctx, hook, releaseCtx)
 
 	results := make([]PluginResult, 0, len(responses))
 	for i, resp := range responses {
-		result := PluginResult{
-			PluginName: fmt.Sprintf("plugin-%d", i),
-			Hook:       hook,
-			Success:    resp.Success,
-			Message:    resp.Message,
-			Duration:   time.Since(start),
+		name := string(resp.PluginID)
+		if name == "" {
+			name = fmt.Sprintf("plugin-%d", i)
 		}
-		results = append(results, result)
+		results = append(results, uc.recordPluginResult(rel, name, hook, resp, time.Since(start), releaseCtx.DryRun))
+	}
 
-		// Record in release
-		rel.RecordPluginExecution(result.PluginName, string(hook), resp.Success, resp.Message, result.Duration)
+	if err == nil && !releaseCtx.DryRun {
+		rel.RecordCheckpoint(release.Checkpoint{Step: release.StepHook, Hook: string(hook)})
 	}
 
 	return results, err
 }
+
+// rerunFailedPlugins re-runs the plugins that failed for a hook in an earlier
+// run. The hook is checkpointed once none of its plugins is left failed.
+func (uc *PublishReleaseUseCase) rerunFailedPlugins(
+	ctx context.Context,
+	rel *release.Release,
+	hook integration.Hook,
+	releaseCtx integration.ReleaseContext,
+	output *PublishReleaseOutput,
+) []PluginResult {
+	for _, cp := range rel.Checkpoints() {
+		if cp.Step == release.StepPlugin && cp.Hook == string(hook) {
+			output.SkippedSteps = append(output.SkippedSteps, fmt.Sprintf("%s (%s)", cp.PluginName, hook))
+		}
+	}
+
+	var results []PluginResult
+	for _, failed := range rel.FailedPluginSteps() {
+		if failed.Hook != string(hook) {
+			continue
+		}
+
+		start := time.Now()
+		resp, err := uc.pluginExecutor.ExecutePlugin(ctx, integration.PluginID(failed.PluginName), integration.ExecuteRequest{
+			Hook:    hook,
+			Context: releaseCtx,
+			DryRun:  releaseCtx.DryRun,
+		})
+		if err != nil {
+			resp = &integration.ExecuteResponse{Success: false, Error: err.Error()}
+		}
+		results = append(results, uc.recordPluginResult(rel, failed.PluginName, hook, *resp, time.Since(start), releaseCtx.DryRun))
+	}
+
+	if !releaseCtx.DryRun && !hasFailedPlugins(rel, hook) {
+		rel.RecordCheckpoint(release.Checkpoint{Step: release.StepHook, Hook: string(hook)})
+	}
+
+	return results
+}
+
+// hasFailedPlugins returns true if a plugin of the hook failed and has not
+// succeeded since.
+func hasFailedPlugins(rel *release.Release, hook integration.Hook) bool {
+	for _, failed := range rel.FailedPluginSteps() {
+		if failed.Hook == string(hook) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordPluginResult records a plugin outcome on the release and checkpoints
+// successful runs so that a resumed publish does not repeat them.
+func (uc *PublishReleaseUseCase) recordPluginResult(
+	rel *release.Release,
+	name string,
+	hook integration.Hook,
+	resp integration.ExecuteResponse,
+	duration time.Duration,
+	dryRun bool,
+) PluginResult {
+	message := resp.Message
+	if message == "" {
+		message = resp.Error
+	}
+
+	result := PluginResult{
+		PluginName: name,
+		Hook:       hook,
+		Success:    resp.Success,
+		Message:    message,
+		Duration:   duration,
+	}
+
+	// Record in release
+	rel.RecordPluginExecution(result.PluginName, string(hook), resp.Success, message, result.Duration)
+	if resp.Success {
+		recordPluginOutput(rel, name, resp)
+	}
+	if resp.Success && !dryRun {
+		rel.RecordCheckpoint(release.Checkpoint{
+			Step:       release.StepPlugin,
+			PluginName: name,
+			Hook:       string(hook),
+			Outputs:    resp.Outputs,
+		})
+	}
+
+	return result
+}
+
+// hookStarted returns true if the hook already ran, fully or in part.
+func hookStarted(rel *release.Release, hook integration.Hook) bool {
+	if rel.HasCheckpoint(release.StepHook, "", string(hook)) {
+		return true
+	}
+	for _, exec := range rel.PluginExecutions() {
+		if exec.Hook == string(hook) {
+			return true
+		}
+	}
+	return false
+}
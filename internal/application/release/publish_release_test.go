@@ -4,6 +4,7 @@ package release
 import (
 	"context"
 	"errors"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -43,7 +44,7 @@ func newMockPluginExecutor() *mockPluginExecutor {
 func (m *mockPluginExecutor) ExecuteHook(ctx context.Context, hook integration.Hook, releaseCtx integration.ReleaseContext) ([]integration.ExecuteResponse, error) {
 	m.execCalls = append(m.execCalls, execCall{hook: hook, releaseCtx: releaseCtx})
 	if err, ok := m.errors[hook]; ok && err != nil {
-		return nil, err
+		return m.responses[hook], err
 	}
 	if resp, ok := m.responses[hook]; ok {
 		return resp, nil
@@ -86,6 +87,8 @@ func createApprovedRelease(id release.ReleaseID, branch, repoPath string) *relea
 		Summary:     "Release 1.1.0 with new feature",
 		AIGenerated: false,
 		GeneratedAt: time.Now(),
+		Title:       "Release 1.1.0",
+		Sections:    []release.NotesSection{{Title: "New Features", Items: []string{"new feature"}}},
 	}
 	_ = r.SetNotes(notes)
 
@@ -659,6 +662,9 @@ func TestPublishReleaseUseCase_ReleaseContextBuilding(t *testing.T) {
 
 	releaseRepo := newMockReleaseRepository()
 	r := createApprovedRelease("release-123", "main", "/path/to/repo")
+	_ = r.SetLocalizedNotes([]release.LocalizedNotes{{Locale: "ja", Changelog: "- 新機能", Summary: "新機能を含むリリース 1.1.0"}})
+	_ = r.SetMigrationGuide(&release.MigrationGuide{Markdown: "## Migrating to 1.1.0\n"})
+	_ = r.Approve("admin", false)
 	releaseRepo.releases["release-123"] = r
 
 	pluginExec := newMockPluginExecutor()
@@ -719,6 +725,67 @@ func TestPublishReleaseUseCase_ReleaseContextBuilding(t *testing.T) {
 	if releaseCtx.ReleaseNotes == "" {
 		t.Error("ReleaseNotes should not be empty")
 	}
+	if notes := releaseCtx.Notes; notes == nil || notes.Title != "Release 1.1.0" || len(notes.Sections) != 1 || notes.Sections[0].Items[0] != "new feature" {
+		t.Errorf("Notes = %+v, want the structured release notes", notes)
+	}
+	if l := releaseCtx.LocalizedNotes; len(l) != 1 || l[0].Locale != "ja" || l[0].ReleaseNotes != "新機能を含むリリース 1.1.0" {
+		t.Errorf("LocalizedNotes = %+v", l)
+	}
+	if releaseCtx.MigrationGuide != "## Migrating to 1.1.0\n" {
+		t.Errorf("MigrationGuide = %q", releaseCtx.MigrationGuide)
+	}
+}
+
+func TestPublishReleaseUseCase_PluginOutputs(t *testing.T) {
+	ctx := context.Background()
+
+	releaseRepo := newMockReleaseRepository()
+	r := createApprovedRelease("release-123", "main", "/path/to/repo")
+	releaseRepo.releases["release-123"] = r
+
+	pluginExec := newMockPluginExecutor()
+	pluginExec.responses[integration.HookPostPublish] = []integration.ExecuteResponse{
+		{
+			PluginID:  "github",
+			Success:   true,
+			Outputs:   map[string]any{"release_url": "https://github.com/owner/repo/releases/tag/v1.1.0"},
+			Artifacts: []integration.Artifact{{Name: "app.tar.gz", URL: "https://github.com/owner/repo/releases/download/v1.1.0/app.tar.gz"}},
+		},
+		{PluginID: "homebrew", Success: false, Error: "tap not found", Outputs: map[string]any{"tap": "owner/tap"}},
+	}
+
+	uc := NewPublishReleaseUseCase(releaseRepo, &mockGitRepository{
+		latestCommit: createTestCommit("abc123", "latest"),
+		tagCreated:   sourcecontrol.NewTag("v1.1.0", "abc123"),
+	}, pluginExec, &mockEventPublisher{})
+
+	if _, err := uc.Execute(ctx, PublishReleaseInput{ReleaseID: "release-123", CreateTag: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// on-success plugins see what post-publish plugins reported
+	var onSuccess integration.ReleaseContext
+	for _, call := range pluginExec.execCalls {
+		if call.hook == integration.HookOnSuccess {
+			onSuccess = call.releaseCtx
+		}
+	}
+	github, ok := onSuccess.PluginOutputs["github"]
+	if !ok || github.Outputs["release_url"] != "https://github.com/owner/repo/releases/tag/v1.1.0" {
+		t.Errorf("PluginOutputs[github] = %+v, want the release URL", github)
+	}
+	if len(github.Artifacts) != 1 || github.Artifacts[0].URL != "https://github.com/owner/repo/releases/download/v1.1.0/app.tar.gz" {
+		t.Errorf("Artifacts = %+v, want the asset URL", github.Artifacts)
+	}
+	if _, ok := onSuccess.PluginOutputs["homebrew"]; ok {
+		t.Error("outputs of failed plugins should not be passed on")
+	}
+
+	// The outputs are saved with the release for resumed runs
+	saved := releaseRepo.releases["release-123"].PluginOutputs()
+	if out, ok := saved["github"]; !ok || len(out.Artifacts) != 1 || out.Artifacts[0].URL == "" {
+		t.Errorf("saved PluginOutputs = %+v, want the github outputs with the asset URL", saved)
+	}
 }
 
 func TestPublishReleaseUseCase_DefaultRemote(t *testing.T) {
@@ -799,6 +866,333 @@ func TestPublishReleaseUseCase_DefaultTagPrefix(t *testing.T) {
 	}
 }
 
+func TestPublishReleaseUseCase_PackageTagPrefix(t *testing.T) {
+	ctx := context.Background()
+
+	r := release.NewRelease("rel-api", "main", "/path/to/repo")
+	pkg := release.PackageRef{Name: "api", Path: "services/api", TagPrefix: "api/v"}
+	if err := r.AssignPackage(pkg, "grp-1"); err != nil {
+		t.Fatalf("AssignPackage() error = %v", err)
+	}
+	cs := changes.NewChangeSet("cs-api", "api/v1.0.0", "HEAD")
+	cs.AddCommit(changes.NewConventionalCommit("abc123", changes.CommitTypeFix, "fix api"))
+	nextVersion := version.MustParse("1.0.1")
+	_ = r.SetPlan(release.NewReleasePlan(version.MustParse("1.0.0"), nextVersion, changes.ReleaseTypePatch, cs, false))
+	_ = r.SetVersion(nextVersion, "api/v1.0.1")
+	_ = r.SetNotes(&release.ReleaseNotes{Changelog: "## [1.0.1]", GeneratedAt: time.Now()})
+	_ = r.Approve("test-user", false)
+
+	releaseRepo := newMockReleaseRepository()
+	releaseRepo.releases["rel-api"] = r
+
+	gitRepo := &mockGitRepository{
+		latestCommit: createTestCommit("abc123", "latest"),
+		tagCreated:   sourcecontrol.NewTag("api/v1.0.1", "abc123"),
+	}
+
+	uc := NewPublishReleaseUseCase(releaseRepo, gitRepo, newMockPluginExecutor(), &mockEventPublisher{})
+
+	// The repository-wide prefix must not leak into package tags
+	output, err := uc.Execute(ctx, PublishReleaseInput{
+		ReleaseID: "rel-api",
+		CreateTag: true,
+		TagPrefix: "v",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.TagName != "api/v1.0.1" {
+		t.Errorf("TagName = %s, want api/v1.0.1", output.TagName)
+	}
+}
+
+func TestPublishReleaseUseCase_ApprovalPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	releaseRepo := newMockReleaseRepository()
+	releaseRepo.releases["release-123"] = createApprovedRelease("release-123", "main", "/path/to/repo")
+
+	gitRepo := &mockGitRepository{
+		latestCommit: createTestCommit("abc123", "latest"),
+		tagCreated:   sourcecontrol.NewTag("v1.1.0", "abc123"),
+	}
+
+	uc := NewPublishReleaseUseCase(releaseRepo, gitRepo, newMockPluginExecutor(), &mockEventPublisher{})
+
+	// A single approval does not satisfy a two-person policy
+	_, err := uc.Execute(ctx, PublishReleaseInput{
+		ReleaseID:      "release-123",
+		CreateTag:      true,
+		ApprovalPolicy: &release.ApprovalPolicy{RequiredApprovals: 2},
+	})
+	if !errors.Is(err, release.ErrApprovalPolicyNotSatisfied) {
+		t.Fatalf("Execute() error = %v, want ErrApprovalPolicyNotSatisfied", err)
+	}
+	if gitRepo.createTagCalls != 0 {
+		t.Error("no tag should be created when the policy is not satisfied")
+	}
+
+	if _, err := uc.Execute(ctx, PublishReleaseInput{
+		ReleaseID:      "release-123",
+		CreateTag:      true,
+		ApprovalPolicy: &release.ApprovalPolicy{RequiredApprovals: 1},
+	}); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+}
+
+func TestPublishReleaseUseCase_ApprovalPolicy_Resume(t *testing.T) {
+	ctx := context.Background()
+
+	releaseRepo := newMockReleaseRepository()
+	releaseRepo.releases["release-123"] = createApprovedRelease("release-123", "main", "/path/to/repo")
+
+	gitRepo := &mockGitRepository{
+		latestCommit: createTestCommit("abc123", "latest"),
+		tagCreated:   sourcecontrol.NewTag("v1.1.0", "abc123"),
+		pushTagErr:   errors.New("connection reset"),
+	}
+
+	uc := NewPublishReleaseUseCase(releaseRepo, gitRepo, newMockPluginExecutor(), &mockEventPublisher{})
+	input := PublishReleaseInput{ReleaseID: "release-123", CreateTag: true, PushTag: true}
+
+	if _, err := uc.Execute(ctx, input); err == nil {
+		t.Fatal("expected push failure")
+	}
+
+	// The policy was tightened while the publish was interrupted
+	gitRepo.pushTagErr = nil
+	input.Resume = true
+	input.ApprovalPolicy = &release.ApprovalPolicy{RequiredApprovals: 2}
+
+	_, err := uc.Execute(ctx, input)
+	if !errors.Is(err, release.ErrApprovalPolicyNotSatisfied) {
+		t.Fatalf("Execute() error = %v, want ErrApprovalPolicyNotSatisfied", err)
+	}
+	if gitRepo.pushTagCalls != 1 {
+		t.Errorf("PushTag called %d times, want 1", gitRepo.pushTagCalls)
+	}
+
+	input.ApprovalPolicy = &release.ApprovalPolicy{RequiredApprovals: 1}
+	if _, err := uc.Execute(ctx, input); err != nil {
+		t.Fatalf("resume error = %v", err)
+	}
+}
+
+func TestPublishReleaseUseCase_FreezeWindows(t *testing.T) {
+	ctx := context.Background()
+	friday := time.Date(2025, 6, 13, 16, 0, 0, 0, time.UTC)
+	freeze := []release.FreezeWindow{
+		{Name: "friday-afternoon", Days: []time.Weekday{time.Friday}, StartTime: 15 * time.Hour, Location: time.UTC},
+	}
+
+	releaseRepo := newMockReleaseRepository()
+	releaseRepo.releases["release-123"] = createApprovedRelease("release-123", "main", "/path/to/repo")
+
+	gitRepo := &mockGitRepository{
+		latestCommit: createTestCommit("abc123", "latest"),
+		tagCreated:   sourcecontrol.NewTag("v1.1.0", "abc123"),
+	}
+
+	uc := NewPublishReleaseUseCase(releaseRepo, gitRepo, newMockPluginExecutor(), &mockEventPublisher{})
+	uc.now = func() time.Time { return friday }
+
+	_, err := uc.Execute(ctx, PublishReleaseInput{ReleaseID: "release-123", CreateTag: true, FreezeWindows: freeze})
+	if !errors.Is(err, release.ErrReleaseFrozen) {
+		t.Fatalf("Execute() error = %v, want ErrReleaseFrozen", err)
+	}
+	if !strings.Contains(err.Error(), "2025-06-14T00:00:00Z") {
+		t.Errorf("error should say when the freeze ends: %v", err)
+	}
+	if gitRepo.createTagCalls != 0 {
+		t.Error("no tag should be created during a freeze")
+	}
+
+	_, err = uc.Execute(ctx, PublishReleaseInput{
+		ReleaseID:            "release-123",
+		CreateTag:            true,
+		FreezeWindows:        freeze,
+		FreezeOverrideReason: "security fix for CVE-2025-0001",
+		FreezeOverriddenBy:   "alice",
+	})
+	if err != nil {
+		t.Fatalf("Execute() with override error = %v", err)
+	}
+
+	overrides := releaseRepo.releases["release-123"].FreezeOverrides()
+	if len(overrides) != 1 || overrides[0].Reason != "security fix for CVE-2025-0001" || overrides[0].OverriddenBy != "alice" {
+		t.Errorf("FreezeOverrides() = %+v", overrides)
+	}
+}
+
+func TestPublishReleaseUseCase_Resume(t *testing.T) {
+	ctx := context.Background()
+
+	releaseRepo := newMockReleaseRepository()
+	releaseRepo.releases["release-123"] = createApprovedRelease("release-123", "main", "/path/to/repo")
+
+	pluginExec := newMockPluginExecutor()
+	pluginExec.responses[integration.HookPrePublish] = []integration.ExecuteResponse{
+		{PluginID: "lint", Success: true, Message: "lint ok"},
+	}
+	pluginExec.responses[integration.HookPostPublish] = []integration.ExecuteResponse{
+		{PluginID: "github", Success: true, Message: "release created", Outputs: map[string]any{"release_id": 42}},
+		{PluginID: "npm", Success: false, Error: "publish timed out"},
+	}
+
+	gitRepo := &mockGitRepository{
+		latestCommit: createTestCommit("abc123", "latest"),
+		tagCreated:   sourcecontrol.NewTag("v1.1.0", "abc123"),
+		pushTagErr:   errors.New("connection reset"),
+	}
+
+	uc := NewPublishReleaseUseCase(releaseRepo, gitRepo, pluginExec, &mockEventPublisher{})
+	input := PublishReleaseInput{ReleaseID: "release-123", CreateTag: true, PushTag: true}
+
+	// First attempt fails pushing the tag; the failure and completed steps are saved
+	if _, err := uc.Execute(ctx, input); err == nil {
+		t.Fatal("expected push failure")
+	}
+	rel := releaseRepo.releases["release-123"]
+	if rel.State() != release.StateFailed {
+		t.Fatalf("State() = %v, want %v", rel.State(), release.StateFailed)
+	}
+	if !rel.HasCheckpoint(release.StepTagCreated, "", "") {
+		t.Error("tag creation should be checkpointed")
+	}
+	if !rel.HasCheckpoint(release.StepPlugin, "lint", string(integration.HookPrePublish)) {
+		t.Error("pre-publish plugin should be checkpointed")
+	}
+
+	// A plain publish does not replay a failed release
+	if _, err := uc.Execute(ctx, input); err == nil {
+		t.Error("expected publish of failed release without resume to fail")
+	}
+
+	// Resuming skips the tag creation and the pre-publish plugin
+	gitRepo.pushTagErr = nil
+	pluginExec.execCalls = nil
+	input.Resume = true
+
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("resume error = %v", err)
+	}
+	if gitRepo.createTagCalls != 1 {
+		t.Errorf("CreateTag called %d times, want 1", gitRepo.createTagCalls)
+	}
+	if gitRepo.pushTagCalls != 2 {
+		t.Errorf("PushTag called %d times, want 2", gitRepo.pushTagCalls)
+	}
+	for _, call := range pluginExec.execCalls {
+		if call.hook == integration.HookPrePublish {
+			t.Error("pre-publish hook should not run again")
+		}
+	}
+	if rel.State() != release.StatePublished {
+		t.Fatalf("State() = %v, want %v", rel.State(), release.StatePublished)
+	}
+	if len(output.SkippedSteps) != 2 {
+		t.Errorf("SkippedSteps = %v, want tag creation and lint", output.SkippedSteps)
+	}
+	for _, cp := range rel.Checkpoints() {
+		if cp.PluginName == "github" && cp.Outputs["release_id"] != 42 {
+			t.Errorf("github checkpoint outputs = %v, want release_id", cp.Outputs)
+		}
+	}
+	if !rel.HasCheckpoint(release.StepPlugin, "github", string(integration.HookPostPublish)) {
+		t.Error("github post-publish should be checkpointed")
+	}
+
+	// Resuming the published release only re-runs the failed npm plugin
+	pluginExec.execCalls = nil
+	output, err = uc.Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("second resume error = %v", err)
+	}
+	if len(pluginExec.execCalls) != 0 {
+		t.Errorf("expected no full hook executions, got %d", len(pluginExec.execCalls))
+	}
+	if len(pluginExec.singleCalls) != 1 || pluginExec.singleCalls[0].id != "npm" {
+		t.Fatalf("singleCalls = %+v, want one call for npm", pluginExec.singleCalls)
+	}
+	if pluginExec.singleCalls[0].req.Hook != integration.HookPostPublish {
+		t.Errorf("npm re-run hook = %s, want %s", pluginExec.singleCalls[0].req.Hook, integration.HookPostPublish)
+	}
+	if len(output.PluginResults) != 1 || !output.PluginResults[0].Success {
+		t.Errorf("PluginResults = %+v, want successful npm result", output.PluginResults)
+	}
+	if rel.CanResumePublish() {
+		t.Error("nothing should be left to resume")
+	}
+	if gitRepo.pushTagCalls != 2 {
+		t.Errorf("published release should not push the tag again")
+	}
+
+	// Nothing left to resume
+	if _, err := uc.Execute(ctx, input); err == nil {
+		t.Error("expected error resuming a completed release")
+	}
+}
+
+func TestPublishReleaseUseCase_Resume_CheckpointsHook(t *testing.T) {
+	ctx := context.Background()
+
+	releaseRepo := newMockReleaseRepository()
+	releaseRepo.releases["release-123"] = createApprovedRelease("release-123", "main", "/path/to/repo")
+
+	// The hook fails part way, so it is not checkpointed
+	pluginExec := newMockPluginExecutor()
+	pluginExec.responses[integration.HookPostPublish] = []integration.ExecuteResponse{
+		{PluginID: "github", Success: true},
+		{PluginID: "npm", Success: false, Error: "publish timed out"},
+	}
+	pluginExec.errors[integration.HookPostPublish] = errors.New("npm failed")
+
+	uc := NewPublishReleaseUseCase(releaseRepo, &mockGitRepository{
+		latestCommit: createTestCommit("abc123", "latest"),
+		tagCreated:   sourcecontrol.NewTag("v1.1.0", "abc123"),
+	}, pluginExec, &mockEventPublisher{})
+	input := PublishReleaseInput{ReleaseID: "release-123", CreateTag: true}
+
+	if _, err := uc.Execute(ctx, input); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	rel := releaseRepo.releases["release-123"]
+	if rel.HasCheckpoint(release.StepHook, "", string(integration.HookPostPublish)) {
+		t.Fatal("post-publish should not be checkpointed after the hook failed")
+	}
+
+	input.Resume = true
+	if _, err := uc.Execute(ctx, input); err != nil {
+		t.Fatalf("resume error = %v", err)
+	}
+	if !rel.HasCheckpoint(release.StepHook, "", string(integration.HookPostPublish)) {
+		t.Error("post-publish should be checkpointed once every plugin succeeded")
+	}
+}
+
+func TestPublishReleaseUseCase_PublishesFailureEvents(t *testing.T) {
+	releaseRepo := newMockReleaseRepository()
+	releaseRepo.releases["release-123"] = createApprovedRelease("release-123", "main", "/path/to/repo")
+	gitRepo := &mockGitRepository{latestCommitErr: errors.New("detached HEAD")}
+	publisher := &mockEventPublisher{}
+
+	uc := NewPublishReleaseUseCase(releaseRepo, gitRepo, newMockPluginExecutor(), publisher)
+	if _, err := uc.Execute(context.Background(), PublishReleaseInput{ReleaseID: "release-123", CreateTag: true}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	var names []string
+	for _, event := range publisher.published {
+		names = append(names, event.EventName())
+	}
+	if !slices.Contains(names, "release.failed") {
+		t.Errorf("published events = %v, want release.failed", names)
+	}
+}
+
 func TestNewPublishReleaseUseCase(t *testing.T) {
 	releaseRepo := newMockReleaseRepository()
 	gitRepo := &mockGitRepository{}
@@ -0,0 +1,240 @@
+// Package release provides application use cases for release management.
+package release
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/integration"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/sourcecontrol"
+)
+
+// RollbackReleaseInput represents the input for the RollbackRelease use case.
+type RollbackReleaseInput struct {
+	ReleaseID       release.ReleaseID
+	Reason          string
+	RolledBackBy    string
+	DeleteTag       bool   // Delete the local release tag
+	DeleteRemoteTag bool   // Delete the release tag from the remote
+	Remote          string // Defaults to "origin"
+	Force           bool   // Mark the release rolled back even if a plugin fails to compensate
+	DryRun          bool
+}
+
+// Validate validates the RollbackReleaseInput.
+func (i *RollbackReleaseInput) Validate() error {
+	if i.ReleaseID == "" {
+		return fmt.Errorf("release ID is required")
+	}
+	if i.Remote != "" && strings.ContainsAny(i.Remote, " \t\n") {
+		return fmt.Errorf("remote name contains whitespace: %s", i.Remote)
+	}
+	return nil
+}
+
+// RollbackReleaseOutput represents the output of the RollbackRelease use case.
+type RollbackReleaseOutput struct {
+	TagName          string
+	TagDeleted       bool
+	RemoteTagDeleted bool
+	PluginResults    []PluginResult
+	FailedPlugins    []string // Plugins that could not compensate; may need manual cleanup
+	HookError        string   // Error running the on-rollback hook itself; plugins may not have compensated
+	RolledBack       bool     // False for dry runs and incomplete rollbacks
+}
+
+// RollbackReleaseUseCase undoes a published release: plugins compensate
+// through the on-rollback hook, the release tag is deleted and the
+// release is marked as rolled back.
+type RollbackReleaseUseCase struct {
+	releaseRepo    release.Repository
+	gitRepo        sourcecontrol.GitRepository
+	pluginExecutor integration.PluginExecutor
+	eventPublisher release.EventPublisher
+	logger         *slog.Logger
+}
+
+// NewRollbackReleaseUseCase creates a new RollbackReleaseUseCase.
+func NewRollbackReleaseUseCase(
+	releaseRepo release.Repository,
+	gitRepo sourcecontrol.GitRepository,
+	pluginExecutor integration.PluginExecutor,
+	eventPublisher release.EventPublisher,
+) *RollbackReleaseUseCase {
+	return &RollbackReleaseUseCase{
+		releaseRepo:    releaseRepo,
+		gitRepo:        gitRepo,
+		pluginExecutor: pluginExecutor,
+		eventPublisher: eventPublisher,
+		logger:         slog.Default().With("usecase", "rollback_release"),
+	}
+}
+
+// Execute executes the rollback release use case.
+// Plugins run first so they can still look up what was published under the
+// tag. If any of them fails, or the hook cannot be run, the tag is kept and
+// the release is left as is, so the rollback can be re-run, unless Force is set.
+func (uc *RollbackReleaseUseCase) Execute(ctx context.Context, input RollbackReleaseInput) (*RollbackReleaseOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+
+	rel, err := uc.releaseRepo.FindByID(ctx, input.ReleaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find release: %w", err)
+	}
+
+	if !rel.CanRollBack() {
+		return nil, fmt.Errorf("release cannot be rolled back: current state is %s", rel.State())
+	}
+
+	output := &RollbackReleaseOutput{
+		TagName:       rel.TagName(),
+		PluginResults: make([]PluginResult, 0),
+	}
+
+	if uc.pluginExecutor != nil && rel.Plan() != nil {
+		uc.executeRollbackHook(ctx, rel, input, output)
+	}
+
+	if (len(output.FailedPlugins) > 0 || output.HookError != "") && !input.Force {
+		uc.save(ctx, rel, input.DryRun)
+		if !input.DryRun {
+			uc.publishDomainEvents(ctx, rel)
+		}
+		if output.HookError != "" {
+			return output, fmt.Errorf("rollback incomplete: on-rollback hook failed: %s", output.HookError)
+		}
+		return output, fmt.Errorf("rollback incomplete: %s failed to compensate", strings.Join(output.FailedPlugins, ", "))
+	}
+
+	if input.DryRun {
+		return output, nil
+	}
+
+	if err := uc.deleteTags(ctx, rel, input, output); err != nil {
+		uc.save(ctx, rel, false)
+		uc.publishDomainEvents(ctx, rel)
+		return output, err
+	}
+
+	if err := rel.RollBack(input.Reason, input.RolledBackBy); err != nil {
+		return nil, fmt.Errorf("failed to mark release as rolled back: %w", err)
+	}
+	if err := uc.releaseRepo.Save(ctx, rel); err != nil {
+		return nil, fmt.Errorf("failed to save release: %w", err)
+	}
+	output.RolledBack = true
+
+	uc.publishDomainEvents(ctx, rel)
+
+	return output, nil
+}
+
+// publishDomainEvents publishes domain events (errors are non-fatal).
+func (uc *RollbackReleaseUseCase) publishDomainEvents(ctx context.Context, rel *release.Release) {
+	if uc.eventPublisher == nil {
+		return
+	}
+
+	if err := uc.eventPublisher.Publish(ctx, rel.DomainEvents()...); err != nil {
+		uc.logger.Warn("failed to publish domain events",
+			"error", err,
+			"release_id", rel.ID())
+	}
+	rel.ClearDomainEvents()
+}
+
+// executeRollbackHook runs the on-rollback hook and records each plugin outcome.
+func (uc *RollbackReleaseUseCase) executeRollbackHook(
+	ctx context.Context,
+	rel *release.Release,
+	input RollbackReleaseInput,
+	output *RollbackReleaseOutput,
+) {
+	releaseCtx := buildReleaseContext(rel, rel.TagName(), input.DryRun)
+
+	start := time.Now()
+	responses, err := uc.pluginExecutor.ExecuteHook(ctx, integration.HookOnRollback, releaseCtx)
+	if err != nil {
+		uc.logger.Warn("on-rollback plugin hook failed",
+			"error", err,
+			"release_id", rel.ID())
+		output.HookError = err.Error()
+	}
+
+	for i, resp := range responses {
+		name := string(resp.PluginID)
+		if name == "" {
+			name = fmt.Sprintf("plugin-%d", i)
+		}
+		message := resp.Message
+		if message == "" {
+			message = resp.Error
+		}
+
+		result := PluginResult{
+			PluginName: name,
+			Hook:       integration.HookOnRollback,
+			Success:    resp.Success,
+			Message:    message,
+			Duration:   time.Since(start),
+		}
+		rel.RecordPluginExecution(name, string(integration.HookOnRollback), resp.Success, message, result.Duration)
+		output.PluginResults = append(output.PluginResults, result)
+
+		if !resp.Success {
+			output.FailedPlugins = append(output.FailedPlugins, name)
+		}
+	}
+}
+
+// deleteTags deletes the release tag from the remote and locally, as requested.
+func (uc *RollbackReleaseUseCase) deleteTags(
+	ctx context.Context,
+	rel *release.Release,
+	input RollbackReleaseInput,
+	output *RollbackReleaseOutput,
+) error {
+	tagName := rel.TagName()
+	if tagName == "" {
+		return nil
+	}
+
+	if input.DeleteRemoteTag {
+		remote := input.Remote
+		if remote == "" {
+			remote = "origin"
+		}
+		if err := uc.gitRepo.DeleteRemoteTag(ctx, tagName, remote); err != nil {
+			return fmt.Errorf("failed to delete remote tag %s: %w", tagName, err)
+		}
+		output.RemoteTagDeleted = true
+	}
+
+	if input.DeleteTag {
+		if err := uc.gitRepo.DeleteTag(ctx, tagName); err != nil {
+			return fmt.Errorf("failed to delete tag %s: %w", tagName, err)
+		}
+		output.TagDeleted = true
+	}
+
+	return nil
+}
+
+// save persists the release, e.g. to keep plugin outcomes of an
+// incomplete rollback (errors are non-fatal).
+func (uc *RollbackReleaseUseCase) save(ctx context.Context, rel *release.Release, dryRun bool) {
+	if dryRun {
+		return
+	}
+	if err := uc.releaseRepo.Save(ctx, rel); err != nil {
+		uc.logger.Warn("failed to save release",
+			"error", err,
+			"release_id", rel.ID())
+	}
+}
@@ -0,0 +1,236 @@
+// Package release provides application use cases for release management.
+package release
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/integration"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+)
+
+// createPublishedRelease creates a release in the published state.
+func createPublishedRelease(id release.ReleaseID) *release.Release {
+	r := createApprovedRelease(id, "main", "/path/to/repo")
+	_ = r.StartPublishing(nil)
+	_ = r.MarkPublished("")
+	r.ClearDomainEvents()
+	return r
+}
+
+func TestRollbackReleaseInput_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   RollbackReleaseInput
+		wantErr bool
+	}{
+		{"valid", RollbackReleaseInput{ReleaseID: "release-123", Remote: "origin"}, false},
+		{"missing release ID", RollbackReleaseInput{}, true},
+		{"remote with whitespace", RollbackReleaseInput{ReleaseID: "release-123", Remote: "my remote"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRollbackReleaseUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name            string
+		setupRelease    func() *release.Release
+		input           RollbackReleaseInput
+		hookResponses   []integration.ExecuteResponse
+		hookErr         error
+		deleteRemoteErr error
+		wantErr         string
+		wantState       release.ReleaseState
+		wantDeleted     []string
+		wantRemote      []string
+		wantRolledBack  bool
+	}{
+		{
+			name:         "rolls back published release",
+			setupRelease: func() *release.Release { return createPublishedRelease("rel-1") },
+			input: RollbackReleaseInput{
+				ReleaseID:       "rel-1",
+				Reason:          "regression",
+				RolledBackBy:    "alice",
+				DeleteTag:       true,
+				DeleteRemoteTag: true,
+			},
+			hookResponses: []integration.ExecuteResponse{
+				{PluginID: "github", Success: true, Message: "deleted release v1.1.0"},
+			},
+			wantState:      release.StateRolledBack,
+			wantDeleted:    []string{"v1.1.0"},
+			wantRemote:     []string{"v1.1.0"},
+			wantRolledBack: true,
+		},
+		{
+			name:           "keeps tags when asked",
+			setupRelease:   func() *release.Release { return createPublishedRelease("rel-1") },
+			input:          RollbackReleaseInput{ReleaseID: "rel-1"},
+			wantState:      release.StateRolledBack,
+			wantRolledBack: true,
+		},
+		{
+			name: "rolls back failed publish",
+			setupRelease: func() *release.Release {
+				r := createApprovedRelease("rel-1", "main", "/path/to/repo")
+				_ = r.StartPublishing(nil)
+				_ = r.MarkFailed("npm publish failed", true)
+				return r
+			},
+			input:          RollbackReleaseInput{ReleaseID: "rel-1", DeleteTag: true},
+			wantState:      release.StateRolledBack,
+			wantDeleted:    []string{"v1.1.0"},
+			wantRolledBack: true,
+		},
+		{
+			name:         "plugin failure stops rollback",
+			setupRelease: func() *release.Release { return createPublishedRelease("rel-1") },
+			input:        RollbackReleaseInput{ReleaseID: "rel-1", DeleteTag: true, DeleteRemoteTag: true},
+			hookResponses: []integration.ExecuteResponse{
+				{PluginID: "github", Success: true},
+				{PluginID: "npm", Success: false, Error: "403 forbidden"},
+			},
+			wantErr:   "npm failed to compensate",
+			wantState: release.StatePublished,
+		},
+		{
+			name:         "plugin failure with force",
+			setupRelease: func() *release.Release { return createPublishedRelease("rel-1") },
+			input:        RollbackReleaseInput{ReleaseID: "rel-1", DeleteTag: true, Force: true},
+			hookResponses: []integration.ExecuteResponse{
+				{PluginID: "npm", Success: false, Error: "403 forbidden"},
+			},
+			wantState:      release.StateRolledBack,
+			wantDeleted:    []string{"v1.1.0"},
+			wantRolledBack: true,
+		},
+		{
+			name:         "hook failure stops rollback",
+			setupRelease: func() *release.Release { return createPublishedRelease("rel-1") },
+			input:        RollbackReleaseInput{ReleaseID: "rel-1", DeleteTag: true, DeleteRemoteTag: true},
+			hookErr:      errors.New("plugin github: connection refused"),
+			wantErr:      "on-rollback hook failed: plugin github: connection refused",
+			wantState:    release.StatePublished,
+		},
+		{
+			name:           "hook failure with force",
+			setupRelease:   func() *release.Release { return createPublishedRelease("rel-1") },
+			input:          RollbackReleaseInput{ReleaseID: "rel-1", DeleteTag: true, Force: true},
+			hookErr:        errors.New("plugin github: connection refused"),
+			wantState:      release.StateRolledBack,
+			wantDeleted:    []string{"v1.1.0"},
+			wantRolledBack: true,
+		},
+		{
+			name:            "remote tag deletion fails",
+			setupRelease:    func() *release.Release { return createPublishedRelease("rel-1") },
+			input:           RollbackReleaseInput{ReleaseID: "rel-1", DeleteTag: true, DeleteRemoteTag: true},
+			deleteRemoteErr: errors.New("permission denied"),
+			wantErr:         "failed to delete remote tag",
+			wantState:       release.StatePublished,
+			wantRemote:      []string{"v1.1.0"},
+		},
+		{
+			name:         "dry run changes nothing",
+			setupRelease: func() *release.Release { return createPublishedRelease("rel-1") },
+			input:        RollbackReleaseInput{ReleaseID: "rel-1", DeleteTag: true, DeleteRemoteTag: true, DryRun: true},
+			wantState:    release.StatePublished,
+		},
+		{
+			name:         "release not published",
+			setupRelease: func() *release.Release { return createApprovedRelease("rel-1", "main", "/path/to/repo") },
+			input:        RollbackReleaseInput{ReleaseID: "rel-1"},
+			wantErr:      "cannot be rolled back",
+			wantState:    release.StateApproved,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rel := tt.setupRelease()
+			repo := newMockReleaseRepository()
+			repo.releases[rel.ID()] = rel
+			gitRepo := &mockGitRepository{deleteRemoteErr: tt.deleteRemoteErr}
+			executor := newMockPluginExecutor()
+			executor.responses[integration.HookOnRollback] = tt.hookResponses
+			executor.errors[integration.HookOnRollback] = tt.hookErr
+			publisher := &mockEventPublisher{}
+
+			uc := NewRollbackReleaseUseCase(repo, gitRepo, executor, publisher)
+			output, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want error containing %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Execute() unexpected error = %v", err)
+			}
+
+			if rel.State() != tt.wantState {
+				t.Errorf("State() = %v, want %v", rel.State(), tt.wantState)
+			}
+			if strings.Join(gitRepo.deletedTags, ",") != strings.Join(tt.wantDeleted, ",") {
+				t.Errorf("deleted tags = %v, want %v", gitRepo.deletedTags, tt.wantDeleted)
+			}
+			if strings.Join(gitRepo.deletedRemote, ",") != strings.Join(tt.wantRemote, ",") {
+				t.Errorf("deleted remote tags = %v, want %v", gitRepo.deletedRemote, tt.wantRemote)
+			}
+			if output != nil && output.RolledBack != tt.wantRolledBack {
+				t.Errorf("RolledBack = %v, want %v", output.RolledBack, tt.wantRolledBack)
+			}
+			if tt.hookErr != nil && (output == nil || output.HookError != tt.hookErr.Error()) {
+				t.Errorf("HookError = %+v, want %q", output, tt.hookErr)
+			}
+			if tt.wantRolledBack && len(publisher.published) == 0 {
+				t.Error("expected domain events to be published")
+			}
+		})
+	}
+}
+
+func TestRollbackReleaseUseCase_RecordsCompensation(t *testing.T) {
+	rel := createPublishedRelease("rel-1")
+	repo := newMockReleaseRepository()
+	repo.releases[rel.ID()] = rel
+	executor := newMockPluginExecutor()
+	executor.responses[integration.HookOnRollback] = []integration.ExecuteResponse{
+		{PluginID: "github", Success: true, Message: "deleted release v1.1.0"},
+	}
+
+	uc := NewRollbackReleaseUseCase(repo, &mockGitRepository{}, executor, nil)
+	output, err := uc.Execute(context.Background(), RollbackReleaseInput{ReleaseID: "rel-1", Reason: "bad build", RolledBackBy: "alice"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if len(executor.execCalls) != 1 || executor.execCalls[0].hook != integration.HookOnRollback {
+		t.Fatalf("expected a single on-rollback hook call, got %+v", executor.execCalls)
+	}
+	if executor.execCalls[0].releaseCtx.TagName != "v1.1.0" {
+		t.Errorf("TagName = %q, want v1.1.0", executor.execCalls[0].releaseCtx.TagName)
+	}
+	if len(output.PluginResults) != 1 || output.PluginResults[0].PluginName != "github" {
+		t.Errorf("PluginResults = %+v", output.PluginResults)
+	}
+
+	executions := rel.PluginExecutions()
+	if len(executions) != 1 || executions[0].Hook != string(integration.HookOnRollback) {
+		t.Errorf("PluginExecutions() = %+v, want on-rollback execution", executions)
+	}
+	rollback := rel.Rollback()
+	if rollback == nil || rollback.Reason != "bad build" || rollback.RolledBackBy != "alice" {
+		t.Errorf("Rollback() = %+v", rollback)
+	}
+}
@@ -0,0 +1,121 @@
+// Package release provides application use cases for release management.
+package release
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+)
+
+// SchedulePublishInput represents the input for the SchedulePublish use case.
+type SchedulePublishInput struct {
+	ReleaseID   release.ReleaseID
+	PublishAt   time.Time
+	ScheduledBy string
+	// FreezeWindows are checked so callers can warn about schedules that
+	// fall into a freeze; they do not prevent scheduling.
+	FreezeWindows []release.FreezeWindow
+}
+
+// SchedulePublishOutput represents the output of the SchedulePublish use case.
+type SchedulePublishOutput struct {
+	PublishAt time.Time
+	// FrozenBy is the freeze window the publish time falls into, if any.
+	FrozenBy *release.FreezeWindow
+}
+
+// SchedulePublishUseCase schedules an approved release to be published
+// later, e.g. by a cron job running `publish --due`.
+type SchedulePublishUseCase struct {
+	releaseRepo    release.Repository
+	eventPublisher release.EventPublisher
+	logger         *slog.Logger
+	now            func() time.Time
+}
+
+// NewSchedulePublishUseCase creates a new SchedulePublishUseCase.
+func NewSchedulePublishUseCase(
+	releaseRepo release.Repository,
+	eventPublisher release.EventPublisher,
+) *SchedulePublishUseCase {
+	return &SchedulePublishUseCase{
+		releaseRepo:    releaseRepo,
+		eventPublisher: eventPublisher,
+		logger:         slog.Default().With("usecase", "schedule_publish"),
+		now:            time.Now,
+	}
+}
+
+// Execute executes the schedule publish use case.
+func (uc *SchedulePublishUseCase) Execute(ctx context.Context, input SchedulePublishInput) (*SchedulePublishOutput, error) {
+	if input.ReleaseID == "" {
+		return nil, fmt.Errorf("release ID is required")
+	}
+	if !input.PublishAt.After(uc.now()) {
+		return nil, fmt.Errorf("publish time %s is not in the future", input.PublishAt.Format(time.RFC3339))
+	}
+
+	rel, err := uc.releaseRepo.FindByID(ctx, input.ReleaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find release: %w", err)
+	}
+
+	if err := rel.SchedulePublish(input.PublishAt, input.ScheduledBy); err != nil {
+		return nil, fmt.Errorf("failed to schedule release: %w", err)
+	}
+
+	if err := uc.releaseRepo.Save(ctx, rel); err != nil {
+		return nil, fmt.Errorf("failed to save release: %w", err)
+	}
+
+	if uc.eventPublisher != nil {
+		if err := uc.eventPublisher.Publish(ctx, rel.DomainEvents()...); err != nil {
+			uc.logger.Warn("failed to publish domain events",
+				"error", err,
+				"release_id", rel.ID())
+		}
+		rel.ClearDomainEvents()
+	}
+
+	output := &SchedulePublishOutput{PublishAt: input.PublishAt}
+	if window, frozen := release.ActiveFreezeWindow(input.FreezeWindows, input.PublishAt); frozen {
+		output.FrozenBy = &window
+	}
+
+	return output, nil
+}
+
+// FindDueReleasesUseCase finds scheduled releases whose publish time has come.
+type FindDueReleasesUseCase struct {
+	releaseRepo release.Repository
+}
+
+// NewFindDueReleasesUseCase creates a new FindDueReleasesUseCase.
+func NewFindDueReleasesUseCase(releaseRepo release.Repository) *FindDueReleasesUseCase {
+	return &FindDueReleasesUseCase{releaseRepo: releaseRepo}
+}
+
+// Execute returns the releases due at the given time, earliest first.
+func (uc *FindDueReleasesUseCase) Execute(ctx context.Context, now time.Time) ([]*release.Release, error) {
+	approved, err := uc.releaseRepo.FindByState(ctx, release.StateApproved)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find approved releases: %w", err)
+	}
+
+	due := make([]*release.Release, 0, len(approved))
+	for _, rel := range approved {
+		if rel.IsDue(now) {
+			due = append(due, rel)
+		}
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].PublishSchedule().PublishAt.Before(due[j].PublishSchedule().PublishAt)
+	})
+
+	return due, nil
+}
@@ -0,0 +1,78 @@
+// Package release provides application use cases for release management.
+package release
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+)
+
+func TestSchedulePublishUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 6, 12, 10, 0, 0, 0, time.UTC) // a Thursday
+
+	releaseRepo := newMockReleaseRepository()
+	releaseRepo.releases["release-123"] = createApprovedRelease("release-123", "main", "/path/to/repo")
+	eventPublisher := &mockEventPublisher{}
+
+	uc := NewSchedulePublishUseCase(releaseRepo, eventPublisher)
+	uc.now = func() time.Time { return now }
+
+	if _, err := uc.Execute(ctx, SchedulePublishInput{ReleaseID: "release-123", PublishAt: now.Add(-time.Minute)}); err == nil {
+		t.Error("Execute() should reject publish times in the past")
+	}
+
+	fridays := release.FreezeWindow{Name: "fridays", Days: []time.Weekday{time.Friday}, Location: time.UTC}
+	publishAt := now.Add(24 * time.Hour)
+	output, err := uc.Execute(ctx, SchedulePublishInput{
+		ReleaseID:     "release-123",
+		PublishAt:     publishAt,
+		ScheduledBy:   "alice",
+		FreezeWindows: []release.FreezeWindow{fridays},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.FrozenBy == nil || output.FrozenBy.Name != "fridays" {
+		t.Errorf("FrozenBy = %v, want the fridays window", output.FrozenBy)
+	}
+
+	schedule := releaseRepo.releases["release-123"].PublishSchedule()
+	if schedule == nil || !schedule.PublishAt.Equal(publishAt) || schedule.ScheduledBy != "alice" {
+		t.Errorf("PublishSchedule() = %+v", schedule)
+	}
+	if len(eventPublisher.published) == 0 {
+		t.Error("expected the scheduled event to be published")
+	}
+}
+
+func TestFindDueReleasesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	releaseRepo := newMockReleaseRepository()
+	for id, offset := range map[release.ReleaseID]time.Duration{
+		"due-later":   -time.Minute,
+		"due-earlier": -time.Hour,
+		"not-due":     time.Hour,
+	} {
+		rel := createApprovedRelease(id, "main", "/path/to/repo")
+		_ = rel.SchedulePublish(now.Add(offset), "alice")
+		releaseRepo.releases[id] = rel
+	}
+	releaseRepo.releases["unscheduled"] = createApprovedRelease("unscheduled", "main", "/path/to/repo")
+
+	due, err := NewFindDueReleasesUseCase(releaseRepo).Execute(ctx, now)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if len(due) != 2 || due[0].ID() != "due-earlier" || due[1].ID() != "due-later" {
+		var ids []release.ReleaseID
+		for _, rel := range due {
+			ids = append(ids, rel.ID())
+		}
+		t.Errorf("due releases = %v, want [due-earlier due-later]", ids)
+	}
+}
@@ -0,0 +1,137 @@
+// Package release provides application use cases for release management.
+package release
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+)
+
+// TranslateNotesInput represents the input for the TranslateNotes use case.
+type TranslateNotesInput struct {
+	ReleaseID release.ReleaseID
+	// Locales lists the locales to produce, e.g. "en", "de", "ja". The first
+	// one is the locale the notes are written in: it is stored as is and
+	// every other locale is translated from it.
+	Locales []string
+}
+
+// TranslateNotesOutput represents the output of the TranslateNotes use case.
+type TranslateNotesOutput struct {
+	Localized []release.LocalizedNotes
+	// State is the state of the release afterwards. Translating the notes
+	// of an approved release sends it back for approval.
+	State release.ReleaseState
+}
+
+// NotesTranslator defines the interface for translating release notes.
+type NotesTranslator interface {
+	Translate(ctx context.Context, text, sourceLocale, targetLocale string) (string, error)
+}
+
+// TranslateNotesUseCase implements the translate notes use case. It
+// translates the notes of a release, so every locale says what the
+// approved source says rather than being generated independently.
+type TranslateNotesUseCase struct {
+	releaseRepo    release.Repository
+	translator     NotesTranslator
+	eventPublisher release.EventPublisher
+	logger         *slog.Logger
+}
+
+// NewTranslateNotesUseCase creates a new TranslateNotesUseCase.
+func NewTranslateNotesUseCase(
+	releaseRepo release.Repository,
+	translator NotesTranslator,
+	eventPublisher release.EventPublisher,
+) *TranslateNotesUseCase {
+	return &TranslateNotesUseCase{
+		releaseRepo:    releaseRepo,
+		translator:     translator,
+		eventPublisher: eventPublisher,
+		logger:         slog.Default().With("usecase", "translate_notes"),
+	}
+}
+
+// Execute executes the translate notes use case.
+func (uc *TranslateNotesUseCase) Execute(ctx context.Context, input TranslateNotesInput) (*TranslateNotesOutput, error) {
+	locales := uniqueLocales(input.Locales)
+	if len(locales) == 0 {
+		return nil, errors.New("at least one locale is required")
+	}
+	if uc.translator == nil && len(locales) > 1 {
+		return nil, errors.New("no translator is configured")
+	}
+
+	// Retrieve release
+	rel, err := uc.releaseRepo.FindByID(ctx, input.ReleaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find release: %w", err)
+	}
+
+	notes := rel.Notes()
+	if notes == nil {
+		return nil, release.ErrNilNotes
+	}
+
+	source := locales[0]
+	localized := []release.LocalizedNotes{{Locale: source, Changelog: notes.Changelog, Summary: notes.Summary}}
+	for _, locale := range locales[1:] {
+		changelog, err := uc.translator.Translate(ctx, notes.Changelog, source, locale)
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate changelog into %s: %w", locale, err)
+		}
+		summary, err := uc.translator.Translate(ctx, notes.Summary, source, locale)
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate release notes into %s: %w", locale, err)
+		}
+		localized = append(localized, release.LocalizedNotes{Locale: locale, Changelog: changelog, Summary: summary})
+
+		uc.logger.Info("release notes translated",
+			"release_id", rel.ID(),
+			"source", source,
+			"locale", locale)
+	}
+
+	if err := rel.SetLocalizedNotes(localized); err != nil {
+		return nil, fmt.Errorf("failed to set localized notes: %w", err)
+	}
+
+	// Save release
+	if err := uc.releaseRepo.Save(ctx, rel); err != nil {
+		return nil, fmt.Errorf("failed to save release: %w", err)
+	}
+
+	// Publish domain events
+	if uc.eventPublisher != nil {
+		if err := uc.eventPublisher.Publish(ctx, rel.DomainEvents()...); err != nil {
+			uc.logger.Warn("failed to publish domain events",
+				"error", err,
+				"release_id", rel.ID())
+		}
+		rel.ClearDomainEvents()
+	}
+
+	return &TranslateNotesOutput{Localized: localized, State: rel.State()}, nil
+}
+
+// uniqueLocales trims the locales and drops blanks and case-insensitive
+// duplicates, keeping the first occurrence.
+func uniqueLocales(locales []string) []string {
+	var unique []string
+	seen := make(map[string]bool, len(locales))
+	for _, l := range locales {
+		l = strings.TrimSpace(l)
+		key := strings.ToLower(l)
+		if l == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, l)
+	}
+	return unique
+}
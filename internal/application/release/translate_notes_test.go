@@ -0,0 +1,118 @@
+// Package release provides application use cases for release management.
+package release
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+)
+
+// mockNotesTranslator prefixes texts with the target locale.
+type mockNotesTranslator struct {
+	calls []string
+	err   error
+}
+
+func (m *mockNotesTranslator) Translate(ctx context.Context, text, sourceLocale, targetLocale string) (string, error) {
+	m.calls = append(m.calls, sourceLocale+"->"+targetLocale)
+	if m.err != nil {
+		return "", m.err
+	}
+	return "[" + targetLocale + "] " + text, nil
+}
+
+func TestTranslateNotesUseCase_Execute(t *testing.T) {
+	releaseRepo := newMockReleaseRepository()
+	releaseRepo.releases["release-123"] = createApprovedRelease("release-123", "main", "/path/to/repo")
+	translator := &mockNotesTranslator{}
+	eventPublisher := &mockEventPublisher{}
+
+	uc := NewTranslateNotesUseCase(releaseRepo, translator, eventPublisher)
+	output, err := uc.Execute(context.Background(), TranslateNotesInput{
+		ReleaseID: "release-123",
+		Locales:   []string{"en", "de", " ja ", "DE", ""},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if len(output.Localized) != 3 {
+		t.Fatalf("Localized = %+v, want en, de and ja", output.Localized)
+	}
+	if en := output.Localized[0]; en.Locale != "en" || en.Summary != "Release 1.1.0 with new feature" {
+		t.Errorf("source notes = %+v, want the notes unchanged", en)
+	}
+	if ja := output.Localized[2]; ja.Locale != "ja" || ja.Summary != "[ja] Release 1.1.0 with new feature" || ja.Changelog != "[ja] ## [1.1.0] - Changes\n- feat: new feature" {
+		t.Errorf("ja notes = %+v", ja)
+	}
+	if got := strings.Join(translator.calls, ","); got != "en->de,en->de,en->ja,en->ja" {
+		t.Errorf("translations = %s", got)
+	}
+
+	saved := releaseRepo.releases["release-123"]
+	if saved.State() != release.StateNotesGenerated || output.State != release.StateNotesGenerated {
+		t.Errorf("State = %s, output state = %s, translations should need a new approval", saved.State(), output.State)
+	}
+	if de := saved.Notes().ForLocale("de"); de == nil || de.Summary != "[de] Release 1.1.0 with new feature" {
+		t.Errorf("saved de notes = %+v", de)
+	}
+	if len(eventPublisher.published) == 0 {
+		t.Error("expected domain events to be published, but none were")
+	}
+}
+
+func TestTranslateNotesUseCase_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		release    *release.Release
+		translator NotesTranslator
+		locales    []string
+		wantErr    string
+	}{
+		{
+			name:       "no locales",
+			release:    createApprovedRelease("release-123", "main", "/path/to/repo"),
+			translator: &mockNotesTranslator{},
+			wantErr:    "at least one locale",
+		},
+		{
+			name:       "no notes",
+			release:    createReleaseWithPlan("release-123", "main", "/path/to/repo"),
+			translator: &mockNotesTranslator{},
+			locales:    []string{"en", "de"},
+			wantErr:    release.ErrNilNotes.Error(),
+		},
+		{
+			name:       "translation fails",
+			release:    createApprovedRelease("release-123", "main", "/path/to/repo"),
+			translator: &mockNotesTranslator{err: errors.New("rate limited")},
+			locales:    []string{"en", "fr"},
+			wantErr:    "failed to translate changelog into fr: rate limited",
+		},
+		{
+			name:    "no translator",
+			release: createApprovedRelease("release-123", "main", "/path/to/repo"),
+			locales: []string{"en", "fr"},
+			wantErr: "no translator",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			releaseRepo := newMockReleaseRepository()
+			releaseRepo.releases["release-123"] = tt.release
+
+			uc := NewTranslateNotesUseCase(releaseRepo, tt.translator, nil)
+			_, err := uc.Execute(context.Background(), TranslateNotesInput{ReleaseID: "release-123", Locales: tt.locales})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Execute() error = %v, want %q", err, tt.wantErr)
+			}
+			if notes := tt.release.Notes(); notes != nil && len(notes.Localized) != 0 {
+				t.Errorf("a failed translation should not store localized notes")
+			}
+		})
+	}
+}
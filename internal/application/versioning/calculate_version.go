@@ -55,7 +55,7 @@ func (uc *CalculateVersionUseCase) Execute(ctx context.Context, input CalculateV
 	}
 
 	// Discover current version
-	versionDiscovery := sourcecontrol.NewVersionDiscovery(tagPrefix)
+	versionDiscovery := sourcecontrol.NewVersionDiscovery(tagPrefix).WithScheme(version.SchemeOf(uc.versionCalc))
 	currentVersion, err := versionDiscovery.DiscoverCurrentVersion(ctx, uc.gitRepo)
 	if err != nil {
 		currentVersion = version.Initial
@@ -66,7 +66,7 @@ func (uc *CalculateVersionUseCase) Execute(ctx context.Context, input CalculateV
 
 	if input.Auto {
 		// Auto-detect from commits
-		latestTag, tagErr := uc.gitRepo.GetLatestVersionTag(ctx, tagPrefix)
+		latestTag, tagErr := versionDiscovery.LatestTag(ctx, uc.gitRepo)
 		if tagErr != nil {
 			// "Not found" is expected for repos with no tags yet - log at debug level
 			uc.logger.Debug("no version tags found, will analyze all commits",
@@ -142,6 +142,7 @@ type SetVersionInput struct {
 	Remote     string
 	TagMessage string
 	DryRun     bool
+	Scheme     version.Scheme // Formats the tag version; defaults to semver
 }
 
 // SetVersionOutput represents output of the SetVersion use case.
@@ -168,7 +169,8 @@ func (uc *SetVersionUseCase) Execute(ctx context.Context, input SetVersionInput)
 	if tagPrefix == "" {
 		tagPrefix = "v"
 	}
-	tagName := tagPrefix + input.Version.String()
+	label := version.SchemeOf(input.Scheme).Format(input.Version)
+	tagName := tagPrefix + label
 
 	output := &SetVersionOutput{
 		Version: input.Version,
@@ -193,7 +195,7 @@ func (uc *SetVersionUseCase) Execute(ctx context.Context, input SetVersionInput)
 
 		tagMsg := input.TagMessage
 		if tagMsg == "" {
-			tagMsg = fmt.Sprintf("Release %s", input.Version.String())
+			tagMsg = fmt.Sprintf("Release %s", label)
 		}
 
 		_, err = uc.gitRepo.CreateTag(ctx, tagName, latestCommit.Hash(), tagMsg)
@@ -86,6 +86,10 @@ func (m *mockGitRepository) PushTag(ctx context.Context, name string, remote str
 	return m.pushTagErr
 }
 
+func (m *mockGitRepository) DeleteRemoteTag(ctx context.Context, name string, remote string) error {
+	return nil
+}
+
 func (m *mockGitRepository) IsDirty(ctx context.Context) (bool, error) {
 	if m.info != nil {
 		return m.info.IsDirty, nil
@@ -393,6 +397,20 @@ func TestSetVersionUseCase_Execute(t *testing.T) {
 			wantCreated: false,
 			wantPushed:  false,
 		},
+		{
+			name: "scheme formats tag name",
+			input: SetVersionInput{
+				Version:   version.MustParse("2025.7.0"),
+				CreateTag: true,
+				DryRun:    true,
+				Scheme:    mustCalVerScheme(t, "YYYY.0M.MICRO"),
+			},
+			gitRepo:     &mockGitRepository{},
+			wantErr:     false,
+			wantTagName: "v2025.07.0",
+			wantCreated: false,
+			wantPushed:  false,
+		},
 		{
 			name: "create tag without push",
 			input: SetVersionInput{
@@ -608,6 +626,16 @@ func TestNewSetVersionUseCase(t *testing.T) {
 	}
 }
 
+// mustCalVerScheme creates a calendar versioning scheme or fails the test.
+func mustCalVerScheme(t *testing.T, format string) version.Scheme {
+	t.Helper()
+	scheme, err := version.NewCalVerScheme(format)
+	if err != nil {
+		t.Fatalf("NewCalVerScheme() error = %v", err)
+	}
+	return scheme
+}
+
 // containsString checks if s contains substr.
 func containsString(s, substr string) bool {
 	return strings.Contains(s, substr)
@@ -0,0 +1,299 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/felixgeelhaar/release-pilot/internal/container"
+	"github.com/felixgeelhaar/release-pilot/internal/service/ai"
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+)
+
+var aiCmd = &cobra.Command{
+	Use:   "ai",
+	Short: "Manage AI content generation",
+	Long: `Manage the AI features of ReleasePilot.
+
+Examples:
+  # Show how many AI responses are cached
+  release-pilot ai cache stats
+
+  # Remove all cached AI responses
+  release-pilot ai cache clear
+
+  # Score AI notes against the recorded fixtures, offline
+  release-pilot ai eval --fake`,
+}
+
+var aiCacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the AI response cache",
+	Long: `Manage the AI response cache.
+
+AI responses are cached under ai.cache.dir (default .release-pilot/cache/ai),
+keyed by a hash of the providers, models, prompts, options and changeset.
+Re-running 'notes --ai' for the same release returns the cached text without
+calling the provider. Use 'notes --refresh' to regenerate, or 'notes --no-cache'
+to bypass the cache for one run.`,
+}
+
+var aiCacheStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show AI response cache statistics",
+	Args:  cobra.NoArgs,
+	RunE:  runAICacheStats,
+}
+
+var aiCacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove all cached AI responses",
+	Args:  cobra.NoArgs,
+	RunE:  runAICacheClear,
+}
+
+var aiEvalCmd = &cobra.Command{
+	Use:   "eval",
+	Short: "Score AI notes against recorded changesets",
+	Long: `Generate release notes for each recorded changeset (fixture) with the
+configured AI provider and score them.
+
+Fixtures are JSON files in the fixture directory (default .release-pilot/eval).
+Each output is checked for coverage of the fixture's commits, its length,
+forbidden phrases, mention of breaking changes and, if <name>.golden.md
+exists, word overlap with that golden output. The command fails if any
+fixture fails a check, so it can gate changes to the model, tone or prompts
+in CI. Responses are never served from the AI response cache.
+
+Examples:
+  # Evaluate the configured provider and prompts
+  release-pilot ai eval
+
+  # Evaluate offline with the deterministic fake provider
+  release-pilot ai eval --fake
+
+  # Accept the current outputs as the golden outputs
+  release-pilot ai eval --update`,
+	Args: cobra.NoArgs,
+	RunE: runAIEval,
+}
+
+var (
+	aiEvalDir    string
+	aiEvalFake   bool
+	aiEvalUpdate bool
+)
+
+func init() {
+	rootCmd.AddCommand(aiCmd)
+	aiCmd.AddCommand(aiCacheCmd)
+	aiCacheCmd.AddCommand(aiCacheStatsCmd)
+	aiCacheCmd.AddCommand(aiCacheClearCmd)
+	aiCmd.AddCommand(aiEvalCmd)
+
+	aiEvalCmd.Flags().StringVar(&aiEvalDir, "fixtures", ai.DefaultEvalDir, "directory of evaluation fixtures")
+	aiEvalCmd.Flags().BoolVar(&aiEvalFake, "fake", false, "use the offline fake provider instead of the configured one")
+	aiEvalCmd.Flags().BoolVar(&aiEvalUpdate, "update", false, "write the outputs as the golden outputs")
+}
+
+// openAICache opens the configured AI response cache.
+func openAICache() (*ai.Cache, error) {
+	dir := ai.DefaultCacheDir
+	if cfg != nil && cfg.AI.Cache.Dir != "" {
+		dir = cfg.AI.Cache.Dir
+	}
+	return ai.NewCache(dir)
+}
+
+// runAICacheStats implements the ai cache stats command.
+func runAICacheStats(cmd *cobra.Command, args []string) error {
+	cache, err := openAICache()
+	if err != nil {
+		return err
+	}
+	stats, err := cache.Stats()
+	if err != nil {
+		return err
+	}
+
+	if outputJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(map[string]any{
+			"dir":     cache.Dir(),
+			"enabled": cfg == nil || cfg.AI.Cache.Enabled,
+			"entries": stats.Entries,
+			"bytes":   stats.Bytes,
+		})
+	}
+
+	printTitle("AI Response Cache")
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "  Directory:\t%s\n", cache.Dir())
+	fmt.Fprintf(w, "  Entries:\t%d\n", stats.Entries)
+	fmt.Fprintf(w, "  Size:\t%s\n", formatBytes(stats.Bytes))
+	w.Flush()
+	if cfg != nil && !cfg.AI.Cache.Enabled {
+		fmt.Println()
+		printWarning("The cache is disabled (ai.cache.enabled: false)")
+	}
+	return nil
+}
+
+// runAICacheClear implements the ai cache clear command.
+func runAICacheClear(cmd *cobra.Command, args []string) error {
+	cache, err := openAICache()
+	if err != nil {
+		return err
+	}
+	stats, err := cache.Stats()
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		printInfo(fmt.Sprintf("Would remove %d cached AI response(s) from %s", stats.Entries, cache.Dir()))
+		return nil
+	}
+	if err := cache.Clear(); err != nil {
+		return err
+	}
+	printSuccess(fmt.Sprintf("Removed %d cached AI response(s) from %s", stats.Entries, cache.Dir()))
+	return nil
+}
+
+// runAIEval implements the ai eval command.
+func runAIEval(cmd *cobra.Command, args []string) error {
+	ctx := ai.WithCacheMode(cmd.Context(), ai.CacheDisabled)
+
+	fixtures, err := ai.LoadEvalFixtures(aiEvalDir)
+	if err != nil {
+		return err
+	}
+
+	svc := ai.NewFakeService()
+	withPrompts := func(ctx context.Context, _ string, _ *git.CategorizedChanges) (context.Context, error) {
+		return ctx, nil
+	}
+	if !aiEvalFake {
+		dddContainer, err := container.NewInitializedDDDContainer(ctx, cfg)
+		if err != nil {
+			return fmt.Errorf("failed to initialize container: %w", err)
+		}
+		defer dddContainer.Close()
+		if !dddContainer.HasAI() {
+			return fmt.Errorf("ai eval requires a configured AI provider; use --fake to evaluate offline")
+		}
+		svc = dddContainer.AI()
+		withPrompts = dddContainer.WithAIPrompts
+	}
+
+	opts, structured := aiEvalOptions()
+	results := make([]*ai.EvalResult, 0, len(fixtures))
+	for _, f := range fixtures {
+		fixtureCtx, err := withPrompts(ctx, f.Version, f.Changes())
+		if err != nil {
+			return err
+		}
+		result, err := ai.RunEval(fixtureCtx, svc, f, opts, structured)
+		if err != nil {
+			result = &ai.EvalResult{
+				Fixture: f.Name,
+				Checks:  []ai.EvalCheck{{Name: "generate", Detail: err.Error()}},
+			}
+		} else if aiEvalUpdate {
+			if err := f.WriteGolden(result.Output); err != nil {
+				return err
+			}
+			result = ai.ScoreEval(f, result.Output)
+		}
+		results = append(results, result)
+	}
+
+	failed := 0
+	for _, r := range results {
+		if !r.Passed {
+			failed++
+		}
+	}
+
+	if outputJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(map[string]any{
+			"fixtures": results,
+			"passed":   len(results) - failed,
+			"failed":   failed,
+		}); err != nil {
+			return err
+		}
+	} else {
+		outputEvalResults(results)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d fixture(s) failed evaluation", failed, len(results))
+	}
+	return nil
+}
+
+// aiEvalOptions returns the generation options notes generation uses, and
+// whether it asks for structured notes.
+func aiEvalOptions() (ai.GenerateOptions, bool) {
+	opts := ai.DefaultGenerateOptions()
+	if cfg == nil {
+		return opts, false
+	}
+	if cfg.AI.Tone != "" {
+		opts.Tone = ai.Tone(cfg.AI.Tone)
+	}
+	if cfg.AI.Audience != "" {
+		opts.Audience = ai.Audience(cfg.AI.Audience)
+	}
+	opts.IncludeEmoji = cfg.AI.IncludeEmoji
+	return opts, cfg.AI.Structured
+}
+
+// outputEvalResults prints the score of each fixture and its failed checks.
+func outputEvalResults(results []*ai.EvalResult) {
+	printTitle("AI Notes Evaluation")
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, r := range results {
+		status := "PASS"
+		if !r.Passed {
+			status = "FAIL"
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%.2f\n", status, r.Fixture, r.Score)
+		for _, c := range r.Checks {
+			if !c.Passed {
+				fmt.Fprintf(w, "  \t  %s: %s\t\n", c.Name, c.Detail)
+			}
+		}
+	}
+	w.Flush()
+	if aiEvalUpdate {
+		fmt.Println()
+		printInfo(fmt.Sprintf("Updated the golden outputs in %s", aiEvalDir))
+	}
+}
+
+// formatBytes formats a size in bytes for humans.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
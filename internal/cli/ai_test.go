@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/config"
+	"github.com/felixgeelhaar/release-pilot/internal/service/ai"
+)
+
+func TestNotesCacheMode(t *testing.T) {
+	defer func() { notesNoCache, notesRefresh = false, false }()
+
+	tests := []struct {
+		noCache, refresh bool
+		want             ai.CacheMode
+	}{
+		{false, false, ai.CacheReadWrite},
+		{true, false, ai.CacheDisabled},
+		{false, true, ai.CacheRefresh},
+	}
+	for _, tt := range tests {
+		notesNoCache, notesRefresh = tt.noCache, tt.refresh
+		if got := notesCacheMode(); got != tt.want {
+			t.Errorf("notesCacheMode() with no-cache=%v refresh=%v = %v, want %v", tt.noCache, tt.refresh, got, tt.want)
+		}
+	}
+}
+
+func TestAICacheClear(t *testing.T) {
+	originalCfg := cfg
+	defer func() { cfg = originalCfg }()
+
+	dir := filepath.Join(t.TempDir(), "ai")
+	cfg = config.DefaultConfig()
+	cfg.AI.Cache.Dir = dir
+
+	entry := filepath.Join(dir, "ab", "abcdef.json")
+	if err := os.MkdirAll(filepath.Dir(entry), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(entry, []byte(`{"response":"cached"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runAICacheStats(aiCacheStatsCmd, nil); err != nil {
+		t.Fatalf("runAICacheStats() error = %v", err)
+	}
+	if err := runAICacheClear(aiCacheClearCmd, nil); err != nil {
+		t.Fatalf("runAICacheClear() error = %v", err)
+	}
+	if _, err := os.Stat(entry); !os.IsNotExist(err) {
+		t.Errorf("cache entry should be removed, stat error = %v", err)
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1536, "1.5 KiB"},
+		{5 * 1024 * 1024, "5.0 MiB"},
+	}
+	for _, tt := range tests {
+		if got := formatBytes(tt.n); got != tt.want {
+			t.Errorf("formatBytes(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestRunAIEval_Fake(t *testing.T) {
+	originalCfg, originalJSON := cfg, outputJSON
+	defer func() {
+		cfg, outputJSON = originalCfg, originalJSON
+		aiEvalDir, aiEvalFake, aiEvalUpdate = ai.DefaultEvalDir, false, false
+	}()
+	cfg = config.DefaultConfig()
+	outputJSON = false
+
+	dir := t.TempDir()
+	fixture := `{"version": "1.1.0", "commits": [{"type": "feat", "description": "add cursor pagination"}]}`
+	if err := os.WriteFile(filepath.Join(dir, "feature.json"), []byte(fixture), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	aiEvalDir, aiEvalFake = dir, true
+	aiEvalCmd.SetContext(context.Background())
+
+	aiEvalUpdate = true
+	if err := runAIEval(aiEvalCmd, nil); err != nil {
+		t.Fatalf("runAIEval(--update) error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "feature.golden.md")); err != nil {
+		t.Fatalf("golden output not written: %v", err)
+	}
+
+	aiEvalUpdate = false
+	if err := runAIEval(aiEvalCmd, nil); err != nil {
+		t.Errorf("runAIEval() against the golden output error = %v", err)
+	}
+
+	// An output that drifts from the golden output fails the run
+	if err := os.WriteFile(filepath.Join(dir, "feature.golden.md"), []byte("Something else entirely, unrelated wording throughout."), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := runAIEval(aiEvalCmd, nil); err == nil {
+		t.Error("runAIEval() should fail when the output drifts from the golden output")
+	}
+}
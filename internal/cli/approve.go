@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"os/user"
 	"path/filepath"
 	"strings"
 	"text/tabwriter"
@@ -34,6 +35,15 @@ func init() {
 	approveCmd.Flags().BoolVarP(&approveInteractive, "interactive", "i", false, "use interactive TUI for approval")
 }
 
+// approvalPolicy returns the configured approval policy, or nil if none is configured.
+func approvalPolicy() *release.ApprovalPolicy {
+	if cfg == nil || cfg.Workflow.ApprovalPolicy == nil {
+		return nil
+	}
+	policy := cfg.Workflow.ApprovalPolicy.Policy()
+	return &policy
+}
+
 // getLatestRelease retrieves the latest release from the repository.
 func getLatestRelease(ctx context.Context, dddContainer *container.DDDContainer) (*release.Release, error) {
 	gitAdapter := dddContainer.GitAdapter()
@@ -103,6 +113,49 @@ func promptForApproval() (bool, error) {
 	return response == "y" || response == "yes", nil
 }
 
+// ciActorVars lists, per CI platform, the variable the platform sets when a
+// job runs on it and the variable holding the account that triggered the job.
+var ciActorVars = []struct{ platform, actor string }{
+	{"GITHUB_ACTIONS", "GITHUB_ACTOR"},
+	{"GITLAB_CI", "GITLAB_USER_LOGIN"},
+}
+
+// ciActor returns the account the CI platform reports as having triggered
+// the job, or "" when not running in a known CI platform.
+func ciActor() string {
+	for _, vars := range ciActorVars {
+		if os.Getenv(vars.platform) == "true" {
+			return os.Getenv(vars.actor)
+		}
+	}
+	return ""
+}
+
+// approvalOperator returns the account running the approval: the CI actor,
+// or the local user. An approval policy counts one approval per operator.
+func approvalOperator() string {
+	if actor := ciActor(); actor != "" {
+		return actor
+	}
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return getApproverName()
+}
+
+// approverIdentity returns the identity an approval is recorded under: the
+// CI actor, or the configured git email, falling back to the operator.
+func approverIdentity(ctx context.Context) string {
+	if actor := ciActor(); actor != "" {
+		return actor
+	}
+	out, err := exec.CommandContext(ctx, "git", "config", "user.email").Output()
+	if email := strings.TrimSpace(string(out)); err == nil && email != "" {
+		return email
+	}
+	return approvalOperator()
+}
+
 // getApproverName returns the name of the approver from environment.
 func getApproverName() string {
 	approvedBy := os.Getenv("USER")
@@ -113,19 +166,84 @@ func getApproverName() string {
 }
 
 // executeApproval executes the approval use case.
-func executeApproval(ctx context.Context, dddContainer *container.DDDContainer, rel *release.Release, editedNotes *string) error {
+func executeApproval(ctx context.Context, dddContainer *container.DDDContainer, rel *release.Release, editedNotes *string) (*apprelease.ApproveReleaseOutput, error) {
 	input := apprelease.ApproveReleaseInput{
 		ReleaseID:   rel.ID(),
-		ApprovedBy:  getApproverName(),
+		ApprovedBy:  approverIdentity(ctx),
+		Operator:    approvalOperator(),
 		AutoApprove: approveYes,
 		EditedNotes: editedNotes,
+		Policy:      approvalPolicy(),
 	}
 
-	_, err := dddContainer.ApproveRelease().Execute(ctx, input)
+	output, err := dddContainer.ApproveRelease().Execute(ctx, input)
 	if err != nil {
-		return fmt.Errorf("failed to approve release: %w", err)
+		return nil, fmt.Errorf("failed to approve release: %w", err)
+	}
+	return output, nil
+}
+
+// printApprovalResult prints the next steps, or who still has to approve
+// when the approval policy is not yet satisfied.
+func printApprovalResult(output *apprelease.ApproveReleaseOutput) {
+	if output.Approved {
+		printApproveNextSteps()
+		return
+	}
+
+	printSuccess(fmt.Sprintf("Approval by %s recorded (%d of %d)", output.ApprovedBy, output.Status.Approvals, output.Status.Required))
+	fmt.Println()
+	printApprovalStatus(output.Status)
+	fmt.Println()
+	printInfo("The release can be published once the approval policy is satisfied")
+}
+
+// printApprovalStatus prints the recorded approvals and the outstanding requirements.
+func printApprovalStatus(status release.ApprovalStatus) {
+	printTitle("Approvals")
+	fmt.Println()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	approvedBy := "-"
+	if len(status.ApprovedBy) > 0 {
+		approvedBy = strings.Join(status.ApprovedBy, ", ")
+	}
+	fmt.Fprintf(w, "  Approved by:\t%s\n", approvedBy)
+	fmt.Fprintf(w, "  Approvals:\t%d of %d\n", status.Approvals, status.Required)
+	for _, g := range status.Groups {
+		line := fmt.Sprintf("%d of %d", g.Approvals, g.Required)
+		if !g.Satisfied() && len(g.Pending) > 0 {
+			line += fmt.Sprintf(" (pending: %s)", strings.Join(g.Pending, ", "))
+		}
+		fmt.Fprintf(w, "  Group %s:\t%s\n", g.Name, line)
+	}
+	w.Flush()
+
+	if !status.Satisfied {
+		fmt.Println()
+		printWarning("Still required: " + status.String())
+	}
+}
+
+// approvalStatusJSON returns the JSON representation of an approval status.
+func approvalStatusJSON(status release.ApprovalStatus) map[string]any {
+	groups := make([]map[string]any, 0, len(status.Groups))
+	for _, g := range status.Groups {
+		groups = append(groups, map[string]any{
+			"name":      g.Name,
+			"required":  g.Required,
+			"approvals": g.Approvals,
+			"pending":   g.Pending,
+			"satisfied": g.Satisfied(),
+		})
+	}
+	return map[string]any{
+		"satisfied":   status.Satisfied,
+		"approvals":   status.Approvals,
+		"required":    status.Required,
+		"approved_by": status.ApprovedBy,
+		"groups":      groups,
 	}
-	return nil
 }
 
 // printApproveNextSteps prints the next steps after approval.
@@ -153,11 +271,22 @@ func runApprove(cmd *cobra.Command, args []string) error {
 	}
 	defer dddContainer.Close()
 
-	// Get latest release
-	rel, err := getLatestRelease(ctx, dddContainer)
+	// Monorepo release groups are approved together unless --package narrows them
+	packageReleases, err := findPackageReleases(ctx, dddContainer)
 	if err != nil {
 		return err
 	}
+	if len(packageReleases) > 1 {
+		return approvePackageReleases(ctx, dddContainer, packageReleases)
+	}
+
+	// Get latest release
+	var rel *release.Release
+	if len(packageReleases) == 1 {
+		rel = packageReleases[0]
+	} else if rel, err = getLatestRelease(ctx, dddContainer); err != nil {
+		return err
+	}
 
 	// Check if already approved
 	if isReleaseAlreadyApproved(rel) {
@@ -200,11 +329,12 @@ func runApprove(cmd *cobra.Command, args []string) error {
 	}
 
 	// Execute approval
-	if err := executeApproval(ctx, dddContainer, rel, editedNotes); err != nil {
+	output, err := executeApproval(ctx, dddContainer, rel, editedNotes)
+	if err != nil {
 		return err
 	}
 
-	printApproveNextSteps()
+	printApprovalResult(output)
 	return nil
 }
 
@@ -218,6 +348,9 @@ func displayReleaseSummary(rel *release.Release) {
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintf(w, "  Release ID:\t%s\n", summary.ID)
+	if summary.Package != "" {
+		fmt.Fprintf(w, "  Package:\t%s\n", summary.Package)
+	}
 	fmt.Fprintf(w, "  Current version:\t%s\n", summary.CurrentVersion)
 	fmt.Fprintf(w, "  Next version:\t%s\n", summary.NextVersion)
 	fmt.Fprintf(w, "  Release type:\t%s\n", summary.ReleaseType)
@@ -226,6 +359,16 @@ func displayReleaseSummary(rel *release.Release) {
 	fmt.Fprintf(w, "  State:\t%s\n", summary.State.String())
 	w.Flush()
 
+	// Show recorded approvals when more than one approval may be required
+	if policy := approvalPolicy(); policy != nil || len(rel.Approvals()) > 0 {
+		if policy == nil {
+			defaultPolicy := release.DefaultApprovalPolicy()
+			policy = &defaultPolicy
+		}
+		fmt.Println()
+		printApprovalStatus(rel.ApprovalStatus(*policy))
+	}
+
 	// Show changes overview
 	if rel.Plan() != nil && rel.Plan().HasChangeSet() {
 		fmt.Println()
@@ -252,6 +395,14 @@ func displayReleaseSummary(rel *release.Release) {
 		}
 	}
 
+	// Show what the reviewer should double-check in AI-written notes
+	if rel.Notes() != nil && rel.Notes().Fidelity != nil {
+		fmt.Println()
+		printTitle("AI Notes Fidelity")
+		fmt.Println()
+		printFidelityReport(rel.Notes().Fidelity)
+	}
+
 	// Show release notes preview
 	if rel.Notes() != nil && rel.Notes().Changelog != "" {
 		fmt.Println()
@@ -397,8 +548,16 @@ func outputApproveJSON(rel *release.Release) error {
 		"ci_mode":         ciMode,
 	}
 
+	if summary.Package != "" {
+		output["package"] = summary.Package
+	}
+
 	if summary.NextVersion != "" {
-		output["tag_name"] = cfg.Versioning.TagPrefix + summary.NextVersion
+		output["tag_name"] = releaseTagPrefix(rel) + summary.NextVersion
+	}
+
+	if policy := approvalPolicy(); policy != nil {
+		output["approval_status"] = approvalStatusJSON(rel.ApprovalStatus(*policy))
 	}
 
 	// Add changes summary if available
@@ -414,11 +573,59 @@ func outputApproveJSON(rel *release.Release) error {
 		}
 	}
 
+	if rel.Notes() != nil && rel.Notes().Fidelity != nil {
+		output["fidelity"] = fidelityJSON(rel.Notes().Fidelity)
+	}
+
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(output)
 }
 
+// printFidelityReport prints the statements of AI notes that no commit
+// supports and the breaking changes the notes leave out.
+func printFidelityReport(report *release.FidelityReport) {
+	if report.IsClean() {
+		printSuccess(report.String())
+		return
+	}
+	printWarning(report.String())
+	for _, issue := range fidelityIssues(report) {
+		fmt.Printf("  - %s\n", issue)
+	}
+}
+
+// fidelityIssues lists the findings of a fidelity report as lines.
+func fidelityIssues(report *release.FidelityReport) []string {
+	var issues []string
+	for _, u := range report.Unsupported {
+		issues = append(issues, fmt.Sprintf("%q: %s", u.Text, u.Reason))
+	}
+	for _, o := range report.OmittedBreaking {
+		issues = append(issues, fmt.Sprintf("breaking change not mentioned: %s %s", o.Hash, o.Subject))
+	}
+	return issues
+}
+
+// fidelityJSON converts a fidelity report to its JSON representation.
+func fidelityJSON(report *release.FidelityReport) map[string]any {
+	unsupported := make([]map[string]string, 0, len(report.Unsupported))
+	for _, u := range report.Unsupported {
+		unsupported = append(unsupported, map[string]string{"text": u.Text, "reason": u.Reason})
+	}
+	omitted := make([]map[string]string, 0, len(report.OmittedBreaking))
+	for _, o := range report.OmittedBreaking {
+		omitted = append(omitted, map[string]string{"hash": o.Hash, "subject": o.Subject})
+	}
+	return map[string]any{
+		"clean":            report.IsClean(),
+		"claims":           report.Claims,
+		"supported":        report.Supported(),
+		"unsupported":      unsupported,
+		"omitted_breaking": omitted,
+	}
+}
+
 // buildTUISummary builds the TUI summary from a release.
 func buildTUISummary(rel *release.Release) ui.ReleaseSummary {
 	summary := rel.Summary()
@@ -446,6 +653,10 @@ func buildTUISummary(rel *release.Release) ui.ReleaseSummary {
 	// Add release notes
 	if rel.Notes() != nil {
 		tuiSummary.ReleaseNotes = rel.Notes().Changelog
+		if report := rel.Notes().Fidelity; report != nil {
+			tuiSummary.Fidelity = report.String()
+			tuiSummary.FidelityIssues = fidelityIssues(report)
+		}
 	}
 
 	// Add plugins
@@ -530,10 +741,11 @@ func runInteractiveApproval(ctx context.Context, dddContainer *container.DDDCont
 	}
 
 	// Execute approval (reuse the common helper)
-	if err := executeApproval(ctx, dddContainer, rel, editedNotes); err != nil {
+	output, err := executeApproval(ctx, dddContainer, rel, editedNotes)
+	if err != nil {
 		return err
 	}
 
-	printApproveNextSteps()
+	printApprovalResult(output)
 	return nil
 }
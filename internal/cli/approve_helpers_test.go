@@ -2,10 +2,14 @@
 package cli
 
 import (
+	"context"
 	"os"
+	"os/user"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/felixgeelhaar/release-pilot/internal/config"
 	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
 	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
 	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
@@ -182,3 +186,67 @@ func TestIsReleaseAlreadyApproved(t *testing.T) {
 		})
 	}
 }
+
+func TestApproverIdentity(t *testing.T) {
+	t.Setenv("GITHUB_ACTIONS", "")
+	t.Setenv("GITLAB_CI", "")
+
+	// Outside CI approvals are recorded under the configured git email
+	gitConfig := filepath.Join(t.TempDir(), "gitconfig")
+	if err := os.WriteFile(gitConfig, []byte("[user]\n\temail = alice@example.com\n"), 0600); err != nil {
+		t.Fatalf("failed to write git config: %v", err)
+	}
+	t.Setenv("GIT_CONFIG_GLOBAL", gitConfig)
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	t.Chdir(t.TempDir())
+
+	if got := approverIdentity(context.Background()); got != "alice@example.com" {
+		t.Errorf("approverIdentity() = %q, want alice@example.com", got)
+	}
+
+	// The operator is the local account, whatever identity is configured
+	u, err := user.Current()
+	if err != nil {
+		t.Skipf("no current user: %v", err)
+	}
+	t.Setenv("USER", "mallory")
+	if got := approvalOperator(); got != u.Username {
+		t.Errorf("approvalOperator() = %q, want %q", got, u.Username)
+	}
+
+	// In CI both are the account that triggered the job
+	t.Setenv("GITHUB_ACTIONS", "true")
+	t.Setenv("GITHUB_ACTOR", "bob")
+	if got := approverIdentity(context.Background()); got != "bob" {
+		t.Errorf("approverIdentity() in CI = %q, want bob", got)
+	}
+	if got := approvalOperator(); got != "bob" {
+		t.Errorf("approvalOperator() in CI = %q, want bob", got)
+	}
+}
+
+func TestValidateReleaseForPublish_ApprovalPolicy(t *testing.T) {
+	origCfg, origSkip := cfg, publishSkipApproval
+	defer func() { cfg, publishSkipApproval = origCfg, origSkip }()
+
+	cfg = config.DefaultConfig()
+	cfg.Workflow.ApprovalPolicy = &config.ApprovalPolicyConfig{RequiredApprovals: 2}
+	publishSkipApproval = true
+
+	// Approved before the policy required a second approval
+	rel := release.NewRelease("rel-1", "main", "/repo")
+	v1, v2 := version.MustParse("1.0.0"), version.MustParse("1.1.0")
+	_ = rel.SetPlan(release.NewReleasePlan(v1, v2, changes.ReleaseTypeMinor, changes.NewChangeSet("cs-1", "v1.0.0", "HEAD"), false))
+	_ = rel.SetVersion(v2, "v1.1.0")
+	_ = rel.SetNotes(&release.ReleaseNotes{Changelog: "notes", GeneratedAt: time.Now()})
+	_ = rel.Approve("alice", false)
+
+	if err := validateReleaseForPublish(rel); err == nil {
+		t.Error("validateReleaseForPublish() should fail while the policy is not satisfied, even with --skip-approval")
+	}
+
+	cfg.Workflow.ApprovalPolicy.RequiredApprovals = 1
+	if err := validateReleaseForPublish(rel); err != nil {
+		t.Errorf("validateReleaseForPublish() error = %v", err)
+	}
+}
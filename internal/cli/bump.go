@@ -48,6 +48,24 @@ func parseBumpLevel(level string) (version.BumpType, bool, error) {
 	}
 }
 
+// versionScheme returns the version scheme selected by the configuration.
+// The configuration is validated on load, so errors fall back to semver.
+func versionScheme() version.Scheme {
+	if cfg == nil {
+		return version.NewSemVerScheme()
+	}
+	scheme, err := cfg.Versioning.Scheme()
+	if err != nil {
+		return version.NewSemVerScheme()
+	}
+	return scheme
+}
+
+// formatVersion writes a version in the configured scheme (without tag prefix).
+func formatVersion(v version.SemanticVersion) string {
+	return versionScheme().Format(v)
+}
+
 // buildSetVersionInput creates the input for the SetVersion use case.
 func buildSetVersionInput(ver version.SemanticVersion, createTag, pushTag, dryRunMode bool) versioning.SetVersionInput {
 	return versioning.SetVersionInput{
@@ -56,14 +74,15 @@ func buildSetVersionInput(ver version.SemanticVersion, createTag, pushTag, dryRu
 		CreateTag:  createTag && cfg.Versioning.GitTag,
 		PushTag:    pushTag && cfg.Versioning.GitPush,
 		Remote:     "origin",
-		TagMessage: fmt.Sprintf("Release %s", ver.String()),
+		TagMessage: fmt.Sprintf("Release %s", formatVersion(ver)),
 		DryRun:     dryRunMode,
+		Scheme:     versionScheme(),
 	}
 }
 
 // outputSetVersionResult outputs the result of a SetVersion operation as text.
 func outputSetVersionResult(output *versioning.SetVersionOutput) {
-	printInfo(fmt.Sprintf("Version set to: %s%s", cfg.Versioning.TagPrefix, output.Version.String()))
+	printInfo(fmt.Sprintf("Version set to: %s%s", cfg.Versioning.TagPrefix, formatVersion(output.Version)))
 	if output.TagCreated {
 		printSuccess(fmt.Sprintf("Created tag %s", output.TagName))
 	}
@@ -74,7 +93,7 @@ func outputSetVersionResult(output *versioning.SetVersionOutput) {
 
 // handleForcedVersion handles the --force flag to set a specific version.
 func handleForcedVersion(ctx context.Context, dddContainer *container.DDDContainer, forcedVersionStr string) error {
-	forcedVersion, err := version.Parse(forcedVersionStr)
+	forcedVersion, err := versionScheme().Parse(forcedVersionStr)
 	if err != nil {
 		return fmt.Errorf("invalid version format: %w", err)
 	}
@@ -111,8 +130,8 @@ func buildCalculateVersionInput(bumpType version.BumpType, auto bool) versioning
 
 // outputCalculatedVersionText outputs the calculated version information as text.
 func outputCalculatedVersionText(calcOutput *versioning.CalculateVersionOutput, nextVersion version.SemanticVersion) {
-	printInfo(fmt.Sprintf("Current version: %s%s", cfg.Versioning.TagPrefix, calcOutput.CurrentVersion.String()))
-	printInfo(fmt.Sprintf("Next version:    %s%s", cfg.Versioning.TagPrefix, nextVersion.String()))
+	printInfo(fmt.Sprintf("Current version: %s%s", cfg.Versioning.TagPrefix, formatVersion(calcOutput.CurrentVersion)))
+	printInfo(fmt.Sprintf("Next version:    %s%s", cfg.Versioning.TagPrefix, formatVersion(nextVersion)))
 	printInfo(fmt.Sprintf("Bump type:       %s", calcOutput.BumpType.String()))
 	if calcOutput.AutoDetected {
 		printInfo("Bump type was auto-detected from commits")
@@ -168,6 +187,15 @@ func runVersion(cmd *cobra.Command, args []string) error {
 	}
 	defer dddContainer.Close()
 
+	// Monorepo release groups are versioned from their per-package plans
+	packageReleases, err := findPackageReleases(ctx, dddContainer)
+	if err != nil {
+		return err
+	}
+	if packageReleases != nil {
+		return bumpPackageReleases(ctx, dddContainer, packageReleases)
+	}
+
 	// Parse bump type from flag
 	bumpType, auto, err := parseBumpLevel(bumpLevel)
 	if err != nil {
@@ -233,7 +261,7 @@ func updateReleaseVersion(ctx context.Context, dddContainer *container.DDDContai
 		return err
 	}
 
-	tagName := cfg.Versioning.TagPrefix + ver.String()
+	tagName := cfg.Versioning.TagPrefix + formatVersion(ver)
 	if err := rel.SetVersion(ver, tagName); err != nil {
 		return err
 	}
@@ -244,11 +272,11 @@ func updateReleaseVersion(ctx context.Context, dddContainer *container.DDDContai
 // outputBumpJSON outputs the version bump as JSON.
 func outputBumpJSON(current, next version.SemanticVersion, bumpType version.BumpType, autoDetected bool) error {
 	output := map[string]any{
-		"current_version": current.String(),
-		"next_version":    next.String(),
+		"current_version": formatVersion(current),
+		"next_version":    formatVersion(next),
 		"bump_type":       bumpType.String(),
 		"auto_detected":   autoDetected,
-		"tag_name":        cfg.Versioning.TagPrefix + next.String(),
+		"tag_name":        cfg.Versioning.TagPrefix + formatVersion(next),
 	}
 
 	encoder := json.NewEncoder(os.Stdout)
@@ -259,7 +287,7 @@ func outputBumpJSON(current, next version.SemanticVersion, bumpType version.Bump
 // outputSetVersionJSON outputs the set version result as JSON.
 func outputSetVersionJSON(output *versioning.SetVersionOutput) error {
 	result := map[string]any{
-		"version":     output.Version.String(),
+		"version":     formatVersion(output.Version),
 		"tag_name":    output.TagName,
 		"tag_created": output.TagCreated,
 		"tag_pushed":  output.TagPushed,
@@ -0,0 +1,159 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/felixgeelhaar/release-pilot/internal/application/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+)
+
+// classificationRegex matches a classification such as "feat", "fix(api)"
+// or "feat(cli)!".
+var classificationRegex = regexp.MustCompile(`^(\w+)(?:\(([^)]+)\))?(!)?$`)
+
+// parseClassification parses a classification such as "feat(cli)!".
+func parseClassification(s string) (release.CommitClassification, error) {
+	matches := classificationRegex.FindStringSubmatch(strings.TrimSpace(s))
+	if matches == nil {
+		return release.CommitClassification{}, fmt.Errorf("invalid classification %q, expected type(scope)!", s)
+	}
+	commitType, ok := changes.ParseCommitType(matches[1])
+	if !ok {
+		return release.CommitClassification{}, fmt.Errorf("unknown commit type %q", matches[1])
+	}
+	return release.CommitClassification{
+		Type:     commitType,
+		Scope:    strings.TrimSpace(matches[2]),
+		Breaking: matches[3] == "!",
+		Source:   release.ClassificationSourceOverride,
+	}, nil
+}
+
+// formatClassification formats a classification as "type(scope)!".
+func formatClassification(c release.CommitClassification) string {
+	s := string(c.Type)
+	if c.Scope != "" {
+		s += "(" + c.Scope + ")"
+	}
+	if c.Breaking {
+		s += "!"
+	}
+	return s
+}
+
+// parseClassificationOverrides parses --classify-override values of the form
+// <hash>=<type(scope)!> or <hash>=skip. Hashes may be abbreviated.
+func parseClassificationOverrides(values []string) (map[string]*release.CommitClassification, error) {
+	overrides := make(map[string]*release.CommitClassification, len(values))
+	for _, v := range values {
+		hash, classification, ok := strings.Cut(v, "=")
+		hash = strings.TrimSpace(hash)
+		if !ok || hash == "" {
+			return nil, fmt.Errorf("invalid override %q, expected <hash>=<type(scope)!> or <hash>=skip", v)
+		}
+		if strings.TrimSpace(classification) == "skip" {
+			overrides[hash] = nil
+			continue
+		}
+		c, err := parseClassification(classification)
+		if err != nil {
+			return nil, fmt.Errorf("invalid override for %s: %w", hash, err)
+		}
+		overrides[hash] = &c
+	}
+	return overrides, nil
+}
+
+// findOverride returns the override for a commit hash, matching abbreviated
+// hashes by prefix.
+func findOverride(overrides map[string]*release.CommitClassification, hash string) (*release.CommitClassification, bool) {
+	for prefix, c := range overrides {
+		if strings.HasPrefix(hash, prefix) {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// classificationReviewer returns a reviewer that applies the overrides and,
+// if in is non-nil, asks the user to accept, override or skip each
+// remaining inferred classification.
+func classificationReviewer(overrides map[string]*release.CommitClassification, in io.Reader, out io.Writer) release.ClassificationReviewer {
+	return func(ctx context.Context, inferred []release.InferredCommit) ([]release.InferredCommit, error) {
+		var reader *bufio.Reader
+		if in != nil {
+			reader = bufio.NewReader(in)
+			fmt.Fprintf(out, "%d commit(s) do not follow the conventional commit format.\n", len(inferred))
+			fmt.Fprintln(out, "Press Enter to accept the inferred type, enter type(scope)! to override it, or n to skip the commit.")
+		}
+
+		var accepted []release.InferredCommit
+		for _, c := range inferred {
+			if override, ok := findOverride(overrides, c.Commit.Hash); ok {
+				if override != nil {
+					c.Classification = *override
+					accepted = append(accepted, c)
+				}
+				continue
+			}
+			if reader == nil {
+				accepted = append(accepted, c)
+				continue
+			}
+
+			keep, err := promptClassification(reader, out, &c)
+			if err != nil {
+				return nil, err
+			}
+			if keep {
+				accepted = append(accepted, c)
+			}
+		}
+		return accepted, nil
+	}
+}
+
+// promptClassification asks the user to review one inferred commit until
+// the answer is valid. It returns false if the commit is skipped.
+func promptClassification(reader *bufio.Reader, out io.Writer, c *release.InferredCommit) (bool, error) {
+	fmt.Fprintf(out, "\n  %s %s\n", shortHash(c.Commit.Hash), c.Commit.Subject())
+	for {
+		fmt.Fprintf(out, "  Inferred %s [%s]. Accept? [Y/n/type(scope)!]: ",
+			formatClassification(c.Classification), c.Classification.Source)
+
+		// At the end of the input, the rest is accepted as inferred
+		answer, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return false, fmt.Errorf("failed to read input: %w", err)
+		}
+
+		switch answer = strings.TrimSpace(answer); strings.ToLower(answer) {
+		case "", "y", "yes":
+			return true, nil
+		case "n", "no", "skip":
+			return false, nil
+		}
+
+		override, parseErr := parseClassification(answer)
+		if parseErr != nil {
+			fmt.Fprintf(out, "  %v\n", parseErr)
+			continue
+		}
+		c.Classification = override
+		return true, nil
+	}
+}
+
+// shortHash returns the first 7 characters of a commit hash.
+func shortHash(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+	return hash
+}
@@ -0,0 +1,119 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/application/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+)
+
+func TestParseClassification(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "feat", want: "feat"},
+		{in: "fix(api)", want: "fix(api)"},
+		{in: " FEAT(cli)! ", want: "feat(cli)!"},
+		{in: "feature", wantErr: true},
+		{in: "feat: add", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseClassification(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseClassification(%q) = %+v, want error", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseClassification(%q) error = %v", tt.in, err)
+			}
+			if formatClassification(got) != tt.want || got.Source != release.ClassificationSourceOverride {
+				t.Errorf("parseClassification(%q) = %+v, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseClassificationOverrides(t *testing.T) {
+	overrides, err := parseClassificationOverrides([]string{"abc1234=feat!", "def5678=skip"})
+	if err != nil {
+		t.Fatalf("parseClassificationOverrides() error = %v", err)
+	}
+	if c, ok := findOverride(overrides, "abc1234ffff"); !ok || c == nil || c.Type != changes.CommitTypeFeat || !c.Breaking {
+		t.Errorf("override for abc1234 = %+v", c)
+	}
+	if c, ok := findOverride(overrides, "def5678"); !ok || c != nil {
+		t.Errorf("def5678 should be skipped, got %+v", c)
+	}
+	if _, ok := findOverride(overrides, "0000000"); ok {
+		t.Error("unexpected override for 0000000")
+	}
+
+	for _, invalid := range []string{"abc1234", "=feat", "abc1234=feature"} {
+		if _, err := parseClassificationOverrides([]string{invalid}); err == nil {
+			t.Errorf("parseClassificationOverrides(%q) should fail", invalid)
+		}
+	}
+}
+
+func TestClassificationReviewer(t *testing.T) {
+	inferred := func() []release.InferredCommit {
+		return []release.InferredCommit{
+			{Commit: release.UnconventionalCommit{Hash: "aaa1111", Message: "Add search"}, Classification: release.CommitClassification{Type: changes.CommitTypeFeat, Source: "ai"}},
+			{Commit: release.UnconventionalCommit{Hash: "bbb2222", Message: "Tidy up"}, Classification: release.CommitClassification{Type: changes.CommitTypeChore, Source: "ai"}},
+			{Commit: release.UnconventionalCommit{Hash: "ccc3333", Message: "Rework config"}, Classification: release.CommitClassification{Type: changes.CommitTypeRefactor, Source: "ai"}},
+		}
+	}
+	summary := func(commits []release.InferredCommit) string {
+		var parts []string
+		for _, c := range commits {
+			parts = append(parts, shortHash(c.Commit.Hash)+"="+formatClassification(c.Classification))
+		}
+		return strings.Join(parts, ",")
+	}
+
+	t.Run("overrides only", func(t *testing.T) {
+		overrides := map[string]*release.CommitClassification{"bbb": nil, "ccc": {Type: changes.CommitTypeFeat, Breaking: true}}
+		got, err := classificationReviewer(overrides, nil, &bytes.Buffer{})(context.Background(), inferred())
+		if err != nil {
+			t.Fatalf("review error = %v", err)
+		}
+		if s := summary(got); s != "aaa1111=feat,ccc3333=feat!" {
+			t.Errorf("accepted = %s", s)
+		}
+	})
+
+	t.Run("interactive", func(t *testing.T) {
+		var out bytes.Buffer
+		in := strings.NewReader("\nn\nfeature\nfix(config)!\n")
+		got, err := classificationReviewer(nil, in, &out)(context.Background(), inferred())
+		if err != nil {
+			t.Fatalf("review error = %v", err)
+		}
+		if s := summary(got); s != "aaa1111=feat,ccc3333=fix(config)!" {
+			t.Errorf("accepted = %s", s)
+		}
+		if !strings.Contains(out.String(), `unknown commit type "feature"`) {
+			t.Errorf("invalid answers should be reported, output:\n%s", out.String())
+		}
+	})
+
+	t.Run("end of input accepts the rest", func(t *testing.T) {
+		got, err := classificationReviewer(nil, strings.NewReader("n\n"), &bytes.Buffer{})(context.Background(), inferred())
+		if err != nil {
+			t.Fatalf("review error = %v", err)
+		}
+		if s := summary(got); s != "bbb2222=chore,ccc3333=refactor" {
+			t.Errorf("accepted = %s", s)
+		}
+	})
+}
@@ -23,6 +23,7 @@ func TestRootCommand_AllGlobalFlags(t *testing.T) {
 		{"no-color", "", "false", false},
 		{"log-level", "", "info", false},
 		{"model", "", "", false},
+		{"prompt-profile", "", "", false},
 		{"ci", "", "false", false},
 	}
 
@@ -0,0 +1,311 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/felixgeelhaar/release-pilot/internal/container"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+)
+
+// eventsPollInterval is how often --follow checks for new events.
+const eventsPollInterval = time.Second
+
+var (
+	eventsReleaseID string
+	eventsTypes     []string
+	eventsSince     string
+	eventsUntil     string
+	eventsLimit     int
+	eventsFollow    bool
+)
+
+var eventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Show the release event log",
+	Long: `Show the domain events recorded for releases, oldest first.
+
+Every state change (planned, approved, published, failed, rolled back, ...)
+and every plugin run is appended to a per-release log under
+.release-pilot/events, so the timeline of a release can be reconstructed
+for audits long after the release finished.
+
+Example:
+  release-pilot events
+  release-pilot events --release <id>
+  release-pilot events --type published --type failed --since 2025-01-01
+  release-pilot events --follow`,
+	RunE: runEvents,
+}
+
+func init() {
+	rootCmd.AddCommand(eventsCmd)
+	eventsCmd.Flags().StringVar(&eventsReleaseID, "release", "", "only show events of this release")
+	eventsCmd.Flags().StringSliceVar(&eventsTypes, "type", nil, "only show events of these types, e.g. published or release.published (repeatable)")
+	eventsCmd.Flags().StringVar(&eventsSince, "since", "", "only show events on or after this date (YYYY-MM-DD or RFC 3339)")
+	eventsCmd.Flags().StringVar(&eventsUntil, "until", "", "only show events on or before this date (YYYY-MM-DD or RFC 3339)")
+	eventsCmd.Flags().IntVar(&eventsLimit, "limit", 50, "show only the most recent events (0 for all)")
+	eventsCmd.Flags().BoolVarP(&eventsFollow, "follow", "f", false, "keep watching for new events")
+}
+
+// runEvents implements the events command.
+func runEvents(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	filter, err := buildEventFilter()
+	if err != nil {
+		return err
+	}
+
+	dddContainer, err := container.NewInitializedDDDContainer(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize container: %w", err)
+	}
+	defer dddContainer.Close()
+
+	eventLog := dddContainer.EventLog()
+	records, err := eventLog.ReadEvents(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to read events: %w", err)
+	}
+
+	if !eventsFollow {
+		if outputJSON {
+			return outputEventsJSON(records)
+		}
+		outputEventsText(records)
+		return nil
+	}
+
+	if outputJSON {
+		for _, rec := range records {
+			if err := writeEventJSONLine(rec); err != nil {
+				return err
+			}
+		}
+	} else {
+		outputEventsText(records)
+		printSubtle("Watching for new events (Ctrl+C to stop)...")
+	}
+
+	return followEvents(ctx, eventLog, filter, records)
+}
+
+// buildEventFilter creates the event filter from the command flags.
+func buildEventFilter() (release.EventFilter, error) {
+	filter := release.EventFilter{
+		ReleaseID: release.ReleaseID(eventsReleaseID),
+		Names:     eventsTypes,
+	}
+
+	if eventsSince != "" {
+		since, _, err := parseHistoryDate(eventsSince)
+		if err != nil {
+			return filter, fmt.Errorf("invalid --since: %w", err)
+		}
+		filter.Since = since
+	}
+	if eventsUntil != "" {
+		until, dateOnly, err := parseHistoryDate(eventsUntil)
+		if err != nil {
+			return filter, fmt.Errorf("invalid --until: %w", err)
+		}
+		if dateOnly {
+			// Include the whole day
+			until = until.Add(24*time.Hour - time.Nanosecond)
+		}
+		filter.Until = until
+	}
+
+	if eventsLimit < 0 {
+		return filter, fmt.Errorf("--limit must not be negative")
+	}
+	filter.Limit = eventsLimit
+
+	return filter, nil
+}
+
+// followEvents polls the event log and prints events newer than the ones
+// already shown until the context is canceled.
+func followEvents(ctx context.Context, eventLog release.EventLog, filter release.EventFilter, shown []release.EventRecord) error {
+	var last time.Time
+	if len(shown) > 0 {
+		last = shown[len(shown)-1].OccurredAt
+	}
+	filter.Limit = 0
+
+	ticker := time.NewTicker(eventsPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if !last.IsZero() && (filter.Since.IsZero() || last.After(filter.Since)) {
+			filter.Since = last
+		}
+		records, err := eventLog.ReadEvents(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to read events: %w", err)
+		}
+
+		for _, rec := range records {
+			if !rec.OccurredAt.After(last) {
+				continue
+			}
+			if outputJSON {
+				if err := writeEventJSONLine(rec); err != nil {
+					return err
+				}
+			} else {
+				fmt.Println(formatEventLine(rec))
+			}
+			last = rec.OccurredAt
+		}
+	}
+}
+
+// describeEvent returns a one-line, human-readable summary of an event.
+func describeEvent(rec release.EventRecord) string {
+	d := func(key string) string {
+		if v, ok := rec.Data[key]; ok && v != nil {
+			return fmt.Sprint(v)
+		}
+		return ""
+	}
+
+	switch rec.Name {
+	case "release.initialized":
+		return fmt.Sprintf("initialized on %s", d("branch"))
+	case "release.planned":
+		return fmt.Sprintf("planned %s -> %s (%s, %s commits)", d("current_version"), d("next_version"), d("release_type"), d("commit_count"))
+	case "release.versioned":
+		return fmt.Sprintf("versioned %s (tag %s)", d("version"), d("tag_name"))
+	case "release.notes_generated":
+		return fmt.Sprintf("notes generated (%s chars)", d("notes_length"))
+	case "release.notes_updated":
+		return fmt.Sprintf("notes updated (%s chars)", d("notes_length"))
+	case "release.notes_localized":
+		return fmt.Sprintf("notes localized %s", d("locales"))
+	case "release.migration_guide_generated":
+		return fmt.Sprintf("migration guide generated (%s entries)", d("entries"))
+	case "release.approval_recorded":
+		return fmt.Sprintf("approval by %s (%s of %s)", d("approved_by"), d("approvals"), d("required"))
+	case "release.approved":
+		return fmt.Sprintf("approved by %s", d("approved_by"))
+	case "release.scheduled":
+		return fmt.Sprintf("scheduled for %s by %s", d("publish_at"), d("scheduled_by"))
+	case "release.freeze_overridden":
+		return fmt.Sprintf("freeze %s overridden by %s: %s", d("window"), d("overridden_by"), d("reason"))
+	case "release.publishing_started":
+		return "publishing started"
+	case "release.publishing_resumed":
+		return fmt.Sprintf("publishing resumed from %s (%s steps done)", d("previous_state"), d("completed_steps"))
+	case "release.published":
+		if url := d("release_url"); url != "" {
+			return fmt.Sprintf("published %s (%s)", d("tag_name"), url)
+		}
+		return fmt.Sprintf("published %s", d("tag_name"))
+	case "release.failed":
+		return fmt.Sprintf("failed during %s: %s", d("failed_at"), d("reason"))
+	case "release.canceled":
+		return fmt.Sprintf("canceled by %s: %s", d("canceled_by"), d("reason"))
+	case "release.rolled_back":
+		return fmt.Sprintf("rolled back by %s: %s", d("rolled_back_by"), d("reason"))
+	case "release.plugin_executed":
+		status := "ok"
+		if d("success") != "true" {
+			status = "failed"
+		}
+		return fmt.Sprintf("plugin %s %s %s (%sms)", d("plugin"), d("hook"), status, d("duration_ms"))
+	default:
+		return rec.Name
+	}
+}
+
+// formatEventLine formats an event for --follow output.
+func formatEventLine(rec release.EventRecord) string {
+	return fmt.Sprintf("  %s  %s  %s", rec.OccurredAt.Local().Format("2006-01-02 15:04:05"), rec.ReleaseID, describeEvent(rec))
+}
+
+// outputEventsText prints the events as a timeline.
+func outputEventsText(records []release.EventRecord) {
+	if eventsReleaseID != "" {
+		printTitle(fmt.Sprintf("Release Timeline: %s", eventsReleaseID))
+	} else {
+		printTitle("Release Events")
+	}
+	fmt.Println()
+
+	if len(records) == 0 {
+		printInfo("No events found")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if eventsReleaseID != "" {
+		fmt.Fprintln(w, "  TIME\tEVENT\tDETAILS")
+		for _, rec := range records {
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", rec.OccurredAt.Local().Format("2006-01-02 15:04:05"), rec.Name, describeEvent(rec))
+		}
+	} else {
+		fmt.Fprintln(w, "  TIME\tRELEASE\tEVENT\tDETAILS")
+		for _, rec := range records {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", rec.OccurredAt.Local().Format("2006-01-02 15:04:05"), rec.ReleaseID, rec.Name, describeEvent(rec))
+		}
+	}
+	w.Flush()
+
+	fmt.Println()
+	if eventsLimit > 0 && len(records) == eventsLimit {
+		printSubtle(fmt.Sprintf("Showing the last %d events. Use --limit 0 to see all.", eventsLimit))
+	} else {
+		printSubtle(fmt.Sprintf("%d events", len(records)))
+	}
+}
+
+// eventJSON returns the JSON representation of an event.
+func eventJSON(rec release.EventRecord) map[string]any {
+	entry := map[string]any{
+		"event":       rec.Name,
+		"release_id":  string(rec.ReleaseID),
+		"occurred_at": rec.OccurredAt.Format(time.RFC3339Nano),
+		"summary":     describeEvent(rec),
+	}
+	if len(rec.Data) > 0 {
+		entry["data"] = rec.Data
+	}
+	return entry
+}
+
+// writeEventJSONLine prints a single event as a JSON line (for --follow).
+func writeEventJSONLine(rec release.EventRecord) error {
+	return json.NewEncoder(os.Stdout).Encode(eventJSON(rec))
+}
+
+// outputEventsJSON prints the events as JSON.
+func outputEventsJSON(records []release.EventRecord) error {
+	events := make([]map[string]any, 0, len(records))
+	for _, rec := range records {
+		events = append(events, eventJSON(rec))
+	}
+
+	result := map[string]any{
+		"total":  len(events),
+		"events": events,
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+)
+
+func TestEventsCommand_FlagsExist(t *testing.T) {
+	for _, name := range []string{"release", "type", "since", "until", "limit", "follow"} {
+		if eventsCmd.Flags().Lookup(name) == nil {
+			t.Errorf("events command missing %s flag", name)
+		}
+	}
+}
+
+func TestBuildEventFilter(t *testing.T) {
+	defer func() {
+		eventsReleaseID, eventsTypes, eventsSince, eventsUntil = "", nil, "", ""
+		eventsLimit = 50
+	}()
+
+	eventsReleaseID = "rel-1"
+	eventsTypes = []string{"published", "failed"}
+	eventsSince = "2025-01-01"
+	eventsUntil = "2025-01-31"
+	eventsLimit = 10
+
+	filter, err := buildEventFilter()
+	if err != nil {
+		t.Fatalf("buildEventFilter() error = %v", err)
+	}
+
+	if filter.ReleaseID != "rel-1" {
+		t.Errorf("ReleaseID = %q, want rel-1", filter.ReleaseID)
+	}
+	if len(filter.Names) != 2 || filter.Names[0] != "published" {
+		t.Errorf("Names = %v, want [published failed]", filter.Names)
+	}
+	if want := time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local); !filter.Since.Equal(want) {
+		t.Errorf("Since = %v, want %v", filter.Since, want)
+	}
+	if want := time.Date(2025, 1, 31, 23, 59, 59, 999999999, time.Local); !filter.Until.Equal(want) {
+		t.Errorf("Until = %v, want %v", filter.Until, want)
+	}
+	if filter.Limit != 10 {
+		t.Errorf("Limit = %d, want 10", filter.Limit)
+	}
+
+	eventsLimit = -1
+	if _, err := buildEventFilter(); err == nil {
+		t.Error("buildEventFilter() expected error for negative limit")
+	}
+}
+
+func TestDescribeEvent(t *testing.T) {
+	tests := []struct {
+		rec  release.EventRecord
+		want string
+	}{
+		{
+			release.EventRecord{Name: "release.planned", Data: map[string]any{
+				"current_version": "1.0.0", "next_version": "1.1.0", "release_type": "minor", "commit_count": float64(5),
+			}},
+			"planned 1.0.0 -> 1.1.0 (minor, 5 commits)",
+		},
+		{
+			release.EventRecord{Name: "release.approved", Data: map[string]any{"approved_by": "alice"}},
+			"approved by alice",
+		},
+		{
+			release.EventRecord{Name: "release.approval_recorded", Data: map[string]any{
+				"approved_by": "bob", "approvals": float64(1), "required": float64(2),
+			}},
+			"approval by bob (1 of 2)",
+		},
+		{
+			release.EventRecord{Name: "release.scheduled", Data: map[string]any{
+				"publish_at": "2025-06-16T09:00:00Z", "scheduled_by": "alice",
+			}},
+			"scheduled for 2025-06-16T09:00:00Z by alice",
+		},
+		{
+			release.EventRecord{Name: "release.freeze_overridden", Data: map[string]any{
+				"window": "fridays", "reason": "hotfix", "overridden_by": "alice",
+			}},
+			"freeze fridays overridden by alice: hotfix",
+		},
+		{
+			release.EventRecord{Name: "release.published", Data: map[string]any{"tag_name": "v1.1.0"}},
+			"published v1.1.0",
+		},
+		{
+			release.EventRecord{Name: "release.plugin_executed", Data: map[string]any{
+				"plugin": "npm", "hook": "post-publish", "success": false, "duration_ms": float64(1200),
+			}},
+			"plugin npm post-publish failed (1200ms)",
+		},
+		{
+			release.EventRecord{Name: "custom.event"},
+			"custom.event",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rec.Name, func(t *testing.T) {
+			if got := describeEvent(tt.rec); got != tt.want {
+				t.Errorf("describeEvent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/felixgeelhaar/release-pilot/internal/container"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+)
+
+var (
+	historyStates []string
+	historyBranch string
+	historySince  string
+	historyUntil  string
+	historyLimit  int
+	historyPage   int
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List past releases",
+	Long: `List past releases for this repository, newest first, with their
+version, state, approver, publish time and plugin outcomes.
+
+Example:
+  release-pilot history
+  release-pilot history --state published --since 2025-01-01
+  release-pilot history --branch main --limit 10 --page 2
+  release-pilot history --json`,
+	RunE: runHistory,
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+	historyCmd.Flags().StringSliceVar(&historyStates, "state", nil, "only show releases in these states (repeatable)")
+	historyCmd.Flags().StringVar(&historyBranch, "branch", "", "only show releases from this branch")
+	historyCmd.Flags().StringVar(&historySince, "since", "", "only show releases created on or after this date (YYYY-MM-DD or RFC 3339)")
+	historyCmd.Flags().StringVar(&historyUntil, "until", "", "only show releases created on or before this date (YYYY-MM-DD or RFC 3339)")
+	historyCmd.Flags().IntVar(&historyLimit, "limit", 20, "number of releases per page (0 for all)")
+	historyCmd.Flags().IntVar(&historyPage, "page", 1, "page number")
+}
+
+// runHistory implements the history command.
+func runHistory(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	filter, err := buildHistoryFilter()
+	if err != nil {
+		return err
+	}
+
+	dddContainer, err := container.NewInitializedDDDContainer(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize container: %w", err)
+	}
+	defer dddContainer.Close()
+
+	repoInfo, err := dddContainer.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+	filter.RepositoryPath = repoInfo.Path
+
+	page, err := dddContainer.ReleaseHistory().FindHistory(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to load release history: %w", err)
+	}
+
+	if outputJSON {
+		return outputHistoryJSON(page)
+	}
+
+	outputHistoryText(page)
+	return nil
+}
+
+// buildHistoryFilter creates the history filter from the command flags.
+func buildHistoryFilter() (release.HistoryFilter, error) {
+	var filter release.HistoryFilter
+
+	for _, s := range historyStates {
+		state, err := release.ParseReleaseState(s)
+		if err != nil {
+			return filter, err
+		}
+		filter.States = append(filter.States, state)
+	}
+
+	filter.Branch = historyBranch
+
+	if historySince != "" {
+		since, _, err := parseHistoryDate(historySince)
+		if err != nil {
+			return filter, fmt.Errorf("invalid --since: %w", err)
+		}
+		filter.Since = since
+	}
+	if historyUntil != "" {
+		until, dateOnly, err := parseHistoryDate(historyUntil)
+		if err != nil {
+			return filter, fmt.Errorf("invalid --until: %w", err)
+		}
+		if dateOnly {
+			// Include the whole day
+			until = until.Add(24*time.Hour - time.Nanosecond)
+		}
+		filter.Until = until
+	}
+
+	if historyLimit < 0 {
+		return filter, fmt.Errorf("--limit must not be negative")
+	}
+	if historyPage < 1 {
+		return filter, fmt.Errorf("--page must be at least 1")
+	}
+	filter.Limit = historyLimit
+	filter.Offset = (historyPage - 1) * historyLimit
+
+	return filter, nil
+}
+
+// parseHistoryDate parses a YYYY-MM-DD date (in local time) or an RFC 3339 timestamp.
+func parseHistoryDate(s string) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		return t, true, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("expected YYYY-MM-DD or RFC 3339 timestamp, got %q", s)
+	}
+	return t, false, nil
+}
+
+// summarizePlugins returns a short summary of plugin outcomes (e.g., "2/3 ok").
+func summarizePlugins(executions []release.PluginExecution) string {
+	if len(executions) == 0 {
+		return "-"
+	}
+	succeeded := 0
+	var failed []string
+	for _, exec := range executions {
+		if exec.Success {
+			succeeded++
+		} else {
+			failed = append(failed, exec.PluginName)
+		}
+	}
+	summary := fmt.Sprintf("%d/%d ok", succeeded, len(executions))
+	if len(failed) > 0 {
+		summary += " (failed: " + strings.Join(failed, ", ") + ")"
+	}
+	return summary
+}
+
+// outputHistoryText prints the release history as a table.
+func outputHistoryText(page *release.HistoryPage) {
+	printTitle("Release History")
+	fmt.Println()
+
+	if len(page.Releases) == 0 {
+		printInfo("No releases found")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  CREATED\tVERSION\tSTATE\tBRANCH\tAPPROVED BY\tPUBLISHED\tPLUGINS")
+	for _, rel := range page.Releases {
+		ver := releaseVersionLabel(rel)
+		if pkg := rel.Package(); pkg != nil {
+			ver = pkg.Name + " " + ver
+		}
+		approvedBy := "-"
+		if approval := rel.Approval(); approval != nil {
+			approvedBy = approval.ApprovedBy
+		}
+		published := "-"
+		if rel.PublishedAt() != nil {
+			published = rel.PublishedAt().Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			rel.CreatedAt().Format("2006-01-02 15:04"),
+			ver,
+			rel.State(),
+			rel.Branch(),
+			approvedBy,
+			published,
+			summarizePlugins(rel.PluginExecutions()),
+		)
+	}
+	w.Flush()
+
+	fmt.Println()
+	if historyLimit > 0 && page.Total > historyLimit {
+		pages := (page.Total + historyLimit - 1) / historyLimit
+		printSubtle(fmt.Sprintf("Page %d of %d (%d releases). Use --page to see more.", historyPage, pages, page.Total))
+	} else {
+		printSubtle(fmt.Sprintf("%d releases", page.Total))
+	}
+}
+
+// outputHistoryJSON prints the release history as JSON.
+func outputHistoryJSON(page *release.HistoryPage) error {
+	releases := make([]map[string]any, 0, len(page.Releases))
+	for _, rel := range page.Releases {
+		entry := map[string]any{
+			"release_id": string(rel.ID()),
+			"version":    releaseVersionLabel(rel),
+			"state":      string(rel.State()),
+			"branch":     rel.Branch(),
+			"tag_name":   rel.TagName(),
+			"created_at": rel.CreatedAt().Format(time.RFC3339),
+		}
+		if pkg := rel.Package(); pkg != nil {
+			entry["package"] = pkg.Name
+		}
+		if approval := rel.Approval(); approval != nil {
+			entry["approved_by"] = approval.ApprovedBy
+			entry["auto_approved"] = approval.AutoApproved
+		}
+		if rel.PublishedAt() != nil {
+			entry["published_at"] = rel.PublishedAt().Format(time.RFC3339)
+		}
+		if rel.LastError() != "" {
+			entry["last_error"] = rel.LastError()
+		}
+
+		plugins := make([]map[string]any, 0, len(rel.PluginExecutions()))
+		for _, exec := range rel.PluginExecutions() {
+			plugins = append(plugins, map[string]any{
+				"name":        exec.PluginName,
+				"hook":        exec.Hook,
+				"success":     exec.Success,
+				"message":     exec.Message,
+				"duration_ms": exec.Duration.Milliseconds(),
+			})
+		}
+		entry["plugins"] = plugins
+
+		releases = append(releases, entry)
+	}
+
+	result := map[string]any{
+		"total":    page.Total,
+		"page":     historyPage,
+		"limit":    historyLimit,
+		"releases": releases,
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+)
+
+func TestHistoryCommand_FlagsExist(t *testing.T) {
+	for _, name := range []string{"state", "branch", "since", "until", "limit", "page"} {
+		if historyCmd.Flags().Lookup(name) == nil {
+			t.Errorf("history command missing %s flag", name)
+		}
+	}
+}
+
+func TestBuildHistoryFilter(t *testing.T) {
+	defer func() {
+		historyStates, historyBranch, historySince, historyUntil = nil, "", "", ""
+		historyLimit, historyPage = 20, 1
+	}()
+
+	historyStates = []string{"published", "failed"}
+	historyBranch = "main"
+	historySince = "2025-01-01"
+	historyUntil = "2025-01-31"
+	historyLimit = 10
+	historyPage = 3
+
+	filter, err := buildHistoryFilter()
+	if err != nil {
+		t.Fatalf("buildHistoryFilter() error = %v", err)
+	}
+
+	if len(filter.States) != 2 || filter.States[0] != release.StatePublished || filter.States[1] != release.StateFailed {
+		t.Errorf("States = %v, want [published failed]", filter.States)
+	}
+	if filter.Branch != "main" {
+		t.Errorf("Branch = %q, want main", filter.Branch)
+	}
+	if want := time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local); !filter.Since.Equal(want) {
+		t.Errorf("Since = %v, want %v", filter.Since, want)
+	}
+	// A date-only --until includes the whole day
+	if want := time.Date(2025, 1, 31, 23, 59, 59, 999999999, time.Local); !filter.Until.Equal(want) {
+		t.Errorf("Until = %v, want %v", filter.Until, want)
+	}
+	if filter.Limit != 10 || filter.Offset != 20 {
+		t.Errorf("Limit/Offset = %d/%d, want 10/20", filter.Limit, filter.Offset)
+	}
+}
+
+func TestBuildHistoryFilter_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func()
+	}{
+		{"unknown state", func() { historyStates = []string{"shipped"} }},
+		{"invalid since", func() { historySince = "yesterday" }},
+		{"invalid until", func() { historyUntil = "2025-13-01" }},
+		{"negative limit", func() { historyLimit = -1 }},
+		{"zero page", func() { historyPage = 0 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			historyStates, historyBranch, historySince, historyUntil = nil, "", "", ""
+			historyLimit, historyPage = 20, 1
+			defer func() {
+				historyStates, historyBranch, historySince, historyUntil = nil, "", "", ""
+				historyLimit, historyPage = 20, 1
+			}()
+
+			tt.setup()
+			if _, err := buildHistoryFilter(); err == nil {
+				t.Error("buildHistoryFilter() expected error")
+			}
+		})
+	}
+}
+
+func TestParseHistoryDate_RFC3339(t *testing.T) {
+	got, dateOnly, err := parseHistoryDate("2025-01-02T15:04:05Z")
+	if err != nil {
+		t.Fatalf("parseHistoryDate() error = %v", err)
+	}
+	if dateOnly {
+		t.Error("parseHistoryDate() dateOnly = true, want false")
+	}
+	if want := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("parseHistoryDate() = %v, want %v", got, want)
+	}
+}
+
+func TestSummarizePlugins(t *testing.T) {
+	tests := []struct {
+		name       string
+		executions []release.PluginExecution
+		want       string
+	}{
+		{"none", nil, "-"},
+		{"all ok", []release.PluginExecution{{PluginName: "github", Success: true}}, "1/1 ok"},
+		{
+			"with failure",
+			[]release.PluginExecution{{PluginName: "github", Success: true}, {PluginName: "slack"}},
+			"1/2 ok (failed: slack)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := summarizePlugins(tt.executions); got != tt.want {
+				t.Errorf("summarizePlugins() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	apprelease "github.com/felixgeelhaar/release-pilot/internal/application/release"
+	"github.com/felixgeelhaar/release-pilot/internal/container"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+)
+
+// migrationGuideHeader starts a new migration guide file.
+const migrationGuideHeader = "# Migration Guide\n\nHow to upgrade across the breaking changes of each major release.\n\n"
+
+// wantMigrationGuide reports whether the notes command generates a
+// migration guide, by --migration-guide or changelog.migration_guide.enabled.
+func wantMigrationGuide() bool {
+	return notesMigrationGuide || cfg.Changelog.MigrationGuide.Enabled
+}
+
+// generateMigrationGuide generates the migration guide of a release with
+// breaking changes. It returns nil if the guide is not wanted or the
+// release has no breaking changes.
+func generateMigrationGuide(ctx context.Context, dddContainer *container.DDDContainer, rel *release.Release) (*apprelease.GenerateMigrationGuideOutput, error) {
+	if !wantMigrationGuide() {
+		return nil, nil
+	}
+
+	output, err := dddContainer.GenerateMigrationGuide().Execute(ctx, apprelease.GenerateMigrationGuideInput{
+		ReleaseID: rel.ID(),
+		UseAI:     notesUseAI && dddContainer.HasAI(),
+		APIPaths:  cfg.Changelog.MigrationGuide.APIPaths,
+		Scheme:    versionScheme(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate migration guide: %w", err)
+	}
+	if output.Guide == nil {
+		return nil, nil
+	}
+	return output, nil
+}
+
+// outputMigrationGuide prints a migration guide and why the template was
+// used if AI generation failed.
+func outputMigrationGuide(output *apprelease.GenerateMigrationGuideOutput) {
+	fmt.Println()
+	printTitle("Migration Guide")
+	fmt.Println()
+	fmt.Println(strings.TrimSpace(output.Guide.Markdown))
+	if output.FallbackReason != "" {
+		printWarning(fmt.Sprintf("AI fallback used: migration guide generated from the template (%s)", output.FallbackReason))
+	}
+}
+
+// migrationGuideJSON converts a migration guide for JSON output.
+func migrationGuideJSON(output *apprelease.GenerateMigrationGuideOutput) map[string]any {
+	entries := make([]map[string]string, 0, len(output.Guide.Entries))
+	for _, e := range output.Guide.Entries {
+		entries = append(entries, map[string]string{
+			"commit":    e.CommitHash,
+			"scope":     e.Scope,
+			"change":    e.Change,
+			"before":    e.Before,
+			"after":     e.After,
+			"migration": e.Migration,
+		})
+	}
+	result := map[string]any{
+		"markdown":     output.Guide.Markdown,
+		"entries":      entries,
+		"ai_generated": output.Guide.AIGenerated,
+		"provider":     output.Guide.Provider,
+	}
+	if output.FallbackReason != "" {
+		result["fallback_reason"] = output.FallbackReason
+	}
+	return result
+}
+
+// releaseMigrationGuideFile returns the migration guide file to update for
+// a release, or an empty string if there is nothing to write.
+func releaseMigrationGuideFile(rel *release.Release) string {
+	file := cfg.Changelog.MigrationGuide.File
+	if file == "" || rel.Notes() == nil || rel.Notes().MigrationGuide == nil || rel.Notes().MigrationGuide.Markdown == "" {
+		return ""
+	}
+
+	// Package releases keep their guide next to the package sources
+	if pkg := rel.Package(); pkg != nil {
+		return filepath.Join(pkg.Path, file)
+	}
+	return file
+}
+
+// handleMigrationGuideUpdate adds the release's migration guide to the
+// migration guide file if the release has one.
+func handleMigrationGuideUpdate(rel *release.Release) {
+	file := releaseMigrationGuideFile(rel)
+	if file == "" {
+		return
+	}
+
+	printInfo(fmt.Sprintf("Updating %s...", file))
+	if err := updateMigrationGuideFile(file, rel.Notes().MigrationGuide.Markdown); err != nil {
+		printWarning(fmt.Sprintf("Failed to update migration guide: %v", err))
+	} else {
+		printSuccess(fmt.Sprintf("Updated %s", file))
+	}
+}
+
+// updateMigrationGuideFile inserts a release's section above the sections
+// of earlier releases, so the newest release comes first. A section whose
+// heading is already in the file is not added again.
+func updateMigrationGuideFile(filename, section string) error {
+	section = strings.TrimSpace(section)
+
+	existing := ""
+	if data, err := os.ReadFile(filename); err == nil {
+		existing = string(data)
+	}
+
+	heading, _, _ := strings.Cut(section, "\n")
+	if existing != "" && strings.Contains(existing, heading+"\n") {
+		return nil
+	}
+
+	var content string
+	switch insertPoint := findSectionEntryPoint(existing); {
+	case existing == "":
+		content = migrationGuideHeader + section + "\n"
+	case insertPoint >= 0:
+		content = existing[:insertPoint] + section + "\n\n" + existing[insertPoint:]
+	default:
+		content = strings.TrimRight(existing, "\n") + "\n\n" + section + "\n"
+	}
+
+	return os.WriteFile(filename, []byte(content), 0o644)
+}
+
+// findSectionEntryPoint returns the byte position of the first "## "
+// heading, or -1 if there is none.
+func findSectionEntryPoint(content string) int {
+	pos := 0
+	for _, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(line, "## ") {
+			return pos
+		}
+		pos += len(line) + 1
+	}
+	return -1
+}
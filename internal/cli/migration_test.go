@@ -0,0 +1,88 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/config"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+)
+
+func TestUpdateMigrationGuideFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "MIGRATION.md")
+
+	if err := updateMigrationGuideFile(path, "## Migrating to 2.0.0\n\n### Config moved\n"); err != nil {
+		t.Fatalf("updateMigrationGuideFile() error = %v", err)
+	}
+	if err := updateMigrationGuideFile(path, "## Migrating to 3.0.0\n\n### Go 1.21 dropped\n"); err != nil {
+		t.Fatalf("updateMigrationGuideFile() error = %v", err)
+	}
+	// Publishing the same release again does not duplicate its section
+	if err := updateMigrationGuideFile(path, "## Migrating to 3.0.0\n\n### Go 1.21 dropped\n"); err != nil {
+		t.Fatalf("updateMigrationGuideFile() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read migration guide: %v", err)
+	}
+	want := migrationGuideHeader +
+		"## Migrating to 3.0.0\n\n### Go 1.21 dropped\n\n" +
+		"## Migrating to 2.0.0\n\n### Config moved\n"
+	if string(data) != want {
+		t.Errorf("MIGRATION.md = %q, want %q", data, want)
+	}
+}
+
+func TestUpdateMigrationGuideFile_WithoutSections(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "MIGRATION.md")
+	if err := os.WriteFile(path, []byte("# Upgrading\n\nSee below.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := updateMigrationGuideFile(path, "## Migrating to 2.0.0\n"); err != nil {
+		t.Fatalf("updateMigrationGuideFile() error = %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	if string(data) != "# Upgrading\n\nSee below.\n\n## Migrating to 2.0.0\n" {
+		t.Errorf("MIGRATION.md = %q", data)
+	}
+}
+
+func TestReleaseMigrationGuideFile(t *testing.T) {
+	origCfg := cfg
+	defer func() { cfg = origCfg }()
+	cfg = config.DefaultConfig()
+
+	newRelease := func(pkg *release.PackageRef, guide *release.MigrationGuide) *release.Release {
+		rel := release.NewRelease("rel-1", "main", "/repo")
+		if pkg != nil {
+			_ = rel.AssignPackage(*pkg, "group-1")
+		}
+		next := version.MustParse("2.0.0")
+		_ = rel.SetPlan(release.NewReleasePlan(version.MustParse("1.0.0"), next, changes.ReleaseTypeMajor, changes.NewChangeSet("cs-1", "v1.0.0", "HEAD"), false))
+		_ = rel.SetVersion(next, "v2.0.0")
+		_ = rel.SetNotes(&release.ReleaseNotes{Changelog: "## [2.0.0]"})
+		if guide != nil {
+			_ = rel.SetMigrationGuide(guide)
+		}
+		return rel
+	}
+	guide := &release.MigrationGuide{Markdown: "## Migrating to 2.0.0\n"}
+
+	if got := releaseMigrationGuideFile(newRelease(nil, nil)); got != "" {
+		t.Errorf("releaseMigrationGuideFile() without a guide = %q, want empty", got)
+	}
+	if got := releaseMigrationGuideFile(newRelease(nil, guide)); got != "MIGRATION.md" {
+		t.Errorf("releaseMigrationGuideFile() = %q, want MIGRATION.md", got)
+	}
+	pkg := &release.PackageRef{Name: "api", Path: "services/api", TagPrefix: "api/v"}
+	if got := releaseMigrationGuideFile(newRelease(pkg, guide)); got != filepath.Join("services/api", "MIGRATION.md") {
+		t.Errorf("releaseMigrationGuideFile() for a package = %q", got)
+	}
+}
@@ -2,16 +2,21 @@
 package cli
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/text/language"
 
 	apprelease "github.com/felixgeelhaar/release-pilot/internal/application/release"
 	"github.com/felixgeelhaar/release-pilot/internal/container"
 	"github.com/felixgeelhaar/release-pilot/internal/domain/communication"
 	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/service/ai"
 )
 
 var (
@@ -21,6 +26,12 @@ var (
 	notesIncludeEmoji bool
 	notesLanguage     string
 	notesUseAI        bool
+	notesNoCache      bool
+	notesRefresh      bool
+	notesLanguages    []string
+
+	notesMigrationGuide bool
+	notesShowPrompt     bool
 )
 
 func init() {
@@ -29,7 +40,52 @@ func init() {
 	notesCmd.Flags().StringVar(&notesAudience, "audience", "", "target audience (developers, users, public, stakeholders)")
 	notesCmd.Flags().BoolVar(&notesIncludeEmoji, "emoji", false, "include emojis in output")
 	notesCmd.Flags().StringVar(&notesLanguage, "language", "English", "output language")
-	notesCmd.Flags().BoolVar(&notesUseAI, "ai", false, "use AI to generate notes (requires OPENAI_API_KEY)")
+	notesCmd.Flags().BoolVar(&notesUseAI, "ai", false, "use AI to generate notes (requires a configured AI provider)")
+	notesCmd.Flags().BoolVar(&notesNoCache, "no-cache", false, "neither read nor write the AI response cache")
+	notesCmd.Flags().BoolVar(&notesRefresh, "refresh", false, "ignore cached AI responses and cache the new ones")
+	notesCmd.Flags().StringSliceVar(&notesLanguages, "languages", nil, "locales to localize the notes into, source locale first (e.g. en,de,fr,ja)")
+	notesCmd.Flags().BoolVar(&notesMigrationGuide, "migration-guide", false, "generate a migration guide for breaking changes (default: changelog.migration_guide.enabled)")
+	notesCmd.Flags().BoolVar(&notesShowPrompt, "show-prompt", false, "print the rendered AI prompts without calling the provider")
+	notesCmd.MarkFlagsMutuallyExclusive("no-cache", "refresh")
+}
+
+// parseNotesLocales validates the --languages locales and returns them in
+// canonical form, e.g. "pt-br" becomes "pt-BR".
+func parseNotesLocales(locales []string) ([]string, error) {
+	parsed := make([]string, 0, len(locales))
+	for _, l := range locales {
+		tag, err := language.Parse(strings.TrimSpace(l))
+		if err != nil {
+			return nil, fmt.Errorf("invalid locale %q in --languages: %w", l, err)
+		}
+		parsed = append(parsed, tag.String())
+	}
+	return parsed, nil
+}
+
+// translateReleaseNotes translates the notes of a release from the first
+// locale into the others.
+func translateReleaseNotes(ctx context.Context, dddContainer *container.DDDContainer, rel *release.Release, locales []string) (*apprelease.TranslateNotesOutput, error) {
+	output, err := dddContainer.TranslateNotes().Execute(ctx, apprelease.TranslateNotesInput{
+		ReleaseID: rel.ID(),
+		Locales:   locales,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate notes: %w", err)
+	}
+	return output, nil
+}
+
+// notesCacheMode returns how the AI response cache is used by this run.
+func notesCacheMode() ai.CacheMode {
+	switch {
+	case notesNoCache:
+		return ai.CacheDisabled
+	case notesRefresh:
+		return ai.CacheRefresh
+	default:
+		return ai.CacheReadWrite
+	}
 }
 
 // parseNoteTone parses the tone flag and returns the corresponding NoteTone.
@@ -73,6 +129,7 @@ func buildGenerateNotesInput(rel *release.Release, hasAI bool) apprelease.Genera
 		Audience:         parseNoteAudience(notesAudience),
 		IncludeChangelog: true,
 		RepositoryURL:    cfg.Changelog.RepositoryURL,
+		Scheme:           versionScheme(),
 	}
 }
 
@@ -100,6 +157,103 @@ func outputNotesToStdout(output *apprelease.GenerateNotesOutput) {
 	fmt.Println(output.ReleaseNotes.Render())
 }
 
+// localizedNotesFile returns the file of a locale's notes next to the
+// source file, e.g. RELEASE_NOTES.de.md for RELEASE_NOTES.md.
+func localizedNotesFile(filename, locale string) string {
+	ext := filepath.Ext(filename)
+	return strings.TrimSuffix(filename, ext) + "." + locale + ext
+}
+
+// renderLocalizedNotes renders a locale's summary followed by its changelog.
+func renderLocalizedNotes(l release.LocalizedNotes) string {
+	parts := make([]string, 0, 2)
+	for _, p := range []string{l.Summary, l.Changelog} {
+		if p = strings.TrimSpace(p); p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, "\n\n") + "\n"
+}
+
+// writeLocalizedNotes writes every translation next to the source file.
+// The first entry is the source locale, which is already written.
+func writeLocalizedNotes(localized []release.LocalizedNotes, filename string) error {
+	for _, l := range localized[1:] {
+		path := localizedNotesFile(filename, l.Locale)
+		if err := os.WriteFile(path, []byte(renderLocalizedNotes(l)), 0o644); err != nil {
+			return fmt.Errorf("failed to write %s notes to file: %w", l.Locale, err)
+		}
+		printSuccess(fmt.Sprintf("Release notes (%s) written to %s", l.Locale, path))
+	}
+	return nil
+}
+
+// outputLocalizedNotes prints every translation, skipping the source locale.
+func outputLocalizedNotes(localized []release.LocalizedNotes) {
+	for _, l := range localized[1:] {
+		fmt.Println()
+		printTitle(fmt.Sprintf("Release Notes (%s)", l.Locale))
+		fmt.Println()
+		fmt.Print(renderLocalizedNotes(l))
+	}
+}
+
+// localizedNotesJSON converts localized notes for JSON output.
+func localizedNotesJSON(localized []release.LocalizedNotes) []map[string]string {
+	result := make([]map[string]string, 0, len(localized))
+	for _, l := range localized {
+		result = append(result, map[string]string{
+			"locale":    l.Locale,
+			"summary":   l.Summary,
+			"changelog": l.Changelog,
+		})
+	}
+	return result
+}
+
+// printNotesProvider prints which provider produced the notes and, in
+// verbose mode, the providers that were tried before it.
+func printNotesProvider(notes *communication.ReleaseNotes) {
+	if notes == nil || (!verbose && len(notes.Fallbacks()) == 0) {
+		return
+	}
+	if verbose {
+		printSubtle(fmt.Sprintf("Notes generated by: %s", notes.Provider()))
+		for _, f := range notes.Fallbacks() {
+			printSubtle(fmt.Sprintf("  fell back from %s: %s", f.Provider, f.Reason))
+		}
+		return
+	}
+	printWarning(fmt.Sprintf("AI fallback used: notes generated by %s (run with --verbose for details)", notes.Provider()))
+}
+
+// notesProviderJSON adds the producing provider and fallback decisions to a JSON result.
+func notesProviderJSON(result map[string]any, notes *communication.ReleaseNotes) {
+	result["provider"] = notes.Provider()
+	fallbacks := make([]map[string]string, 0, len(notes.Fallbacks()))
+	for _, f := range notes.Fallbacks() {
+		fallbacks = append(fallbacks, map[string]string{"provider": f.Provider, "reason": f.Reason})
+	}
+	result["fallbacks"] = fallbacks
+}
+
+// aiCacheStats returns the stats of the AI response cache, if it was used.
+func aiCacheStats(cache *ai.Cache) (ai.CacheStats, bool) {
+	if cache == nil || (!notesUseAI && len(notesLanguages) < 2) || notesNoCache {
+		return ai.CacheStats{}, false
+	}
+	stats, err := cache.Stats()
+	return stats, err == nil
+}
+
+// printAICacheStats prints whether AI responses came from the cache in verbose mode.
+func printAICacheStats(cache *ai.Cache) {
+	if stats, ok := aiCacheStats(cache); ok && verbose {
+		printSubtle(fmt.Sprintf("AI cache: %d hit(s), %d miss(es), %d entries in %s",
+			stats.Hits, stats.Misses, stats.Entries, cache.Dir()))
+	}
+}
+
 // printNotesNextSteps prints the next steps after generating notes.
 func printNotesNextSteps() {
 	fmt.Println()
@@ -115,6 +269,11 @@ func printNotesNextSteps() {
 func runNotes(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
+	locales, err := parseNotesLocales(notesLanguages)
+	if err != nil {
+		return err
+	}
+
 	printTitle("Release Notes Generation")
 	fmt.Println()
 
@@ -124,6 +283,11 @@ func runNotes(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to initialize container: %w", err)
 	}
 	defer dddContainer.Close()
+	ctx = ai.WithCacheMode(ctx, notesCacheMode())
+
+	if len(locales) > 1 && !dddContainer.HasAI() {
+		return fmt.Errorf("translating notes into %s requires a configured AI provider", strings.Join(locales[1:], ", "))
+	}
 
 	// Get latest release from repository
 	gitAdapter := dddContainer.GitAdapter()
@@ -132,6 +296,18 @@ func runNotes(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get repository info: %w", err)
 	}
 
+	// Monorepo release groups get notes per package
+	packageReleases, err := findPackageReleases(ctx, dddContainer)
+	if err != nil {
+		return err
+	}
+	if packageReleases != nil {
+		if notesShowPrompt {
+			return runShowPrompt(ctx, dddContainer, packageReleases)
+		}
+		return runPackageNotes(ctx, dddContainer, packageReleases, locales)
+	}
+
 	// Find the latest release
 	releaseRepo := dddContainer.ReleaseRepository()
 	rel, err := releaseRepo.FindLatest(ctx, repoInfo.Path)
@@ -141,6 +317,15 @@ func runNotes(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("no release state found")
 	}
 
+	if notesShowPrompt {
+		return runShowPrompt(ctx, dddContainer, []*release.Release{rel})
+	}
+
+	// Approved notes are translated as they are rather than regenerated
+	if len(locales) > 0 && rel.State() == release.StateApproved {
+		return runLocalizeApprovedNotes(ctx, dddContainer, rel, locales)
+	}
+
 	// Build input and execute use case
 	input := buildGenerateNotesInput(rel, dddContainer.HasAI())
 	output, err := dddContainer.GenerateNotes().Execute(ctx, input)
@@ -148,9 +333,23 @@ func runNotes(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to generate notes: %w", err)
 	}
 
+	migration, err := generateMigrationGuide(ctx, dddContainer, rel)
+	if err != nil {
+		return err
+	}
+
+	var localized []release.LocalizedNotes
+	if len(locales) > 0 {
+		translated, err := translateReleaseNotes(ctx, dddContainer, rel, locales)
+		if err != nil {
+			return err
+		}
+		localized = translated.Localized
+	}
+
 	// Output results
 	if outputJSON {
-		return outputNotesJSON(output, rel)
+		return outputNotesJSON(output, rel, localized, migration, dddContainer.AICache())
 	}
 
 	// Write to file or stdout
@@ -158,23 +357,81 @@ func runNotes(cmd *cobra.Command, args []string) error {
 		if err := writeNotesToFile(output, notesOutput); err != nil {
 			return err
 		}
+		if len(localized) > 0 {
+			if err := writeLocalizedNotes(localized, notesOutput); err != nil {
+				return err
+			}
+		}
 	} else {
 		outputNotesToStdout(output)
+		if len(localized) > 0 {
+			outputLocalizedNotes(localized)
+		}
+	}
+	if migration != nil {
+		outputMigrationGuide(migration)
+	}
+	printNotesProvider(output.ReleaseNotes)
+	printAICacheStats(dddContainer.AICache())
+	if output.Fidelity != nil && !output.Fidelity.IsClean() {
+		fmt.Println()
+		printTitle("AI Notes Fidelity")
+		fmt.Println()
+		printFidelityReport(output.Fidelity)
 	}
 
 	printNotesNextSteps()
 	return nil
 }
 
+// runLocalizeApprovedNotes translates the notes of an approved release
+// without regenerating them, so the translations match what was approved.
+// The translations were not part of the approval, so the release has to be
+// approved again.
+func runLocalizeApprovedNotes(ctx context.Context, dddContainer *container.DDDContainer, rel *release.Release, locales []string) error {
+	output, err := translateReleaseNotes(ctx, dddContainer, rel, locales)
+	if err != nil {
+		return err
+	}
+
+	if outputJSON {
+		result := map[string]any{
+			"release_id": string(rel.ID()),
+			"state":      string(output.State),
+			"localized":  localizedNotesJSON(output.Localized),
+		}
+		if rel.Plan() != nil {
+			result["version"] = formatVersion(rel.Plan().NextVersion)
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	}
+
+	if notesOutput != "" {
+		if err := writeLocalizedNotes(output.Localized, notesOutput); err != nil {
+			return err
+		}
+	} else {
+		outputLocalizedNotes(output.Localized)
+	}
+	printAICacheStats(dddContainer.AICache())
+
+	fmt.Println()
+	printSuccess(fmt.Sprintf("Approved notes localized into %d locale(s)", len(output.Localized)))
+	printInfo("Translations need their own approval: run 'release-pilot approve' before publishing")
+	return nil
+}
+
 // outputNotesJSON outputs the notes as JSON.
-func outputNotesJSON(output *apprelease.GenerateNotesOutput, rel *release.Release) error {
+func outputNotesJSON(output *apprelease.GenerateNotesOutput, rel *release.Release, localized []release.LocalizedNotes, migration *apprelease.GenerateMigrationGuideOutput, aiCache *ai.Cache) error {
 	result := map[string]any{
 		"release_id": string(rel.ID()),
 		"state":      string(rel.State()),
 	}
 
 	if rel.Plan() != nil {
-		result["version"] = rel.Plan().NextVersion.String()
+		result["version"] = formatVersion(rel.Plan().NextVersion)
 	}
 
 	if output.Changelog != nil {
@@ -185,6 +442,23 @@ func outputNotesJSON(output *apprelease.GenerateNotesOutput, rel *release.Releas
 		result["release_notes"] = output.ReleaseNotes.Render()
 		result["summary"] = output.ReleaseNotes.Summary()
 		result["ai_generated"] = output.ReleaseNotes.IsAIGenerated()
+		notesProviderJSON(result, output.ReleaseNotes)
+	}
+
+	if output.Fidelity != nil {
+		result["fidelity"] = fidelityJSON(output.Fidelity)
+	}
+
+	if len(localized) > 0 {
+		result["localized"] = localizedNotesJSON(localized)
+	}
+
+	if migration != nil {
+		result["migration_guide"] = migrationGuideJSON(migration)
+	}
+
+	if stats, ok := aiCacheStats(aiCache); ok {
+		result["cache"] = stats
 	}
 
 	encoder := json.NewEncoder(os.Stdout)
@@ -200,7 +200,7 @@ func TestOutputNotesJSON_Complete(t *testing.T) {
 	}
 
 	// Call function
-	err := outputNotesJSON(output, rel)
+	err := outputNotesJSON(output, rel, nil, nil, nil)
 
 	// Close writer and restore stdout
 	w.Close()
@@ -9,6 +9,7 @@ import (
 	"github.com/felixgeelhaar/release-pilot/internal/config"
 	"github.com/felixgeelhaar/release-pilot/internal/domain/communication"
 	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
 )
 
 func TestParseNoteTone(t *testing.T) {
@@ -138,6 +139,9 @@ func TestNotesCommand_FlagsExist(t *testing.T) {
 		{"emoji flag", "emoji"},
 		{"language flag", "language"},
 		{"ai flag", "ai"},
+		{"languages flag", "languages"},
+		{"migration-guide flag", "migration-guide"},
+		{"show-prompt flag", "show-prompt"},
 	}
 
 	for _, tt := range tests {
@@ -360,3 +364,69 @@ func TestWriteNotesToFile(t *testing.T) {
 
 	_ = mockOutput // Suppress unused variable warning
 }
+
+func TestNotesProviderJSON(t *testing.T) {
+	notes := communication.NewReleaseNotesBuilder(version.MustParse("1.1.0")).
+		AIGenerated().
+		WithProvider("ollama").
+		WithFallbacks([]communication.ProviderFallback{
+			{Provider: "anthropic", Reason: "circuit breaker is open"},
+			{Provider: "openai", Reason: "provider not configured"},
+		}).
+		Build()
+
+	result := map[string]any{}
+	notesProviderJSON(result, notes)
+
+	if result["provider"] != "ollama" {
+		t.Errorf("provider = %v, want ollama", result["provider"])
+	}
+	fallbacks, ok := result["fallbacks"].([]map[string]string)
+	if !ok || len(fallbacks) != 2 {
+		t.Fatalf("fallbacks = %v, want two entries", result["fallbacks"])
+	}
+	if fallbacks[0]["provider"] != "anthropic" || fallbacks[0]["reason"] != "circuit breaker is open" {
+		t.Errorf("fallbacks[0] = %v", fallbacks[0])
+	}
+}
+
+func TestParseNotesLocales(t *testing.T) {
+	got, err := parseNotesLocales([]string{"en", " de ", "pt-br", "JA"})
+	if err != nil {
+		t.Fatalf("parseNotesLocales() error = %v", err)
+	}
+	want := []string{"en", "de", "pt-BR", "ja"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("parseNotesLocales() = %v, want %v", got, want)
+			break
+		}
+	}
+
+	if _, err := parseNotesLocales([]string{"en", "not-a-locale!"}); err == nil {
+		t.Error("parseNotesLocales() should reject invalid locales")
+	}
+}
+
+func TestWriteLocalizedNotes(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "RELEASE_NOTES.md")
+	localized := []release.LocalizedNotes{
+		{Locale: "en", Summary: "Search is here.", Changelog: "- add search"},
+		{Locale: "de", Summary: "Die Suche ist da.", Changelog: "- Suche hinzugefügt"},
+	}
+
+	if err := writeLocalizedNotes(localized, filename); err != nil {
+		t.Fatalf("writeLocalizedNotes() error = %v", err)
+	}
+
+	if _, err := os.Stat(localizedNotesFile(filename, "en")); !os.IsNotExist(err) {
+		t.Error("the source locale should not be written again")
+	}
+	content, err := os.ReadFile(filepath.Join(filepath.Dir(filename), "RELEASE_NOTES.de.md"))
+	if err != nil {
+		t.Fatalf("failed to read localized notes: %v", err)
+	}
+	if string(content) != "Die Suche ist da.\n\n- Suche hinzugefügt\n" {
+		t.Errorf("localized notes = %q", content)
+	}
+}
@@ -0,0 +1,815 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	apprelease "github.com/felixgeelhaar/release-pilot/internal/application/release"
+	"github.com/felixgeelhaar/release-pilot/internal/container"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/sourcecontrol"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+	"github.com/felixgeelhaar/release-pilot/internal/service/blast"
+)
+
+var (
+	planAllPackages bool
+	releasePackage  string
+)
+
+func init() {
+	planCmd.Flags().BoolVar(&planAllPackages, "all-packages", false, "plan an independent release for every monorepo package")
+
+	for _, cmd := range []*cobra.Command{bumpCmd, notesCmd, approveCmd, publishCmd, rollbackCmd} {
+		cmd.Flags().StringVar(&releasePackage, "package", "", "limit to a single package of the current monorepo release")
+	}
+}
+
+// packageSet is the set of monorepo packages to release.
+type packageSet struct {
+	refs []release.PackageRef
+	// manifests maps package names to their discovered manifest information.
+	manifests map[string]*blast.Package
+	// dependencies maps package names to the names of the packages they depend on.
+	dependencies map[string][]string
+}
+
+// resolvePackages discovers the monorepo packages to release.
+// Packages are found with the blast radius service and merged with the
+// packages declared explicitly in the configuration. Internal dependencies
+// between packages are taken from the blast dependency graph, keeping those
+// the dependent's manifest pins by version.
+func resolvePackages(ctx context.Context, repoRoot string) (*packageSet, error) {
+	monorepoConfig := blast.DefaultMonorepoConfig()
+	if len(cfg.Packages.Discover) > 0 {
+		monorepoConfig.PackagePaths = cfg.Packages.Discover
+		monorepoConfig.SharedDirs = nil
+	}
+	monorepoConfig.ExcludePaths = append(monorepoConfig.ExcludePaths, cfg.Packages.Exclude...)
+
+	// Explicitly declared packages are discovered too, so their manifests are known
+	includedByPath := make(map[string]string, len(cfg.Packages.Include))
+	for _, pkg := range cfg.Packages.Include {
+		path := filepath.ToSlash(filepath.Clean(pkg.Path))
+		includedByPath[path] = pkg.Name
+		monorepoConfig.PackagePaths = append(monorepoConfig.PackagePaths, path)
+	}
+
+	svc := blast.NewService(
+		blast.WithRepoPath(repoRoot),
+		blast.WithMonorepoConfig(monorepoConfig),
+	)
+
+	discovered, err := svc.DiscoverPackages(ctx, &blast.AnalysisOptions{MonorepoConfig: monorepoConfig})
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover packages: %w", err)
+	}
+
+	set := &packageSet{
+		manifests:    make(map[string]*blast.Package, len(discovered)),
+		dependencies: make(map[string][]string),
+	}
+	byName := make(map[string]release.PackageRef, len(discovered)+len(cfg.Packages.Include))
+	nameByPath := make(map[string]string, len(discovered))
+	for _, pkg := range discovered {
+		path := filepath.ToSlash(pkg.Path)
+		// Directory names make stable tag prefixes; manifest names may be scoped (e.g., @org/api)
+		name, ok := includedByPath[path]
+		if !ok {
+			name = filepath.Base(pkg.Path)
+		}
+		nameByPath[path] = name
+		set.manifests[name] = pkg
+		byName[name] = release.PackageRef{
+			Name:      name,
+			Path:      path,
+			TagPrefix: cfg.Packages.TagPrefixFor(name),
+		}
+	}
+
+	// Explicitly declared packages take precedence over discovered ones
+	for _, pkg := range cfg.Packages.Include {
+		byName[pkg.Name] = release.PackageRef{
+			Name:      pkg.Name,
+			Path:      filepath.ToSlash(filepath.Clean(pkg.Path)),
+			TagPrefix: cfg.Packages.TagPrefixFor(pkg.Name),
+		}
+	}
+
+	for _, pkg := range byName {
+		set.refs = append(set.refs, pkg)
+	}
+	sort.Slice(set.refs, func(i, j int) bool {
+		return set.refs[i].Name < set.refs[j].Name
+	})
+
+	graph, err := svc.BuildDependencyGraph(ctx, discovered)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build dependency graph: %w", err)
+	}
+	// Only dependents that pin a dependency by version need a release when it changes
+	for _, edge := range graph.Edges {
+		dependent, dependency := nameByPath[filepath.ToSlash(edge.Source)], nameByPath[filepath.ToSlash(edge.Target)]
+		if dependent == "" || dependency == "" || dependent == dependency {
+			continue
+		}
+		pinned, err := blast.PinsDependency(repoRoot, set.manifests[dependent], set.manifests[dependency].Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s manifest: %w", dependent, err)
+		}
+		if pinned {
+			set.dependencies[dependent] = append(set.dependencies[dependent], dependency)
+		}
+	}
+
+	return set, nil
+}
+
+// updateDependentManifests rewrites the manifests of packages that depend on the
+// versioned releases so they reference the new versions.
+// It returns the updated manifests as "package: dependency version" descriptions.
+func updateDependentManifests(repoRoot string, set *packageSet, rels []*release.Release) ([]string, error) {
+	var updated []string
+
+	for _, rel := range rels {
+		name := packageName(rel)
+		dependency, ok := set.manifests[name]
+		if !ok || rel.Version() == nil {
+			continue
+		}
+
+		for dependent, deps := range set.dependencies {
+			if !slices.Contains(deps, name) || set.manifests[dependent] == nil {
+				continue
+			}
+
+			changed, err := blast.UpdateDependencyVersion(repoRoot, set.manifests[dependent], dependency.Name, rel.Version().String())
+			if err != nil {
+				return nil, fmt.Errorf("failed to update %s manifest: %w", dependent, err)
+			}
+			if changed {
+				updated = append(updated, fmt.Sprintf("%s: %s %s", dependent, dependency.Name, rel.Version().String()))
+			}
+		}
+	}
+
+	sort.Strings(updated)
+	return updated, nil
+}
+
+// checkManifestsCommitted refuses to publish while the working tree has
+// uncommitted changes. Package tags point at HEAD, so dependency manifests
+// rewritten by a cascading bump must be committed for the tags to include them.
+func checkManifestsCommitted(ctx context.Context, repo sourcecontrol.WorkingTreeInspector) error {
+	dirty, err := repo.IsDirty(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check working tree: %w", err)
+	}
+	if dirty {
+		return fmt.Errorf("%w: commit the dependency manifests updated by 'release-pilot bump' before publishing, so the package tags include them", sourcecontrol.ErrWorkingTreeDirty)
+	}
+	return nil
+}
+
+// getTargetReleases returns the releases the current command operates on.
+// For a monorepo release group this is every package release in the group,
+// or only the one selected with --package.
+func getTargetReleases(ctx context.Context, dddContainer *container.DDDContainer) ([]*release.Release, error) {
+	rel, err := getLatestRelease(ctx, dddContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	if rel.GroupID() == "" {
+		if releasePackage != "" {
+			printError("The current release is not a monorepo package release")
+			printInfo("Run 'release-pilot plan --all-packages' to plan package releases")
+			return nil, fmt.Errorf("no package release found for %q", releasePackage)
+		}
+		return []*release.Release{rel}, nil
+	}
+
+	group, err := dddContainer.ReleaseRepository().FindByGroup(ctx, rel.GroupID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load release group: %w", err)
+	}
+	sortByPackage(group)
+
+	if releasePackage == "" {
+		return group, nil
+	}
+
+	for _, r := range group {
+		if r.Package() != nil && r.Package().Name == releasePackage {
+			return []*release.Release{r}, nil
+		}
+	}
+
+	printError(fmt.Sprintf("Package %q is not part of the current release", releasePackage))
+	return nil, fmt.Errorf("package %q not found in release group %s", releasePackage, rel.GroupID())
+}
+
+// sortByPackage sorts releases by package name for stable output.
+func sortByPackage(releases []*release.Release) {
+	sort.Slice(releases, func(i, j int) bool {
+		return packageName(releases[i]) < packageName(releases[j])
+	})
+}
+
+// packageName returns the package name of a release, or an empty string for repository-wide releases.
+func packageName(rel *release.Release) string {
+	if pkg := rel.Package(); pkg != nil {
+		return pkg.Name
+	}
+	return ""
+}
+
+// releaseTagPrefix returns the tag prefix for a release.
+// Package releases use their own prefix instead of the repository-wide one.
+func releaseTagPrefix(rel *release.Release) string {
+	if pkg := rel.Package(); pkg != nil {
+		return pkg.TagPrefix
+	}
+	return cfg.Versioning.TagPrefix
+}
+
+// runPlanPackages implements 'plan --all-packages'.
+func runPlanPackages(ctx context.Context, dddContainer *container.DDDContainer, repoPath, branch string) error {
+	packages, err := resolvePackages(ctx, repoPath)
+	if err != nil {
+		return err
+	}
+	if len(packages.refs) == 0 {
+		printError("No packages found")
+		printInfo("Configure 'packages.discover' or 'packages.include' in your config")
+		return fmt.Errorf("no packages found")
+	}
+
+	input := apprelease.PlanPackagesInput{
+		RepositoryPath: repoPath,
+		Branch:         branch,
+		ToRef:          planToRef,
+		DryRun:         dryRun,
+		Packages:       packages.refs,
+		Dependencies:   packages.dependencies,
+		Cascade:        cfg.Packages.Cascade,
+	}
+
+	output, err := dddContainer.PlanPackages().Execute(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to plan package releases: %w", err)
+	}
+
+	if outputJSON {
+		return outputPlanPackagesJSON(output)
+	}
+
+	return outputPlanPackagesText(output, planShowAll, planMinimal)
+}
+
+// outputPlanPackagesJSON outputs the package release plans as JSON.
+func outputPlanPackagesJSON(output *apprelease.PlanPackagesOutput) error {
+	plans := make([]map[string]any, 0, len(output.Plans))
+	for _, p := range output.Plans {
+		cats := p.ChangeSet.Categories()
+		plans = append(plans, map[string]any{
+			"package":         p.Package.Name,
+			"path":            p.Package.Path,
+			"release_id":      string(p.ReleaseID),
+			"current_version": formatVersion(p.CurrentVersion),
+			"next_version":    formatVersion(p.NextVersion),
+			"release_type":    p.ReleaseType.String(),
+			"tag_name":        p.TagName,
+			"cascaded_from":   cascadeReasonsJSON(p.CascadedFrom),
+			"summary": map[string]int{
+				"total":            p.ChangeSet.CommitCount(),
+				"features":         len(cats.Features),
+				"fixes":            len(cats.Fixes),
+				"breaking_changes": len(cats.Breaking),
+			},
+		})
+	}
+
+	unchanged := make([]string, 0, len(output.Unchanged))
+	for _, pkg := range output.Unchanged {
+		unchanged = append(unchanged, pkg.Name)
+	}
+
+	result := map[string]any{
+		"group_id":        string(output.GroupID),
+		"repository_name": output.RepositoryName,
+		"branch":          output.Branch,
+		"ci_mode":         ciMode,
+		"packages":        plans,
+		"unchanged":       unchanged,
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+// cascadeReasonsJSON converts cascade reasons to their JSON representation.
+func cascadeReasonsJSON(reasons []apprelease.CascadeReason) []map[string]string {
+	result := make([]map[string]string, 0, len(reasons))
+	for _, r := range reasons {
+		result = append(result, map[string]string{
+			"dependency": r.Dependency,
+			"version":    formatVersion(r.Version),
+		})
+	}
+	return result
+}
+
+// describeCascade explains why a package was bumped because of its dependencies.
+func describeCascade(reasons []apprelease.CascadeReason) string {
+	parts := make([]string, 0, len(reasons))
+	for _, r := range reasons {
+		parts = append(parts, fmt.Sprintf("%s → %s", r.Dependency, formatVersion(r.Version)))
+	}
+	return "depends on " + strings.Join(parts, ", ")
+}
+
+// outputPlanPackagesText outputs the package release plans as text.
+func outputPlanPackagesText(output *apprelease.PlanPackagesOutput, showAll, minimal bool) error {
+	printTitle("Packages")
+	fmt.Println()
+
+	if len(output.Plans) == 0 {
+		printInfo("No package has releasable changes")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  PACKAGE\tCURRENT\tNEXT\tTYPE\tCOMMITS\tTAG")
+	for _, p := range output.Plans {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%d\t%s\n",
+			p.Package.Name,
+			formatVersion(p.CurrentVersion),
+			formatVersion(p.NextVersion),
+			p.ReleaseType.String(),
+			p.ChangeSet.CommitCount(),
+			p.TagName,
+		)
+	}
+	w.Flush()
+	fmt.Println()
+
+	var cascaded []apprelease.PackagePlanOutput
+	for _, p := range output.Plans {
+		if len(p.CascadedFrom) > 0 {
+			cascaded = append(cascaded, p)
+		}
+	}
+	if len(cascaded) > 0 {
+		printTitle("Dependency Bumps")
+		fmt.Println()
+		for _, p := range cascaded {
+			fmt.Printf("  %s: %s\n", p.Package.Name, describeCascade(p.CascadedFrom))
+		}
+		fmt.Println()
+	}
+
+	if len(output.Unchanged) > 0 {
+		names := make([]string, 0, len(output.Unchanged))
+		for _, pkg := range output.Unchanged {
+			names = append(names, pkg.Name)
+		}
+		printSubtle("  Unchanged: " + strings.Join(names, ", "))
+		fmt.Println()
+	}
+
+	if !minimal {
+		for _, p := range output.Plans {
+			printTitle(fmt.Sprintf("%s (%s)", p.Package.Name, p.Package.Path))
+			fmt.Println()
+			if p.ChangeSet.IsEmpty() {
+				printSubtle("  No direct changes; " + describeCascade(p.CascadedFrom))
+			}
+			cats := p.ChangeSet.Categories()
+			for _, commit := range cats.Breaking {
+				printConventionalCommit(commit)
+			}
+			for _, commit := range filterNonBreaking(cats.Features) {
+				printConventionalCommit(commit)
+			}
+			for _, commit := range cats.Fixes {
+				printConventionalCommit(commit)
+			}
+			for _, commit := range cats.Perf {
+				printConventionalCommit(commit)
+			}
+			if showAll {
+				for _, commit := range getNonCoreCategorizedCommits(cats) {
+					printConventionalCommit(commit)
+				}
+			}
+			fmt.Println()
+		}
+	}
+
+	printTitle("Next Steps")
+	fmt.Println()
+	fmt.Println("  1. Run 'release-pilot bump' to version all packages")
+	fmt.Println("  2. Run 'release-pilot notes' to generate release notes")
+	fmt.Println("  3. Run 'release-pilot approve' to review and approve")
+	fmt.Println("  4. Run 'release-pilot publish' to execute the releases")
+	fmt.Println()
+	printInfo("Use --package <name> with any step to handle a single package")
+
+	if !dryRun {
+		printSuccess(fmt.Sprintf("Saved %d package release plan(s) in group %s", len(output.Plans), output.GroupID))
+	}
+
+	return nil
+}
+
+// findPackageReleases returns the releases of the current monorepo release group
+// (narrowed by --package), or nil if the latest release is repository-wide or
+// its group has already been released.
+func findPackageReleases(ctx context.Context, dddContainer *container.DDDContainer) ([]*release.Release, error) {
+	repoInfo, err := dddContainer.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository info: %w", err)
+	}
+
+	latest, err := dddContainer.ReleaseRepository().FindLatest(ctx, repoInfo.Path)
+	if err != nil || latest.GroupID() == "" {
+		if releasePackage != "" {
+			printError("The current release is not a monorepo package release")
+			return nil, fmt.Errorf("no package release found for %q", releasePackage)
+		}
+		return nil, nil
+	}
+
+	rels, err := getTargetReleases(ctx, dddContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rel := range rels {
+		if !rel.State().IsFinal() {
+			return rels, nil
+		}
+	}
+	return nil, nil
+}
+
+// bumpPackageReleases versions every planned package release with its planned version.
+// Tags are created when the packages are published.
+func bumpPackageReleases(ctx context.Context, dddContainer *container.DDDContainer, rels []*release.Release) error {
+	if bumpForce != "" || bumpLevel != "" {
+		return fmt.Errorf("--force and --level are not supported for package releases; run 'release-pilot plan --all-packages' to re-plan")
+	}
+
+	releaseRepo := dddContainer.ReleaseRepository()
+	results := make([]map[string]any, 0, len(rels))
+	versioned := make([]*release.Release, 0, len(rels))
+
+	for _, rel := range rels {
+		if rel.State() != release.StatePlanned || rel.Plan() == nil {
+			continue
+		}
+
+		ver := rel.Plan().NextVersion
+		if bumpPrerelease != "" {
+			ver = ver.WithPrerelease(version.Prerelease(bumpPrerelease))
+		}
+		if bumpBuild != "" {
+			ver = ver.WithMetadata(version.BuildMetadata(bumpBuild))
+		}
+		tagName := releaseTagPrefix(rel) + formatVersion(ver)
+
+		if !dryRun {
+			if err := rel.SetVersion(ver, tagName); err != nil {
+				return fmt.Errorf("failed to set version for %s: %w", packageName(rel), err)
+			}
+			if err := releaseRepo.Save(ctx, rel); err != nil {
+				return fmt.Errorf("failed to save release for %s: %w", packageName(rel), err)
+			}
+			versioned = append(versioned, rel)
+		}
+
+		results = append(results, map[string]any{
+			"package":         packageName(rel),
+			"current_version": formatVersion(rel.Plan().CurrentVersion),
+			"next_version":    formatVersion(ver),
+			"tag_name":        tagName,
+		})
+	}
+
+	// Point dependents at the new versions of the packages they depend on
+	var manifests []string
+	if cfg.Packages.Cascade && len(versioned) > 0 {
+		repoInfo, err := dddContainer.GitAdapter().GetInfo(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get repository info: %w", err)
+		}
+		set, err := resolvePackages(ctx, repoInfo.Path)
+		if err != nil {
+			return err
+		}
+		if manifests, err = updateDependentManifests(repoInfo.Path, set, versioned); err != nil {
+			return err
+		}
+	}
+
+	if outputJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(map[string]any{"packages": results, "updated_manifests": manifests, "dry_run": dryRun})
+	}
+
+	if len(results) == 0 {
+		printInfo("All package releases are already versioned")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, r := range results {
+		fmt.Fprintf(w, "  %s\t%s → %s\t%s\n", r["package"], r["current_version"], r["next_version"], r["tag_name"])
+	}
+	w.Flush()
+	fmt.Println()
+
+	if dryRun {
+		printWarning("Dry run - no changes will be made")
+		return nil
+	}
+
+	if len(manifests) > 0 {
+		printTitle("Updated Dependencies")
+		fmt.Println()
+		for _, m := range manifests {
+			fmt.Printf("  %s\n", m)
+		}
+		fmt.Println()
+		printInfo("Commit the updated manifests before publishing; publish refuses to tag uncommitted changes")
+	}
+
+	printInfo("Package tags are created when the releases are published")
+	printBumpNextSteps()
+	return nil
+}
+
+// runPackageNotes generates release notes for each versioned package release.
+// When --output is set, the notes of all packages are written to that file,
+// each under its own heading.
+func runPackageNotes(ctx context.Context, dddContainer *container.DDDContainer, rels []*release.Release, locales []string) error {
+	var (
+		results  []map[string]any
+		combined strings.Builder
+	)
+
+	for _, rel := range rels {
+		if rel.State() != release.StateVersioned && rel.State() != release.StateNotesGenerated {
+			continue
+		}
+
+		input := buildGenerateNotesInput(rel, dddContainer.HasAI())
+		output, err := dddContainer.GenerateNotes().Execute(ctx, input)
+		if err != nil {
+			return fmt.Errorf("failed to generate notes for %s: %w", packageName(rel), err)
+		}
+
+		migration, err := generateMigrationGuide(ctx, dddContainer, rel)
+		if err != nil {
+			return fmt.Errorf("%s: %w", packageName(rel), err)
+		}
+
+		var localized []release.LocalizedNotes
+		if len(locales) > 0 {
+			translated, err := translateReleaseNotes(ctx, dddContainer, rel, locales)
+			if err != nil {
+				return fmt.Errorf("%s: %w", packageName(rel), err)
+			}
+			localized = translated.Localized
+		}
+
+		switch {
+		case outputJSON:
+			result := map[string]any{
+				"package":    packageName(rel),
+				"release_id": string(rel.ID()),
+			}
+			if rel.Plan() != nil {
+				result["version"] = formatVersion(rel.Plan().NextVersion)
+			}
+			if output.ReleaseNotes != nil {
+				result["release_notes"] = output.ReleaseNotes.Render()
+				result["ai_generated"] = output.ReleaseNotes.IsAIGenerated()
+				notesProviderJSON(result, output.ReleaseNotes)
+			}
+			if len(localized) > 0 {
+				result["localized"] = localizedNotesJSON(localized)
+			}
+			if migration != nil {
+				result["migration_guide"] = migrationGuideJSON(migration)
+			}
+			results = append(results, result)
+		case notesOutput != "":
+			fmt.Fprintf(&combined, "# %s %s\n\n%s\n\n", packageName(rel), formatVersion(*rel.Version()), output.ReleaseNotes.Render())
+			if len(localized) > 0 {
+				for _, l := range localized[1:] {
+					fmt.Fprintf(&combined, "## %s\n\n%s\n", l.Locale, renderLocalizedNotes(l))
+				}
+			}
+			if migration != nil {
+				fmt.Fprintf(&combined, "%s\n\n", strings.TrimSpace(migration.Guide.Markdown))
+			}
+		default:
+			fmt.Println()
+			printTitle(fmt.Sprintf("%s %s", packageName(rel), formatVersion(*rel.Version())))
+			outputNotesToStdout(output)
+			if len(localized) > 0 {
+				outputLocalizedNotes(localized)
+			}
+			if migration != nil {
+				outputMigrationGuide(migration)
+			}
+			printNotesProvider(output.ReleaseNotes)
+		}
+	}
+
+	if outputJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(map[string]any{"packages": results})
+	}
+
+	if notesOutput != "" && combined.Len() > 0 {
+		if err := os.WriteFile(notesOutput, []byte(combined.String()), 0o644); err != nil {
+			return fmt.Errorf("failed to write notes to file: %w", err)
+		}
+		printSuccess(fmt.Sprintf("Release notes written to %s", notesOutput))
+	}
+
+	printNotesNextSteps()
+	return nil
+}
+
+// approvePackageReleases approves every package release of a group with a single prompt.
+// Editing notes is only supported for individual packages (use --package).
+func approvePackageReleases(ctx context.Context, dddContainer *container.DDDContainer, rels []*release.Release) error {
+	if approveEdit || approveInteractive {
+		return fmt.Errorf("--edit and --interactive require selecting a single package with --package")
+	}
+
+	pending := make([]*release.Release, 0, len(rels))
+	for _, rel := range rels {
+		if rel.State() == release.StateApproved || rel.IsApproved() || rel.State().IsFinal() {
+			continue
+		}
+		pending = append(pending, rel)
+	}
+
+	if len(pending) == 0 {
+		printInfo("All package releases already approved")
+		printInfo("Run 'release-pilot publish' to execute the releases")
+		return nil
+	}
+
+	if outputJSON {
+		for _, rel := range pending {
+			if err := outputApproveJSON(rel); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, rel := range pending {
+		displayReleaseSummary(rel)
+	}
+
+	approved, err := promptForApproval()
+	if err != nil {
+		return err
+	}
+	if !approved {
+		printWarning("Releases not approved")
+		return nil
+	}
+
+	if dryRun {
+		printWarning("Dry run - approval not saved")
+		return nil
+	}
+
+	allApproved := true
+	for _, rel := range pending {
+		output, err := executeApproval(ctx, dddContainer, rel, nil)
+		if err != nil {
+			return fmt.Errorf("%s: %w", packageName(rel), err)
+		}
+		if !output.Approved {
+			allApproved = false
+			printInfo(fmt.Sprintf("%s: still required: %s", packageName(rel), output.Status))
+		}
+	}
+
+	if !allApproved {
+		printSuccess(fmt.Sprintf("Approval by %s recorded", approverIdentity(ctx)))
+		printInfo("The releases can be published once the approval policy is satisfied")
+		return nil
+	}
+
+	printApproveNextSteps()
+	return nil
+}
+
+// publishPackageReleases publishes every approved package release of a group.
+// Packages that were already published are skipped, so a partially failed
+// group publish can be retried.
+func publishPackageReleases(ctx context.Context, dddContainer *container.DDDContainer, rels []*release.Release) error {
+	pending := make([]*release.Release, 0, len(rels))
+	for _, rel := range rels {
+		if rel.State().IsFinal() && !(publishResume && rel.CanResumePublish()) {
+			continue
+		}
+		if err := validateReleaseForResume(rel); err != nil {
+			return fmt.Errorf("%s: %w", packageName(rel), err)
+		}
+		if err := validateReleaseForPublish(rel); err != nil {
+			return fmt.Errorf("%s: %w", packageName(rel), err)
+		}
+		pending = append(pending, rel)
+	}
+
+	if len(pending) == 0 {
+		printInfo("All package releases already published")
+		return nil
+	}
+
+	if publishScheduleAt != "" {
+		return schedulePublish(ctx, dddContainer, pending)
+	}
+
+	if outputJSON {
+		results := make([]map[string]any, 0, len(pending))
+		for _, rel := range pending {
+			results = append(results, buildPublishJSON(rel))
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(map[string]any{"packages": results})
+	}
+
+	for _, rel := range pending {
+		printTitle(packageName(rel))
+		displayPublishActions(releaseTagPrefix(rel), formatVersion(rel.Plan().NextVersion))
+	}
+
+	warnActiveFreeze(time.Now())
+
+	if dryRun {
+		printWarning("Dry run - no changes will be made")
+		return nil
+	}
+
+	if cfg.Packages.Cascade {
+		if err := checkManifestsCommitted(ctx, dddContainer.GitAdapter()); err != nil {
+			return err
+		}
+	}
+
+	tags := make([]string, 0, len(pending))
+	for _, rel := range pending {
+		output, err := dddContainer.PublishRelease().Execute(ctx, buildPublishInput(rel))
+		if errors.Is(err, release.ErrReleaseFrozen) {
+			printFreezeError(err)
+			return err
+		}
+		if err != nil {
+			printError(fmt.Sprintf("Failed to publish %s: %v", packageName(rel), err))
+			return fmt.Errorf("failed to publish release for %s: %w", packageName(rel), err)
+		}
+
+		outputPublishResults(output)
+		outputPluginResults(output.PluginResults)
+		if rel.State() != release.StatePublished {
+			handleChangelogUpdate(rel)
+			handleMigrationGuideUpdate(rel)
+		}
+		tags = append(tags, output.TagName)
+	}
+
+	fmt.Println()
+	printSuccess(fmt.Sprintf("Published %d packages: %s", len(tags), strings.Join(tags, ", ")))
+	fmt.Println()
+	printInfo("Run 'release-pilot plan --all-packages' to start the next release.")
+	fmt.Println()
+	return nil
+}
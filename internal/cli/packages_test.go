@@ -0,0 +1,193 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/config"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/sourcecontrol"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+	"github.com/felixgeelhaar/release-pilot/internal/service/blast"
+)
+
+func TestPackageFlagsExist(t *testing.T) {
+	if planCmd.Flags().Lookup("all-packages") == nil {
+		t.Error("plan command missing all-packages flag")
+	}
+
+	for _, cmd := range []string{"bump", "notes", "approve", "publish"} {
+		c, _, err := rootCmd.Find([]string{cmd})
+		if err != nil {
+			t.Fatalf("command %s not found: %v", cmd, err)
+		}
+		if c.Flags().Lookup("package") == nil {
+			t.Errorf("%s command missing package flag", cmd)
+		}
+	}
+}
+
+func TestReleaseTagPrefix(t *testing.T) {
+	originalCfg := cfg
+	defer func() { cfg = originalCfg }()
+	cfg = config.DefaultConfig()
+	cfg.Versioning.TagPrefix = "v"
+
+	repoWide := release.NewRelease("rel-1", "main", "/repo")
+	if got := releaseTagPrefix(repoWide); got != "v" {
+		t.Errorf("releaseTagPrefix() = %q, want %q", got, "v")
+	}
+
+	pkgRelease := release.NewRelease("rel-2", "main", "/repo")
+	if err := pkgRelease.AssignPackage(release.PackageRef{Name: "api", Path: "packages/api", TagPrefix: "api/v"}, "grp-1"); err != nil {
+		t.Fatalf("AssignPackage() error = %v", err)
+	}
+	if got := releaseTagPrefix(pkgRelease); got != "api/v" {
+		t.Errorf("releaseTagPrefix() = %q, want %q", got, "api/v")
+	}
+}
+
+func TestSortByPackage(t *testing.T) {
+	var rels []*release.Release
+	for _, name := range []string{"web", "api", "core"} {
+		rel := release.NewRelease(release.ReleaseID("rel-"+name), "main", "/repo")
+		if err := rel.AssignPackage(release.PackageRef{Name: name, Path: name, TagPrefix: name + "/v"}, "grp-1"); err != nil {
+			t.Fatalf("AssignPackage() error = %v", err)
+		}
+		rels = append(rels, rel)
+	}
+
+	sortByPackage(rels)
+
+	want := []string{"api", "core", "web"}
+	for i, rel := range rels {
+		if got := packageName(rel); got != want[i] {
+			t.Errorf("rels[%d] = %q, want %q", i, got, want[i])
+		}
+	}
+}
+
+func TestUpdateDependentManifests(t *testing.T) {
+	repoRoot := t.TempDir()
+	webDir := filepath.Join(repoRoot, "packages", "web")
+	if err := os.MkdirAll(webDir, 0755); err != nil {
+		t.Fatalf("Failed to create package dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(webDir, "package.json"), []byte(`{"dependencies": {"@acme/api": "^1.0.0"}}`), 0644); err != nil {
+		t.Fatalf("Failed to write package.json: %v", err)
+	}
+
+	set := &packageSet{
+		manifests: map[string]*blast.Package{
+			"api": {Name: "@acme/api", Path: "packages/api", Type: blast.PackageTypeNPM},
+			"web": {Name: "web", Path: "packages/web", Type: blast.PackageTypeNPM},
+		},
+		dependencies: map[string][]string{"web": {"api"}},
+	}
+
+	api := release.NewRelease("rel-api", "main", repoRoot)
+	if err := api.AssignPackage(release.PackageRef{Name: "api", Path: "packages/api", TagPrefix: "api/v"}, "grp-1"); err != nil {
+		t.Fatalf("AssignPackage() error = %v", err)
+	}
+	plan := release.NewReleasePlan(version.MustParse("1.0.0"), version.MustParse("1.1.0"), changes.ReleaseTypeMinor, changes.NewChangeSet("cs-1", "", "HEAD"), false)
+	if err := api.SetPlan(plan); err != nil {
+		t.Fatalf("SetPlan() error = %v", err)
+	}
+	if err := api.SetVersion(version.MustParse("1.1.0"), "api/v1.1.0"); err != nil {
+		t.Fatalf("SetVersion() error = %v", err)
+	}
+
+	updated, err := updateDependentManifests(repoRoot, set, []*release.Release{api})
+	if err != nil {
+		t.Fatalf("updateDependentManifests() error = %v", err)
+	}
+	if len(updated) != 1 || updated[0] != "web: @acme/api 1.1.0" {
+		t.Errorf("updated = %v", updated)
+	}
+
+	data, err := os.ReadFile(filepath.Join(webDir, "package.json"))
+	if err != nil {
+		t.Fatalf("Failed to read package.json: %v", err)
+	}
+	if !strings.Contains(string(data), `"@acme/api": "^1.1.0"`) {
+		t.Errorf("package.json = %s", data)
+	}
+}
+
+// stubWorkingTree reports a fixed working tree state.
+type stubWorkingTree struct {
+	dirty bool
+	err   error
+}
+
+func (s stubWorkingTree) IsDirty(context.Context) (bool, error) { return s.dirty, s.err }
+
+func (s stubWorkingTree) GetStatus(context.Context) (*sourcecontrol.WorkingTreeStatus, error) {
+	return &sourcecontrol.WorkingTreeStatus{IsClean: !s.dirty}, s.err
+}
+
+func TestCheckManifestsCommitted(t *testing.T) {
+	tests := []struct {
+		name    string
+		tree    stubWorkingTree
+		wantErr error
+	}{
+		{name: "clean", tree: stubWorkingTree{}},
+		{name: "uncommitted manifests", tree: stubWorkingTree{dirty: true}, wantErr: sourcecontrol.ErrWorkingTreeDirty},
+		{name: "status error", tree: stubWorkingTree{err: errors.New("git status failed")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkManifestsCommitted(context.Background(), tt.tree)
+			switch {
+			case tt.tree.err != nil:
+				if err == nil {
+					t.Error("checkManifestsCommitted() error = nil, want status error")
+				}
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("checkManifestsCommitted() error = %v, want %v", err, tt.wantErr)
+				}
+			case err != nil:
+				t.Errorf("checkManifestsCommitted() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckDueManifestsCommitted(t *testing.T) {
+	originalCfg := cfg
+	defer func() { cfg = originalCfg }()
+	cfg = config.DefaultConfig()
+
+	pkgRelease := release.NewRelease("rel-api", "main", "/repo")
+	if err := pkgRelease.AssignPackage(release.PackageRef{Name: "api", Path: "api", TagPrefix: "api/v"}, "group-1"); err != nil {
+		t.Fatalf("AssignPackage() error = %v", err)
+	}
+	plainRelease := release.NewRelease("rel-1", "main", "/repo")
+	dirty := stubWorkingTree{dirty: true}
+
+	cfg.Packages.Cascade = false
+	if err := checkDueManifestsCommitted(context.Background(), dirty, []*release.Release{pkgRelease}); err != nil {
+		t.Errorf("without cascading error = %v, want nil", err)
+	}
+
+	cfg.Packages.Cascade = true
+	if err := checkDueManifestsCommitted(context.Background(), dirty, []*release.Release{plainRelease}); err != nil {
+		t.Errorf("without package releases error = %v, want nil", err)
+	}
+	err := checkDueManifestsCommitted(context.Background(), dirty, []*release.Release{plainRelease, pkgRelease})
+	if !errors.Is(err, sourcecontrol.ErrWorkingTreeDirty) {
+		t.Errorf("with a due package release error = %v, want %v", err, sourcecontrol.ErrWorkingTreeDirty)
+	}
+	if err := checkDueManifestsCommitted(context.Background(), stubWorkingTree{}, []*release.Release{pkgRelease}); err != nil {
+		t.Errorf("with committed manifests error = %v, want nil", err)
+	}
+}
@@ -4,6 +4,7 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"text/tabwriter"
 
@@ -19,6 +20,10 @@ var (
 	planToRef   string
 	planShowAll bool
 	planMinimal bool
+
+	planClassify          string
+	planReview            bool
+	planClassifyOverrides []string
 )
 
 func init() {
@@ -26,6 +31,9 @@ func init() {
 	planCmd.Flags().StringVar(&planToRef, "to", "HEAD", "ending reference")
 	planCmd.Flags().BoolVar(&planShowAll, "all", false, "show all commits including non-conventional")
 	planCmd.Flags().BoolVar(&planMinimal, "minimal", false, "show minimal output")
+	planCmd.Flags().StringVar(&planClassify, "classify", "", "classify non-conventional commits: off, heuristic or ai (default: versioning.classify)")
+	planCmd.Flags().BoolVar(&planReview, "review", false, "review the inferred classifications interactively before the bump is computed")
+	planCmd.Flags().StringSliceVar(&planClassifyOverrides, "classify-override", nil, "override an inferred classification, e.g. abc1234=feat(cli)! or abc1234=skip")
 }
 
 // runPlan implements the plan command.
@@ -35,6 +43,16 @@ func runPlan(cmd *cobra.Command, args []string) error {
 	printTitle("Release Plan")
 	fmt.Println()
 
+	// Classification settings must be in place before the container wires
+	// the plan use case
+	if cmd.Flags().Changed("classify") {
+		cfg.Versioning.Classify = planClassify
+	}
+	reviewer, err := planClassificationReviewer()
+	if err != nil {
+		return err
+	}
+
 	// Initialize container
 	dddContainer, err := container.NewInitializedDDDContainer(ctx, cfg)
 	if err != nil {
@@ -49,6 +67,11 @@ func runPlan(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get repository info: %w", err)
 	}
 
+	// Monorepo mode: plan each package independently
+	if planAllPackages {
+		return runPlanPackages(ctx, dddContainer, repoInfo.Path, repoInfo.CurrentBranch)
+	}
+
 	// Prepare input
 	input := release.PlanReleaseInput{
 		RepositoryPath: repoInfo.Path,
@@ -57,6 +80,8 @@ func runPlan(cmd *cobra.Command, args []string) error {
 		ToRef:          planToRef,
 		DryRun:         dryRun,
 		TagPrefix:      cfg.Versioning.TagPrefix,
+
+		ReviewClassifications: reviewer,
 	}
 
 	// Execute use case
@@ -73,13 +98,41 @@ func runPlan(cmd *cobra.Command, args []string) error {
 	return outputPlanText(output, planShowAll, planMinimal)
 }
 
+// planClassificationReviewer returns the reviewer for inferred commit
+// classifications, applying --classify-override and prompting with
+// --review.
+func planClassificationReviewer() (release.ClassificationReviewer, error) {
+	switch cfg.Versioning.Classify {
+	case "", "off", "heuristic", "ai":
+	default:
+		return nil, fmt.Errorf("invalid --classify value %q, must be off, heuristic or ai", cfg.Versioning.Classify)
+	}
+
+	overrides, err := parseClassificationOverrides(planClassifyOverrides)
+	if err != nil {
+		return nil, err
+	}
+	if planReview && (ciMode || outputJSON) {
+		return nil, fmt.Errorf("--review is interactive and cannot be used with --ci or --json")
+	}
+	if len(overrides) == 0 && !planReview {
+		return nil, nil
+	}
+
+	var in io.Reader
+	if planReview {
+		in = os.Stdin
+	}
+	return classificationReviewer(overrides, in, os.Stdout), nil
+}
+
 // outputPlanJSON outputs the plan as JSON.
 func outputPlanJSON(output *release.PlanReleaseOutput) error {
 	cats := output.ChangeSet.Categories()
 	result := map[string]any{
 		"release_id":      string(output.ReleaseID),
-		"current_version": output.CurrentVersion.String(),
-		"next_version":    output.NextVersion.String(),
+		"current_version": formatVersion(output.CurrentVersion),
+		"next_version":    formatVersion(output.NextVersion),
 		"release_type":    output.ReleaseType.String(),
 		"repository_name": output.RepositoryName,
 		"branch":          output.Branch,
@@ -89,14 +142,34 @@ func outputPlanJSON(output *release.PlanReleaseOutput) error {
 			"features":         len(cats.Features),
 			"fixes":            len(cats.Fixes),
 			"breaking_changes": len(cats.Breaking),
+			"inferred":         len(output.Inferred),
 		},
 	}
+	if len(output.Inferred) > 0 {
+		result["inferred"] = inferredCommitsJSON(output.Inferred)
+	}
 
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(result)
 }
 
+// inferredCommitsJSON converts inferred commits to JSON-friendly maps.
+func inferredCommitsJSON(inferred []release.InferredCommit) []map[string]any {
+	result := make([]map[string]any, 0, len(inferred))
+	for _, c := range inferred {
+		result = append(result, map[string]any{
+			"hash":     c.Commit.Hash,
+			"subject":  c.Commit.Subject(),
+			"type":     string(c.Classification.Type),
+			"scope":    c.Classification.Scope,
+			"breaking": c.Classification.Breaking,
+			"source":   c.Classification.Source,
+		})
+	}
+	return result
+}
+
 // outputPlanText outputs the plan as text.
 func outputPlanText(output *release.PlanReleaseOutput, showAll, minimal bool) error {
 	// Summary
@@ -104,8 +177,8 @@ func outputPlanText(output *release.PlanReleaseOutput, showAll, minimal bool) er
 	fmt.Println()
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(w, "  Current version:\t%s\n", output.CurrentVersion.String())
-	fmt.Fprintf(w, "  Next version:\t%s\n", output.NextVersion.String())
+	fmt.Fprintf(w, "  Current version:\t%s\n", formatVersion(output.CurrentVersion))
+	fmt.Fprintf(w, "  Next version:\t%s\n", formatVersion(output.NextVersion))
 	fmt.Fprintf(w, "  Release type:\t%s\n", releaseTypeDisplay(output.ReleaseType))
 	fmt.Fprintf(w, "  Total commits:\t%d\n", output.ChangeSet.CommitCount())
 	fmt.Fprintf(w, "  Repository:\t%s\n", output.RepositoryName)
@@ -114,6 +187,19 @@ func outputPlanText(output *release.PlanReleaseOutput, showAll, minimal bool) er
 
 	fmt.Println()
 
+	if len(output.Inferred) > 0 {
+		printTitle("Inferred Classifications")
+		fmt.Println()
+		for _, c := range output.Inferred {
+			fmt.Printf("  %s %s %s %s\n",
+				styles.Subtle.Render(shortHash(c.Commit.Hash)),
+				formatClassification(c.Classification),
+				c.Commit.Subject(),
+				styles.Subtle.Render("["+c.Classification.Source+"]"))
+		}
+		fmt.Println()
+	}
+
 	if !minimal {
 		cats := output.ChangeSet.Categories()
 
@@ -175,7 +261,7 @@ func outputPlanText(output *release.PlanReleaseOutput, showAll, minimal bool) er
 	// Next steps
 	printTitle("Next Steps")
 	fmt.Println()
-	fmt.Printf("  1. Run 'release-pilot bump' to bump to %s\n", output.NextVersion.String())
+	fmt.Printf("  1. Run 'release-pilot bump' to bump to %s\n", formatVersion(output.NextVersion))
 	fmt.Println("  2. Run 'release-pilot notes' to generate release notes")
 	fmt.Println("  3. Run 'release-pilot approve' to review and approve")
 	fmt.Println("  4. Run 'release-pilot publish' to execute the release")
@@ -201,6 +287,9 @@ func printConventionalCommit(commit *changes.ConventionalCommit) {
 	if commit.IsBreaking() {
 		desc = styles.Error.Render("BREAKING: " + desc)
 	}
+	if commit.IsInferred() {
+		desc += " " + styles.Subtle.Render("(inferred)")
+	}
 
 	fmt.Printf("  %s %s%s\n", hash, scope, desc)
 }
@@ -2,6 +2,9 @@
 package cli
 
 import (
+	"bytes"
+	"os"
+	"strings"
 	"testing"
 
 	apprelease "github.com/felixgeelhaar/release-pilot/internal/application/release"
@@ -143,6 +146,53 @@ func TestOutputPlanText_DryRun(t *testing.T) {
 	}
 }
 
+func TestOutputPlanText_Inferred(t *testing.T) {
+	origDryRun := dryRun
+	defer func() { dryRun = origDryRun }()
+	dryRun = true
+
+	v1, _ := version.Parse("1.0.0")
+	v2, _ := version.Parse("1.1.0")
+
+	changeSet := changes.NewChangeSet(changes.ChangeSetID("test-changeset"), "v1.0.0", "HEAD")
+	changeSet.AddCommit(changes.NewConventionalCommit("abc1234567", changes.CommitTypeFeat, "Add GitLab support",
+		changes.WithScope("gitlab"), changes.WithInferred()))
+
+	output := &apprelease.PlanReleaseOutput{
+		ReleaseID:      "test-release",
+		CurrentVersion: v1,
+		NextVersion:    v2,
+		ReleaseType:    changes.ReleaseTypeMinor,
+		ChangeSet:      changeSet,
+		Inferred: []apprelease.InferredCommit{{
+			Commit:         apprelease.UnconventionalCommit{Hash: "abc1234567", Message: "Add GitLab support"},
+			Classification: apprelease.CommitClassification{Type: changes.CommitTypeFeat, Scope: "gitlab", Source: apprelease.ClassificationSourceAI},
+		}},
+	}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := outputPlanText(output, false, false)
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	if err != nil {
+		t.Fatalf("outputPlanText() error = %v", err)
+	}
+
+	text := buf.String()
+	for _, want := range []string{"Inferred Classifications", "abc1234 feat(gitlab) Add GitLab support", "[ai]", "(inferred)"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("output missing %q:\n%s", want, text)
+		}
+	}
+}
+
 func TestOutputPlanText_AllCommitTypes(t *testing.T) {
 	// Save original dryRun flag
 	origDryRun := dryRun
@@ -17,6 +17,9 @@ func TestPlanCommand_FlagsExist(t *testing.T) {
 		{"to flag", "to"},
 		{"all flag", "all"},
 		{"minimal flag", "minimal"},
+		{"classify flag", "classify"},
+		{"review flag", "review"},
+		{"classify-override flag", "classify-override"},
 	}
 
 	for _, tt := range tests {
@@ -1,14 +1,19 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
 
 	"github.com/felixgeelhaar/release-pilot/internal/plugin/manager"
+	"github.com/felixgeelhaar/release-pilot/pkg/plugin/plugintest"
 )
 
 var pluginCmd = &cobra.Command{
@@ -33,7 +38,10 @@ Examples:
   release-pilot plugin update github
 
   # Get plugin information
-  release-pilot plugin info github`,
+  release-pilot plugin info github
+
+  # Check a plugin binary against the plugin contracts
+  release-pilot plugin test ./bin/release-pilot-plugin-mytool`,
 }
 
 var pluginListCmd = &cobra.Command{
@@ -118,9 +126,29 @@ This command helps you set up plugin configuration by:
 	RunE: runPluginConfigure,
 }
 
+var pluginTestCmd = &cobra.Command{
+	Use:   "test <path>",
+	Short: "Check a plugin binary against the plugin contracts",
+	Long: `Launch a plugin binary and check that it behaves the way ReleasePilot
+expects:
+- GetInfo returns a valid name, semantic version, description and hooks
+- The configuration schema is a valid JSON Schema
+- Validate accepts the configuration given with --plugin-config
+- Every declared hook succeeds in dry-run mode with a synthetic release
+- Execution returns promptly when it times out or is canceled
+- Mistyped configuration is reported as errors instead of failing the call
+
+The command exits with an error if a check fails. The same checks are
+available to Go tests in the pkg/plugin/plugintest package.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPluginTest,
+}
+
 var (
 	pluginListAvailable bool
 	pluginListRefresh   bool
+	pluginTestConfig    string
+	pluginTestTimeout   time.Duration
 )
 
 func init() {
@@ -136,10 +164,15 @@ func init() {
 	pluginCmd.AddCommand(pluginInfoCmd)
 	pluginCmd.AddCommand(pluginUpdateCmd)
 	pluginCmd.AddCommand(pluginConfigureCmd)
+	pluginCmd.AddCommand(pluginTestCmd)
 
 	// Flags for plugin list
 	pluginListCmd.Flags().BoolVarP(&pluginListAvailable, "available", "a", false, "Show all available plugins from registry")
 	pluginListCmd.Flags().BoolVarP(&pluginListRefresh, "refresh", "r", false, "Force refresh registry cache")
+
+	// Flags for plugin test
+	pluginTestCmd.Flags().StringVar(&pluginTestConfig, "plugin-config", "", "YAML or JSON file with the plugin configuration")
+	pluginTestCmd.Flags().DurationVar(&pluginTestTimeout, "timeout", plugintest.DefaultTimeout, "Time each hook may take")
 }
 
 func runPluginList(cmd *cobra.Command, args []string) error {
@@ -663,3 +696,75 @@ func runPluginConfigure(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runPluginTest(cmd *cobra.Command, args []string) error {
+	path := args[0]
+
+	var pluginConfig map[string]any
+	if pluginTestConfig != "" {
+		data, err := os.ReadFile(pluginTestConfig)
+		if err != nil {
+			return fmt.Errorf("failed to read plugin config: %w", err)
+		}
+		if err := yaml.Unmarshal(data, &pluginConfig); err != nil {
+			return fmt.Errorf("failed to parse plugin config: %w", err)
+		}
+	}
+
+	p, stop, err := plugintest.Launch(path, os.Stderr)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	report := plugintest.Run(cmd.Context(), p,
+		plugintest.WithConfig(pluginConfig),
+		plugintest.WithTimeout(pluginTestTimeout),
+	)
+
+	if outputJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return err
+		}
+	} else {
+		printPluginTestReport(path, report)
+	}
+
+	if failed := report.Failed(); len(failed) > 0 {
+		return fmt.Errorf("plugin failed %d of %d checks", len(failed), len(report.Checks))
+	}
+	return nil
+}
+
+// printPluginTestReport prints the checks of a conformance run.
+func printPluginTestReport(path string, report *plugintest.Report) {
+	fmt.Printf("Testing %s %s (%s)\n\n", report.Plugin.Name, report.Plugin.Version, path)
+
+	for _, c := range report.Checks {
+		var icon string
+		switch c.Status {
+		case plugintest.StatusPass:
+			icon = styles.Success.Render("[PASS]")
+		case plugintest.StatusSkip:
+			icon = styles.Warning.Render("[SKIP]")
+		default:
+			icon = styles.Error.Render("[FAIL]")
+		}
+
+		line := fmt.Sprintf("  %s %s", icon, c.Name)
+		if c.Message != "" {
+			line += ": " + c.Message
+		}
+		if c.Duration >= time.Millisecond {
+			line += styles.Subtle.Render(fmt.Sprintf(" (%dms)", c.Duration.Milliseconds()))
+		}
+		fmt.Println(line)
+	}
+	fmt.Println()
+
+	if report.Passed() {
+		printSuccess(fmt.Sprintf("All %d checks passed", len(report.Checks)))
+	}
+}
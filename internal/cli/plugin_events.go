@@ -0,0 +1,95 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/integration"
+)
+
+// withPluginEventOutput returns ctx with the events plugins report while
+// they execute printed as they arrive. In JSON and CI mode they are written
+// to stderr as JSON lines, so stdout keeps the single JSON result.
+func withPluginEventOutput(ctx context.Context) context.Context {
+	var mu sync.Mutex
+	return integration.WithPluginEventHandler(ctx, func(e integration.PluginEvent) {
+		// Plugins run in parallel; keep their lines whole
+		mu.Lock()
+		defer mu.Unlock()
+		if outputJSON {
+			_ = writePluginEventJSON(os.Stderr, e, time.Now())
+			return
+		}
+		if line := formatPluginEvent(e); line != "" {
+			fmt.Println(styles.Subtle.Render("  ["+string(e.PluginID)+"]") + " " + line)
+		}
+	})
+}
+
+// formatPluginEvent formats a plugin event for the terminal, or returns an
+// empty string for an event without content.
+func formatPluginEvent(e integration.PluginEvent) string {
+	switch {
+	case e.Step != "":
+		return "▸ " + e.Step
+	case e.Log != "":
+		if e.Level == "warn" || e.Level == "error" {
+			return e.Level + ": " + e.Log
+		}
+		return e.Log
+	case e.Progress > 0:
+		return fmt.Sprintf("%.0f%%", e.Progress)
+	default:
+		return ""
+	}
+}
+
+// pluginEventJSON is the JSON line of a plugin event.
+type pluginEventJSON struct {
+	Time     string  `json:"time"`
+	Type     string  `json:"type"`
+	Plugin   string  `json:"plugin"`
+	Hook     string  `json:"hook,omitempty"`
+	Log      string  `json:"log,omitempty"`
+	Level    string  `json:"level,omitempty"`
+	Step     string  `json:"step,omitempty"`
+	Progress float64 `json:"progress,omitempty"`
+}
+
+// writePluginEventJSON writes a plugin event as one line of JSON.
+func writePluginEventJSON(w io.Writer, e integration.PluginEvent, at time.Time) error {
+	line := pluginEventJSON{
+		Time:     at.UTC().Format(time.RFC3339),
+		Type:     "plugin_" + pluginEventType(e),
+		Plugin:   string(e.PluginID),
+		Hook:     string(e.Hook),
+		Log:      e.Log,
+		Level:    e.Level,
+		Step:     e.Step,
+		Progress: e.Progress,
+	}
+	data, err := json.Marshal(line)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// pluginEventType names the kind of a plugin event: step, log or progress.
+func pluginEventType(e integration.PluginEvent) string {
+	switch {
+	case e.Step != "":
+		return "step"
+	case e.Log != "":
+		return "log"
+	default:
+		return "progress"
+	}
+}
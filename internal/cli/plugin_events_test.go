@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/integration"
+)
+
+func TestFormatPluginEvent(t *testing.T) {
+	tests := []struct {
+		name  string
+		event integration.PluginEvent
+		want  string
+	}{
+		{"step", integration.PluginEvent{Step: "push image"}, "▸ push image"},
+		{"log", integration.PluginEvent{Log: "uploaded 3 files", Level: "info"}, "uploaded 3 files"},
+		{"warning", integration.PluginEvent{Log: "retrying", Level: "warn"}, "warn: retrying"},
+		{"progress", integration.PluginEvent{Progress: 42.4}, "42%"},
+		{"empty", integration.PluginEvent{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatPluginEvent(tt.event); got != tt.want {
+				t.Errorf("formatPluginEvent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWritePluginEventJSON(t *testing.T) {
+	var buf bytes.Buffer
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	event := integration.PluginEvent{PluginID: "maven", Hook: integration.HookPostPublish, Progress: 75}
+	if err := writePluginEventJSON(&buf, event, at); err != nil {
+		t.Fatalf("writePluginEventJSON() error = %v", err)
+	}
+
+	want := `{"time":"2026-03-01T12:00:00Z","type":"plugin_progress","plugin":"maven","hook":"post-publish","progress":75}` + "\n"
+	if buf.String() != want {
+		t.Errorf("line = %s, want %s", buf.String(), want)
+	}
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/pkg/plugin"
+	"github.com/felixgeelhaar/release-pilot/pkg/plugin/plugintest"
+)
+
+func TestPrintPluginTestReport(t *testing.T) {
+	tests := []struct {
+		name    string
+		checks  []plugintest.Check
+		want    []string
+		notWant string
+	}{
+		{
+			name: "passing",
+			checks: []plugintest.Check{
+				{Name: "info", Status: plugintest.StatusPass},
+				{Name: "config schema", Status: plugintest.StatusSkip, Message: "plugin declares no configuration schema"},
+			},
+			want: []string{"Testing slack 1.0.0 (./slack)", "[PASS]", "info", "[SKIP]", "config schema: plugin declares no configuration schema", "All 2 checks passed"},
+		},
+		{
+			name: "failing",
+			checks: []plugintest.Check{
+				{Name: "info", Status: plugintest.StatusPass},
+				{Name: "validate", Status: plugintest.StatusFail, Message: "configuration rejected: webhook: required"},
+			},
+			want:    []string{"[FAIL]", "validate: configuration rejected: webhook: required"},
+			notWant: "checks passed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := &plugintest.Report{
+				Plugin: plugin.Info{Name: "slack", Version: "1.0.0"},
+				Checks: tt.checks,
+			}
+
+			oldStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			printPluginTestReport("./slack", report)
+
+			w.Close()
+			os.Stdout = oldStdout
+			var buf bytes.Buffer
+			_, _ = buf.ReadFrom(r)
+			output := buf.String()
+
+			for _, want := range tt.want {
+				if !strings.Contains(output, want) {
+					t.Errorf("output missing %q:\n%s", want, output)
+				}
+			}
+			if tt.notWant != "" && strings.Contains(output, tt.notWant) {
+				t.Errorf("output contains %q:\n%s", tt.notWant, output)
+			}
+		})
+	}
+}
@@ -3,8 +3,10 @@ package cli
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -20,6 +22,10 @@ var (
 	publishSkipTag      bool
 	publishSkipPush     bool
 	publishSkipPlugins  bool
+	publishResume       bool
+	publishScheduleAt   string
+	publishDue          bool
+	publishOverride     string
 )
 
 func init() {
@@ -27,10 +33,48 @@ func init() {
 	publishCmd.Flags().BoolVar(&publishSkipTag, "skip-tag", false, "skip git tag creation")
 	publishCmd.Flags().BoolVar(&publishSkipPush, "skip-push", false, "skip pushing to remote")
 	publishCmd.Flags().BoolVar(&publishSkipPlugins, "skip-plugins", false, "skip running plugins")
+	publishCmd.Flags().BoolVar(&publishResume, "resume", false, "resume a failed or interrupted publish, skipping completed steps")
+	publishCmd.Flags().StringVar(&publishScheduleAt, "at", "", "schedule the approved release for later (RFC 3339, \"YYYY-MM-DD HH:MM\" or a duration like 2h)")
+	publishCmd.Flags().BoolVar(&publishDue, "due", false, "publish all scheduled releases whose time has come (for cron jobs)")
+	publishCmd.Flags().StringVar(&publishOverride, "override-freeze", "", "publish during a freeze window, recording the given reason")
+	publishCmd.MarkFlagsMutuallyExclusive("at", "due", "resume")
+}
+
+// validateReleaseForResume checks that a release can be resumed, or
+// suggests resuming when a plain publish would fail.
+func validateReleaseForResume(rel *release.Release) error {
+	if publishResume && !rel.CanResumePublish() {
+		printError(fmt.Sprintf("Nothing to resume: release is %s", rel.State()))
+		if rel.State() == release.StateApproved {
+			printInfo("Run 'release-pilot publish' to publish the release")
+		}
+		return fmt.Errorf("no publish to resume")
+	}
+
+	if !publishResume && rel.CanResumePublish() && rel.State() != release.StatePublished {
+		printError(fmt.Sprintf("Release publish did not complete (state: %s)", rel.State()))
+		if rel.LastError() != "" {
+			printInfo(fmt.Sprintf("Last error: %s", rel.LastError()))
+		}
+		printInfo("Run 'release-pilot publish --resume' to continue from the last completed step")
+		return fmt.Errorf("release publish did not complete")
+	}
+
+	return nil
 }
 
 // validateReleaseForPublish validates that the release is ready for publishing.
 func validateReleaseForPublish(rel *release.Release) error {
+	// An approval policy cannot be skipped
+	if policy := approvalPolicy(); policy != nil && (rel.State() == release.StateNotesGenerated || rel.State() == release.StateApproved) {
+		if status := rel.ApprovalStatus(*policy); !status.Satisfied {
+			printError("Approval policy not satisfied")
+			printInfo("Still required: " + status.String())
+			printInfo("Run 'release-pilot approve' to add an approval")
+			return fmt.Errorf("approval policy not satisfied")
+		}
+	}
+
 	// Check approval
 	if cfg.Workflow.RequireApproval && !rel.IsApproved() && !publishSkipApproval {
 		printError("Release not approved")
@@ -64,11 +108,11 @@ func shouldRunPlugins() bool {
 }
 
 // displayPublishActions displays what actions will be performed.
-func displayPublishActions(nextVersion string) {
+func displayPublishActions(tagPrefix, nextVersion string) {
 	fmt.Println()
 	printTitle("Release Actions")
 	fmt.Println()
-	fmt.Printf("  Version:    %s%s\n", cfg.Versioning.TagPrefix, nextVersion)
+	fmt.Printf("  Version:    %s%s\n", tagPrefix, nextVersion)
 	fmt.Printf("  Create tag: %v\n", shouldCreateTag())
 	fmt.Printf("  Push:       %v\n", shouldPushTag())
 	fmt.Printf("  Plugins:    %v\n", shouldRunPlugins())
@@ -78,12 +122,19 @@ func displayPublishActions(nextVersion string) {
 // buildPublishInput creates the input for the PublishRelease use case.
 func buildPublishInput(rel *release.Release) apprelease.PublishReleaseInput {
 	return apprelease.PublishReleaseInput{
-		ReleaseID: rel.ID(),
-		DryRun:    dryRun,
-		CreateTag: shouldCreateTag(),
-		PushTag:   shouldPushTag(),
-		TagPrefix: cfg.Versioning.TagPrefix,
-		Remote:    "origin",
+		ReleaseID:      rel.ID(),
+		DryRun:         dryRun,
+		CreateTag:      shouldCreateTag(),
+		PushTag:        shouldPushTag(),
+		TagPrefix:      cfg.Versioning.TagPrefix,
+		Remote:         "origin",
+		Scheme:         versionScheme(),
+		Resume:         publishResume,
+		ApprovalPolicy: approvalPolicy(),
+
+		FreezeWindows:        freezeWindows(),
+		FreezeOverrideReason: publishOverride,
+		FreezeOverriddenBy:   getApproverName(),
 	}
 }
 
@@ -96,6 +147,10 @@ func outputPublishResults(output *apprelease.PublishReleaseOutput) {
 	if output.ReleaseURL != "" {
 		printSuccess(fmt.Sprintf("Release URL: %s", output.ReleaseURL))
 	}
+
+	for _, step := range output.SkippedSteps {
+		printSubtle(fmt.Sprintf("  Skipped %s (completed earlier)", step))
+	}
 }
 
 // outputPluginResults outputs the results of plugin executions.
@@ -116,17 +171,32 @@ func outputPluginResults(results []apprelease.PluginResult) {
 	}
 }
 
+// releaseChangelogFile returns the changelog file to update for a release,
+// or an empty string if there is nothing to write.
+func releaseChangelogFile(rel *release.Release) string {
+	if cfg.Changelog.File == "" || rel.Notes() == nil || rel.Notes().Changelog == "" {
+		return ""
+	}
+
+	// Package releases keep their changelog next to the package sources
+	if pkg := rel.Package(); pkg != nil {
+		return filepath.Join(pkg.Path, cfg.Changelog.File)
+	}
+	return cfg.Changelog.File
+}
+
 // handleChangelogUpdate updates the changelog file if configured.
 func handleChangelogUpdate(rel *release.Release) {
-	if cfg.Changelog.File == "" || rel.Notes() == nil || rel.Notes().Changelog == "" {
+	changelogFile := releaseChangelogFile(rel)
+	if changelogFile == "" {
 		return
 	}
 
-	printInfo(fmt.Sprintf("Updating %s...", cfg.Changelog.File))
-	if err := updateChangelogFile(cfg.Changelog.File, rel.Notes().Changelog); err != nil {
+	printInfo(fmt.Sprintf("Updating %s...", changelogFile))
+	if err := updateChangelogFile(changelogFile, rel.Notes().Changelog); err != nil {
 		printWarning(fmt.Sprintf("Failed to update changelog: %v", err))
 	} else {
-		printSuccess(fmt.Sprintf("Updated %s", cfg.Changelog.File))
+		printSuccess(fmt.Sprintf("Updated %s", changelogFile))
 	}
 }
 
@@ -135,7 +205,7 @@ func printPublishSummary(nextVersion, tagName string) {
 	fmt.Println()
 	printTitle("Release Summary")
 	fmt.Println()
-	fmt.Printf("  Version:    %s%s\n", cfg.Versioning.TagPrefix, nextVersion)
+	fmt.Printf("  Version:    %s\n", nextVersion)
 	fmt.Printf("  Tag:        %s\n", tagName)
 	fmt.Printf("  Status:     published\n")
 	fmt.Printf("  Published:  %s\n", time.Now().Format(time.RFC3339))
@@ -148,7 +218,7 @@ func printPublishSummary(nextVersion, tagName string) {
 
 // runPublish implements the publish command.
 func runPublish(cmd *cobra.Command, args []string) error {
-	ctx := cmd.Context()
+	ctx := withPluginEventOutput(cmd.Context())
 
 	printTitle("Release Publish")
 	fmt.Println()
@@ -160,17 +230,42 @@ func runPublish(cmd *cobra.Command, args []string) error {
 	}
 	defer dddContainer.Close()
 
-	// Get latest release (reuse helper from approve.go)
-	rel, err := getLatestRelease(ctx, dddContainer)
+	if publishDue {
+		return publishDueReleases(ctx, dddContainer)
+	}
+
+	// Monorepo release groups are published together unless --package narrows them
+	packageReleases, err := findPackageReleases(ctx, dddContainer)
 	if err != nil {
 		return err
 	}
+	if len(packageReleases) > 1 {
+		return publishPackageReleases(ctx, dddContainer, packageReleases)
+	}
+
+	// Get latest release (reuse helper from approve.go)
+	var rel *release.Release
+	if len(packageReleases) == 1 {
+		rel = packageReleases[0]
+	} else if rel, err = getLatestRelease(ctx, dddContainer); err != nil {
+		return err
+	}
 
 	// Validate release state
+	if err := validateReleaseForResume(rel); err != nil {
+		return err
+	}
 	if err := validateReleaseForPublish(rel); err != nil {
 		return err
 	}
 
+	if publishScheduleAt != "" {
+		return schedulePublish(ctx, dddContainer, []*release.Release{rel})
+	}
+
+	// The changelog was already updated if the release was published before
+	alreadyPublished := rel.State() == release.StatePublished
+
 	nextVersion := rel.Plan().NextVersion
 
 	// Output JSON if requested
@@ -179,7 +274,15 @@ func runPublish(cmd *cobra.Command, args []string) error {
 	}
 
 	// Display planned actions
-	displayPublishActions(nextVersion.String())
+	displayPublishActions(releaseTagPrefix(rel), formatVersion(nextVersion))
+
+	if publishResume {
+		printInfo(fmt.Sprintf("Resuming publish: %d completed steps will be skipped", len(rel.Checkpoints())))
+	}
+	if schedule := rel.PublishSchedule(); schedule != nil && rel.State() == release.StateApproved {
+		printInfo(fmt.Sprintf("Release was scheduled for %s; publishing now", schedule.PublishAt.Format(time.RFC3339)))
+	}
+	warnActiveFreeze(time.Now())
 
 	// Dry run check
 	if dryRun {
@@ -190,6 +293,10 @@ func runPublish(cmd *cobra.Command, args []string) error {
 	// Execute publish use case
 	input := buildPublishInput(rel)
 	output, err := dddContainer.PublishRelease().Execute(ctx, input)
+	if errors.Is(err, release.ErrReleaseFrozen) {
+		printFreezeError(err)
+		return err
+	}
 	if err != nil {
 		printError(fmt.Sprintf("Failed to publish release: %v", err))
 		return fmt.Errorf("failed to publish release: %w", err)
@@ -198,8 +305,11 @@ func runPublish(cmd *cobra.Command, args []string) error {
 	// Output results
 	outputPublishResults(output)
 	outputPluginResults(output.PluginResults)
-	handleChangelogUpdate(rel)
-	printPublishSummary(nextVersion.String(), output.TagName)
+	if !alreadyPublished {
+		handleChangelogUpdate(rel)
+		handleMigrationGuideUpdate(rel)
+	}
+	printPublishSummary(formatVersion(nextVersion), output.TagName)
 
 	return nil
 }
@@ -285,12 +395,19 @@ func findVersionEntryPoint(content string) int {
 
 // outputPublishJSON outputs the publish information as JSON.
 func outputPublishJSON(rel *release.Release) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(buildPublishJSON(rel))
+}
+
+// buildPublishJSON builds the JSON representation of a pending publish.
+func buildPublishJSON(rel *release.Release) map[string]any {
 	plan := rel.Plan()
 
 	output := map[string]any{
 		"release_id":   string(rel.ID()),
-		"version":      plan.NextVersion.String(),
-		"tag_name":     cfg.Versioning.TagPrefix + plan.NextVersion.String(),
+		"version":      formatVersion(plan.NextVersion),
+		"tag_name":     releaseTagPrefix(rel) + formatVersion(plan.NextVersion),
 		"approved":     rel.IsApproved(),
 		"state":        rel.State().String(),
 		"dry_run":      dryRun,
@@ -298,6 +415,7 @@ func outputPublishJSON(rel *release.Release) error {
 		"skip_tag":     publishSkipTag,
 		"skip_push":    publishSkipPush,
 		"skip_plugins": publishSkipPlugins,
+		"resume":       publishResume,
 		"actions": map[string]bool{
 			"create_tag":  !publishSkipTag && cfg.Versioning.GitTag,
 			"push_tag":    !publishSkipPush && cfg.Versioning.GitPush,
@@ -319,7 +437,29 @@ func outputPublishJSON(rel *release.Release) error {
 		output["plugins"] = plugins
 	}
 
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(output)
+	if pkg := rel.Package(); pkg != nil {
+		output["package"] = pkg.Name
+	}
+
+	if checkpoints := rel.Checkpoints(); len(checkpoints) > 0 {
+		completed := make([]string, 0, len(checkpoints))
+		for _, cp := range checkpoints {
+			completed = append(completed, describeCheckpoint(cp))
+		}
+		output["completed_steps"] = completed
+	}
+
+	return output
+}
+
+// describeCheckpoint returns a short description of a completed publish step.
+func describeCheckpoint(cp release.Checkpoint) string {
+	switch cp.Step {
+	case release.StepPlugin:
+		return fmt.Sprintf("%s (%s)", cp.PluginName, cp.Hook)
+	case release.StepHook:
+		return fmt.Sprintf("%s hook", cp.Hook)
+	default:
+		return string(cp.Step)
+	}
 }
@@ -3,10 +3,13 @@ package cli
 
 import (
 	"testing"
+	"time"
 
 	apprelease "github.com/felixgeelhaar/release-pilot/internal/application/release"
 	"github.com/felixgeelhaar/release-pilot/internal/config"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
 	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
 )
 
 func TestPublishCommand_FlagsExist(t *testing.T) {
@@ -18,6 +21,10 @@ func TestPublishCommand_FlagsExist(t *testing.T) {
 		{"skip-tag flag", "skip-tag"},
 		{"skip-push flag", "skip-push"},
 		{"skip-plugins flag", "skip-plugins"},
+		{"resume flag", "resume"},
+		{"at flag", "at"},
+		{"due flag", "due"},
+		{"override-freeze flag", "override-freeze"},
 	}
 
 	for _, tt := range tests {
@@ -478,7 +485,100 @@ func TestDisplayPublishActions(t *testing.T) {
 			publishSkipPlugins = tt.skipPlugins
 
 			// Just verify it doesn't panic
-			displayPublishActions(tt.version)
+			displayPublishActions("v", tt.version)
 		})
 	}
 }
+
+func TestValidateReleaseForResume(t *testing.T) {
+	origResume := publishResume
+	defer func() { publishResume = origResume }()
+
+	newRelease := func(state release.ReleaseState) *release.Release {
+		rel := release.NewRelease("test-rel-id", "main", "/repo")
+		ver := version.MustParse("1.1.0")
+		plan := release.NewReleasePlan(version.MustParse("1.0.0"), ver, changes.ReleaseTypeMinor, nil, false)
+		rel.ReconstructState(state, plan, &ver, "v1.1.0", nil, nil, time.Now(), time.Now(), nil, "push failed")
+		return rel
+	}
+
+	tests := []struct {
+		name    string
+		state   release.ReleaseState
+		resume  bool
+		wantErr bool
+	}{
+		{"publish approved release", release.StateApproved, false, false},
+		{"resume approved release", release.StateApproved, true, true},
+		{"publish failed release", release.StateFailed, false, true},
+		{"resume failed release", release.StateFailed, true, false},
+		{"publish interrupted release", release.StatePublishing, false, true},
+		{"resume interrupted release", release.StatePublishing, true, false},
+		{"resume published release", release.StatePublished, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publishResume = tt.resume
+			err := validateReleaseForResume(newRelease(tt.state))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateReleaseForResume() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParsePublishTime(t *testing.T) {
+	now := time.Date(2025, 6, 12, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"rfc3339", "2025-06-16T09:00:00Z", time.Date(2025, 6, 16, 9, 0, 0, 0, time.UTC), false},
+		{"local date and time", "2025-06-16 09:00", time.Date(2025, 6, 16, 9, 0, 0, 0, time.Local), false},
+		{"duration", "2h", now.Add(2 * time.Hour), false},
+		{"negative duration", "-2h", time.Time{}, true},
+		{"garbage", "next tuesday", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePublishTime(tt.input, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parsePublishTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("parsePublishTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildPublishInput_FreezeWindows(t *testing.T) {
+	origCfg := cfg
+	origOverride := publishOverride
+	defer func() {
+		cfg = origCfg
+		publishOverride = origOverride
+	}()
+
+	cfg = &config.Config{
+		Workflow: config.WorkflowConfig{
+			FreezeWindows: []config.FreezeWindowConfig{
+				{Name: "fridays", Days: []string{"friday"}, StartTime: "15:00", Timezone: "UTC"},
+			},
+		},
+	}
+	publishOverride = "security fix"
+
+	input := buildPublishInput(release.NewRelease("test-rel-id", "main", "test-repo"))
+	if len(input.FreezeWindows) != 1 || input.FreezeWindows[0].Name != "fridays" {
+		t.Errorf("FreezeWindows = %v, want the fridays window", input.FreezeWindows)
+	}
+	if input.FreezeOverrideReason != "security fix" {
+		t.Errorf("FreezeOverrideReason = %q, want %q", input.FreezeOverrideReason, "security fix")
+	}
+}
@@ -0,0 +1,289 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	apprelease "github.com/felixgeelhaar/release-pilot/internal/application/release"
+	"github.com/felixgeelhaar/release-pilot/internal/container"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+)
+
+var (
+	rollbackReason    string
+	rollbackReleaseID string
+	rollbackKeepTag   bool
+	rollbackSkipPush  bool
+	rollbackForce     bool
+	rollbackYes       bool
+)
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Roll back a published release",
+	Long: `Roll back a published (or partially published) release.
+
+Plugins get a chance to undo what they published through the on-rollback
+hook: the GitHub plugin deletes the release or turns it back into a draft,
+npm deprecates the version or moves a dist-tag back, Docker retags
+"latest" and Jira un-releases the version. Afterwards the release tag is
+deleted locally and on the remote, and the release is marked rolled_back.
+
+If a plugin fails to compensate, the tag is kept and the release is left
+unchanged so the rollback can be re-run. Use --force to finish anyway.
+
+Example:
+  release-pilot rollback --reason "regression in parser"
+  release-pilot rollback --release <id> --keep-tag
+  release-pilot rollback --dry-run`,
+	RunE: runRollback,
+}
+
+func init() {
+	rootCmd.AddCommand(rollbackCmd)
+	rollbackCmd.Flags().StringVar(&rollbackReason, "reason", "", "why the release is rolled back")
+	rollbackCmd.Flags().StringVar(&rollbackReleaseID, "release", "", "roll back this release instead of the latest one")
+	rollbackCmd.Flags().BoolVar(&rollbackKeepTag, "keep-tag", false, "keep the release tag")
+	rollbackCmd.Flags().BoolVar(&rollbackSkipPush, "skip-push", false, "only delete the local tag, not the remote one")
+	rollbackCmd.Flags().BoolVar(&rollbackForce, "force", false, "mark the release rolled back even if a plugin fails to compensate")
+	rollbackCmd.Flags().BoolVarP(&rollbackYes, "yes", "y", false, "roll back without prompting")
+}
+
+// runRollback implements the rollback command.
+func runRollback(cmd *cobra.Command, args []string) error {
+	ctx := withPluginEventOutput(cmd.Context())
+
+	dddContainer, err := container.NewInitializedDDDContainer(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize container: %w", err)
+	}
+	defer dddContainer.Close()
+
+	rels, err := findRollbackReleases(ctx, dddContainer)
+	if err != nil {
+		return err
+	}
+
+	if outputJSON && !dryRun && !rollbackYes && !ciMode {
+		return fmt.Errorf("--yes is required to roll back with --json")
+	}
+
+	if !outputJSON {
+		printTitle("Release Rollback")
+		fmt.Println()
+		displayRollbackActions(rels)
+
+		if dryRun {
+			printWarning("Dry run - plugins run in dry-run mode and no tags are deleted")
+		} else {
+			confirmed, err := confirmRollback()
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				printInfo("Rollback aborted")
+				return nil
+			}
+		}
+	}
+
+	outputs := make([]*apprelease.RollbackReleaseOutput, 0, len(rels))
+	var failed error
+	for _, rel := range rels {
+		output, err := dddContainer.RollbackRelease().Execute(ctx, buildRollbackInput(rel))
+		if output != nil {
+			outputs = append(outputs, output)
+		}
+		if err != nil {
+			failed = fmt.Errorf("failed to roll back %s: %w", releaseLabel(rel), err)
+			break
+		}
+	}
+
+	if outputJSON {
+		if err := outputRollbackJSON(rels, outputs); err != nil {
+			return err
+		}
+		return failed
+	}
+
+	for _, output := range outputs {
+		outputRollbackResults(output)
+	}
+	if failed != nil {
+		printError(failed.Error())
+		printInfo("Fix the failing plugins and re-run 'release-pilot rollback', or use --force to finish anyway")
+		return failed
+	}
+
+	if !dryRun {
+		fmt.Println()
+		printSuccess("Release rolled back")
+	}
+	return nil
+}
+
+// findRollbackReleases returns the releases to roll back: the one given by
+// --release, or the latest release (or release group, narrowed by --package).
+func findRollbackReleases(ctx context.Context, dddContainer *container.DDDContainer) ([]*release.Release, error) {
+	var candidates []*release.Release
+	if rollbackReleaseID != "" {
+		rel, err := dddContainer.ReleaseRepository().FindByID(ctx, release.ReleaseID(rollbackReleaseID))
+		if err != nil {
+			printError(fmt.Sprintf("Release %s not found", rollbackReleaseID))
+			printInfo("Run 'release-pilot history' to list past releases")
+			return nil, fmt.Errorf("release %s not found: %w", rollbackReleaseID, err)
+		}
+		candidates = []*release.Release{rel}
+	} else {
+		rels, err := getTargetReleases(ctx, dddContainer)
+		if err != nil {
+			return nil, err
+		}
+		candidates = rels
+	}
+
+	var rels []*release.Release
+	for _, rel := range candidates {
+		if rel.CanRollBack() {
+			rels = append(rels, rel)
+		}
+	}
+
+	if len(rels) == 0 {
+		printError(fmt.Sprintf("Nothing to roll back: release is %s", candidates[0].State()))
+		printInfo("Only published or failed releases can be rolled back")
+		return nil, fmt.Errorf("no release to roll back")
+	}
+
+	return rels, nil
+}
+
+// buildRollbackInput creates the input for the RollbackRelease use case.
+func buildRollbackInput(rel *release.Release) apprelease.RollbackReleaseInput {
+	return apprelease.RollbackReleaseInput{
+		ReleaseID:       rel.ID(),
+		Reason:          rollbackReason,
+		RolledBackBy:    getApproverName(),
+		DeleteTag:       !rollbackKeepTag,
+		DeleteRemoteTag: !rollbackKeepTag && !rollbackSkipPush,
+		Remote:          "origin",
+		Force:           rollbackForce,
+		DryRun:          dryRun,
+	}
+}
+
+// releaseLabel returns a short description of a release: its tag, or
+// its package and version if it was never tagged.
+func releaseLabel(rel *release.Release) string {
+	if rel.TagName() != "" {
+		return rel.TagName()
+	}
+	if pkg := rel.Package(); pkg != nil {
+		return pkg.Name + " " + releaseVersionLabel(rel)
+	}
+	return releaseVersionLabel(rel)
+}
+
+// displayRollbackActions displays what the rollback will do.
+func displayRollbackActions(rels []*release.Release) {
+	for _, rel := range rels {
+		fmt.Printf("  Release:     %s (%s)\n", releaseLabel(rel), rel.State())
+	}
+	fmt.Printf("  Delete tag:  %v\n", !rollbackKeepTag)
+	fmt.Printf("  Push delete: %v\n", !rollbackKeepTag && !rollbackSkipPush)
+	fmt.Printf("  Plugins:     %v\n", len(cfg.Plugins) > 0)
+	if rollbackReason != "" {
+		fmt.Printf("  Reason:      %s\n", rollbackReason)
+	}
+	fmt.Println()
+}
+
+// confirmRollback asks the user to confirm the rollback.
+func confirmRollback() (bool, error) {
+	if rollbackYes || ciMode {
+		return true, nil
+	}
+
+	fmt.Print("Roll back this release? [y/N]: ")
+
+	reader := bufio.NewReader(os.Stdin)
+	response, err := reader.ReadString('\n')
+	if err != nil {
+		return false, fmt.Errorf("failed to read input: %w", err)
+	}
+
+	response = strings.TrimSpace(strings.ToLower(response))
+	return response == "y" || response == "yes", nil
+}
+
+// outputRollbackResults prints the outcome of a rollback.
+func outputRollbackResults(output *apprelease.RollbackReleaseOutput) {
+	outputPluginResults(output.PluginResults)
+	if output.RemoteTagDeleted {
+		printSuccess(fmt.Sprintf("Deleted remote tag %s", output.TagName))
+	}
+	if output.TagDeleted {
+		printSuccess(fmt.Sprintf("Deleted tag %s", output.TagName))
+	}
+	if output.RolledBack && len(output.FailedPlugins) > 0 {
+		printWarning(fmt.Sprintf("Rolled back without compensation from: %s. Clean these up manually.",
+			strings.Join(output.FailedPlugins, ", ")))
+	}
+	if output.RolledBack && output.HookError != "" {
+		printWarning(fmt.Sprintf("Rolled back although the on-rollback hook failed (%s). Check what the plugins published and clean it up manually.",
+			output.HookError))
+	}
+}
+
+// outputRollbackJSON outputs the rollback results as JSON.
+func outputRollbackJSON(rels []*release.Release, outputs []*apprelease.RollbackReleaseOutput) error {
+	results := make([]map[string]any, 0, len(outputs))
+	for i, output := range outputs {
+		plugins := make([]map[string]any, 0, len(output.PluginResults))
+		for _, result := range output.PluginResults {
+			plugins = append(plugins, map[string]any{
+				"name":    result.PluginName,
+				"success": result.Success,
+				"message": result.Message,
+			})
+		}
+
+		entry := map[string]any{
+			"release_id":         string(rels[i].ID()),
+			"tag_name":           output.TagName,
+			"state":              string(rels[i].State()),
+			"rolled_back":        output.RolledBack,
+			"tag_deleted":        output.TagDeleted,
+			"remote_tag_deleted": output.RemoteTagDeleted,
+			"plugins":            plugins,
+		}
+		if pkg := rels[i].Package(); pkg != nil {
+			entry["package"] = pkg.Name
+		}
+		if len(output.FailedPlugins) > 0 {
+			entry["failed_plugins"] = output.FailedPlugins
+		}
+		if output.HookError != "" {
+			entry["hook_error"] = output.HookError
+		}
+		results = append(results, entry)
+	}
+
+	result := map[string]any{
+		"dry_run":  dryRun,
+		"reason":   rollbackReason,
+		"releases": results,
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
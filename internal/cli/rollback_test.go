@@ -0,0 +1,87 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+)
+
+func TestRollbackCommand_FlagsExist(t *testing.T) {
+	for _, name := range []string{"reason", "release", "keep-tag", "skip-push", "force", "yes", "package"} {
+		t.Run(name, func(t *testing.T) {
+			if rollbackCmd.Flags().Lookup(name) == nil {
+				t.Errorf("rollback command missing %s flag", name)
+			}
+		})
+	}
+}
+
+func TestBuildRollbackInput(t *testing.T) {
+	origDryRun := dryRun
+	origKeepTag := rollbackKeepTag
+	origSkipPush := rollbackSkipPush
+	origReason := rollbackReason
+	defer func() {
+		dryRun = origDryRun
+		rollbackKeepTag = origKeepTag
+		rollbackSkipPush = origSkipPush
+		rollbackReason = origReason
+	}()
+
+	rel := release.NewRelease("test-rel-id", "main", "test-repo")
+	rollbackReason = "regression"
+
+	tests := []struct {
+		name           string
+		keepTag        bool
+		skipPush       bool
+		wantDeleteTag  bool
+		wantDeletePush bool
+	}{
+		{"delete tag everywhere", false, false, true, true},
+		{"local only", false, true, true, false},
+		{"keep tag", true, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rollbackKeepTag = tt.keepTag
+			rollbackSkipPush = tt.skipPush
+
+			input := buildRollbackInput(rel)
+			if input.ReleaseID != rel.ID() {
+				t.Errorf("ReleaseID = %v, want %v", input.ReleaseID, rel.ID())
+			}
+			if input.Reason != "regression" {
+				t.Errorf("Reason = %q, want regression", input.Reason)
+			}
+			if input.DeleteTag != tt.wantDeleteTag {
+				t.Errorf("DeleteTag = %v, want %v", input.DeleteTag, tt.wantDeleteTag)
+			}
+			if input.DeleteRemoteTag != tt.wantDeletePush {
+				t.Errorf("DeleteRemoteTag = %v, want %v", input.DeleteRemoteTag, tt.wantDeletePush)
+			}
+		})
+	}
+}
+
+func TestRollbackLabel(t *testing.T) {
+	plan := release.NewReleasePlan(version.MustParse("1.0.0"), version.MustParse("1.1.0"), changes.ReleaseTypeMinor, nil, false)
+
+	tagged := release.NewRelease("rel-1", "main", "/repo")
+	_ = tagged.SetPlan(plan)
+	_ = tagged.SetVersion(version.MustParse("1.1.0"), "v1.1.0")
+	if got := releaseLabel(tagged); got != "v1.1.0" {
+		t.Errorf("releaseLabel() = %q, want v1.1.0", got)
+	}
+
+	pkg := release.NewRelease("rel-2", "main", "/repo")
+	_ = pkg.AssignPackage(release.PackageRef{Name: "api", Path: "api", TagPrefix: "api/v"}, "group-1")
+	_ = pkg.SetPlan(plan)
+	if got := releaseLabel(pkg); got != "api 1.1.0" {
+		t.Errorf("releaseLabel() for untagged package = %q, want %q", got, "api 1.1.0")
+	}
+}
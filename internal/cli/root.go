@@ -25,14 +25,15 @@ var (
 	}
 
 	// Global flags
-	cfgFile    string
-	verbose    bool
-	dryRun     bool
-	outputJSON bool
-	noColor    bool
-	logLevel   string
-	modelFlag  string // --model flag for AI provider/model selection
-	ciMode     bool   // --ci flag for CI/CD pipeline mode (auto-approve, JSON output)
+	cfgFile       string
+	verbose       bool
+	dryRun        bool
+	outputJSON    bool
+	noColor       bool
+	logLevel      string
+	modelFlag     string // --model flag for AI provider/model selection
+	promptProfile string // --prompt-profile flag for the AI prompt profile
+	ciMode        bool   // --ci flag for CI/CD pipeline mode (auto-approve, JSON output)
 
 	// Global config
 	cfg *config.Config
@@ -124,6 +125,7 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "disable colored output")
 	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "info", "log level (debug, info, warn, error)")
 	rootCmd.PersistentFlags().StringVar(&modelFlag, "model", "", "AI model to use (format: provider/model, e.g., ollama/llama3.2, openai/gpt-4, anthropic/claude-sonnet-4, local/mistral)")
+	rootCmd.PersistentFlags().StringVar(&promptProfile, "prompt-profile", "", "AI prompt profile: a subdirectory of ai.prompts.dir (default: ai.prompts.profile)")
 	rootCmd.PersistentFlags().BoolVar(&ciMode, "ci", false, "CI/CD mode: auto-approve, JSON output, non-interactive")
 
 	// Bind flags to viper
@@ -194,6 +196,19 @@ func applyModelFlag() {
 	}
 }
 
+// applyPromptProfileFlag applies the --prompt-profile flag to the
+// configuration, validated like ai.prompts.profile.
+func applyPromptProfileFlag() error {
+	if promptProfile == "" {
+		return nil
+	}
+	if promptProfile == "." || promptProfile == ".." || strings.ContainsAny(promptProfile, `/\`) {
+		return fmt.Errorf("invalid --prompt-profile %q: must be a directory name", promptProfile)
+	}
+	cfg.AI.Prompts.Profile = promptProfile
+	return nil
+}
+
 // applyCIModeFlag applies the --ci flag settings.
 func applyCIModeFlag() {
 	if !ciMode {
@@ -260,6 +275,9 @@ func initConfig() error {
 	// Apply CLI flags to configuration
 	applyGlobalFlags()
 	applyModelFlag()
+	if err := applyPromptProfileFlag(); err != nil {
+		return err
+	}
 	applyCIModeFlag()
 
 	// Configure logger
@@ -309,7 +327,17 @@ var planCmd = &cobra.Command{
 	Long: `Analyze commits since the last release and suggest a version bump.
 
 This command examines your commit history using conventional commits
-to determine what type of release is needed (major, minor, or patch).`,
+to determine what type of release is needed (major, minor, or patch).
+
+In a monorepo, --all-packages plans an independent release for every
+package, scoping commits by path and versioning each package from its
+own tags (e.g., api/v1.2.0).
+
+Commits that do not follow the conventional format are ignored unless
+--classify (or versioning.classify) infers their type, scope and
+breaking-ness with a local heuristic or the configured AI provider.
+Inferred commits are marked in the output; accept or override them with
+--review or --classify-override before the bump is computed.`,
 	RunE: runPlan,
 }
 
@@ -329,7 +357,11 @@ var notesCmd = &cobra.Command{
 	Long: `Generate changelog entries and release notes for the current release.
 
 This command creates human-readable release documentation from your
-commit history, optionally using AI to enhance the content.`,
+commit history, optionally using AI to enhance the content.
+
+With --languages, the notes are also translated from the first locale into
+the others. For an approved release, the approved notes are translated
+without being regenerated.`,
 	RunE: runNotes,
 }
 
@@ -339,7 +371,10 @@ var approveCmd = &cobra.Command{
 	Long: `Review the prepared release and approve it for publishing.
 
 This command presents the release summary and allows you to
-review and edit the release notes before publishing.`,
+review and edit the release notes before publishing.
+
+When workflow.approval_policy is configured, each run records one
+approval and the release is approved once the policy is satisfied.`,
 	RunE: runApprove,
 }
 
@@ -352,7 +387,19 @@ running configured plugins.
 This command performs all the release actions including:
 - Creating and pushing git tags
 - Updating the changelog file
-- Running plugins (GitHub release, npm publish, Slack notification)`,
+- Running plugins (GitHub release, npm publish, Slack notification)
+
+Every completed step is checkpointed. If a publish fails midway, run
+'release-pilot publish --resume' to skip the completed steps and only
+re-run what failed.
+
+Freeze windows configured under workflow.freeze_windows block publishing.
+Use --override-freeze "<reason>" to publish anyway; the reason is recorded
+on the release.
+
+Use --at to schedule an approved release instead of publishing it now, and
+run 'release-pilot publish --due' from cron to publish scheduled releases
+once their time has come.`,
 	RunE: runPublish,
 }
 
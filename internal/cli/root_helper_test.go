@@ -223,6 +223,38 @@ func TestApplyModelFlag(t *testing.T) {
 	}
 }
 
+func TestApplyPromptProfileFlag(t *testing.T) {
+	tests := []struct {
+		name        string
+		flag        string
+		wantProfile string
+		wantErr     bool
+	}{
+		{name: "empty flag keeps the config", flag: "", wantProfile: "default"},
+		{name: "profile", flag: "concise", wantProfile: "concise"},
+		{name: "path", flag: "../shared", wantProfile: "default", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origProfile := promptProfile
+			defer func() { promptProfile = origProfile }()
+
+			cfg = config.DefaultConfig()
+			cfg.AI.Prompts.Profile = "default"
+
+			promptProfile = tt.flag
+			err := applyPromptProfileFlag()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("applyPromptProfileFlag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if cfg.AI.Prompts.Profile != tt.wantProfile {
+				t.Errorf("profile = %q, want %q", cfg.AI.Prompts.Profile, tt.wantProfile)
+			}
+		})
+	}
+}
+
 func TestApplyCIModeFlag(t *testing.T) {
 	// Setup
 	origCIMode := ciMode
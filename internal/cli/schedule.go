@@ -0,0 +1,243 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	apprelease "github.com/felixgeelhaar/release-pilot/internal/application/release"
+	"github.com/felixgeelhaar/release-pilot/internal/container"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/sourcecontrol"
+)
+
+// freezeWindows returns the configured freeze windows. Invalid windows are
+// rejected by config validation, so conversion errors are ignored here.
+func freezeWindows() []release.FreezeWindow {
+	if cfg == nil {
+		return nil
+	}
+	windows := make([]release.FreezeWindow, 0, len(cfg.Workflow.FreezeWindows))
+	for _, wc := range cfg.Workflow.FreezeWindows {
+		if window, err := wc.Window(); err == nil {
+			windows = append(windows, window)
+		}
+	}
+	return windows
+}
+
+// parsePublishTime parses the --at value: an RFC 3339 timestamp, a local
+// "YYYY-MM-DD HH:MM" time, or a duration from now such as "2h".
+func parsePublishTime(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02T15:04"} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil && d > 0 {
+		return now.Add(d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid publish time %q: expected RFC 3339, \"YYYY-MM-DD HH:MM\" or a duration like 2h", s)
+}
+
+// printFreezeError explains how to publish despite an active freeze window.
+func printFreezeError(err error) {
+	printError(err.Error())
+	printInfo("Run 'release-pilot publish --override-freeze \"<reason>\"' to publish anyway")
+}
+
+// warnActiveFreeze warns when publishing now would be blocked by a freeze window.
+func warnActiveFreeze(now time.Time) {
+	window, frozen := release.ActiveFreezeWindow(freezeWindows(), now)
+	if !frozen {
+		return
+	}
+	if publishOverride != "" {
+		printWarning(fmt.Sprintf("Overriding freeze window %s: %s", window, publishOverride))
+		return
+	}
+	printWarning(fmt.Sprintf("Freeze window %s is in effect", window))
+}
+
+// schedulePublish implements 'publish --at', storing a publish time on each
+// approved release for a later 'publish --due' run.
+func schedulePublish(ctx context.Context, dddContainer *container.DDDContainer, rels []*release.Release) error {
+	now := time.Now()
+	publishAt, err := parsePublishTime(publishScheduleAt, now)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range rels {
+		if rel.State() != release.StateApproved {
+			printError(fmt.Sprintf("Only approved releases can be scheduled: %s is %s", releaseLabel(rel), rel.State()))
+			printInfo("Run 'release-pilot approve' to approve the release")
+			return fmt.Errorf("release not approved")
+		}
+	}
+
+	if dryRun {
+		if !outputJSON {
+			printWarning(fmt.Sprintf("Dry run - would schedule %d release(s) for %s", len(rels), publishAt.Format(time.RFC3339)))
+		}
+		return nil
+	}
+
+	results := make([]map[string]any, 0, len(rels))
+	for _, rel := range rels {
+		output, err := dddContainer.SchedulePublish().Execute(ctx, apprelease.SchedulePublishInput{
+			ReleaseID:     rel.ID(),
+			PublishAt:     publishAt,
+			ScheduledBy:   getApproverName(),
+			FreezeWindows: freezeWindows(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to schedule %s: %w", releaseLabel(rel), err)
+		}
+
+		result := map[string]any{
+			"release_id": string(rel.ID()),
+			"release":    releaseLabel(rel),
+			"publish_at": output.PublishAt.Format(time.RFC3339),
+		}
+		if output.FrozenBy != nil {
+			result["frozen_by"] = output.FrozenBy.String()
+		}
+		results = append(results, result)
+
+		if !outputJSON {
+			printSuccess(fmt.Sprintf("Scheduled %s for %s", releaseLabel(rel), output.PublishAt.Format(time.RFC3339)))
+			if output.FrozenBy != nil {
+				printWarning(fmt.Sprintf("The publish time falls into freeze window %s; 'publish --due' will skip it until the window ends", output.FrozenBy))
+			}
+		}
+	}
+
+	if outputJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(map[string]any{"scheduled": results})
+	}
+
+	fmt.Println()
+	printInfo("Run 'release-pilot publish --due' (e.g. from cron) to publish scheduled releases when they are due")
+	return nil
+}
+
+// checkDueManifestsCommitted applies the check of checkManifestsCommitted
+// when cascading is enabled and a due release is a package release.
+func checkDueManifestsCommitted(ctx context.Context, repo sourcecontrol.WorkingTreeInspector, due []*release.Release) error {
+	if !cfg.Packages.Cascade || !slices.ContainsFunc(due, func(rel *release.Release) bool { return rel.Package() != nil }) {
+		return nil
+	}
+	return checkManifestsCommitted(ctx, repo)
+}
+
+// publishDueReleases implements 'publish --due', publishing every scheduled
+// release whose time has come. Releases blocked by a freeze window are
+// skipped and picked up by a later run. Like 'publish', package releases fail
+// while cascaded dependency manifests are uncommitted.
+func publishDueReleases(ctx context.Context, dddContainer *container.DDDContainer) error {
+	due, err := dddContainer.FindDueReleases().Execute(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to find due releases: %w", err)
+	}
+
+	if len(due) == 0 && !outputJSON {
+		printInfo("No scheduled releases are due")
+		return nil
+	}
+
+	// Checked before publishing, which updates changelog files
+	var manifestsErr error
+	if !dryRun {
+		manifestsErr = checkDueManifestsCommitted(ctx, dddContainer.GitAdapter(), due)
+	}
+
+	results := make([]map[string]any, 0, len(due))
+	failed := 0
+	for _, rel := range due {
+		result := map[string]any{
+			"release_id": string(rel.ID()),
+			"release":    releaseLabel(rel),
+			"publish_at": rel.PublishSchedule().PublishAt.Format(time.RFC3339),
+		}
+		results = append(results, result)
+
+		if dryRun {
+			result["status"] = "due"
+			if !outputJSON {
+				printInfo(fmt.Sprintf("Due: %s (scheduled for %s)", releaseLabel(rel), result["publish_at"]))
+			}
+			continue
+		}
+
+		var output *apprelease.PublishReleaseOutput
+		if rel.Package() != nil && manifestsErr != nil {
+			err = manifestsErr
+		} else {
+			output, err = dddContainer.PublishRelease().Execute(ctx, buildPublishInput(rel))
+		}
+		switch {
+		case errors.Is(err, release.ErrReleaseFrozen):
+			result["status"] = "frozen"
+			result["error"] = err.Error()
+			if !outputJSON {
+				printWarning(fmt.Sprintf("Skipped %s: %v", releaseLabel(rel), err))
+			}
+		case err != nil:
+			failed++
+			result["status"] = "failed"
+			result["error"] = err.Error()
+			if !outputJSON {
+				printError(fmt.Sprintf("Failed to publish %s: %v", releaseLabel(rel), err))
+			}
+		default:
+			result["status"] = "published"
+			result["tag_name"] = output.TagName
+			if output.ReleaseURL != "" {
+				result["release_url"] = output.ReleaseURL
+			}
+			if outputJSON {
+				if file := releaseChangelogFile(rel); file != "" {
+					if err := updateChangelogFile(file, rel.Notes().Changelog); err != nil {
+						result["changelog_error"] = err.Error()
+					}
+				}
+				if file := releaseMigrationGuideFile(rel); file != "" {
+					if err := updateMigrationGuideFile(file, rel.Notes().MigrationGuide.Markdown); err != nil {
+						result["migration_guide_error"] = err.Error()
+					}
+				}
+			} else {
+				printSuccess(fmt.Sprintf("Published %s", releaseLabel(rel)))
+				outputPluginResults(output.PluginResults)
+				handleChangelogUpdate(rel)
+				handleMigrationGuideUpdate(rel)
+			}
+		}
+	}
+
+	if outputJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(map[string]any{"releases": results}); err != nil {
+			return err
+		}
+	} else if dryRun {
+		printWarning("Dry run - no changes will be made")
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to publish %d of %d due releases", failed, len(due))
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	apprelease "github.com/felixgeelhaar/release-pilot/internal/application/release"
+	"github.com/felixgeelhaar/release-pilot/internal/container"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/service/ai"
+)
+
+// runShowPrompt prints the prompts AI notes generation would send for each
+// release, without calling the provider or changing the releases.
+func runShowPrompt(ctx context.Context, dddContainer *container.DDDContainer, rels []*release.Release) error {
+	if !dddContainer.HasAI() {
+		return fmt.Errorf("--show-prompt requires a configured AI provider")
+	}
+
+	results := make([]map[string]any, 0, len(rels))
+	for _, rel := range rels {
+		if rel.Plan() == nil {
+			return fmt.Errorf("release %s has no plan", rel.ID())
+		}
+		prompts, err := dddContainer.PreviewNotesPrompts(ctx, apprelease.AIGenerateInput{
+			ReleaseContext: rel,
+			Tone:           parseNoteTone(notesTone),
+			Audience:       parseNoteAudience(notesAudience),
+			VersionLabel:   formatVersion(rel.Plan().NextVersion),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to render prompts: %w", err)
+		}
+
+		if outputJSON {
+			result := map[string]any{
+				"release_id": string(rel.ID()),
+				"version":    formatVersion(rel.Plan().NextVersion),
+				"profile":    cfg.AI.Prompts.Profile,
+				"prompts":    prompts,
+			}
+			if pkg := rel.Package(); pkg != nil {
+				result["package"] = pkg.Name
+			}
+			results = append(results, result)
+			continue
+		}
+		outputPrompts(rel, prompts)
+	}
+
+	if outputJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if len(results) == 1 {
+			return encoder.Encode(results[0])
+		}
+		return encoder.Encode(results)
+	}
+	return nil
+}
+
+// outputPrompts prints the system and user prompts of a release's requests.
+func outputPrompts(rel *release.Release, prompts []ai.CapturedPrompt) {
+	title := "Prompts for " + formatVersion(rel.Plan().NextVersion)
+	if pkg := rel.Package(); pkg != nil {
+		title = fmt.Sprintf("Prompts for %s %s", pkg.Name, formatVersion(rel.Plan().NextVersion))
+	}
+	if profile := cfg.AI.Prompts.Profile; profile != "" {
+		title += fmt.Sprintf(" (profile %s)", profile)
+	}
+	printTitle(title)
+
+	for _, p := range prompts {
+		fmt.Println()
+		printSubtle("--- system ---")
+		fmt.Println(p.System)
+		fmt.Println()
+		printSubtle("--- user ---")
+		fmt.Println(p.User)
+	}
+	if len(prompts) == 0 {
+		printInfo("No prompts: the release has no changes to send")
+	}
+	fmt.Println()
+}
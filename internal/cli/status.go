@@ -0,0 +1,270 @@
+// Package cli provides the command-line interface for ReleasePilot.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/felixgeelhaar/release-pilot/internal/container"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the current release and what can happen next",
+	Long: `Show the most recent release for this repository, its state,
+and the states it can move to next.
+
+Example:
+  release-pilot status
+  release-pilot status --json`,
+	RunE: runStatus,
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+}
+
+// stateCommands maps release states to the command that moves a release into them.
+var stateCommands = map[release.ReleaseState]string{
+	release.StatePlanned:        "release-pilot plan",
+	release.StateVersioned:      "release-pilot bump",
+	release.StateNotesGenerated: "release-pilot notes",
+	release.StateApproved:       "release-pilot approve",
+	release.StatePublishing:     "release-pilot publish",
+	release.StateRolledBack:     "release-pilot rollback",
+}
+
+// nextStateCommand returns the command that moves the release into the given state.
+func nextStateCommand(rel *release.Release, state release.ReleaseState) string {
+	if state == release.StatePublishing && rel.CanResumePublish() {
+		return "release-pilot publish --resume"
+	}
+	return stateCommands[state]
+}
+
+// runStatus implements the status command.
+func runStatus(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	dddContainer, err := container.NewInitializedDDDContainer(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize container: %w", err)
+	}
+	defer dddContainer.Close()
+
+	rel, group, err := findStatusRelease(ctx, dddContainer)
+	if err != nil {
+		return err
+	}
+
+	if outputJSON {
+		return outputStatusJSON(rel, group)
+	}
+
+	if rel == nil {
+		printInfo("No release in progress")
+		printInfo("Run 'release-pilot plan' to start a new release")
+		return nil
+	}
+
+	outputStatusText(rel, group)
+	return nil
+}
+
+// findStatusRelease returns the latest release for the repository and,
+// for monorepo releases, the other releases in its group.
+func findStatusRelease(ctx context.Context, dddContainer *container.DDDContainer) (*release.Release, []*release.Release, error) {
+	repoInfo, err := dddContainer.GitAdapter().GetInfo(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get repository info: %w", err)
+	}
+
+	releaseRepo := dddContainer.ReleaseRepository()
+	rel, err := releaseRepo.FindLatest(ctx, repoInfo.Path)
+	if err != nil {
+		if errors.Is(err, release.ErrReleaseNotFound) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to load release: %w", err)
+	}
+
+	if rel.GroupID() == "" {
+		return rel, nil, nil
+	}
+
+	group, err := releaseRepo.FindByGroup(ctx, rel.GroupID())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load release group: %w", err)
+	}
+	sortByPackage(group)
+	return rel, group, nil
+}
+
+// releaseVersionLabel returns the version of a release, falling back to its plan.
+func releaseVersionLabel(rel *release.Release) string {
+	if rel.Version() != nil {
+		return formatVersion(*rel.Version())
+	}
+	if rel.Plan() != nil {
+		return formatVersion(rel.Plan().NextVersion)
+	}
+	return ""
+}
+
+// outputStatusText prints the release status as text.
+func outputStatusText(rel *release.Release, group []*release.Release) {
+	printTitle("Release Status")
+	fmt.Println()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "  Release ID:\t%s\n", rel.ID())
+	if pkg := rel.Package(); pkg != nil && group == nil {
+		fmt.Fprintf(w, "  Package:\t%s\n", pkg.Name)
+	}
+	if plan := rel.Plan(); plan != nil {
+		fmt.Fprintf(w, "  Version:\t%s → %s\n", formatVersion(plan.CurrentVersion), releaseVersionLabel(rel))
+	}
+	fmt.Fprintf(w, "  State:\t%s (%s)\n", rel.State(), rel.State().Description())
+	fmt.Fprintf(w, "  Branch:\t%s\n", rel.Branch())
+	if rel.TagName() != "" {
+		fmt.Fprintf(w, "  Tag:\t%s\n", rel.TagName())
+	}
+	if approval := rel.Approval(); approval != nil {
+		fmt.Fprintf(w, "  Approved by:\t%s\n", approval.ApprovedBy)
+	} else if policy := approvalPolicy(); policy != nil && rel.State() == release.StateNotesGenerated {
+		fmt.Fprintf(w, "  Approvals:\t%s\n", rel.ApprovalStatus(*policy))
+	}
+	if schedule := rel.PublishSchedule(); schedule != nil && rel.State() == release.StateApproved {
+		fmt.Fprintf(w, "  Scheduled:\t%s (by %s)\n", schedule.PublishAt.Format(time.RFC3339), schedule.ScheduledBy)
+	}
+	for _, override := range rel.FreezeOverrides() {
+		fmt.Fprintf(w, "  Freeze override:\t%s by %s: %s\n", override.Window, override.OverriddenBy, override.Reason)
+	}
+	fmt.Fprintf(w, "  Updated:\t%s\n", rel.UpdatedAt().Format(time.RFC3339))
+	if rel.LastError() != "" {
+		fmt.Fprintf(w, "  Last error:\t%s\n", rel.LastError())
+	}
+	if rollback := rel.Rollback(); rollback != nil {
+		fmt.Fprintf(w, "  Rolled back by:\t%s (%s)\n", rollback.RolledBackBy, rollback.RolledBackAt.Format(time.RFC3339))
+		if rollback.Reason != "" {
+			fmt.Fprintf(w, "  Reason:\t%s\n", rollback.Reason)
+		}
+	}
+	w.Flush()
+
+	if len(group) > 0 {
+		fmt.Println()
+		printTitle("Packages")
+		fmt.Println()
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, member := range group {
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", packageName(member), releaseVersionLabel(member), member.State())
+		}
+		w.Flush()
+	}
+
+	fmt.Println()
+	next := rel.State().NextValidStates()
+	if len(next) == 0 {
+		printSubtle("This release is complete. Run 'release-pilot plan' to start a new release.")
+		return
+	}
+
+	printTitle("Next Steps")
+	fmt.Println()
+	for _, state := range next {
+		if command := nextStateCommand(rel, state); command != "" {
+			fmt.Printf("  → %-16s %s\n", state, command)
+		} else {
+			fmt.Printf("  → %s\n", state)
+		}
+	}
+	fmt.Println()
+}
+
+// outputStatusJSON prints the release status as JSON.
+func outputStatusJSON(rel *release.Release, group []*release.Release) error {
+	result := map[string]any{"active": false}
+
+	if rel != nil {
+		next := rel.State().NextValidStates()
+		nextStates := make([]string, 0, len(next))
+		for _, state := range next {
+			nextStates = append(nextStates, string(state))
+		}
+
+		result = map[string]any{
+			"active":            !rel.State().IsFinal(),
+			"release_id":        string(rel.ID()),
+			"state":             string(rel.State()),
+			"state_description": rel.State().Description(),
+			"version":           releaseVersionLabel(rel),
+			"branch":            rel.Branch(),
+			"tag_name":          rel.TagName(),
+			"updated_at":        rel.UpdatedAt().Format(time.RFC3339),
+			"next_states":       nextStates,
+		}
+		if plan := rel.Plan(); plan != nil {
+			result["current_version"] = formatVersion(plan.CurrentVersion)
+		}
+		if pkg := rel.Package(); pkg != nil {
+			result["package"] = pkg.Name
+		}
+		if approval := rel.Approval(); approval != nil {
+			result["approved_by"] = approval.ApprovedBy
+		}
+		if policy := approvalPolicy(); policy != nil {
+			result["approval_status"] = approvalStatusJSON(rel.ApprovalStatus(*policy))
+		}
+		if schedule := rel.PublishSchedule(); schedule != nil {
+			result["publish_at"] = schedule.PublishAt.Format(time.RFC3339)
+			result["scheduled_by"] = schedule.ScheduledBy
+		}
+		if overrides := rel.FreezeOverrides(); len(overrides) > 0 {
+			list := make([]map[string]string, 0, len(overrides))
+			for _, override := range overrides {
+				list = append(list, map[string]string{
+					"window":        override.Window,
+					"reason":        override.Reason,
+					"overridden_by": override.OverriddenBy,
+					"overridden_at": override.OverriddenAt.Format(time.RFC3339),
+				})
+			}
+			result["freeze_overrides"] = list
+		}
+		if rel.LastError() != "" {
+			result["last_error"] = rel.LastError()
+		}
+		if rollback := rel.Rollback(); rollback != nil {
+			result["rollback"] = map[string]any{
+				"reason":         rollback.Reason,
+				"rolled_back_by": rollback.RolledBackBy,
+				"rolled_back_at": rollback.RolledBackAt.Format(time.RFC3339),
+			}
+		}
+		if len(group) > 0 {
+			packages := make([]map[string]any, 0, len(group))
+			for _, member := range group {
+				packages = append(packages, map[string]any{
+					"package":    packageName(member),
+					"release_id": string(member.ID()),
+					"version":    releaseVersionLabel(member),
+					"state":      string(member.State()),
+				})
+			}
+			result["packages"] = packages
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
@@ -159,6 +159,116 @@ func TestValidator_Validate_InvalidStrategy(t *testing.T) {
 	}
 }
 
+func TestValidator_Validate_CalVer(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		modifier string
+		wantErr  string
+	}{
+		{name: "default format", format: "YYYY.MM.MICRO"},
+		{name: "weekly format with modifier", format: "YY.0W", modifier: "beta"},
+		{name: "unknown token", format: "YYYY.QQ", wantErr: "versioning.calver.format"},
+		{name: "micro not last", format: "YYYY.MICRO.MM", wantErr: "versioning.calver.format"},
+		{name: "invalid modifier", format: "YYYY.MM.MICRO", modifier: "beta!", wantErr: "versioning.calver.modifier"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.AI.Enabled = false
+			cfg.Versioning.Strategy = "calver"
+			cfg.Versioning.CalVer = CalVerConfig{Format: tt.format, Modifier: tt.modifier}
+
+			err := Validate(cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want error mentioning %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidator_Validate_Classify(t *testing.T) {
+	for _, classify := range []string{"", "off", "heuristic", "ai"} {
+		cfg := DefaultConfig()
+		cfg.AI.Enabled = false
+		cfg.Versioning.Classify = classify
+		if err := Validate(cfg); err != nil {
+			t.Errorf("Validate() with classify %q error = %v", classify, err)
+		}
+	}
+
+	cfg := DefaultConfig()
+	cfg.AI.Enabled = false
+	cfg.Versioning.Classify = "llm"
+	if err := Validate(cfg); err == nil || !strings.Contains(err.Error(), "versioning.classify") {
+		t.Errorf("Validate() error = %v, want error mentioning versioning.classify", err)
+	}
+}
+
+func TestValidator_Validate_MigrationGuide(t *testing.T) {
+	tests := []struct {
+		name    string
+		guide   MigrationGuideConfig
+		wantErr string
+	}{
+		{name: "disabled", guide: MigrationGuideConfig{}},
+		{name: "enabled", guide: MigrationGuideConfig{Enabled: true, File: "MIGRATION.md", APIPaths: []string{"pkg/**", "api/*.proto"}}},
+		{name: "missing file", guide: MigrationGuideConfig{Enabled: true}, wantErr: "changelog.migration_guide.file"},
+		{name: "invalid glob", guide: MigrationGuideConfig{APIPaths: []string{"pkg/[a"}}, wantErr: "changelog.migration_guide.api_paths"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.AI.Enabled = false
+			cfg.Changelog.MigrationGuide = tt.guide
+			err := Validate(cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want error mentioning %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVersioningConfig_Scheme(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      VersioningConfig
+		wantName string
+		wantErr  bool
+	}{
+		{name: "conventional", cfg: VersioningConfig{Strategy: "conventional"}, wantName: "semver"},
+		{name: "calver", cfg: VersioningConfig{Strategy: "calver", CalVer: CalVerConfig{Format: "YY.0W"}}, wantName: "calver"},
+		{name: "calver default format", cfg: VersioningConfig{Strategy: "calver"}, wantName: "calver"},
+		{name: "invalid calver format", cfg: VersioningConfig{Strategy: "calver", CalVer: CalVerConfig{Format: "MM.YYYY"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme, err := tt.cfg.Scheme()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scheme() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && scheme.Name() != tt.wantName {
+				t.Errorf("Scheme() = %s, want %s", scheme.Name(), tt.wantName)
+			}
+		})
+	}
+}
+
 func TestValidator_Validate_InvalidBumpFrom(t *testing.T) {
 	cfg := DefaultConfig()
 	cfg.Versioning.BumpFrom = "invalid"
@@ -333,6 +443,95 @@ func TestValidator_Validate_InvalidAITemperature(t *testing.T) {
 	}
 }
 
+func TestValidator_Validate_AIContextWindowSmallerThanMaxTokens(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.AI.Enabled = true
+	cfg.AI.APIKey = "test-key"
+	cfg.AI.ContextWindow = 1024 // Smaller than the default max_tokens
+
+	err := Validate(cfg)
+	if err == nil {
+		t.Fatal("Validate() should return error for a context window smaller than max_tokens")
+	}
+	if !strings.Contains(err.Error(), "ai.context_window") {
+		t.Errorf("Error should mention ai.context_window, got: %v", err)
+	}
+}
+
+func TestValidator_Validate_AIPromptProfile(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.AI.Enabled = true
+	cfg.AI.APIKey = "test-key"
+	cfg.AI.Prompts.Profile = "concise"
+	if err := Validate(cfg); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	cfg.AI.Prompts.Profile = "../shared"
+	err := Validate(cfg)
+	if err == nil || !strings.Contains(err.Error(), "ai.prompts.profile") {
+		t.Errorf("Validate() error = %v, want error mentioning ai.prompts.profile", err)
+	}
+}
+
+func TestValidator_Validate_AIProviders(t *testing.T) {
+	tests := []struct {
+		name      string
+		providers []AIProviderConfig
+		wantErr   string
+	}{
+		{
+			name:      "chain without api keys",
+			providers: []AIProviderConfig{{Provider: "anthropic"}, {Provider: "openai"}, {Provider: "ollama"}, {Provider: "template"}},
+		},
+		{
+			name:      "unknown provider",
+			providers: []AIProviderConfig{{Provider: "gemini"}},
+			wantErr:   "ai.providers[0].provider: must be one of",
+		},
+		{
+			name:      "template not last",
+			providers: []AIProviderConfig{{Provider: "template"}, {Provider: "openai"}},
+			wantErr:   "ai.providers[0].provider: template must be the last provider",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OPENAI_API_KEY", "")
+			t.Setenv("RELEASE_PILOT_AI_API_KEY", "")
+
+			cfg := DefaultConfig()
+			cfg.AI.Enabled = true
+			cfg.AI.Providers = tt.providers
+
+			err := Validate(cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAIConfig_AIProviders(t *testing.T) {
+	cfg := AIConfig{Provider: "openai", Model: "gpt-4", APIKey: "sk-test"}
+	providers := cfg.AIProviders()
+	if len(providers) != 1 || providers[0].Provider != "openai" || providers[0].Model != "gpt-4" || providers[0].APIKey != "sk-test" {
+		t.Errorf("AIProviders() = %+v, want the single configured provider", providers)
+	}
+
+	cfg.Providers = []AIProviderConfig{{Provider: "anthropic"}, {Provider: "ollama"}}
+	if providers := cfg.AIProviders(); len(providers) != 2 || providers[0].Provider != "anthropic" {
+		t.Errorf("AIProviders() = %+v, want the configured chain", providers)
+	}
+}
+
 func TestValidator_Validate_DuplicatePluginNames(t *testing.T) {
 	cfg := DefaultConfig()
 	cfg.AI.Enabled = false
@@ -850,3 +1049,405 @@ func TestValidator_Validate_SlackPluginWithoutWebhook(t *testing.T) {
 		t.Errorf("Error should mention webhook, got: %v", err)
 	}
 }
+
+func TestValidator_Validate_Packages(t *testing.T) {
+	tests := []struct {
+		name     string
+		packages PackagesConfig
+		wantErr  string
+	}{
+		{
+			name: "valid",
+			packages: PackagesConfig{
+				Discover:  []string{"packages/*"},
+				TagPrefix: "{name}/v",
+				Include:   []PackageConfig{{Name: "api", Path: "services/api"}},
+			},
+		},
+		{
+			name:     "invalid glob",
+			packages: PackagesConfig{Discover: []string{"packages/["}},
+			wantErr:  "invalid glob pattern",
+		},
+		{
+			name:     "missing name",
+			packages: PackagesConfig{Include: []PackageConfig{{Path: "services/api"}}},
+			wantErr:  "packages.include[0].name",
+		},
+		{
+			name:     "invalid name",
+			packages: PackagesConfig{Include: []PackageConfig{{Name: "@scope/api", Path: "services/api"}}},
+			wantErr:  "invalid package name",
+		},
+		{
+			name: "duplicate name",
+			packages: PackagesConfig{Include: []PackageConfig{
+				{Name: "api", Path: "services/api"},
+				{Name: "api", Path: "services/api2"},
+			}},
+			wantErr: "duplicate package name",
+		},
+		{
+			name:     "path traversal",
+			packages: PackagesConfig{Include: []PackageConfig{{Name: "api", Path: "../api"}}},
+			wantErr:  "must be relative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.AI.Enabled = false
+			cfg.Packages = tt.packages
+
+			err := Validate(cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidator_Validate_ApprovalPolicy(t *testing.T) {
+	managers := ApprovalGroupConfig{Name: "release-managers", Members: []string{"alice", "bob"}, MinApprovals: 1}
+
+	tests := []struct {
+		name    string
+		policy  ApprovalPolicyConfig
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			policy: ApprovalPolicyConfig{RequiredApprovals: 2, Groups: []ApprovalGroupConfig{managers}, ExcludeBreakingAuthors: true},
+		},
+		{
+			name:    "negative required approvals",
+			policy:  ApprovalPolicyConfig{RequiredApprovals: -1},
+			wantErr: "required_approvals: must not be negative",
+		},
+		{
+			name:    "group without name",
+			policy:  ApprovalPolicyConfig{Groups: []ApprovalGroupConfig{{Members: []string{"alice"}}}},
+			wantErr: "groups[0].name: required",
+		},
+		{
+			name:    "duplicate group",
+			policy:  ApprovalPolicyConfig{Groups: []ApprovalGroupConfig{managers, managers}},
+			wantErr: "duplicate group",
+		},
+		{
+			name:    "group without members",
+			policy:  ApprovalPolicyConfig{Groups: []ApprovalGroupConfig{{Name: "qa"}}},
+			wantErr: "at least one member is required",
+		},
+		{
+			name:    "email as identity domain",
+			policy:  ApprovalPolicyConfig{IdentityDomains: []string{"example.com", "alice@example.com"}},
+			wantErr: `identity_domains[1]: "alice@example.com" is not a domain`,
+		},
+		{
+			name:    "more approvals than members",
+			policy:  ApprovalPolicyConfig{Groups: []ApprovalGroupConfig{{Name: "qa", Members: []string{"erin"}, MinApprovals: 2}}},
+			wantErr: "exceeds the 1 members",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.AI.Enabled = false
+			cfg.Workflow.ApprovalPolicy = &tt.policy
+
+			err := Validate(cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestApprovalPolicyConfig_Policy(t *testing.T) {
+	cfg := &ApprovalPolicyConfig{
+		RequiredApprovals: 2,
+		Groups: []ApprovalGroupConfig{
+			{Name: "release-managers", Members: []string{"alice", "bob"}, MinApprovals: 1},
+		},
+		ExcludeBreakingAuthors: true,
+		IdentityDomains:        []string{"example.com"},
+	}
+
+	policy := cfg.Policy()
+	if policy.RequiredApprovals != 2 || !policy.ExcludeBreakingAuthors || len(policy.IdentityDomains) != 1 {
+		t.Errorf("Policy() = %+v", policy)
+	}
+	if len(policy.Groups) != 1 || policy.Groups[0].Name != "release-managers" || len(policy.Groups[0].Members) != 2 || policy.Groups[0].MinApprovals != 1 {
+		t.Errorf("Policy().Groups = %+v", policy.Groups)
+	}
+}
+
+func TestValidator_Validate_FreezeWindows(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  FreezeWindowConfig
+		wantErr string
+	}{
+		{
+			name:   "weekly window",
+			window: FreezeWindowConfig{Name: "fridays", Days: []string{"friday"}, StartTime: "15:00", Timezone: "Europe/Berlin"},
+		},
+		{
+			name:   "date range",
+			window: FreezeWindowConfig{Name: "holidays", From: "2025-12-20", To: "2026-01-02"},
+		},
+		{
+			name:    "neither days nor range",
+			window:  FreezeWindowConfig{Name: "empty"},
+			wantErr: "freeze_windows[0].days: required unless from and to are set",
+		},
+		{
+			name:    "unknown weekday",
+			window:  FreezeWindowConfig{Days: []string{"funday"}},
+			wantErr: "unknown weekday",
+		},
+		{
+			name:    "invalid start time",
+			window:  FreezeWindowConfig{Days: []string{"fri"}, StartTime: "3pm"},
+			wantErr: "start_time: invalid time of day",
+		},
+		{
+			name:    "unknown timezone",
+			window:  FreezeWindowConfig{Days: []string{"fri"}, Timezone: "Mars/Olympus"},
+			wantErr: "timezone: unknown timezone",
+		},
+		{
+			name:    "range ends before it starts",
+			window:  FreezeWindowConfig{From: "2026-01-02", To: "2025-12-20"},
+			wantErr: "to: must be after from",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.AI.Enabled = false
+			cfg.Workflow.FreezeWindows = []FreezeWindowConfig{tt.window}
+
+			err := Validate(cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFreezeWindowConfig_Window(t *testing.T) {
+	weekly, err := (&FreezeWindowConfig{Days: []string{"Fri", "saturday"}, StartTime: "15:00", EndTime: "06:00", Timezone: "UTC"}).Window()
+	if err != nil {
+		t.Fatalf("Window() error = %v", err)
+	}
+	if len(weekly.Days) != 2 || weekly.Days[0] != time.Friday || weekly.Days[1] != time.Saturday {
+		t.Errorf("Days = %v, want [Friday Saturday]", weekly.Days)
+	}
+	if weekly.StartTime != 15*time.Hour || weekly.EndTime != 6*time.Hour {
+		t.Errorf("StartTime, EndTime = %v, %v", weekly.StartTime, weekly.EndTime)
+	}
+
+	holidays, err := (&FreezeWindowConfig{From: "2025-12-20", To: "2026-01-02", Timezone: "UTC"}).Window()
+	if err != nil {
+		t.Fatalf("Window() error = %v", err)
+	}
+	if !holidays.Contains(time.Date(2026, 1, 2, 23, 0, 0, 0, time.UTC)) {
+		t.Error("a date-only end should include the whole day")
+	}
+	if holidays.Contains(time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Error("the window should end after the last day")
+	}
+}
+
+func TestValidator_Validate_Webhooks(t *testing.T) {
+	negative := -1
+
+	tests := []struct {
+		name    string
+		webhook WebhookConfig
+		wantErr string
+	}{
+		{
+			name:    "valid",
+			webhook: WebhookConfig{URL: "https://example.com/hooks/release", Secret: "s3cret", Events: []string{"release.published", "failed"}},
+		},
+		{
+			name:    "missing url",
+			webhook: WebhookConfig{},
+			wantErr: "webhooks[0].url: required",
+		},
+		{
+			name:    "unsupported scheme",
+			webhook: WebhookConfig{URL: "ftp://example.com/hook"},
+			wantErr: "must be an http or https URL",
+		},
+		{
+			name:    "negative retries",
+			webhook: WebhookConfig{URL: "https://example.com", MaxRetries: &negative},
+			wantErr: "max_retries",
+		},
+		{
+			name:    "empty event name",
+			webhook: WebhookConfig{URL: "https://example.com", Events: []string{" "}},
+			wantErr: "event names must not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.AI.Enabled = false
+			cfg.Webhooks = []WebhookConfig{tt.webhook}
+
+			err := Validate(cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookConfig_RetryCount(t *testing.T) {
+	zero := 0
+	if got := (&WebhookConfig{}).RetryCount(); got != DefaultWebhookMaxRetries {
+		t.Errorf("RetryCount() = %d, want %d", got, DefaultWebhookMaxRetries)
+	}
+	if got := (&WebhookConfig{MaxRetries: &zero}).RetryCount(); got != 0 {
+		t.Errorf("RetryCount() = %d, want 0", got)
+	}
+}
+
+func TestPackagesConfig_TagPrefixFor(t *testing.T) {
+	cfg := PackagesConfig{
+		TagPrefix: "{name}@",
+		Include:   []PackageConfig{{Name: "api", Path: "services/api", TagPrefix: "api-v"}},
+	}
+
+	if got := cfg.TagPrefixFor("api"); got != "api-v" {
+		t.Errorf("TagPrefixFor(api) = %q, want api-v", got)
+	}
+	if got := cfg.TagPrefixFor("web"); got != "web@" {
+		t.Errorf("TagPrefixFor(web) = %q, want web@", got)
+	}
+
+	empty := PackagesConfig{}
+	if got := empty.TagPrefixFor("web"); got != "web/v" {
+		t.Errorf("TagPrefixFor(web) with default = %q, want web/v", got)
+	}
+}
+
+func TestValidator_Validate_PluginDependencies(t *testing.T) {
+	tests := []struct {
+		name    string
+		plugins []PluginConfig
+		wantErr string
+	}{
+		{
+			name: "valid graph",
+			plugins: []PluginConfig{
+				{Name: "github"},
+				{Name: "homebrew", DependsOn: []string{"github"}},
+				{Name: "slack", After: []string{"github", "homebrew"}},
+			},
+		},
+		{
+			name:    "unknown plugin",
+			plugins: []PluginConfig{{Name: "homebrew", DependsOn: []string{"github"}}},
+			wantErr: `plugins[0].depends_on: unknown plugin "github"`,
+		},
+		{
+			name:    "self reference",
+			plugins: []PluginConfig{{Name: "slack", After: []string{"slack"}}},
+			wantErr: `plugins[0].after: plugin "slack" cannot depend on itself`,
+		},
+		{
+			name: "cycle",
+			plugins: []PluginConfig{
+				{Name: "github", After: []string{"slack"}},
+				{Name: "homebrew", DependsOn: []string{"github"}},
+				{Name: "slack", DependsOn: []string{"homebrew"}},
+			},
+			wantErr: "dependency cycle github -> slack -> homebrew -> github",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.AI.Enabled = false
+			cfg.Plugins = tt.plugins
+
+			err := Validate(cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidator_Validate_PluginSecrets(t *testing.T) {
+	tests := []struct {
+		name    string
+		secrets []string
+		wantErr string
+	}{
+		{name: "valid", secrets: []string{"HOMEBREW_TAP_TOKEN", "_TOKEN2"}},
+		{name: "empty", secrets: []string{""}, wantErr: `plugins[0].secrets: invalid environment variable name ""`},
+		{name: "leading digit", secrets: []string{"1TOKEN"}, wantErr: `invalid environment variable name "1TOKEN"`},
+		{name: "invalid character", secrets: []string{"TAP-TOKEN"}, wantErr: `invalid environment variable name "TAP-TOKEN"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.AI.Enabled = false
+			cfg.Plugins = []PluginConfig{{Name: "homebrew", Secrets: tt.secrets}}
+
+			err := Validate(cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -89,6 +89,7 @@ func (l *Loader) setDefaults() {
 	l.v.SetDefault("versioning.git_push", defaults.Versioning.GitPush)
 	l.v.SetDefault("versioning.git_sign", defaults.Versioning.GitSign)
 	l.v.SetDefault("versioning.bump_from", defaults.Versioning.BumpFrom)
+	l.v.SetDefault("versioning.calver.format", defaults.Versioning.CalVer.Format)
 
 	// Changelog defaults
 	l.v.SetDefault("changelog.file", defaults.Changelog.File)
@@ -101,6 +102,8 @@ func (l *Loader) setDefaults() {
 	l.v.SetDefault("changelog.link_issues", defaults.Changelog.LinkIssues)
 	l.v.SetDefault("changelog.exclude", defaults.Changelog.Exclude)
 	l.v.SetDefault("changelog.categories", defaults.Changelog.Categories)
+	l.v.SetDefault("changelog.migration_guide.enabled", defaults.Changelog.MigrationGuide.Enabled)
+	l.v.SetDefault("changelog.migration_guide.file", defaults.Changelog.MigrationGuide.File)
 
 	// AI defaults
 	l.v.SetDefault("ai.enabled", defaults.AI.Enabled)
@@ -112,6 +115,9 @@ func (l *Loader) setDefaults() {
 	l.v.SetDefault("ai.temperature", defaults.AI.Temperature)
 	l.v.SetDefault("ai.timeout", defaults.AI.Timeout)
 	l.v.SetDefault("ai.retry_attempts", defaults.AI.RetryAttempts)
+	l.v.SetDefault("ai.cache.enabled", defaults.AI.Cache.Enabled)
+	l.v.SetDefault("ai.cache.dir", defaults.AI.Cache.Dir)
+	l.v.SetDefault("ai.prompts.dir", defaults.AI.Prompts.Dir)
 
 	// Workflow defaults
 	l.v.SetDefault("workflow.require_approval", defaults.Workflow.RequireApproval)
@@ -122,6 +128,10 @@ func (l *Loader) setDefaults() {
 	l.v.SetDefault("workflow.auto_commit_changelog", defaults.Workflow.AutoCommitChangelog)
 	l.v.SetDefault("workflow.changelog_commit_message", defaults.Workflow.ChangelogCommitMessage)
 
+	// Packages defaults
+	l.v.SetDefault("packages.tag_prefix", defaults.Packages.TagPrefix)
+	l.v.SetDefault("packages.cascade", defaults.Packages.Cascade)
+
 	// Output defaults
 	l.v.SetDefault("output.format", defaults.Output.Format)
 	l.v.SetDefault("output.color", defaults.Output.Color)
@@ -166,6 +176,10 @@ func (l *Loader) expandEnvVars(cfg *Config) {
 	// Expand AI API key
 	cfg.AI.APIKey = expandEnvVar(cfg.AI.APIKey)
 	cfg.AI.BaseURL = expandEnvVar(cfg.AI.BaseURL)
+	for i := range cfg.AI.Providers {
+		cfg.AI.Providers[i].APIKey = expandEnvVar(cfg.AI.Providers[i].APIKey)
+		cfg.AI.Providers[i].BaseURL = expandEnvVar(cfg.AI.Providers[i].BaseURL)
+	}
 
 	// Expand plugin configurations
 	for i := range cfg.Plugins {
@@ -182,6 +196,12 @@ func (l *Loader) expandEnvVars(cfg *Config) {
 
 	// Expand output log file
 	cfg.Output.LogFile = expandEnvVar(cfg.Output.LogFile)
+
+	// Expand webhook endpoints and secrets
+	for i := range cfg.Webhooks {
+		cfg.Webhooks[i].URL = expandEnvVar(cfg.Webhooks[i].URL)
+		cfg.Webhooks[i].Secret = expandEnvVar(cfg.Webhooks[i].Secret)
+	}
 }
 
 // expandEnvVar expands environment variables in a string.
@@ -2,7 +2,12 @@
 package config
 
 import (
+	"fmt"
+	"strings"
 	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
 )
 
 // Config is the root configuration for ReleasePilot.
@@ -23,11 +28,15 @@ type Config struct {
 	Output OutputConfig `mapstructure:"output" json:"output"`
 	// Telemetry configures observability and tracing.
 	Telemetry TelemetryConfig `mapstructure:"telemetry" json:"telemetry"`
+	// Packages configures monorepo mode with independently released packages.
+	Packages PackagesConfig `mapstructure:"packages" json:"packages,omitempty"`
+	// Webhooks lists HTTP endpoints that receive release lifecycle events.
+	Webhooks []WebhookConfig `mapstructure:"webhooks" json:"webhooks,omitempty"`
 }
 
 // VersioningConfig configures version management.
 type VersioningConfig struct {
-	// Strategy is the versioning strategy (conventional, manual).
+	// Strategy is the versioning strategy (conventional, manual, calver).
 	Strategy string `mapstructure:"strategy" json:"strategy"`
 	// TagPrefix is the prefix for version tags (default: "v").
 	TagPrefix string `mapstructure:"tag_prefix" json:"tag_prefix"`
@@ -45,6 +54,35 @@ type VersioningConfig struct {
 	BumpFrom string `mapstructure:"bump_from" json:"bump_from"`
 	// VersionFile is the file to update with the new version (if BumpFrom is "file").
 	VersionFile string `mapstructure:"version_file" json:"version_file,omitempty"`
+	// CalVer configures calendar versioning (used when Strategy is "calver").
+	CalVer CalVerConfig `mapstructure:"calver" json:"calver"`
+	// Classify infers type, scope and breaking-ness for commits that are not
+	// conventional commits instead of ignoring them: "off" (default),
+	// "heuristic" (keywords and changed paths) or "ai" (the configured AI
+	// provider, falling back to the heuristic).
+	Classify string `mapstructure:"classify" json:"classify,omitempty"`
+}
+
+// CalVerConfig configures calendar versioning.
+type CalVerConfig struct {
+	// Format is the CalVer format (e.g., "YYYY.MM.MICRO", "YY.0W").
+	// Supported tokens: YYYY, YY, 0Y, MM, 0M, WW, 0W, DD, 0D, MICRO.
+	Format string `mapstructure:"format" json:"format"`
+	// Modifier is an optional suffix for new versions (e.g., "beta" gives "2024.06.0-beta").
+	Modifier string `mapstructure:"modifier" json:"modifier,omitempty"`
+}
+
+// Scheme returns the version scheme selected by the versioning strategy.
+func (v *VersioningConfig) Scheme() (version.Scheme, error) {
+	if v.Strategy != version.SchemeCalVer {
+		return version.NewSemVerScheme(), nil
+	}
+
+	format := v.CalVer.Format
+	if format == "" {
+		format = version.DefaultCalVerFormat
+	}
+	return version.NewCalVerScheme(format, version.WithCalVerModifier(version.Prerelease(v.CalVer.Modifier)))
 }
 
 // GitConfig configures git operations and authentication.
@@ -117,6 +155,21 @@ type ChangelogConfig struct {
 	Exclude []string `mapstructure:"exclude" json:"exclude,omitempty"`
 	// Categories customizes category labels for commit types.
 	Categories map[string]string `mapstructure:"categories" json:"categories,omitempty"`
+	// MigrationGuide configures migration guides for breaking changes.
+	MigrationGuide MigrationGuideConfig `mapstructure:"migration_guide" json:"migration_guide"`
+}
+
+// MigrationGuideConfig configures migration guide generation for releases
+// with breaking changes.
+type MigrationGuideConfig struct {
+	// Enabled generates a migration guide with the release notes.
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+	// File is the migration guide file the section is prepended to.
+	File string `mapstructure:"file" json:"file"`
+	// APIPaths are glob patterns of the public API files whose diffs are
+	// used for before/after examples. A pattern ending in /** matches a
+	// directory tree. Defaults to exported Go, protobuf and OpenAPI files.
+	APIPaths []string `mapstructure:"api_paths" json:"api_paths,omitempty"`
 }
 
 // AIConfig configures AI integration.
@@ -145,6 +198,10 @@ type AIConfig struct {
 	IncludeEmoji bool `mapstructure:"include_emoji" json:"include_emoji"`
 	// MaxTokens is the maximum tokens for AI responses.
 	MaxTokens int `mapstructure:"max_tokens" json:"max_tokens"`
+	// ContextWindow is the model's context window in tokens (0 = the
+	// provider's default). Changesets that do not fit are summarized in
+	// chunks before the final request.
+	ContextWindow int `mapstructure:"context_window" json:"context_window,omitempty"`
 	// Temperature controls randomness (0.0-2.0).
 	Temperature float64 `mapstructure:"temperature" json:"temperature"`
 	// Timeout is the API request timeout.
@@ -153,6 +210,66 @@ type AIConfig struct {
 	RetryAttempts int `mapstructure:"retry_attempts" json:"retry_attempts"`
 	// CustomPrompts allows custom prompt templates.
 	CustomPrompts CustomPrompts `mapstructure:"custom_prompts" json:"custom_prompts,omitempty"`
+	// Structured asks the model for release notes as schema-validated JSON
+	// (title, summary, highlights, sections, migration notes) instead of a
+	// prose summary. Invalid output is sent back with a repair prompt.
+	Structured bool `mapstructure:"structured" json:"structured"`
+	// Providers is an ordered fallback chain of AI providers. Each call tries
+	// them in turn until one succeeds; "template" may end the chain to fall
+	// back to the deterministic notes. When set, it replaces Provider, Model,
+	// APIKey and BaseURL.
+	Providers []AIProviderConfig `mapstructure:"providers" json:"providers,omitempty"`
+	// Cache stores AI responses keyed by provider, model, prompts, options
+	// and changeset, so repeated runs are deterministic and free.
+	Cache AICacheConfig `mapstructure:"cache" json:"cache"`
+	// Prompts is the prompt library: prompt templates stored as files,
+	// which override the built-in prompts and CustomPrompts.
+	Prompts AIPromptsConfig `mapstructure:"prompts" json:"prompts"`
+}
+
+// AIPromptsConfig configures the prompt library. Templates are named after
+// the prompt and role, e.g. summary.system.tmpl and summary.user.tmpl. The
+// templates of a profile live in a subdirectory of Dir and override the
+// templates at its top.
+type AIPromptsConfig struct {
+	// Dir is the prompt directory (default: .release-pilot/prompts).
+	Dir string `mapstructure:"dir" json:"dir,omitempty"`
+	// Profile selects a subdirectory of Dir (e.g., "concise").
+	Profile string `mapstructure:"profile" json:"profile,omitempty"`
+}
+
+// AICacheConfig configures the AI response cache.
+type AICacheConfig struct {
+	// Enabled turns the cache on (default: true).
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+	// Dir is the cache directory (default: .release-pilot/cache/ai).
+	Dir string `mapstructure:"dir" json:"dir,omitempty"`
+}
+
+// AIProviderConfig configures one provider of the AI fallback chain.
+type AIProviderConfig struct {
+	// Provider is the provider name (openai, anthropic, ollama, template).
+	Provider string `mapstructure:"provider" json:"provider"`
+	// Model is the model to use (default: the provider's default model).
+	Model string `mapstructure:"model" json:"model,omitempty"`
+	// APIKey is the API key (default: OPENAI_API_KEY or ANTHROPIC_API_KEY).
+	APIKey string `mapstructure:"api_key" json:"api_key,omitempty"`
+	// BaseURL is the API base URL (for custom endpoints).
+	BaseURL string `mapstructure:"base_url" json:"base_url,omitempty"`
+}
+
+// AIProviders returns the configured fallback chain, or the single
+// configured provider if no chain is set.
+func (c *AIConfig) AIProviders() []AIProviderConfig {
+	if len(c.Providers) > 0 {
+		return c.Providers
+	}
+	return []AIProviderConfig{{
+		Provider: c.Provider,
+		Model:    c.Model,
+		APIKey:   c.APIKey,
+		BaseURL:  c.BaseURL,
+	}}
 }
 
 // CustomPrompts allows customization of AI prompts.
@@ -187,6 +304,15 @@ type PluginConfig struct {
 	Timeout time.Duration `mapstructure:"timeout" json:"timeout,omitempty"`
 	// ContinueOnError indicates whether to continue if the plugin fails.
 	ContinueOnError bool `mapstructure:"continue_on_error" json:"continue_on_error"`
+	// DependsOn lists plugins that must succeed before this plugin runs on a
+	// hook they share. The plugin is skipped if one of them fails.
+	DependsOn []string `mapstructure:"depends_on" json:"depends_on,omitempty"`
+	// After lists plugins that must finish before this plugin runs on a hook
+	// they share, whether or not they succeed.
+	After []string `mapstructure:"after" json:"after,omitempty"`
+	// Secrets lists the environment variables the plugin may read through
+	// the host's secret service. Other secrets are denied.
+	Secrets []string `mapstructure:"secrets" json:"secrets,omitempty"`
 }
 
 // IsEnabled returns whether the plugin is enabled.
@@ -217,8 +343,249 @@ type WorkflowConfig struct {
 	PreReleaseHook string `mapstructure:"pre_release_hook" json:"pre_release_hook,omitempty"`
 	// PostReleaseHook is a command to run after the release.
 	PostReleaseHook string `mapstructure:"post_release_hook" json:"post_release_hook,omitempty"`
+	// ApprovalPolicy requires multiple or specific approvers before publishing.
+	ApprovalPolicy *ApprovalPolicyConfig `mapstructure:"approval_policy" json:"approval_policy,omitempty"`
+	// FreezeWindows block publishing during the configured periods unless
+	// overridden with a reason.
+	FreezeWindows []FreezeWindowConfig `mapstructure:"freeze_windows" json:"freeze_windows,omitempty"`
+}
+
+// ApprovalPolicyConfig configures who must approve a release.
+type ApprovalPolicyConfig struct {
+	// RequiredApprovals is the number of distinct approvers required (default: 1).
+	RequiredApprovals int `mapstructure:"required_approvals" json:"required_approvals,omitempty"`
+	// Groups lists approver groups that must each contribute approvals.
+	Groups []ApprovalGroupConfig `mapstructure:"groups" json:"groups,omitempty"`
+	// ExcludeBreakingAuthors rejects approvals from authors of breaking commits.
+	ExcludeBreakingAuthors bool `mapstructure:"exclude_breaking_authors" json:"exclude_breaking_authors,omitempty"`
+	// IdentityDomains lists the email domains whose addresses match bare user
+	// names (e.g., "example.com" lets alice@example.com approve as alice).
+	IdentityDomains []string `mapstructure:"identity_domains" json:"identity_domains,omitempty"`
+}
+
+// Policy converts the configuration to a release approval policy.
+func (c *ApprovalPolicyConfig) Policy() release.ApprovalPolicy {
+	policy := release.ApprovalPolicy{
+		RequiredApprovals:      c.RequiredApprovals,
+		ExcludeBreakingAuthors: c.ExcludeBreakingAuthors,
+		IdentityDomains:        c.IdentityDomains,
+	}
+	for _, group := range c.Groups {
+		policy.Groups = append(policy.Groups, release.ApprovalGroup{
+			Name:         group.Name,
+			Members:      group.Members,
+			MinApprovals: group.MinApprovals,
+		})
+	}
+	return policy
+}
+
+// ApprovalGroupConfig configures a group of approvers.
+type ApprovalGroupConfig struct {
+	// Name is the group name (e.g., "release-managers").
+	Name string `mapstructure:"name" json:"name"`
+	// Members are the user names or emails of the group members.
+	Members []string `mapstructure:"members" json:"members"`
+	// MinApprovals is the number of approvals required from the group (default: 1).
+	MinApprovals int `mapstructure:"min_approvals" json:"min_approvals,omitempty"`
 }
 
+// FreezeWindowConfig configures a period during which publishing is blocked.
+// Either Days (a weekly window) or From/To (a date range) must be set.
+type FreezeWindowConfig struct {
+	// Name identifies the window in messages (e.g., "friday-afternoon").
+	Name string `mapstructure:"name" json:"name,omitempty"`
+	// Days lists the weekdays a weekly window recurs on (e.g., ["friday"]).
+	Days []string `mapstructure:"days" json:"days,omitempty"`
+	// StartTime is the time of day the weekly window starts (default: "00:00").
+	StartTime string `mapstructure:"start_time" json:"start_time,omitempty"`
+	// EndTime is the time of day the weekly window ends (default: end of day).
+	// An end time before the start time spans midnight.
+	EndTime string `mapstructure:"end_time" json:"end_time,omitempty"`
+	// From is the start of a date range (YYYY-MM-DD or RFC 3339).
+	From string `mapstructure:"from" json:"from,omitempty"`
+	// To is the end of a date range (YYYY-MM-DD or RFC 3339). A date
+	// includes the whole day.
+	To string `mapstructure:"to" json:"to,omitempty"`
+	// Timezone is the IANA timezone of the window (default: local time).
+	Timezone string `mapstructure:"timezone" json:"timezone,omitempty"`
+}
+
+// Window converts the configuration to a release freeze window.
+func (c *FreezeWindowConfig) Window() (release.FreezeWindow, error) {
+	window := release.FreezeWindow{Name: c.Name, Location: time.Local}
+
+	if c.Timezone != "" {
+		loc, err := time.LoadLocation(c.Timezone)
+		if err != nil {
+			return release.FreezeWindow{}, fmt.Errorf("timezone: unknown timezone %q", c.Timezone)
+		}
+		window.Location = loc
+	}
+
+	if len(c.Days) > 0 {
+		for _, name := range c.Days {
+			day, ok := parseWeekday(name)
+			if !ok {
+				return release.FreezeWindow{}, fmt.Errorf("days: unknown weekday %q", name)
+			}
+			window.Days = append(window.Days, day)
+		}
+
+		var err error
+		if window.StartTime, err = parseClock(c.StartTime); err != nil {
+			return release.FreezeWindow{}, fmt.Errorf("start_time: %w", err)
+		}
+		if window.EndTime, err = parseClock(c.EndTime); err != nil {
+			return release.FreezeWindow{}, fmt.Errorf("end_time: %w", err)
+		}
+		return window, nil
+	}
+
+	if c.From == "" || c.To == "" {
+		return release.FreezeWindow{}, fmt.Errorf("days: required unless from and to are set")
+	}
+
+	start, _, err := parseFreezeDate(c.From, window.Location)
+	if err != nil {
+		return release.FreezeWindow{}, fmt.Errorf("from: %w", err)
+	}
+	end, dateOnly, err := parseFreezeDate(c.To, window.Location)
+	if err != nil {
+		return release.FreezeWindow{}, fmt.Errorf("to: %w", err)
+	}
+	if dateOnly {
+		end = end.AddDate(0, 0, 1)
+	}
+	if !end.After(start) {
+		return release.FreezeWindow{}, fmt.Errorf("to: must be after from")
+	}
+
+	window.Start, window.End = start, end
+	return window, nil
+}
+
+// parseWeekday parses a full or abbreviated weekday name.
+func parseWeekday(name string) (time.Weekday, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		full := strings.ToLower(day.String())
+		if name == full || (len(name) >= 3 && strings.HasPrefix(full, name)) {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// parseClock parses a "HH:MM" time of day into an offset from midnight.
+// An empty string is treated as midnight.
+func parseClock(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if s == "24:00" {
+		return 24 * time.Hour, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// parseFreezeDate parses a date (YYYY-MM-DD) or RFC 3339 timestamp. The
+// second result is true if only a date was given.
+func parseFreezeDate(s string, loc *time.Location) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation("2006-01-02", s, loc); err == nil {
+		return t, true, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid date %q, expected YYYY-MM-DD or RFC 3339", s)
+	}
+	return t, false, nil
+}
+
+// PackagesConfig configures monorepo mode, where each package is versioned,
+// tagged and released independently.
+type PackagesConfig struct {
+	// Discover lists glob patterns for package directories (e.g., "packages/*").
+	Discover []string `mapstructure:"discover" json:"discover,omitempty"`
+	// Exclude lists glob patterns for paths to skip during discovery.
+	Exclude []string `mapstructure:"exclude" json:"exclude,omitempty"`
+	// TagPrefix is the tag prefix template for packages (default: "{name}/v").
+	// The "{name}" placeholder is replaced with the package name.
+	TagPrefix string `mapstructure:"tag_prefix" json:"tag_prefix,omitempty"`
+	// Include declares packages explicitly, in addition to discovered ones.
+	Include []PackageConfig `mapstructure:"include" json:"include,omitempty"`
+	// Cascade bumps packages that depend on a released package (default: true).
+	// Dependents get at least a patch release and their manifests are updated
+	// to reference the new version.
+	Cascade bool `mapstructure:"cascade" json:"cascade"`
+}
+
+// PackageConfig declares a single monorepo package.
+type PackageConfig struct {
+	// Name is the package name used in tags and output.
+	Name string `mapstructure:"name" json:"name"`
+	// Path is the package directory relative to the repository root.
+	Path string `mapstructure:"path" json:"path"`
+	// TagPrefix overrides the tag prefix for this package.
+	TagPrefix string `mapstructure:"tag_prefix" json:"tag_prefix,omitempty"`
+}
+
+// IsEnabled returns whether monorepo mode is configured.
+func (p *PackagesConfig) IsEnabled() bool {
+	return len(p.Discover) > 0 || len(p.Include) > 0
+}
+
+// TagPrefixFor returns the tag prefix for the named package.
+func (p *PackagesConfig) TagPrefixFor(name string) string {
+	for _, pkg := range p.Include {
+		if pkg.Name == name && pkg.TagPrefix != "" {
+			return pkg.TagPrefix
+		}
+	}
+	prefix := p.TagPrefix
+	if prefix == "" {
+		prefix = DefaultPackageTagPrefix
+	}
+	return strings.ReplaceAll(prefix, "{name}", name)
+}
+
+// WebhookConfig configures an HTTP endpoint that receives domain events
+// (e.g., release.published) as JSON POST requests.
+type WebhookConfig struct {
+	// URL is the endpoint URL.
+	URL string `mapstructure:"url" json:"url"`
+	// Secret signs each payload with HMAC-SHA256 (X-ReleasePilot-Signature header).
+	Secret string `mapstructure:"secret" json:"secret,omitempty"`
+	// Events limits delivery to these event names (e.g., "release.published"
+	// or "published"). Empty delivers all events.
+	Events []string `mapstructure:"events" json:"events,omitempty"`
+	// Timeout is the timeout per delivery attempt (default: 10s).
+	Timeout time.Duration `mapstructure:"timeout" json:"timeout,omitempty"`
+	// MaxRetries is the number of retries after a failed delivery (default: 3).
+	MaxRetries *int `mapstructure:"max_retries" json:"max_retries,omitempty"`
+}
+
+// DefaultWebhookTimeout is the default timeout per webhook delivery attempt.
+const DefaultWebhookTimeout = 10 * time.Second
+
+// DefaultWebhookMaxRetries is the default number of webhook delivery retries.
+const DefaultWebhookMaxRetries = 3
+
+// RetryCount returns the number of retries after a failed delivery.
+func (w *WebhookConfig) RetryCount() int {
+	if w.MaxRetries == nil {
+		return DefaultWebhookMaxRetries
+	}
+	return *w.MaxRetries
+}
+
+// DefaultPackageTagPrefix is the default tag prefix template for monorepo packages.
+const DefaultPackageTagPrefix = "{name}/v"
+
 // OutputConfig configures output settings.
 type OutputConfig struct {
 	// Format is the output format (text, json, yaml).
@@ -279,6 +646,9 @@ func DefaultConfig() *Config {
 			GitPush:   true,
 			GitSign:   false,
 			BumpFrom:  "tag",
+			CalVer: CalVerConfig{
+				Format: "YYYY.MM.MICRO",
+			},
 		},
 		Git: GitConfig{
 			DefaultRemote:  "origin",
@@ -305,6 +675,9 @@ func DefaultConfig() *Config {
 				"revert":   "Reverts",
 				"build":    "Build System",
 			},
+			MigrationGuide: MigrationGuideConfig{
+				File: "MIGRATION.md",
+			},
 		},
 		AI: AIConfig{
 			Enabled:       false,
@@ -316,6 +689,13 @@ func DefaultConfig() *Config {
 			Temperature:   0.7,
 			Timeout:       30 * time.Second,
 			RetryAttempts: 3,
+			Cache: AICacheConfig{
+				Enabled: true,
+				Dir:     ".release-pilot/cache/ai",
+			},
+			Prompts: AIPromptsConfig{
+				Dir: ".release-pilot/prompts",
+			},
 		},
 		Plugins: []PluginConfig{
 			{
@@ -335,6 +715,10 @@ func DefaultConfig() *Config {
 			AutoCommitChangelog:     true,
 			ChangelogCommitMessage:  "chore(release): update changelog for ${version}",
 		},
+		Packages: PackagesConfig{
+			TagPrefix: DefaultPackageTagPrefix,
+			Cascade:   true,
+		},
 		Output: OutputConfig{
 			Format:   "text",
 			Color:    true,
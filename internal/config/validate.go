@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
 	"slices"
 	"strings"
 
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
 	rperrors "github.com/felixgeelhaar/release-pilot/internal/errors"
 )
 
@@ -52,6 +55,8 @@ func (v *Validator) Validate(cfg *Config) error {
 	v.validatePlugins(cfg.Plugins)
 	v.validateWorkflow(cfg.Workflow)
 	v.validateOutput(cfg.Output)
+	v.validatePackages(cfg.Packages)
+	v.validateWebhooks(cfg.Webhooks)
 
 	if v.errors.HasErrors() {
 		return rperrors.Validation("config.Validate", v.errors.Error())
@@ -63,7 +68,7 @@ func (v *Validator) Validate(cfg *Config) error {
 // validateVersioning validates versioning configuration.
 func (v *Validator) validateVersioning(cfg VersioningConfig) {
 	// Validate strategy
-	validStrategies := []string{"conventional", "manual"}
+	validStrategies := []string{"conventional", "manual", "calver"}
 	if !slices.Contains(validStrategies, cfg.Strategy) {
 		v.errors.Addf("versioning.strategy: must be one of %v, got %q", validStrategies, cfg.Strategy)
 	}
@@ -79,6 +84,22 @@ func (v *Validator) validateVersioning(cfg VersioningConfig) {
 		v.errors.Addf("versioning.version_file: required when bump_from is 'file'")
 	}
 
+	// Validate calver format
+	if cfg.Strategy == "calver" {
+		if err := version.ValidateCalVerFormat(cfg.CalVer.Format); err != nil {
+			v.errors.Addf("versioning.calver.format: %v", err)
+		}
+		if _, err := version.Parse("0.0.0-" + cfg.CalVer.Modifier); cfg.CalVer.Modifier != "" && err != nil {
+			v.errors.Addf("versioning.calver.modifier: invalid modifier %q", cfg.CalVer.Modifier)
+		}
+	}
+
+	// Validate commit classification
+	validClassify := []string{"", "off", "heuristic", "ai"}
+	if !slices.Contains(validClassify, cfg.Classify) {
+		v.errors.Addf("versioning.classify: must be one of %v, got %q", validClassify[1:], cfg.Classify)
+	}
+
 	// Note: Empty tag_prefix is valid (some repos use tags without prefix)
 }
 
@@ -123,6 +144,15 @@ func (v *Validator) validateChangelog(cfg ChangelogConfig) {
 
 	// Validate changelog file path
 	// Note: If changelog directory doesn't exist, it will be created when needed
+
+	if cfg.MigrationGuide.Enabled && strings.TrimSpace(cfg.MigrationGuide.File) == "" {
+		v.errors.Addf("changelog.migration_guide.file: required when the migration guide is enabled")
+	}
+	for _, pattern := range cfg.MigrationGuide.APIPaths {
+		if _, err := path.Match(strings.TrimSuffix(pattern, "/**"), ""); err != nil {
+			v.errors.Addf("changelog.migration_guide.api_paths: invalid glob pattern %q", pattern)
+		}
+	}
 }
 
 // validateAI validates AI configuration.
@@ -131,22 +161,26 @@ func (v *Validator) validateAI(cfg AIConfig) {
 		return // Skip validation if AI is disabled
 	}
 
-	// Validate provider
-	validProviders := []string{"openai"}
-	if !slices.Contains(validProviders, cfg.Provider) {
-		v.errors.Addf("ai.provider: must be one of %v, got %q", validProviders, cfg.Provider)
-	}
+	if len(cfg.Providers) > 0 {
+		v.validateAIProviders(cfg.Providers)
+	} else {
+		// Validate provider
+		validProviders := []string{"openai"}
+		if !slices.Contains(validProviders, cfg.Provider) {
+			v.errors.Addf("ai.provider: must be one of %v, got %q", validProviders, cfg.Provider)
+		}
 
-	// Validate model
-	if cfg.Model == "" {
-		v.errors.Addf("ai.model: required when AI is enabled")
-	}
+		// Validate model
+		if cfg.Model == "" {
+			v.errors.Addf("ai.model: required when AI is enabled")
+		}
 
-	// Validate API key is provided (after env expansion)
-	if cfg.APIKey == "" {
-		// Check if it's provided via environment variable
-		if os.Getenv("OPENAI_API_KEY") == "" && os.Getenv("RELEASE_PILOT_AI_API_KEY") == "" {
-			v.errors.Addf("ai.api_key: required when AI is enabled (set via config or OPENAI_API_KEY env var)")
+		// Validate API key is provided (after env expansion)
+		if cfg.APIKey == "" {
+			// Check if it's provided via environment variable
+			if os.Getenv("OPENAI_API_KEY") == "" && os.Getenv("RELEASE_PILOT_AI_API_KEY") == "" {
+				v.errors.Addf("ai.api_key: required when AI is enabled (set via config or OPENAI_API_KEY env var)")
+			}
 		}
 	}
 
@@ -172,6 +206,13 @@ func (v *Validator) validateAI(cfg AIConfig) {
 		v.errors.Addf("ai.max_tokens: must be between 1 and 128000, got %d", cfg.MaxTokens)
 	}
 
+	// Validate context_window
+	if cfg.ContextWindow < 0 {
+		v.errors.Addf("ai.context_window: must be non-negative, got %d", cfg.ContextWindow)
+	} else if cfg.ContextWindow > 0 && cfg.ContextWindow <= cfg.MaxTokens {
+		v.errors.Addf("ai.context_window: must be larger than ai.max_tokens (%d), got %d", cfg.MaxTokens, cfg.ContextWindow)
+	}
+
 	// Validate timeout
 	if cfg.Timeout <= 0 {
 		v.errors.Addf("ai.timeout: must be positive")
@@ -188,6 +229,30 @@ func (v *Validator) validateAI(cfg AIConfig) {
 			v.errors.Addf("ai.base_url: invalid URL: %s", cfg.BaseURL)
 		}
 	}
+
+	// Validate prompts: a profile is a subdirectory of the prompt directory
+	if p := cfg.Prompts.Profile; p != "" && (p == "." || p == ".." || strings.ContainsAny(p, `/\`)) {
+		v.errors.Addf("ai.prompts.profile: must be a directory name, got %q", p)
+	}
+}
+
+// validateAIProviders validates the AI provider fallback chain. API keys are
+// not required: providers without one are skipped at runtime.
+func (v *Validator) validateAIProviders(providers []AIProviderConfig) {
+	validProviders := []string{"openai", "anthropic", "claude", "ollama", "template"}
+	for i, p := range providers {
+		if !slices.Contains(validProviders, p.Provider) {
+			v.errors.Addf("ai.providers[%d].provider: must be one of %v, got %q", i, validProviders, p.Provider)
+		}
+		if p.Provider == "template" && i != len(providers)-1 {
+			v.errors.Addf("ai.providers[%d].provider: template must be the last provider", i)
+		}
+		if p.BaseURL != "" {
+			if _, err := url.Parse(p.BaseURL); err != nil {
+				v.errors.Addf("ai.providers[%d].base_url: invalid URL: %s", i, p.BaseURL)
+			}
+		}
+	}
 }
 
 // validatePlugins validates plugin configurations.
@@ -235,9 +300,116 @@ func (v *Validator) validatePlugins(plugins []PluginConfig) {
 			}
 		}
 
+		// Validate secrets; they name environment variables
+		for _, secret := range plugin.Secrets {
+			if !isEnvVarName(secret) {
+				v.errors.Addf("plugins[%d].secrets: invalid environment variable name %q", i, secret)
+			}
+		}
+
 		// Plugin-specific validation
 		v.validatePluginConfig(i, plugin)
 	}
+
+	v.validatePluginDependencies(plugins)
+}
+
+// isEnvVarName reports whether name is a valid environment variable name:
+// letters, digits and underscores, not starting with a digit.
+func isEnvVarName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// validatePluginDependencies validates the depends_on and after references
+// of plugins and rejects dependency cycles.
+func (v *Validator) validatePluginDependencies(plugins []PluginConfig) {
+	names := make(map[string]bool, len(plugins))
+	for _, plugin := range plugins {
+		names[plugin.Name] = true
+	}
+
+	for i, plugin := range plugins {
+		for _, ref := range []struct {
+			field string
+			deps  []string
+		}{
+			{"depends_on", plugin.DependsOn},
+			{"after", plugin.After},
+		} {
+			for _, dep := range ref.deps {
+				switch {
+				case dep == plugin.Name:
+					v.errors.Addf("plugins[%d].%s: plugin %q cannot depend on itself", i, ref.field, dep)
+				case !names[dep]:
+					v.errors.Addf("plugins[%d].%s: unknown plugin %q", i, ref.field, dep)
+				}
+			}
+		}
+	}
+
+	if cycle := findPluginCycle(plugins); cycle != nil {
+		v.errors.Addf("plugins: dependency cycle %s", strings.Join(cycle, " -> "))
+	}
+}
+
+// findPluginCycle returns the plugin names of a cycle in the depends_on and
+// after edges of plugins, starting and ending with the same name, or nil.
+func findPluginCycle(plugins []PluginConfig) []string {
+	edges := make(map[string][]string, len(plugins))
+	for _, plugin := range plugins {
+		edges[plugin.Name] = append(slices.Clone(plugin.DependsOn), plugin.After...)
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(plugins))
+	var path []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		switch state[name] {
+		case visiting:
+			start := slices.Index(path, name)
+			return append(slices.Clone(path[start:]), name)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range edges[name] {
+			// Self-references are reported on their own
+			if dep == name {
+				continue
+			}
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for _, plugin := range plugins {
+		if cycle := visit(plugin.Name); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
 }
 
 // validatePluginConfig validates plugin-specific configuration.
@@ -321,6 +493,47 @@ func (v *Validator) validateWorkflow(cfg WorkflowConfig) {
 	if cfg.AutoCommitChangelog && cfg.ChangelogCommitMessage == "" {
 		v.errors.Addf("workflow.changelog_commit_message: required when auto_commit_changelog is enabled")
 	}
+
+	if cfg.ApprovalPolicy != nil {
+		v.validateApprovalPolicy(*cfg.ApprovalPolicy)
+	}
+
+	for i, window := range cfg.FreezeWindows {
+		if _, err := window.Window(); err != nil {
+			v.errors.Addf("workflow.freeze_windows[%d].%s", i, err)
+		}
+	}
+}
+
+// validateApprovalPolicy validates the approval policy configuration.
+func (v *Validator) validateApprovalPolicy(cfg ApprovalPolicyConfig) {
+	if cfg.RequiredApprovals < 0 {
+		v.errors.Addf("workflow.approval_policy.required_approvals: must not be negative")
+	}
+	for i, domain := range cfg.IdentityDomains {
+		if domain == "" || strings.Contains(domain, "@") {
+			v.errors.Addf("workflow.approval_policy.identity_domains[%d]: %q is not a domain", i, domain)
+		}
+	}
+
+	seen := make(map[string]bool, len(cfg.Groups))
+	for i, group := range cfg.Groups {
+		if group.Name == "" {
+			v.errors.Addf("workflow.approval_policy.groups[%d].name: required", i)
+		} else if seen[group.Name] {
+			v.errors.Addf("workflow.approval_policy.groups[%d].name: duplicate group %q", i, group.Name)
+		}
+		seen[group.Name] = true
+
+		if len(group.Members) == 0 {
+			v.errors.Addf("workflow.approval_policy.groups[%d].members: at least one member is required", i)
+		}
+		if group.MinApprovals < 0 {
+			v.errors.Addf("workflow.approval_policy.groups[%d].min_approvals: must not be negative", i)
+		} else if len(group.Members) > 0 && group.MinApprovals > len(group.Members) {
+			v.errors.Addf("workflow.approval_policy.groups[%d].min_approvals: %d exceeds the %d members", i, group.MinApprovals, len(group.Members))
+		}
+	}
 }
 
 // validateOutput validates output configuration.
@@ -353,6 +566,72 @@ func (v *Validator) validateOutput(cfg OutputConfig) {
 	}
 }
 
+// packageNamePattern restricts package names to characters that are safe in
+// tag names and release file names.
+var packageNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// validatePackages validates monorepo package configuration.
+func (v *Validator) validatePackages(cfg PackagesConfig) {
+	for _, pattern := range append(slices.Clone(cfg.Discover), cfg.Exclude...) {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			v.errors.Addf("packages: invalid glob pattern %q", pattern)
+		}
+	}
+
+	if strings.ContainsAny(cfg.TagPrefix, "~^:?*[\\ ") {
+		v.errors.Addf("packages.tag_prefix: contains invalid characters: %q", cfg.TagPrefix)
+	}
+
+	seenNames := make(map[string]bool)
+	for i, pkg := range cfg.Include {
+		if pkg.Name == "" {
+			v.errors.Addf("packages.include[%d].name: required", i)
+		} else if !packageNamePattern.MatchString(pkg.Name) {
+			v.errors.Addf("packages.include[%d].name: invalid package name %q", i, pkg.Name)
+		}
+
+		if seenNames[pkg.Name] {
+			v.errors.Addf("packages.include[%d].name: duplicate package name %q", i, pkg.Name)
+		}
+		seenNames[pkg.Name] = true
+
+		if pkg.Path == "" {
+			v.errors.Addf("packages.include[%d].path: required", i)
+		} else if filepath.IsAbs(pkg.Path) || strings.Contains(filepath.Clean(pkg.Path), "..") {
+			v.errors.Addf("packages.include[%d].path: must be relative to the repository root: %s", i, pkg.Path)
+		}
+
+		if strings.ContainsAny(pkg.TagPrefix, "~^:?*[\\ ") {
+			v.errors.Addf("packages.include[%d].tag_prefix: contains invalid characters: %q", i, pkg.TagPrefix)
+		}
+	}
+}
+
+// validateWebhooks validates webhook endpoint configuration.
+func (v *Validator) validateWebhooks(webhooks []WebhookConfig) {
+	for i, hook := range webhooks {
+		if hook.URL == "" {
+			v.errors.Addf("webhooks[%d].url: required", i)
+		} else if u, err := url.Parse(hook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			v.errors.Addf("webhooks[%d].url: must be an http or https URL: %s", i, hook.URL)
+		}
+
+		if hook.Timeout < 0 {
+			v.errors.Addf("webhooks[%d].timeout: must not be negative", i)
+		}
+		if hook.MaxRetries != nil && *hook.MaxRetries < 0 {
+			v.errors.Addf("webhooks[%d].max_retries: must not be negative", i)
+		}
+
+		for _, event := range hook.Events {
+			if strings.TrimSpace(event) == "" {
+				v.errors.Addf("webhooks[%d].events: event names must not be empty", i)
+				break
+			}
+		}
+	}
+}
+
 // Validate is a convenience function to validate configuration.
 func Validate(cfg *Config) error {
 	return NewValidator().Validate(cfg)
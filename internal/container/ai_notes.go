@@ -0,0 +1,233 @@
+// Package container provides dependency injection for ReleasePilot services.
+package container
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/felixgeelhaar/release-pilot/internal/application/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/communication"
+	domainrelease "github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+	"github.com/felixgeelhaar/release-pilot/internal/service/ai"
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+	"github.com/felixgeelhaar/release-pilot/internal/service/template"
+)
+
+// aiNotesGenerator adapts the AI service to the application layer's
+// AINotesGenerator, recording which provider of the fallback chain
+// produced the notes.
+type aiNotesGenerator struct {
+	service      ai.Service
+	includeEmoji bool
+	// structured requests schema-validated JSON notes instead of an
+	// AI-written summary on top of the template sections.
+	structured bool
+	// prompts replaces the built-in prompts, if a prompt library is set up.
+	prompts *promptLibrary
+}
+
+// GenerateReleaseNotes generates release notes with an AI-written summary,
+// or entirely from structured AI output in structured mode.
+func (g *aiNotesGenerator) GenerateReleaseNotes(ctx context.Context, input release.AIGenerateInput) (*communication.ReleaseNotes, error) {
+	plan := input.ReleaseContext.Plan()
+	if plan == nil {
+		return nil, domainrelease.ErrNilPlan
+	}
+	changeSet := plan.GetChangeSet()
+
+	label := input.VersionLabel
+	if label == "" {
+		label = plan.NextVersion.String()
+	}
+
+	ctx, err := g.prompts.context(ctx, releasePromptData(input.ReleaseContext, label))
+	if err != nil {
+		return nil, err
+	}
+
+	opts := ai.DefaultGenerateOptions()
+	opts.Tone = aiTone(input.Tone)
+	opts.Audience = aiAudience(input.Audience)
+	opts.IncludeEmoji = g.includeEmoji
+
+	ctx, trace := ai.WithFallbackTrace(ctx)
+	var (
+		summary    string
+		structured *ai.StructuredNotes
+	)
+	if g.structured {
+		opts.Context = "The release version is " + label + "."
+		structured, err = g.service.GenerateStructuredNotes(ctx, categorizeChangeSet(changeSet), opts)
+	} else {
+		summary, err = g.service.SummarizeChanges(ctx, categorizeChangeSet(changeSet), opts)
+	}
+
+	var fallbacks []communication.ProviderFallback
+	for _, attempt := range trace.Fallbacks() {
+		fallbacks = append(fallbacks, communication.ProviderFallback{
+			Provider: attempt.Provider,
+			Reason:   attempt.Err.Error(),
+		})
+	}
+	if err != nil {
+		return nil, &release.AIGenerationError{Fallbacks: fallbacks, Err: err}
+	}
+
+	if structured != nil {
+		return buildStructuredNotes(plan.NextVersion, structured).
+			WithTone(input.Tone).
+			WithAudience(input.Audience).
+			AIGenerated().
+			WithProvider(trace.Provider()).
+			WithFallbacks(fallbacks).
+			Build(), nil
+	}
+
+	return communication.CreateFromChangeSet(plan.NextVersion, changeSet,
+		communication.WithVersionLabel(label),
+		func(b *communication.ReleaseNotesBuilder) {
+			b.WithSummary(summary).
+				WithTone(input.Tone).
+				WithAudience(input.Audience).
+				AIGenerated().
+				WithProvider(trace.Provider()).
+				WithFallbacks(fallbacks)
+		}), nil
+}
+
+// aiNotesTranslator adapts the AI service to the application layer's
+// NotesTranslator.
+type aiNotesTranslator struct {
+	service ai.Service
+	prompts *promptLibrary
+}
+
+// Translate translates text between locales, e.g. from "en" to "de".
+func (t *aiNotesTranslator) Translate(ctx context.Context, text, sourceLocale, targetLocale string) (string, error) {
+	opts := ai.DefaultGenerateOptions()
+	opts.Language = ai.LanguageName(targetLocale)
+	opts.Context = "The text is written in " + ai.LanguageName(sourceLocale) + "."
+
+	ctx, err := t.prompts.context(ctx, template.PromptData{})
+	if err != nil {
+		return "", err
+	}
+	return t.service.Translate(ctx, text, opts)
+}
+
+// aiCommitClassifier adapts the AI service to the application layer's
+// CommitClassifier. When the provider fails, the commit is classified by
+// the fallback classifier instead, so a flaky provider does not block
+// planning.
+type aiCommitClassifier struct {
+	service  ai.Service
+	fallback release.CommitClassifier
+	prompts  *promptLibrary
+}
+
+// Classify infers the type, scope and breaking-ness of a commit.
+func (c *aiCommitClassifier) Classify(ctx context.Context, commit release.UnconventionalCommit) (*release.CommitClassification, error) {
+	ctx, err := c.prompts.context(ctx, template.PromptData{})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.service.ClassifyCommit(ctx, ai.UnclassifiedCommit{Message: commit.Message, Files: commit.Files}, ai.DefaultGenerateOptions())
+	if err != nil {
+		if c.fallback == nil {
+			return nil, err
+		}
+		slog.Default().Warn("AI commit classification failed, using the heuristic",
+			"commit", commit.Hash,
+			"error", err)
+		return c.fallback.Classify(ctx, commit)
+	}
+
+	return &release.CommitClassification{
+		Type:     result.Type,
+		Scope:    result.Scope,
+		Breaking: result.Breaking,
+		Source:   release.ClassificationSourceAI,
+	}, nil
+}
+
+// buildStructuredNotes maps validated structured AI output to release notes.
+func buildStructuredNotes(ver version.SemanticVersion, notes *ai.StructuredNotes) *communication.ReleaseNotesBuilder {
+	builder := communication.NewReleaseNotesBuilder(ver).
+		WithTitle(notes.Title).
+		WithSummary(notes.Summary).
+		WithHighlights(notes.Highlights)
+
+	for i, s := range notes.Sections {
+		builder.AddSection(communication.NotesSection{
+			Title:    s.Title,
+			Items:    s.Items,
+			Priority: i + 1,
+		})
+	}
+
+	var migration []communication.MigrationNote
+	for _, m := range notes.BreakingChanges {
+		migration = append(migration, communication.MigrationNote{Change: m.Change, Migration: m.Migration})
+	}
+	return builder.WithMigrationNotes(migration)
+}
+
+// categorizeChangeSet converts a domain changeset to the categorized
+// changes the AI prompts are built from.
+func categorizeChangeSet(cs *changes.ChangeSet) *git.CategorizedChanges {
+	if cs == nil {
+		return &git.CategorizedChanges{}
+	}
+
+	commits := make([]git.ConventionalCommit, 0, len(cs.Commits()))
+	for _, c := range cs.Commits() {
+		commits = append(commits, git.ConventionalCommit{
+			Commit: git.Commit{
+				Hash:    c.Hash(),
+				Subject: c.Subject(),
+				Body:    c.Body(),
+				Message: c.RawMessage(),
+				Author:  git.Author{Name: c.Author(), Email: c.AuthorEmail()},
+				Date:    c.Date(),
+			},
+			Type:                c.Type(),
+			Scope:               c.Scope(),
+			Description:         c.Subject(),
+			Body:                c.Body(),
+			Footer:              c.Footer(),
+			Breaking:            c.IsBreaking(),
+			BreakingDescription: c.BreakingMessage(),
+			IsConventional:      c.Type() != "",
+		})
+	}
+	return git.CategorizeCommits(commits)
+}
+
+// aiTone maps a release notes tone to the closest AI tone.
+func aiTone(tone communication.NoteTone) ai.Tone {
+	switch tone {
+	case communication.ToneTechnical:
+		return ai.ToneTechnical
+	case communication.ToneFriendly:
+		return ai.ToneFriendly
+	case communication.ToneMarketing:
+		return ai.ToneExcited
+	default:
+		return ai.ToneProfessional
+	}
+}
+
+// aiAudience maps a release notes audience to the closest AI audience.
+func aiAudience(audience communication.NoteAudience) ai.Audience {
+	switch audience {
+	case communication.AudienceUsers:
+		return ai.AudienceUsers
+	case communication.AudiencePublic, communication.AudienceStakeholders:
+		return ai.AudiencePublic
+	default:
+		return ai.AudienceDevelopers
+	}
+}
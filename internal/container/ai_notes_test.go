@@ -0,0 +1,226 @@
+// Package container provides dependency injection for ReleasePilot services.
+package container
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/application/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/communication"
+	domainrelease "github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+	"github.com/felixgeelhaar/release-pilot/internal/service/ai"
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+)
+
+// stubAIService returns a fixed summary, structured notes, translation,
+// classification, migration guide or error.
+type stubAIService struct {
+	summary     string
+	structured  *ai.StructuredNotes
+	translation string
+	classified  *ai.CommitClassification
+	migration   []ai.MigrationGuideEntry
+	err         error
+
+	translateOpts ai.GenerateOptions
+}
+
+func (s *stubAIService) GenerateChangelog(ctx context.Context, changes *git.CategorizedChanges, opts ai.GenerateOptions) (string, error) {
+	return "", s.err
+}
+
+func (s *stubAIService) GenerateReleaseNotes(ctx context.Context, changelog string, opts ai.GenerateOptions) (string, error) {
+	return "", s.err
+}
+
+func (s *stubAIService) GenerateMarketingBlurb(ctx context.Context, releaseNotes string, opts ai.GenerateOptions) (string, error) {
+	return "", s.err
+}
+
+func (s *stubAIService) SummarizeChanges(ctx context.Context, changes *git.CategorizedChanges, opts ai.GenerateOptions) (string, error) {
+	return s.summary, s.err
+}
+
+func (s *stubAIService) GenerateStructuredNotes(ctx context.Context, changes *git.CategorizedChanges, opts ai.GenerateOptions) (*ai.StructuredNotes, error) {
+	return s.structured, s.err
+}
+
+func (s *stubAIService) Translate(ctx context.Context, text string, opts ai.GenerateOptions) (string, error) {
+	s.translateOpts = opts
+	return s.translation, s.err
+}
+
+func (s *stubAIService) ClassifyCommit(ctx context.Context, commit ai.UnclassifiedCommit, opts ai.GenerateOptions) (*ai.CommitClassification, error) {
+	return s.classified, s.err
+}
+
+func (s *stubAIService) GenerateMigrationGuide(ctx context.Context, changes []ai.BreakingChange, opts ai.GenerateOptions) ([]ai.MigrationGuideEntry, error) {
+	return s.migration, s.err
+}
+
+func (s *stubAIService) IsAvailable() bool {
+	return true
+}
+
+func newPlannedRelease() *domainrelease.Release {
+	rel := domainrelease.NewRelease("rel-1", "main", "/repo")
+	changeSet := changes.NewChangeSet("cs-1", "v1.0.0", "HEAD")
+	changeSet.AddCommit(changes.NewConventionalCommit("abc123", changes.CommitTypeFeat, "add search"))
+	_ = rel.SetPlan(domainrelease.NewReleasePlan(
+		version.MustParse("1.0.0"),
+		version.MustParse("1.1.0"),
+		changes.ReleaseTypeMinor,
+		changeSet,
+		false,
+	))
+	return rel
+}
+
+func TestAINotesGenerator_RecordsProvider(t *testing.T) {
+	generator := &aiNotesGenerator{
+		service: ai.NewFallbackService(
+			ai.Provider{Name: "anthropic", Service: &stubAIService{err: stderrors.New("circuit breaker is open")}},
+			ai.Provider{Name: "openai", Service: &stubAIService{summary: "Search is here."}},
+		),
+	}
+
+	notes, err := generator.GenerateReleaseNotes(context.Background(), release.AIGenerateInput{
+		ReleaseContext: newPlannedRelease(),
+		Tone:           communication.ToneFriendly,
+		VersionLabel:   "1.1.0",
+	})
+	if err != nil {
+		t.Fatalf("GenerateReleaseNotes() error = %v", err)
+	}
+
+	if notes.Summary() != "Search is here." || !notes.IsAIGenerated() {
+		t.Errorf("notes = %q (AI: %v), want the AI summary", notes.Summary(), notes.IsAIGenerated())
+	}
+	if notes.Provider() != "openai" {
+		t.Errorf("Provider() = %q, want openai", notes.Provider())
+	}
+	if fallbacks := notes.Fallbacks(); len(fallbacks) != 1 || fallbacks[0].Provider != "anthropic" || fallbacks[0].Reason != "circuit breaker is open" {
+		t.Errorf("Fallbacks() = %+v, want the anthropic failure", fallbacks)
+	}
+	if notes.Title() != "Release 1.1.0" {
+		t.Errorf("Title() = %q, want %q", notes.Title(), "Release 1.1.0")
+	}
+}
+
+func TestAINotesGenerator_AllProvidersFail(t *testing.T) {
+	generator := &aiNotesGenerator{
+		service: ai.NewFallbackService(
+			ai.Provider{Name: "ollama", Service: &stubAIService{err: stderrors.New("connection refused")}},
+		),
+	}
+
+	_, err := generator.GenerateReleaseNotes(context.Background(), release.AIGenerateInput{ReleaseContext: newPlannedRelease()})
+
+	var genErr *release.AIGenerationError
+	if !stderrors.As(err, &genErr) {
+		t.Fatalf("error = %v, want *AIGenerationError", err)
+	}
+	if len(genErr.Fallbacks) != 1 || genErr.Fallbacks[0].Provider != "ollama" {
+		t.Errorf("Fallbacks = %+v, want the ollama failure", genErr.Fallbacks)
+	}
+}
+
+func TestAINotesGenerator_Structured(t *testing.T) {
+	generator := &aiNotesGenerator{
+		structured: true,
+		service: ai.NewFallbackService(ai.Provider{Name: "anthropic", Service: &stubAIService{structured: &ai.StructuredNotes{
+			Title:      "Search, finally",
+			Summary:    "Search is here.",
+			Highlights: []string{"Full-text search"},
+			Sections:   []ai.StructuredSection{{Title: "Features", Items: []string{"Add search"}}},
+			BreakingChanges: []ai.MigrationNote{
+				{Change: "The /find endpoint is gone", Migration: "Call /search instead."},
+			},
+		}}}),
+	}
+
+	notes, err := generator.GenerateReleaseNotes(context.Background(), release.AIGenerateInput{
+		ReleaseContext: newPlannedRelease(),
+		VersionLabel:   "1.1.0",
+	})
+	if err != nil {
+		t.Fatalf("GenerateReleaseNotes() error = %v", err)
+	}
+
+	if notes.Title() != "Search, finally" || notes.Summary() != "Search is here." || notes.Provider() != "anthropic" {
+		t.Errorf("notes = %q / %q from %q", notes.Title(), notes.Summary(), notes.Provider())
+	}
+	if sections := notes.Sections(); len(sections) != 1 || sections[0].Items[0] != "Add search" {
+		t.Errorf("Sections() = %+v, want the AI sections", sections)
+	}
+	if migration := notes.MigrationNotes(); len(migration) != 1 || migration[0].Migration != "Call /search instead." {
+		t.Errorf("MigrationNotes() = %+v", migration)
+	}
+}
+
+func TestAINotesTranslator_Translate(t *testing.T) {
+	svc := &stubAIService{translation: "Die Suche ist da."}
+	translator := &aiNotesTranslator{service: svc}
+
+	got, err := translator.Translate(context.Background(), "Search is here.", "en", "de")
+	if err != nil {
+		t.Fatalf("Translate() error = %v", err)
+	}
+	if got != "Die Suche ist da." {
+		t.Errorf("Translate() = %q", got)
+	}
+	if svc.translateOpts.Language != "German" {
+		t.Errorf("Language = %q, want German", svc.translateOpts.Language)
+	}
+	if svc.translateOpts.Context != "The text is written in English." {
+		t.Errorf("Context = %q", svc.translateOpts.Context)
+	}
+}
+
+func TestAICommitClassifier_Classify(t *testing.T) {
+	commit := release.UnconventionalCommit{Hash: "abc123", Message: "Fix crash on empty config"}
+
+	svc := &stubAIService{classified: &ai.CommitClassification{Type: changes.CommitTypeFeat, Scope: "config"}}
+	classifier := &aiCommitClassifier{service: svc, fallback: release.NewHeuristicCommitClassifier()}
+	got, err := classifier.Classify(context.Background(), commit)
+	if err != nil {
+		t.Fatalf("Classify() error = %v", err)
+	}
+	if got.Type != changes.CommitTypeFeat || got.Scope != "config" || got.Source != release.ClassificationSourceAI {
+		t.Errorf("Classify() = %+v, want the AI classification", got)
+	}
+
+	svc = &stubAIService{err: stderrors.New("rate limited")}
+	classifier = &aiCommitClassifier{service: svc, fallback: release.NewHeuristicCommitClassifier()}
+	got, err = classifier.Classify(context.Background(), commit)
+	if err != nil {
+		t.Fatalf("Classify() error = %v", err)
+	}
+	if got.Type != changes.CommitTypeFix || got.Source != release.ClassificationSourceHeuristic {
+		t.Errorf("Classify() = %+v, want the heuristic classification", got)
+	}
+
+	classifier = &aiCommitClassifier{service: svc}
+	if _, err := classifier.Classify(context.Background(), commit); err == nil {
+		t.Error("Classify() without fallback should return the provider error")
+	}
+}
+
+func TestCategorizeChangeSet(t *testing.T) {
+	changeSet := changes.NewChangeSet("cs-1", "v1.0.0", "HEAD")
+	changeSet.AddCommit(changes.NewConventionalCommit("abc123", changes.CommitTypeFeat, "new api",
+		changes.WithScope("api"),
+		changes.WithBreaking("old api removed")))
+	changeSet.AddCommit(changes.NewConventionalCommit("def456", changes.CommitTypeFix, "typo"))
+
+	categorized := categorizeChangeSet(changeSet)
+	if len(categorized.All) != 2 || len(categorized.Features) != 1 || len(categorized.Fixes) != 1 || len(categorized.Breaking) != 1 {
+		t.Fatalf("categorized = %+v", categorized)
+	}
+	if feat := categorized.Features[0]; feat.Scope != "api" || feat.Description != "new api" || feat.BreakingDescription != "old api removed" {
+		t.Errorf("feature = %+v", feat)
+	}
+}
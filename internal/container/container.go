@@ -3,6 +3,7 @@ package container
 
 import (
 	"context"
+	stderrors "errors"
 	"log/slog"
 	"os"
 	"sync"
@@ -11,6 +12,7 @@ import (
 	"github.com/felixgeelhaar/release-pilot/internal/application/release"
 	"github.com/felixgeelhaar/release-pilot/internal/application/versioning"
 	"github.com/felixgeelhaar/release-pilot/internal/config"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/communication"
 	"github.com/felixgeelhaar/release-pilot/internal/domain/integration"
 	domainrelease "github.com/felixgeelhaar/release-pilot/internal/domain/release"
 	"github.com/felixgeelhaar/release-pilot/internal/domain/sourcecontrol"
@@ -18,9 +20,11 @@ import (
 	"github.com/felixgeelhaar/release-pilot/internal/errors"
 	gitadapter "github.com/felixgeelhaar/release-pilot/internal/infrastructure/git"
 	"github.com/felixgeelhaar/release-pilot/internal/infrastructure/persistence"
+	"github.com/felixgeelhaar/release-pilot/internal/infrastructure/webhook"
 	"github.com/felixgeelhaar/release-pilot/internal/plugin"
 	"github.com/felixgeelhaar/release-pilot/internal/service/ai"
 	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+	"github.com/felixgeelhaar/release-pilot/internal/service/template"
 )
 
 // defaultShutdownTimeout is the default timeout for graceful shutdown of components.
@@ -41,7 +45,8 @@ type DDDContainer struct {
 	// Infrastructure layer
 	gitAdapter     *gitadapter.Adapter
 	releaseRepo    *persistence.FileReleaseRepository
-	eventPublisher *persistence.InMemoryEventPublisher
+	eventStore     *persistence.FileEventStore
+	eventPublisher domainrelease.EventPublisher
 	versionCalc    version.VersionCalculator
 	pluginRegistry integration.PluginRegistry
 	pluginExecutor integration.PluginExecutor
@@ -50,12 +55,21 @@ type DDDContainer struct {
 	// Services (existing infrastructure)
 	gitService git.Service
 	aiService  ai.Service
+	aiCache    *ai.Cache
+	aiPrompts  *promptLibrary
+	aiNotes    *aiNotesGenerator
 
 	// Application layer use cases
 	planReleaseUC      *release.PlanReleaseUseCase
+	planPackagesUC     *release.PlanPackagesUseCase
 	generateNotesUC    *release.GenerateNotesUseCase
+	translateNotesUC   *release.TranslateNotesUseCase
+	migrationGuideUC   *release.GenerateMigrationGuideUseCase
 	approveReleaseUC   *release.ApproveReleaseUseCase
 	publishReleaseUC   *release.PublishReleaseUseCase
+	rollbackReleaseUC  *release.RollbackReleaseUseCase
+	schedulePublishUC  *release.SchedulePublishUseCase
+	findDueReleasesUC  *release.FindDueReleasesUseCase
 	calculateVersionUC *versioning.CalculateVersionUseCase
 	setVersionUC       *versioning.SetVersionUseCase
 
@@ -133,11 +147,23 @@ func (c *DDDContainer) initInfrastructure(ctx context.Context) error {
 		return errors.StateWrap(err, "initInfrastructure", "failed to initialize release repository")
 	}
 
-	// Initialize event publisher
-	c.eventPublisher = persistence.NewInMemoryEventPublisher()
+	// Initialize event store, which keeps an append-only log per release
+	c.eventStore, err = persistence.NewFileEventStore(".release-pilot/events")
+	if err != nil {
+		return errors.StateWrap(err, "initInfrastructure", "failed to initialize event store")
+	}
 
-	// Initialize version calculator
-	c.versionCalc = version.NewDefaultVersionCalculator()
+	// Initialize event publisher, also sending events to configured webhooks
+	c.eventPublisher = c.eventStore
+	if len(c.config.Webhooks) > 0 {
+		c.eventPublisher = persistence.NewMultiEventPublisher(c.eventStore, c.initWebhookPublisher())
+	}
+
+	// Initialize version calculator from the configured versioning scheme
+	c.versionCalc, err = c.config.Versioning.Scheme()
+	if err != nil {
+		return errors.ConfigWrap(err, "initInfrastructure", "invalid versioning scheme")
+	}
 
 	// Initialize plugin system
 	if pluginErr := c.initPluginSystem(ctx); pluginErr != nil {
@@ -156,34 +182,104 @@ func (c *DDDContainer) initInfrastructure(ctx context.Context) error {
 		}
 	}
 
+	// Load the prompt library; an unknown profile is a configuration error
+	if c.aiService != nil {
+		c.aiPrompts, err = newPromptLibrary(c.config.AI.Prompts, c.config.Changelog.RepositoryURL)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// initAIService initializes the AI service based on configuration.
+// initWebhookPublisher creates the webhook publisher from configuration.
+func (c *DDDContainer) initWebhookPublisher() *webhook.Publisher {
+	endpoints := make([]webhook.Endpoint, 0, len(c.config.Webhooks))
+	for _, hook := range c.config.Webhooks {
+		endpoints = append(endpoints, webhook.Endpoint{
+			URL:        hook.URL,
+			Secret:     hook.Secret,
+			Events:     hook.Events,
+			Timeout:    hook.Timeout,
+			MaxRetries: hook.RetryCount(),
+		})
+	}
+	return webhook.NewPublisher(endpoints)
+}
+
+// initAIService initializes the AI service from the configured providers.
+// Calls fail over between providers in order; providers that cannot be
+// created (e.g., missing API key) are left out of the chain.
 func (c *DDDContainer) initAIService() (ai.Service, error) {
-	apiKey := c.config.AI.APIKey
-	if apiKey == "" {
-		apiKey = os.Getenv("OPENAI_API_KEY")
+	var providers []ai.Provider
+	for _, pc := range c.config.AI.AIProviders() {
+		// The template fallback is applied by notes generation itself
+		if pc.Provider == communication.ProviderTemplate {
+			break
+		}
+
+		svc, err := c.newAIProvider(pc)
+		if err != nil {
+			c.logger.Warn("AI provider unavailable, leaving it out of the fallback chain",
+				"provider", pc.Provider,
+				"error", err)
+			continue
+		}
+		providers = append(providers, ai.Provider{Name: pc.Provider, Service: svc})
+	}
+
+	if len(providers) == 0 {
+		return nil, errors.AI("initAIService", "no AI provider configured")
+	}
+	svc := ai.NewFallbackService(providers...)
+
+	// Serve repeated requests from the response cache
+	if cacheCfg := c.config.AI.Cache; cacheCfg.Enabled {
+		dir := cacheCfg.Dir
+		if dir == "" {
+			dir = ai.DefaultCacheDir
+		}
+		cache, err := ai.NewCache(dir)
+		if err != nil {
+			c.logger.Warn("AI response cache unavailable", "error", err)
+			return svc, nil
+		}
+		c.aiCache = cache
+		svc = ai.NewCachingService(svc, cache)
 	}
+	return svc, nil
+}
 
+// newAIProvider creates the AI service for one provider of the chain.
+func (c *DDDContainer) newAIProvider(pc config.AIProviderConfig) (ai.Service, error) {
+	apiKey := pc.APIKey
 	if apiKey == "" {
-		return nil, errors.AI("initAIService", "OpenAI API key not configured")
+		apiKey = os.Getenv(aiAPIKeyEnv(pc.Provider))
+	}
+
+	if apiKey == "" && pc.Provider != "ollama" {
+		return nil, errors.AI("initAIService", pc.Provider+" API key not configured")
 	}
 
 	opts := []ai.ServiceOption{
 		ai.WithAPIKey(apiKey),
-		ai.WithProvider(c.config.AI.Provider),
-		ai.WithModel(c.config.AI.Model),
+		ai.WithProvider(pc.Provider),
+		ai.WithModel(pc.Model),
 	}
 
-	if c.config.AI.BaseURL != "" {
-		opts = append(opts, ai.WithBaseURL(c.config.AI.BaseURL))
+	if pc.BaseURL != "" {
+		opts = append(opts, ai.WithBaseURL(pc.BaseURL))
 	}
 
 	if c.config.AI.MaxTokens > 0 {
 		opts = append(opts, ai.WithMaxTokens(c.config.AI.MaxTokens))
 	}
 
+	if c.config.AI.ContextWindow > 0 {
+		opts = append(opts, ai.WithContextWindow(c.config.AI.ContextWindow))
+	}
+
 	if c.config.AI.Temperature > 0 {
 		opts = append(opts, ai.WithTemperature(c.config.AI.Temperature))
 	}
@@ -192,9 +288,29 @@ func (c *DDDContainer) initAIService() (ai.Service, error) {
 		opts = append(opts, ai.WithTimeout(time.Duration(c.config.AI.Timeout)*time.Second))
 	}
 
+	custom := c.config.AI.CustomPrompts
+	opts = append(opts, ai.WithCustomPrompts(ai.CustomPrompts{
+		ChangelogSystem:    custom.ChangelogSystem,
+		ChangelogUser:      custom.ChangelogUser,
+		ReleaseNotesSystem: custom.ReleaseNotesSystem,
+		ReleaseNotesUser:   custom.ReleaseNotesUser,
+		MarketingSystem:    custom.MarketingSystem,
+		MarketingUser:      custom.MarketingUser,
+	}))
+
 	return ai.NewService(opts...)
 }
 
+// aiAPIKeyEnv returns the environment variable holding the provider's API key.
+func aiAPIKeyEnv(provider string) string {
+	switch provider {
+	case "anthropic", "claude":
+		return "ANTHROPIC_API_KEY"
+	default:
+		return "OPENAI_API_KEY"
+	}
+}
+
 // initPluginSystem initializes the plugin system.
 // If plugins are configured, it uses the plugin.Manager with ExecutorAdapter.
 // Otherwise, it uses an empty in-memory registry.
@@ -206,8 +322,16 @@ func (c *DDDContainer) initPluginSystem(ctx context.Context) error {
 		return nil
 	}
 
-	// Create plugin manager for external gRPC plugins
-	c.pluginManager = plugin.NewManager(c.config)
+	// Create plugin manager for external gRPC plugins; plugins can query
+	// git and render templates through the host while they execute
+	templates, err := template.NewService()
+	if err != nil {
+		return err
+	}
+	c.pluginManager = plugin.NewManager(c.config,
+		plugin.WithGitService(c.gitService),
+		plugin.WithTemplateService(templates),
+	)
 
 	// Load configured plugins
 	if err := c.pluginManager.LoadPlugins(ctx); err != nil {
@@ -229,18 +353,70 @@ func (c *DDDContainer) initPluginSystem(ctx context.Context) error {
 // initApplicationLayer initializes application layer use cases.
 func (c *DDDContainer) initApplicationLayer() error {
 	// Initialize PlanReleaseUseCase
+	var planOpts []release.PlanReleaseOption
+	if classifier := c.commitClassifier(); classifier != nil {
+		planOpts = append(planOpts, release.WithCommitClassifier(classifier, c.gitAdapter))
+	}
 	c.planReleaseUC = release.NewPlanReleaseUseCase(
 		c.releaseRepo,
 		c.gitAdapter,
 		c.versionCalc,
 		c.eventPublisher,
+		planOpts...,
+	)
+
+	// Initialize PlanPackagesUseCase (monorepo mode)
+	c.planPackagesUC = release.NewPlanPackagesUseCase(
+		c.releaseRepo,
+		c.gitAdapter,
+		c.gitAdapter,
+		c.versionCalc,
+		c.eventPublisher,
 	)
 
-	// Initialize GenerateNotesUseCase
-	// Note: AINotesGenerator is nil for now, can be set later
+	// Initialize GenerateNotesUseCase, with AI notes if a provider is available
+	var aiGenerator release.AINotesGenerator
+	if c.aiService != nil {
+		c.aiNotes = &aiNotesGenerator{
+			service:      c.aiService,
+			includeEmoji: c.config.AI.IncludeEmoji,
+			structured:   c.config.AI.Structured,
+			prompts:      c.aiPrompts,
+		}
+		aiGenerator = c.aiNotes
+	}
 	c.generateNotesUC = release.NewGenerateNotesUseCase(
 		c.releaseRepo,
-		nil, // AINotesGenerator - optional
+		aiGenerator,
+		c.eventPublisher,
+	)
+
+	// Initialize TranslateNotesUseCase, translating with the AI provider
+	var translator release.NotesTranslator
+	if c.aiService != nil {
+		translator = &aiNotesTranslator{service: c.aiService, prompts: c.aiPrompts}
+	}
+	c.translateNotesUC = release.NewTranslateNotesUseCase(
+		c.releaseRepo,
+		translator,
+		c.eventPublisher,
+	)
+
+	// Initialize GenerateMigrationGuideUseCase, rendering with the embedded
+	// templates and advised by the AI provider if one is available
+	templates, err := template.NewService()
+	if err != nil {
+		return err
+	}
+	var advisor release.MigrationAdvisor
+	if c.aiService != nil {
+		advisor = &aiMigrationAdvisor{service: c.aiService, prompts: c.aiPrompts}
+	}
+	c.migrationGuideUC = release.NewGenerateMigrationGuideUseCase(
+		c.releaseRepo,
+		c.gitAdapter,
+		advisor,
+		&templateMigrationRenderer{templates: templates},
 		c.eventPublisher,
 	)
 
@@ -258,6 +434,23 @@ func (c *DDDContainer) initApplicationLayer() error {
 		c.eventPublisher,
 	)
 
+	// Initialize RollbackReleaseUseCase
+	c.rollbackReleaseUC = release.NewRollbackReleaseUseCase(
+		c.releaseRepo,
+		c.gitAdapter,
+		c.pluginExecutor,
+		c.eventPublisher,
+	)
+
+	// Initialize SchedulePublishUseCase
+	c.schedulePublishUC = release.NewSchedulePublishUseCase(
+		c.releaseRepo,
+		c.eventPublisher,
+	)
+
+	// Initialize FindDueReleasesUseCase
+	c.findDueReleasesUC = release.NewFindDueReleasesUseCase(c.releaseRepo)
+
 	// Initialize CalculateVersionUseCase
 	c.calculateVersionUC = versioning.NewCalculateVersionUseCase(
 		c.gitAdapter,
@@ -270,6 +463,27 @@ func (c *DDDContainer) initApplicationLayer() error {
 	return nil
 }
 
+// commitClassifier returns the classifier for unconventional commits
+// selected by versioning.classify, or nil if classification is off.
+func (c *DDDContainer) commitClassifier() release.CommitClassifier {
+	switch c.config.Versioning.Classify {
+	case "heuristic":
+		return release.NewHeuristicCommitClassifier()
+	case "ai":
+		if c.aiService == nil {
+			c.logger.Warn("no AI provider is available, classifying commits with the heuristic")
+			return release.NewHeuristicCommitClassifier()
+		}
+		return &aiCommitClassifier{
+			service:  c.aiService,
+			fallback: release.NewHeuristicCommitClassifier(),
+			prompts:  c.aiPrompts,
+		}
+	default:
+		return nil
+	}
+}
+
 // Application layer accessors
 
 // PlanRelease returns the PlanReleaseUseCase.
@@ -279,6 +493,13 @@ func (c *DDDContainer) PlanRelease() *release.PlanReleaseUseCase {
 	return c.planReleaseUC
 }
 
+// PlanPackages returns the PlanPackagesUseCase.
+func (c *DDDContainer) PlanPackages() *release.PlanPackagesUseCase {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.planPackagesUC
+}
+
 // GenerateNotes returns the GenerateNotesUseCase.
 func (c *DDDContainer) GenerateNotes() *release.GenerateNotesUseCase {
 	c.mu.RLock()
@@ -286,6 +507,20 @@ func (c *DDDContainer) GenerateNotes() *release.GenerateNotesUseCase {
 	return c.generateNotesUC
 }
 
+// TranslateNotes returns the TranslateNotesUseCase.
+func (c *DDDContainer) TranslateNotes() *release.TranslateNotesUseCase {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.translateNotesUC
+}
+
+// GenerateMigrationGuide returns the GenerateMigrationGuideUseCase.
+func (c *DDDContainer) GenerateMigrationGuide() *release.GenerateMigrationGuideUseCase {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.migrationGuideUC
+}
+
 // ApproveRelease returns the ApproveReleaseUseCase.
 func (c *DDDContainer) ApproveRelease() *release.ApproveReleaseUseCase {
 	c.mu.RLock()
@@ -300,6 +535,27 @@ func (c *DDDContainer) PublishRelease() *release.PublishReleaseUseCase {
 	return c.publishReleaseUC
 }
 
+// RollbackRelease returns the RollbackReleaseUseCase.
+func (c *DDDContainer) RollbackRelease() *release.RollbackReleaseUseCase {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.rollbackReleaseUC
+}
+
+// SchedulePublish returns the SchedulePublishUseCase.
+func (c *DDDContainer) SchedulePublish() *release.SchedulePublishUseCase {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.schedulePublishUC
+}
+
+// FindDueReleases returns the FindDueReleasesUseCase.
+func (c *DDDContainer) FindDueReleases() *release.FindDueReleasesUseCase {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.findDueReleasesUC
+}
+
 // CalculateVersion returns the CalculateVersionUseCase.
 func (c *DDDContainer) CalculateVersion() *versioning.CalculateVersionUseCase {
 	c.mu.RLock()
@@ -316,6 +572,13 @@ func (c *DDDContainer) SetVersion() *versioning.SetVersionUseCase {
 
 // Infrastructure layer accessors
 
+// VersionScheme returns the configured version scheme.
+func (c *DDDContainer) VersionScheme() version.Scheme {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return version.SchemeOf(c.versionCalc)
+}
+
 // GitAdapter returns the git adapter implementing sourcecontrol.GitRepository.
 func (c *DDDContainer) GitAdapter() sourcecontrol.GitRepository {
 	c.mu.RLock()
@@ -330,6 +593,13 @@ func (c *DDDContainer) ReleaseRepository() domainrelease.Repository {
 	return c.releaseRepo
 }
 
+// ReleaseHistory returns the release history reader.
+func (c *DDDContainer) ReleaseHistory() domainrelease.HistoryReader {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.releaseRepo
+}
+
 // EventPublisher returns the event publisher implementing release.EventPublisher.
 func (c *DDDContainer) EventPublisher() domainrelease.EventPublisher {
 	c.mu.RLock()
@@ -337,6 +607,13 @@ func (c *DDDContainer) EventPublisher() domainrelease.EventPublisher {
 	return c.eventPublisher
 }
 
+// EventLog returns the event log for reading persisted domain events.
+func (c *DDDContainer) EventLog() domainrelease.EventLog {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.eventStore
+}
+
 // PluginRegistry returns the plugin registry.
 func (c *DDDContainer) PluginRegistry() integration.PluginRegistry {
 	c.mu.RLock()
@@ -360,6 +637,41 @@ func (c *DDDContainer) AI() ai.Service {
 	return c.aiService
 }
 
+// AICache returns the AI response cache, or nil if caching is disabled.
+func (c *DDDContainer) AICache() *ai.Cache {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.aiCache
+}
+
+// PreviewNotesPrompts returns the prompts AI notes generation sends for a
+// release, rendered exactly as they would be sent but without calling the
+// provider. Changesets that are summarized in chunks show the first chunk.
+func (c *DDDContainer) PreviewNotesPrompts(ctx context.Context, input release.AIGenerateInput) ([]ai.CapturedPrompt, error) {
+	c.mu.RLock()
+	notes := c.aiNotes
+	c.mu.RUnlock()
+	if notes == nil {
+		return nil, errors.AI("PreviewNotesPrompts", "no AI provider configured")
+	}
+
+	ctx, capture := ai.WithPromptCapture(ctx)
+	if _, err := notes.GenerateReleaseNotes(ctx, input); err != nil && !stderrors.Is(err, ai.ErrPromptCaptured) {
+		return nil, err
+	}
+	return capture.Prompts(), nil
+}
+
+// WithAIPrompts returns ctx with the prompt library's prompts rendered for
+// a release of the given version and changes, as notes generation renders
+// them. Without a prompt library, ctx is returned unchanged.
+func (c *DDDContainer) WithAIPrompts(ctx context.Context, versionLabel string, changes *git.CategorizedChanges) (context.Context, error) {
+	c.mu.RLock()
+	prompts := c.aiPrompts
+	c.mu.RUnlock()
+	return prompts.context(ctx, template.PromptData{Version: versionLabel, Changes: changes})
+}
+
 // HasAI returns true if the AI service is available.
 func (c *DDDContainer) HasAI() bool {
 	c.mu.RLock()
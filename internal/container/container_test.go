@@ -6,12 +6,14 @@ import (
 	"errors"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/felixgeelhaar/release-pilot/internal/config"
+	"github.com/felixgeelhaar/release-pilot/internal/infrastructure/persistence"
 )
 
 // mockCloseable implements Closeable for testing.
@@ -298,6 +300,9 @@ func TestDDDContainer_Accessors_BeforeInitialize(t *testing.T) {
 	if c.PlanRelease() != nil {
 		t.Error("PlanRelease should return nil before Initialize")
 	}
+	if c.PlanPackages() != nil {
+		t.Error("PlanPackages should return nil before Initialize")
+	}
 	if c.GenerateNotes() != nil {
 		t.Error("GenerateNotes should return nil before Initialize")
 	}
@@ -307,6 +312,15 @@ func TestDDDContainer_Accessors_BeforeInitialize(t *testing.T) {
 	if c.PublishRelease() != nil {
 		t.Error("PublishRelease should return nil before Initialize")
 	}
+	if c.RollbackRelease() != nil {
+		t.Error("RollbackRelease should return nil before Initialize")
+	}
+	if c.SchedulePublish() != nil {
+		t.Error("SchedulePublish should return nil before Initialize")
+	}
+	if c.FindDueReleases() != nil {
+		t.Error("FindDueReleases should return nil before Initialize")
+	}
 	if c.CalculateVersion() != nil {
 		t.Error("CalculateVersion should return nil before Initialize")
 	}
@@ -428,6 +442,9 @@ func TestDDDContainer_Initialize_Success(t *testing.T) {
 	if c.EventPublisher() == nil {
 		t.Error("EventPublisher should be initialized")
 	}
+	if c.EventLog() == nil {
+		t.Error("EventLog should be initialized")
+	}
 	if c.PluginRegistry() == nil {
 		t.Error("PluginRegistry should be initialized")
 	}
@@ -436,6 +453,9 @@ func TestDDDContainer_Initialize_Success(t *testing.T) {
 	if c.PlanRelease() == nil {
 		t.Error("PlanRelease should be initialized")
 	}
+	if c.PlanPackages() == nil {
+		t.Error("PlanPackages should be initialized")
+	}
 	if c.GenerateNotes() == nil {
 		t.Error("GenerateNotes should be initialized")
 	}
@@ -445,6 +465,15 @@ func TestDDDContainer_Initialize_Success(t *testing.T) {
 	if c.PublishRelease() == nil {
 		t.Error("PublishRelease should be initialized")
 	}
+	if c.RollbackRelease() == nil {
+		t.Error("RollbackRelease should be initialized")
+	}
+	if c.SchedulePublish() == nil {
+		t.Error("SchedulePublish should be initialized")
+	}
+	if c.FindDueReleases() == nil {
+		t.Error("FindDueReleases should be initialized")
+	}
 	if c.CalculateVersion() == nil {
 		t.Error("CalculateVersion should be initialized")
 	}
@@ -527,6 +556,58 @@ func TestDDDContainer_Initialize_WithPlugins(t *testing.T) {
 	}
 }
 
+func TestDDDContainer_Initialize_WithWebhooks(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cfg := &config.Config{
+		AI: config.AIConfig{
+			Enabled: false,
+		},
+		Webhooks: []config.WebhookConfig{
+			{
+				URL:    "https://hooks.example.com/release",
+				Events: []string{"release.published"},
+			},
+		},
+	}
+
+	c, err := NewDDDContainer(cfg)
+	if err != nil {
+		t.Fatalf("NewDDDContainer failed: %v", err)
+	}
+
+	oldDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get current directory: %v", err)
+	}
+	defer os.Chdir(oldDir)
+
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("failed to change to temp directory: %v", err)
+	}
+
+	cmd := exec.Command("git", "init")
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("failed to initialize git repository: %v", err)
+	}
+
+	if err := c.Initialize(context.Background()); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+
+	// Events fan out to the event log and the webhooks
+	if _, ok := c.EventPublisher().(*persistence.MultiEventPublisher); !ok {
+		t.Errorf("EventPublisher = %T, want *persistence.MultiEventPublisher", c.EventPublisher())
+	}
+	if c.EventLog() == nil {
+		t.Error("EventLog should be initialized")
+	}
+
+	if err := c.Close(); err != nil {
+		t.Errorf("Close failed: %v", err)
+	}
+}
+
 func TestDDDContainer_Initialize_WithAIEnabled(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -583,6 +664,50 @@ func TestDDDContainer_Initialize_WithAIEnabled(t *testing.T) {
 	}
 }
 
+func TestDDDContainer_Initialize_AICache(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cfg := &config.Config{
+		AI: config.AIConfig{
+			Enabled:  true,
+			Provider: "ollama",
+			Cache:    config.AICacheConfig{Enabled: true, Dir: filepath.Join(tmpDir, "ai-cache")},
+		},
+		Plugins: []config.PluginConfig{},
+	}
+
+	c, err := NewDDDContainer(cfg)
+	if err != nil {
+		t.Fatalf("NewDDDContainer failed: %v", err)
+	}
+
+	oldDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get current directory: %v", err)
+	}
+	defer os.Chdir(oldDir)
+
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("failed to change to temp directory: %v", err)
+	}
+
+	if err := exec.Command("git", "init").Run(); err != nil {
+		t.Fatalf("failed to initialize git repository: %v", err)
+	}
+
+	if err := c.Initialize(context.Background()); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer c.Close()
+
+	if c.AICache() == nil || c.AICache().Dir() != cfg.AI.Cache.Dir {
+		t.Fatalf("AICache() = %v, want a cache in %s", c.AICache(), cfg.AI.Cache.Dir)
+	}
+	if _, err := os.Stat(cfg.AI.Cache.Dir); err != nil {
+		t.Errorf("cache directory should exist: %v", err)
+	}
+}
+
 func TestDDDContainer_Initialize_AIEnabledWithoutAPIKey(t *testing.T) {
 	tmpDir := t.TempDir()
 
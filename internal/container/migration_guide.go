@@ -0,0 +1,95 @@
+// Package container provides dependency injection for ReleasePilot services.
+package container
+
+import (
+	"context"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/application/release"
+	domainrelease "github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/service/ai"
+	"github.com/felixgeelhaar/release-pilot/internal/service/template"
+)
+
+// migrationGuideTemplate is the embedded template migration guides are
+// rendered with. A custom template of the same name overrides it.
+const migrationGuideTemplate = "migration"
+
+// aiMigrationAdvisor adapts the AI service to the application layer's
+// MigrationAdvisor, recording which provider wrote the guide.
+type aiMigrationAdvisor struct {
+	service ai.Service
+	prompts *promptLibrary
+}
+
+// AdviseMigration asks the AI provider for before/after examples and
+// migration steps for each breaking commit.
+func (a *aiMigrationAdvisor) AdviseMigration(ctx context.Context, input release.MigrationAdviceInput) (*domainrelease.MigrationGuide, error) {
+	breaking := make([]ai.BreakingChange, 0, len(input.Commits))
+	for _, c := range input.Commits {
+		breaking = append(breaking, ai.BreakingChange{
+			Hash:        c.Hash,
+			Scope:       c.Scope,
+			Subject:     c.Subject,
+			Description: c.Description,
+			Diff:        c.Diff,
+		})
+	}
+
+	opts := ai.DefaultGenerateOptions()
+	opts.Context = "The release version is " + input.VersionLabel + "."
+
+	ctx, err := a.prompts.context(ctx, template.PromptData{Version: input.VersionLabel})
+	if err != nil {
+		return nil, err
+	}
+	ctx, trace := ai.WithFallbackTrace(ctx)
+	entries, err := a.service.GenerateMigrationGuide(ctx, breaking, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Entries come back in the order of the commits
+	guide := &domainrelease.MigrationGuide{AIGenerated: true, Provider: trace.Provider()}
+	for i, e := range entries {
+		guide.Entries = append(guide.Entries, domainrelease.MigrationEntry{
+			CommitHash: e.Hash,
+			Scope:      input.Commits[i].Scope,
+			Change:     e.Change,
+			Before:     e.Before,
+			After:      e.After,
+			Migration:  e.Migration,
+		})
+	}
+	return guide, nil
+}
+
+// templateMigrationRenderer adapts the template service to the application
+// layer's MigrationGuideRenderer.
+type templateMigrationRenderer struct {
+	templates template.Service
+}
+
+// RenderMigrationGuide renders a migration guide as a MIGRATION.md section.
+func (r *templateMigrationRenderer) RenderMigrationGuide(ctx context.Context, input release.MigrationGuideRenderInput) (string, error) {
+	data := template.MigrationGuideData{
+		Version:         input.VersionLabel,
+		PreviousVersion: input.PreviousVersionLabel,
+		Date:            time.Now(),
+	}
+	for _, e := range input.Guide.Entries {
+		hash := e.CommitHash
+		if len(hash) > 7 {
+			hash = hash[:7]
+		}
+		data.Entries = append(data.Entries, template.MigrationEntryData{
+			CommitHash: hash,
+			Scope:      e.Scope,
+			Change:     e.Change,
+			Before:     e.Before,
+			After:      e.After,
+			Migration:  e.Migration,
+		})
+	}
+	return r.templates.Render(migrationGuideTemplate, data)
+}
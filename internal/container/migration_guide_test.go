@@ -0,0 +1,62 @@
+// Package container provides dependency injection for ReleasePilot services.
+package container
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/application/release"
+	domainrelease "github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/service/ai"
+	"github.com/felixgeelhaar/release-pilot/internal/service/template"
+)
+
+func TestAIMigrationAdvisor_AdviseMigration(t *testing.T) {
+	input := release.MigrationAdviceInput{
+		VersionLabel: "2.0.0",
+		Commits:      []release.BreakingCommit{{Hash: "abc1234", Scope: "api", Subject: "rename Execute to Run"}},
+	}
+
+	advisor := &aiMigrationAdvisor{service: &stubAIService{migration: []ai.MigrationGuideEntry{
+		{Hash: "abc1234", Change: "Run replaces Execute", Before: "c.Execute()", After: "c.Run()", Migration: "Rename the calls."},
+	}}}
+	guide, err := advisor.AdviseMigration(context.Background(), input)
+	if err != nil {
+		t.Fatalf("AdviseMigration() error = %v", err)
+	}
+	if !guide.AIGenerated || len(guide.Entries) != 1 {
+		t.Fatalf("guide = %+v", guide)
+	}
+	if e := guide.Entries[0]; e.Scope != "api" || e.After != "c.Run()" {
+		t.Errorf("entry = %+v, want the scope of the commit and the advised example", e)
+	}
+
+	failing := &aiMigrationAdvisor{service: &stubAIService{err: stderrors.New("provider down")}}
+	if _, err := failing.AdviseMigration(context.Background(), input); err == nil {
+		t.Error("AdviseMigration() should return the provider error")
+	}
+}
+
+func TestTemplateMigrationRenderer_RenderMigrationGuide(t *testing.T) {
+	templates, err := template.NewService()
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+
+	renderer := &templateMigrationRenderer{templates: templates}
+	got, err := renderer.RenderMigrationGuide(context.Background(), release.MigrationGuideRenderInput{
+		VersionLabel:         "2.0.0",
+		PreviousVersionLabel: "1.4.0",
+		Guide: &domainrelease.MigrationGuide{Entries: []domainrelease.MigrationEntry{
+			{CommitHash: "abc1234def", Scope: "api", Change: "rename Execute to Run", Migration: "Execute was renamed to Run"},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("RenderMigrationGuide() error = %v", err)
+	}
+	if !strings.HasPrefix(got, "## Migrating to 2.0.0\n") || !strings.Contains(got, "### api: rename Execute to Run (abc1234)") {
+		t.Errorf("RenderMigrationGuide() = %q", got)
+	}
+}
@@ -0,0 +1,183 @@
+// Package container provides dependency injection for ReleasePilot services.
+package container
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/config"
+	domainrelease "github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/errors"
+	"github.com/felixgeelhaar/release-pilot/internal/service/ai"
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+	"github.com/felixgeelhaar/release-pilot/internal/service/template"
+)
+
+// promptTemplatePrefix namespaces prompt templates in the template service,
+// apart from the changelog and notes templates.
+const promptTemplatePrefix = "prompt/"
+
+// promptLibrary renders the prompt templates of a prompt directory
+// (ai.prompts.dir) for each AI call. A template is named after the prompt
+// and role, e.g. summary.user.tmpl; the templates in the selected
+// profile's subdirectory override those at the top of the directory.
+type promptLibrary struct {
+	templates     template.Service
+	profile       string
+	repositoryURL string
+	// names are the loaded templates, e.g. "summary.user".
+	names map[string]bool
+}
+
+// newPromptLibrary loads the prompt templates of the prompt directory and
+// the selected profile. It returns nil if no profile is selected and the
+// directory does not exist, so the built-in prompts are used.
+func newPromptLibrary(cfg config.AIPromptsConfig, repositoryURL string) (*promptLibrary, error) {
+	dir, profile := cfg.Dir, cfg.Profile
+	if _, err := os.Stat(dir); err != nil {
+		if os.IsNotExist(err) {
+			if profile == "" {
+				return nil, nil
+			}
+			return nil, errors.Config("newPromptLibrary", fmt.Sprintf("prompt profile %q selected, but prompt directory %s does not exist", profile, dir))
+		}
+		return nil, errors.ConfigWrap(err, "newPromptLibrary", "prompt directory "+dir+" is not readable")
+	}
+
+	templates, err := template.NewService()
+	if err != nil {
+		return nil, err
+	}
+	lib := &promptLibrary{
+		templates:     templates,
+		profile:       profile,
+		repositoryURL: repositoryURL,
+		names:         make(map[string]bool),
+	}
+
+	if err := lib.load(dir); err != nil {
+		return nil, err
+	}
+	if profile != "" {
+		profileDir := filepath.Join(dir, profile)
+		if info, err := os.Stat(profileDir); err != nil || !info.IsDir() {
+			return nil, errors.Config("newPromptLibrary", fmt.Sprintf("prompt profile %q not found in %s", profile, dir))
+		}
+		// Profile templates are loaded last, so they override the shared ones
+		if err := lib.load(profileDir); err != nil {
+			return nil, err
+		}
+	}
+	return lib, nil
+}
+
+// load registers the prompt templates of one directory. Files other than
+// .tmpl files and subdirectories (other profiles) are ignored.
+func (l *promptLibrary) load(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return errors.ConfigWrap(err, "newPromptLibrary", "failed to read prompt directory "+dir)
+	}
+
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".tmpl")
+		if entry.IsDir() || !ok {
+			continue
+		}
+		if !isPromptTemplateName(name) {
+			return errors.Config("newPromptLibrary", fmt.Sprintf(
+				"unknown prompt template %s: expected <prompt>.system.tmpl or <prompt>.user.tmpl, with a prompt of %s",
+				filepath.Join(dir, entry.Name()), strings.Join(ai.PromptNames(), ", ")))
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return errors.ConfigWrap(err, "newPromptLibrary", "failed to read prompt template "+entry.Name())
+		}
+		if err := l.templates.RegisterTemplate(promptTemplatePrefix+name, string(content)); err != nil {
+			return errors.TemplateWrap(err, "newPromptLibrary", "invalid prompt template in "+dir)
+		}
+		l.names[name] = true
+	}
+	return nil
+}
+
+// isPromptTemplateName reports whether name is <prompt>.system or
+// <prompt>.user for a known prompt.
+func isPromptTemplateName(name string) bool {
+	prompt, role, ok := strings.Cut(name, ".")
+	return ok && (role == "system" || role == "user") && slices.Contains(ai.PromptNames(), prompt)
+}
+
+// context returns ctx with the library's prompts rendered with data, so AI
+// calls made with it use them instead of the configured prompts. A nil
+// library returns ctx unchanged.
+func (l *promptLibrary) context(ctx context.Context, data template.PromptData) (context.Context, error) {
+	if l == nil {
+		return ctx, nil
+	}
+
+	data.Content = ai.ContentPlaceholder
+	data.Part = ai.PartPlaceholder
+	data.Language = ai.LanguagePlaceholder
+	data.Profile = l.profile
+	data.RepositoryURL = l.repositoryURL
+	if data.Date.IsZero() {
+		data.Date = time.Now()
+	}
+	// Every prompt is rendered for every call, so templates can use
+	// .Changes even in calls that are not about a release
+	if data.Changes == nil {
+		data.Changes = &git.CategorizedChanges{}
+	}
+
+	prompts := make(map[string]ai.Prompt)
+	for _, name := range ai.PromptNames() {
+		system, err := l.render(name+".system", data)
+		if err != nil {
+			return ctx, err
+		}
+		user, err := l.render(name+".user", data)
+		if err != nil {
+			return ctx, err
+		}
+		if system != "" || user != "" {
+			prompts[name] = ai.Prompt{System: system, User: user}
+		}
+	}
+	return ai.WithPrompts(ctx, prompts), nil
+}
+
+// render renders one prompt template, or returns an empty string if the
+// library does not have it.
+func (l *promptLibrary) render(name string, data template.PromptData) (string, error) {
+	if !l.names[name] {
+		return "", nil
+	}
+	rendered, err := l.templates.Render(promptTemplatePrefix+name, data)
+	if err != nil {
+		return "", errors.TemplateWrap(err, "promptLibrary.context", "failed to render prompt "+name)
+	}
+	return rendered, nil
+}
+
+// releasePromptData returns the prompt data of a planned release.
+func releasePromptData(rel *domainrelease.Release, versionLabel string) template.PromptData {
+	data := template.PromptData{
+		Version:        versionLabel,
+		RepositoryName: rel.RepositoryName(),
+		RepositoryPath: rel.RepositoryPath(),
+		Branch:         rel.Branch(),
+	}
+	if plan := rel.Plan(); plan != nil {
+		data.PreviousVersion = plan.CurrentVersion.String()
+		data.ReleaseType = plan.ReleaseType.String()
+		data.Changes = categorizeChangeSet(plan.GetChangeSet())
+	}
+	return data
+}
@@ -0,0 +1,138 @@
+// Package container provides dependency injection for ReleasePilot services.
+package container
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/application/release"
+	"github.com/felixgeelhaar/release-pilot/internal/config"
+	"github.com/felixgeelhaar/release-pilot/internal/service/ai"
+	"github.com/felixgeelhaar/release-pilot/internal/service/template"
+)
+
+// writePromptFiles writes prompt templates, keyed by path relative to dir.
+func writePromptFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestNewPromptLibrary(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		profile string
+		wantNil bool
+		wantErr string
+	}{
+		{name: "no prompt directory", wantNil: true},
+		{name: "no prompt directory with a profile", profile: "concise", wantErr: "does not exist"},
+		{name: "shared templates", files: map[string]string{"summary.user.tmpl": "{{.Content}}", "README.md": "notes"}},
+		{name: "profile", files: map[string]string{"concise/summary.user.tmpl": "{{.Content}}"}, profile: "concise"},
+		{name: "unknown profile", files: map[string]string{"concise/summary.user.tmpl": "{{.Content}}"}, profile: "verbose", wantErr: `prompt profile "verbose" not found`},
+		{name: "unknown prompt", files: map[string]string{"summery.user.tmpl": "{{.Content}}"}, wantErr: "unknown prompt template"},
+		{name: "unknown role", files: map[string]string{"summary.assistant.tmpl": "{{.Content}}"}, wantErr: "unknown prompt template"},
+		{name: "invalid template", files: map[string]string{"summary.user.tmpl": "{{.Content"}, wantErr: "invalid prompt template"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "prompts")
+			if tt.files != nil {
+				writePromptFiles(t, dir, tt.files)
+			}
+
+			lib, err := newPromptLibrary(config.AIPromptsConfig{Dir: dir, Profile: tt.profile}, "")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("newPromptLibrary() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newPromptLibrary() error = %v", err)
+			}
+			if (lib == nil) != tt.wantNil {
+				t.Errorf("newPromptLibrary() = %v, want nil %v", lib, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestPromptLibrary_Context(t *testing.T) {
+	dir := t.TempDir()
+	writePromptFiles(t, dir, map[string]string{
+		"summary.system.tmpl":         "You write release notes for {{.RepositoryName}}.",
+		"summary.user.tmpl":           "Summarize {{.Version}} ({{.ReleaseType}} release after {{.PreviousVersion}}, {{len .Changes.Features}} features):\n{{.Content}}",
+		"concise/summary.system.tmpl": "You write one-line release notes for {{.RepositoryName}} ({{.Profile}}).",
+	})
+	lib, err := newPromptLibrary(config.AIPromptsConfig{Dir: dir, Profile: "concise"}, "https://github.com/acme/search")
+	if err != nil {
+		t.Fatalf("newPromptLibrary() error = %v", err)
+	}
+
+	rel := newPlannedRelease()
+	rel.SetRepositoryName("search")
+	ctx, err := lib.context(context.Background(), releasePromptData(rel, "1.1.0"))
+	if err != nil {
+		t.Fatalf("context() error = %v", err)
+	}
+
+	// Calls without a release render the same templates
+	if _, err := lib.context(context.Background(), template.PromptData{}); err != nil {
+		t.Errorf("context() without a release error = %v", err)
+	}
+
+	// The rendered prompts are sent in place of the built-in ones
+	svc, err := ai.NewOpenAIService(ai.ServiceConfig{Provider: "openai", APIKey: "sk-abcdefghijklmnopqrstuvwx", BaseURL: "http://127.0.0.1:1"})
+	if err != nil {
+		t.Fatalf("NewOpenAIService() error = %v", err)
+	}
+	ctx, capture := ai.WithPromptCapture(ctx)
+	_, _ = svc.SummarizeChanges(ctx, categorizeChangeSet(rel.Plan().GetChangeSet()), ai.DefaultGenerateOptions())
+
+	prompts := capture.Prompts()
+	if len(prompts) != 1 {
+		t.Fatalf("captured %d prompts, want 1", len(prompts))
+	}
+	if !strings.HasPrefix(prompts[0].System, "You write one-line release notes for search (concise).") {
+		t.Errorf("System = %q, want the profile template", prompts[0].System)
+	}
+	if !strings.HasPrefix(prompts[0].User, "Summarize 1.1.0 (minor release after 1.0.0, 1 features):\n") || !strings.Contains(prompts[0].User, "add search") {
+		t.Errorf("User = %q, want the shared template with the changes", prompts[0].User)
+	}
+}
+
+func TestDDDContainer_PreviewNotesPrompts(t *testing.T) {
+	svc, err := ai.NewOpenAIService(ai.ServiceConfig{Provider: "openai", APIKey: "sk-abcdefghijklmnopqrstuvwx", BaseURL: "http://127.0.0.1:1"})
+	if err != nil {
+		t.Fatalf("NewOpenAIService() error = %v", err)
+	}
+	c := &DDDContainer{aiNotes: &aiNotesGenerator{service: ai.NewFallbackService(ai.Provider{Name: "openai", Service: svc})}}
+
+	prompts, err := c.PreviewNotesPrompts(context.Background(), release.AIGenerateInput{
+		ReleaseContext: newPlannedRelease(),
+		VersionLabel:   "1.1.0",
+	})
+	if err != nil {
+		t.Fatalf("PreviewNotesPrompts() error = %v", err)
+	}
+	if len(prompts) != 1 || !strings.Contains(prompts[0].User, "add search") {
+		t.Errorf("PreviewNotesPrompts() = %+v, want the summary prompt", prompts)
+	}
+
+	if _, err := (&DDDContainer{}).PreviewNotesPrompts(context.Background(), release.AIGenerateInput{}); err == nil {
+		t.Error("PreviewNotesPrompts() without AI should fail")
+	}
+}
@@ -27,6 +27,10 @@ type ConventionalCommit struct {
 	authorEmail string
 	date        time.Time
 
+	// inferred is set for commits that did not follow the conventional
+	// format and were classified by a CommitClassifier.
+	inferred bool
+
 	// Original raw message
 	rawMessage string
 }
@@ -85,6 +89,14 @@ func WithRawMessage(msg string) ConventionalCommitOption {
 	}
 }
 
+// WithInferred marks the commit's type, scope and breaking-ness as inferred
+// rather than parsed from a conventional commit message.
+func WithInferred() ConventionalCommitOption {
+	return func(c *ConventionalCommit) {
+		c.inferred = true
+	}
+}
+
 // NewConventionalCommit creates a new ConventionalCommit entity.
 func NewConventionalCommit(hash string, commitType CommitType, subject string, opts ...ConventionalCommitOption) *ConventionalCommit {
 	c := &ConventionalCommit{
@@ -288,6 +300,12 @@ func (c *ConventionalCommit) RawMessage() string {
 	return c.rawMessage
 }
 
+// IsInferred returns true if the commit was not a conventional commit and
+// its type was inferred by a classifier.
+func (c *ConventionalCommit) IsInferred() bool {
+	return c.inferred
+}
+
 // AffectsChangelog returns true if this commit should appear in changelog.
 func (c *ConventionalCommit) AffectsChangelog() bool {
 	return c.commitType.AffectsChangelog() || c.breaking
@@ -208,6 +208,12 @@ func TestNewConventionalCommit(t *testing.T) {
 	if !c.Date().Equal(now) {
 		t.Errorf("Date() = %v, want %v", c.Date(), now)
 	}
+	if c.IsInferred() {
+		t.Error("IsInferred() = true, want false")
+	}
+	if !NewConventionalCommit("abc123", CommitTypeFix, "fix crash", WithInferred()).IsInferred() {
+		t.Error("IsInferred() = false with WithInferred, want true")
+	}
 }
 
 func TestConventionalCommit_ShortHash(t *testing.T) {
@@ -39,6 +39,22 @@ type ChangelogEntry struct {
 	Sections     []ChangelogSection
 	CompareURL   string
 	IsUnreleased bool
+	Label        string // Rendered instead of Version when set (e.g., calendar versions)
+}
+
+// VersionLabel returns the version as rendered in the changelog.
+func (e ChangelogEntry) VersionLabel() string {
+	if e.Label != "" {
+		return e.Label
+	}
+	return e.Version.String()
+}
+
+// WithEntryLabel renders the entry under a custom version label.
+func WithEntryLabel(label string) func(*ChangelogEntry) {
+	return func(e *ChangelogEntry) {
+		e.Label = label
+	}
 }
 
 // ChangelogSection represents a section within a changelog entry.
@@ -114,14 +130,17 @@ func (c *Changelog) LatestEntry() *ChangelogEntry {
 }
 
 // CreateEntryFromChangeSet creates a changelog entry from a changeset.
-func CreateEntryFromChangeSet(ver version.SemanticVersion, cs *changes.ChangeSet, repoURL string) ChangelogEntry {
+func CreateEntryFromChangeSet(ver version.SemanticVersion, cs *changes.ChangeSet, repoURL string, opts ...func(*ChangelogEntry)) ChangelogEntry {
 	entry := ChangelogEntry{
 		Version: ver,
 		Date:    time.Now(),
 	}
+	for _, opt := range opts {
+		opt(&entry)
+	}
 
 	if repoURL != "" && cs.FromRef() != "" {
-		entry.CompareURL = fmt.Sprintf("%s/compare/%s...%s", repoURL, cs.FromRef(), ver.TagString())
+		entry.CompareURL = fmt.Sprintf("%s/compare/%s...v%s", repoURL, cs.FromRef(), entry.VersionLabel())
 	}
 
 	cats := cs.Categories()
@@ -255,7 +274,7 @@ func (c *Changelog) renderEntry(sb *strings.Builder, entry ChangelogEntry) {
 		sb.WriteString("## [Unreleased]")
 	} else {
 		sb.WriteString("## [")
-		sb.WriteString(entry.Version.String())
+		sb.WriteString(entry.VersionLabel())
 		sb.WriteString("]")
 		if !entry.Date.IsZero() {
 			sb.WriteString(" - ")
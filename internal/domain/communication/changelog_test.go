@@ -248,6 +248,28 @@ func TestCreateEntryFromChangeSet(t *testing.T) {
 	}
 }
 
+func TestCreateEntryFromChangeSet_WithLabel(t *testing.T) {
+	cs := changes.NewChangeSet("test", "v2025.06.0", "HEAD")
+	cs.AddCommits([]*changes.ConventionalCommit{
+		changes.NewConventionalCommit("abc1234567", changes.CommitTypeFeat, "add feature"),
+	})
+
+	entry := CreateEntryFromChangeSet(version.MustParse("2025.7.0"), cs, "https://github.com/owner/repo", WithEntryLabel("2025.07.0"))
+
+	if entry.VersionLabel() != "2025.07.0" {
+		t.Errorf("VersionLabel() = %v, want 2025.07.0", entry.VersionLabel())
+	}
+	if !strings.HasSuffix(entry.CompareURL, "v2025.06.0...v2025.07.0") {
+		t.Errorf("CompareURL = %v", entry.CompareURL)
+	}
+
+	changelog := NewChangelog("Changelog", FormatKeepAChangelog)
+	changelog.AddEntry(entry)
+	if !strings.Contains(changelog.RenderEntries(), "## [2025.07.0]") {
+		t.Errorf("rendered entry should use the label, got:\n%s", changelog.RenderEntries())
+	}
+}
+
 func TestCreateEntryFromChangeSet_BreakingMessageUsed(t *testing.T) {
 	ver := version.MustParse("2.0.0")
 
@@ -65,13 +65,27 @@ type ReleaseNotes struct {
 	summary      string
 	highlights   []string
 	sections     []NotesSection
+	migration    []MigrationNote
 	contributors []Contributor
 	generatedAt  time.Time
 	aiGenerated  bool
+	provider     string
+	fallbacks    []ProviderFallback
 	tone         NoteTone
 	audience     NoteAudience
 }
 
+// ProviderTemplate is the provider name recorded for notes generated from
+// the deterministic template rather than by an AI provider.
+const ProviderTemplate = "template"
+
+// ProviderFallback records an AI provider that was skipped or failed before
+// the notes were produced by another provider.
+type ProviderFallback struct {
+	Provider string
+	Reason   string
+}
+
 // NotesSection represents a section in release notes.
 type NotesSection struct {
 	Title    string
@@ -81,6 +95,12 @@ type NotesSection struct {
 	Priority int
 }
 
+// MigrationNote explains a breaking change and how to migrate to it.
+type MigrationNote struct {
+	Change    string
+	Migration string
+}
+
 // Contributor represents a contributor to the release.
 type Contributor struct {
 	Name     string
@@ -130,6 +150,12 @@ func (b *ReleaseNotesBuilder) AddSection(section NotesSection) *ReleaseNotesBuil
 	return b
 }
 
+// WithMigrationNotes sets the breaking-change migration notes.
+func (b *ReleaseNotesBuilder) WithMigrationNotes(notes []MigrationNote) *ReleaseNotesBuilder {
+	b.notes.migration = notes
+	return b
+}
+
 // WithContributors sets the contributors.
 func (b *ReleaseNotesBuilder) WithContributors(contributors []Contributor) *ReleaseNotesBuilder {
 	b.notes.contributors = contributors
@@ -154,6 +180,18 @@ func (b *ReleaseNotesBuilder) AIGenerated() *ReleaseNotesBuilder {
 	return b
 }
 
+// WithProvider records the provider that produced the notes.
+func (b *ReleaseNotesBuilder) WithProvider(provider string) *ReleaseNotesBuilder {
+	b.notes.provider = provider
+	return b
+}
+
+// WithFallbacks records the providers that were tried before the producing one.
+func (b *ReleaseNotesBuilder) WithFallbacks(fallbacks []ProviderFallback) *ReleaseNotesBuilder {
+	b.notes.fallbacks = fallbacks
+	return b
+}
+
 // Build creates the ReleaseNotes.
 func (b *ReleaseNotesBuilder) Build() *ReleaseNotes {
 	return b.notes
@@ -184,6 +222,11 @@ func (n *ReleaseNotes) Sections() []NotesSection {
 	return n.sections
 }
 
+// MigrationNotes returns the breaking-change migration notes.
+func (n *ReleaseNotes) MigrationNotes() []MigrationNote {
+	return n.migration
+}
+
 // Contributors returns the contributors.
 func (n *ReleaseNotes) Contributors() []Contributor {
 	return n.contributors
@@ -199,6 +242,16 @@ func (n *ReleaseNotes) IsAIGenerated() bool {
 	return n.aiGenerated
 }
 
+// Provider returns the provider that produced the notes.
+func (n *ReleaseNotes) Provider() string {
+	return n.provider
+}
+
+// Fallbacks returns the providers that were tried before the producing one.
+func (n *ReleaseNotes) Fallbacks() []ProviderFallback {
+	return n.fallbacks
+}
+
 // Tone returns the tone.
 func (n *ReleaseNotes) Tone() NoteTone {
 	return n.tone
@@ -209,9 +262,16 @@ func (n *ReleaseNotes) Audience() NoteAudience {
 	return n.audience
 }
 
+// WithVersionLabel titles the release notes with a custom version label.
+func WithVersionLabel(label string) func(*ReleaseNotesBuilder) {
+	return func(b *ReleaseNotesBuilder) {
+		b.WithTitle("Release " + label)
+	}
+}
+
 // CreateFromChangeSet creates release notes from a changeset.
 func CreateFromChangeSet(ver version.SemanticVersion, cs *changes.ChangeSet, opts ...func(*ReleaseNotesBuilder)) *ReleaseNotes {
-	builder := NewReleaseNotesBuilder(ver)
+	builder := NewReleaseNotesBuilder(ver).WithProvider(ProviderTemplate)
 
 	summary := cs.Summary()
 	title := "Release " + ver.String()
@@ -241,14 +301,19 @@ func CreateFromChangeSet(ver version.SemanticVersion, cs *changes.ChangeSet, opt
 
 	if len(cats.Breaking) > 0 {
 		var items []string
+		var migration []MigrationNote
 		for _, c := range cats.Breaking {
 			items = append(items, c.FormattedSubject())
+			if msg := c.BreakingMessage(); msg != "" {
+				migration = append(migration, MigrationNote{Change: c.FormattedSubject(), Migration: msg})
+			}
 		}
 		builder.AddSection(NotesSection{
 			Title:    "⚠️ Breaking Changes",
 			Items:    items,
 			Priority: 1,
 		})
+		builder.WithMigrationNotes(migration)
 	}
 
 	if len(cats.Features) > 0 {
@@ -360,6 +425,19 @@ func (n *ReleaseNotes) Render() string {
 		sb.WriteString("\n")
 	}
 
+	// Migration notes
+	if len(n.migration) > 0 {
+		sb.WriteString("## Migration Notes\n\n")
+		for _, m := range n.migration {
+			sb.WriteString("- ")
+			sb.WriteString(m.Change)
+			sb.WriteString("\n  ")
+			sb.WriteString(m.Migration)
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+	}
+
 	// Contributors
 	if len(n.contributors) > 0 {
 		sb.WriteString("## Contributors\n\n")
@@ -352,3 +352,36 @@ func TestCreateFromChangeSet_WithOptions(t *testing.T) {
 		t.Errorf("Audience = %v, want public", notes.Audience())
 	}
 }
+
+func TestCreateFromChangeSet_WithVersionLabel(t *testing.T) {
+	cs := changes.NewChangeSet("test", "v25.06", "HEAD")
+
+	notes := CreateFromChangeSet(version.MustParse("25.7.0"), cs, WithVersionLabel("25.07"))
+
+	if notes.Title() != "Release 25.07" {
+		t.Errorf("Title = %v, want Release 25.07", notes.Title())
+	}
+}
+
+func TestCreateFromChangeSet_MigrationNotes(t *testing.T) {
+	breaking := changes.NewConventionalCommit("ghi789", changes.CommitTypeFeat, "new config format",
+		changes.WithScope("config"),
+		changes.WithBreaking("rename `ai.key` to `ai.api_key`"))
+	silent := changes.NewConventionalCommit("jkl012", changes.CommitTypeFeat, "drop v1 api", changes.WithBreaking(""))
+
+	cs := changes.NewChangeSet("test", "v1.0.0", "HEAD")
+	cs.AddCommits([]*changes.ConventionalCommit{breaking, silent})
+
+	notes := CreateFromChangeSet(version.MustParse("2.0.0"), cs)
+
+	migration := notes.MigrationNotes()
+	if len(migration) != 1 {
+		t.Fatalf("MigrationNotes() = %+v, want one note for the commit with a breaking message", migration)
+	}
+	if migration[0].Change != "**config:** new config format" || migration[0].Migration != "rename `ai.key` to `ai.api_key`" {
+		t.Errorf("MigrationNotes()[0] = %+v", migration[0])
+	}
+	if rendered := notes.Render(); !strings.Contains(rendered, "## Migration Notes\n\n- **config:** new config format\n  rename `ai.key` to `ai.api_key`\n") {
+		t.Errorf("Render() should include the migration notes, got:\n%s", rendered)
+	}
+}
@@ -0,0 +1,33 @@
+// Package integration provides domain types for plugin integration.
+package integration
+
+import "context"
+
+// PluginEvent is a log line, step or progress update a plugin reported
+// while it executed.
+type PluginEvent struct {
+	PluginID PluginID
+	Hook     Hook
+	Log      string
+	Level    string // Level of Log: debug, info, warn or error
+	Step     string
+	Progress float64 // Percentage of the work done, from 0 to 100
+}
+
+// PluginEventHandler receives plugin events as they arrive. Plugins run in
+// parallel, so it may be called from several goroutines at once.
+type PluginEventHandler func(PluginEvent)
+
+type pluginEventHandlerKey struct{}
+
+// WithPluginEventHandler returns a context whose plugin executions stream
+// their events to handler.
+func WithPluginEventHandler(ctx context.Context, handler PluginEventHandler) context.Context {
+	return context.WithValue(ctx, pluginEventHandlerKey{}, handler)
+}
+
+// PluginEventHandlerFrom returns the plugin event handler of a context, or nil.
+func PluginEventHandlerFrom(ctx context.Context) PluginEventHandler {
+	handler, _ := ctx.Value(pluginEventHandlerKey{}).(PluginEventHandler)
+	return handler
+}
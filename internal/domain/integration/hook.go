@@ -39,6 +39,10 @@ const (
 	HookOnSuccess Hook = "on-success"
 	// HookOnError is called when release fails.
 	HookOnError Hook = "on-error"
+
+	// HookOnRollback is called when a published release is rolled back,
+	// so plugins can compensate for what they published.
+	HookOnRollback Hook = "on-rollback"
 )
 
 // String returns the string representation of the hook.
@@ -55,7 +59,8 @@ func (h Hook) IsValid() bool {
 		HookPreNotes, HookPostNotes,
 		HookPreApprove, HookPostApprove,
 		HookPrePublish, HookPostPublish,
-		HookOnSuccess, HookOnError:
+		HookOnSuccess, HookOnError,
+		HookOnRollback:
 		return true
 	default:
 		return false
@@ -99,6 +104,7 @@ func AllHooks() []Hook {
 		HookPreApprove, HookPostApprove,
 		HookPrePublish, HookPostPublish,
 		HookOnSuccess, HookOnError,
+		HookOnRollback,
 	}
 }
 
@@ -169,6 +175,8 @@ func (h Hook) Description() string {
 		return "On successful release"
 	case HookOnError:
 		return "On release error"
+	case HookOnRollback:
+		return "On release rollback"
 	default:
 		return "Unknown hook"
 	}
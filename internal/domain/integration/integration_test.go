@@ -30,6 +30,7 @@ func TestHookString(t *testing.T) {
 		{HookPostPublish, "post-publish"},
 		{HookOnSuccess, "on-success"},
 		{HookOnError, "on-error"},
+		{HookOnRollback, "on-rollback"},
 	}
 
 	for _, tt := range tests {
@@ -113,6 +114,7 @@ func TestHookIsPost(t *testing.T) {
 func TestHookIsLifecycle(t *testing.T) {
 	assert.True(t, HookOnSuccess.IsLifecycle())
 	assert.True(t, HookOnError.IsLifecycle())
+	assert.False(t, HookOnRollback.IsLifecycle())
 
 	for _, hook := range PreHooks() {
 		assert.False(t, hook.IsLifecycle())
@@ -174,12 +176,12 @@ func TestHookDescription(t *testing.T) {
 // TestAllHooks tests the AllHooks function.
 func TestAllHooks(t *testing.T) {
 	hooks := AllHooks()
-	assert.Len(t, hooks, 14) // 6 pre + 6 post + 2 lifecycle
+	assert.Len(t, hooks, 15) // 6 pre + 6 post + 2 lifecycle + rollback
 
 	// Verify order
 	assert.Equal(t, HookPreInit, hooks[0])
 	assert.Equal(t, HookPostInit, hooks[1])
-	assert.Equal(t, HookOnError, hooks[len(hooks)-1])
+	assert.Equal(t, HookOnRollback, hooks[len(hooks)-1])
 }
 
 // TestPreHooks tests the PreHooks function.
@@ -435,6 +437,7 @@ func TestSequentialPluginExecutorExecuteHook(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, responses, 1)
 	assert.True(t, responses[0].Success)
+	assert.Equal(t, PluginID("test-plugin"), responses[0].PluginID)
 
 	// Execute hook with no registered plugins
 	responses, err = executor.ExecuteHook(ctx, HookPreInit, releaseCtx)
@@ -44,12 +44,56 @@ type ReleaseContext struct {
 	Changes      *changes.ChangeSet
 	Changelog    string
 	ReleaseNotes string
+	Notes        *StructuredNotes // Typed form of the release notes, if generated
+
+	// Translations of Changelog and ReleaseNotes, one per locale
+	LocalizedNotes []LocalizedNotes
+
+	// Migration guide section for releases with breaking changes, if generated
+	MigrationGuide string
+
+	// Outputs of the plugins that already ran, keyed by plugin name
+	PluginOutputs map[string]PluginOutput
 
 	// Metadata
 	DryRun    bool
 	Timestamp time.Time
 }
 
+// StructuredNotes is the typed form of the release notes.
+type StructuredNotes struct {
+	Title          string
+	Summary        string
+	Highlights     []string
+	Sections       []NotesSection
+	MigrationNotes []MigrationNote
+}
+
+// NotesSection is a titled list of release notes items.
+type NotesSection struct {
+	Title string
+	Items []string
+}
+
+// MigrationNote explains a breaking change and how to migrate to it.
+type MigrationNote struct {
+	Change    string
+	Migration string
+}
+
+// LocalizedNotes is the changelog and release notes in one locale.
+type LocalizedNotes struct {
+	Locale       string
+	Changelog    string
+	ReleaseNotes string
+}
+
+// PluginOutput is what a plugin reported for the release.
+type PluginOutput struct {
+	Outputs   map[string]any
+	Artifacts []Artifact
+}
+
 // ExecuteRequest represents a plugin execution request.
 type ExecuteRequest struct {
 	Hook    Hook
@@ -60,6 +104,7 @@ type ExecuteRequest struct {
 
 // ExecuteResponse represents a plugin execution response.
 type ExecuteResponse struct {
+	PluginID  PluginID // Plugin that produced the response, if known
 	Success   bool
 	Message   string
 	Error     string
@@ -153,12 +153,14 @@ func (e *SequentialPluginExecutor) ExecuteHook(ctx context.Context, hook Hook, r
 		resp, err := plugin.Execute(ctx, req)
 		if err != nil {
 			responses = append(responses, ExecuteResponse{
-				Success: false,
-				Error:   err.Error(),
+				PluginID: info.ID,
+				Success:  false,
+				Error:    err.Error(),
 			})
 			continue
 		}
 
+		resp.PluginID = info.ID
 		responses = append(responses, *resp)
 	}
 
@@ -225,19 +227,21 @@ func (e *ParallelPluginExecutor) ExecuteHook(ctx context.Context, hook Hook, rel
 		go func(idx int, p Plugin) {
 			defer wg.Done()
 
+			info := p.GetInfo()
+
 			// Check for context cancellation before executing
 			select {
 			case <-ctx.Done():
 				responses[idx] = ExecuteResponse{
-					Success: false,
-					Error:   ctx.Err().Error(),
+					PluginID: info.ID,
+					Success:  false,
+					Error:    ctx.Err().Error(),
 				}
 				errs[idx] = ctx.Err()
 				return
 			default:
 			}
 
-			info := p.GetInfo()
 			config := e.GetPluginConfig(info.ID)
 
 			req := ExecuteRequest{
@@ -250,13 +254,15 @@ func (e *ParallelPluginExecutor) ExecuteHook(ctx context.Context, hook Hook, rel
 			resp, err := p.Execute(ctx, req)
 			if err != nil {
 				responses[idx] = ExecuteResponse{
-					Success: false,
-					Error:   err.Error(),
+					PluginID: info.ID,
+					Success:  false,
+					Error:    err.Error(),
 				}
 				errs[idx] = err
 				return
 			}
 
+			resp.PluginID = info.ID
 			responses[idx] = *resp
 		}(i, plugin)
 	}
@@ -3,6 +3,7 @@ package release
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
@@ -12,6 +13,20 @@ import (
 // ReleaseID uniquely identifies a release.
 type ReleaseID string
 
+// ReleaseGroupID identifies a set of releases planned together,
+// e.g. the per-package releases of a monorepo.
+type ReleaseGroupID string
+
+// PackageRef identifies the monorepo package a release is scoped to.
+type PackageRef struct {
+	// Name is the package name used in tags and output.
+	Name string
+	// Path is the package directory relative to the repository root.
+	Path string
+	// TagPrefix is the tag prefix for this package (e.g., "api/v").
+	TagPrefix string
+}
+
 // Release is the aggregate root for the release management bounded context.
 // It encapsulates all invariants and business rules for the release workflow.
 type Release struct {
@@ -25,17 +40,42 @@ type Release struct {
 	notes    *ReleaseNotes
 	approval *Approval
 
+	// Individual approvals recorded towards the approval policy
+	approvals []Approval
+
 	// Context
 	branch         string
 	repositoryPath string
 	repositoryName string
 	tagName        string
 
+	// Monorepo scoping (nil/empty for single-package releases)
+	pkg     *PackageRef
+	groupID ReleaseGroupID
+
 	// Timestamps
 	createdAt   time.Time
 	updatedAt   time.Time
 	publishedAt *time.Time
 
+	// Plugin outcomes recorded while publishing
+	pluginExecutions []PluginExecution
+
+	// Outputs reported by plugins, keyed by plugin name
+	pluginOutputs map[string]PluginOutput
+
+	// Publish steps that completed, used to resume an interrupted publish
+	checkpoints []Checkpoint
+
+	// Rollback details, set once a release is rolled back
+	rollback *Rollback
+
+	// Scheduled publish time, set by SchedulePublish
+	schedule *PublishSchedule
+
+	// Freeze windows the release was published in despite the freeze
+	freezeOverrides []FreezeOverride
+
 	// Domain events (for event sourcing / event publishing)
 	domainEvents []DomainEvent
 
@@ -108,16 +148,100 @@ type ReleaseNotes struct {
 	Changelog   string
 	Summary     string
 	AIGenerated bool
+	Provider    string // AI provider that produced the notes, or "template"
 	GeneratedAt time.Time
+
+	// Structured form of the notes, passed to plugins as typed data.
+	Title          string
+	Highlights     []string
+	Sections       []NotesSection
+	MigrationNotes []MigrationNote
+
+	// Fidelity is the verification of AI-generated notes against the
+	// changeset. Nil for template notes.
+	Fidelity *FidelityReport
+
+	// Localized holds translations of the notes, one per locale. Editing
+	// the notes drops them, since they no longer match the source.
+	Localized []LocalizedNotes
+
+	// MigrationGuide is set for releases with breaking changes once a
+	// migration guide has been generated.
+	MigrationGuide *MigrationGuide
+}
+
+// LocalizedNotes is the changelog and summary of a release in one locale.
+type LocalizedNotes struct {
+	Locale    string // BCP 47 language tag, e.g. "de" or "pt-BR"
+	Changelog string
+	Summary   string
+}
+
+// ForLocale returns the notes in a locale, or nil if they were not translated.
+func (n *ReleaseNotes) ForLocale(locale string) *LocalizedNotes {
+	if n == nil {
+		return nil
+	}
+	for i := range n.Localized {
+		if strings.EqualFold(n.Localized[i].Locale, locale) {
+			return &n.Localized[i]
+		}
+	}
+	return nil
+}
+
+// NotesSection is a titled list of release notes items.
+type NotesSection struct {
+	Title string
+	Items []string
+}
+
+// MigrationNote explains a breaking change and how to migrate to it.
+type MigrationNote struct {
+	Change    string
+	Migration string
+}
+
+// MigrationGuide explains how to upgrade across the breaking changes of a
+// release, one entry per breaking commit.
+type MigrationGuide struct {
+	Entries     []MigrationEntry
+	Markdown    string // rendered section for MIGRATION.md
+	AIGenerated bool
+	Provider    string // AI provider that produced the guide, or "template"
+}
+
+// MigrationEntry is the upgrade guidance for one breaking commit.
+type MigrationEntry struct {
+	CommitHash string
+	Scope      string
+	Change     string // what changed
+	Before     string // usage before the release, e.g. an old signature
+	After      string // usage after the release
+	Migration  string // steps to migrate
 }
 
 // Approval holds release approval information.
 type Approval struct {
-	ApprovedBy   string
+	ApprovedBy string
+	// Operator is the account that recorded the approval, e.g. the local
+	// user or the CI actor. Policies count one approval per operator,
+	// whatever name it was recorded under.
+	Operator     string
 	ApprovedAt   time.Time
 	AutoApproved bool
 }
 
+// PluginExecution records the outcome of a plugin run for a release.
+type PluginExecution struct {
+	PluginName string
+	Hook       string
+	Success    bool
+	Message    string
+	Duration   time.Duration
+	ExecutedAt time.Time
+}
+
 // NewRelease creates a new Release aggregate.
 func NewRelease(id ReleaseID, branch, repoPath string) *Release {
 	r := &Release{
@@ -179,6 +303,26 @@ func (r *Release) TagName() string {
 	return r.tagName
 }
 
+// Package returns a copy of the package the release is scoped to.
+// Returns nil for repository-wide releases.
+func (r *Release) Package() *PackageRef {
+	if r.pkg == nil {
+		return nil
+	}
+	pkg := *r.pkg
+	return &pkg
+}
+
+// IsPackageRelease returns true if the release is scoped to a monorepo package.
+func (r *Release) IsPackageRelease() bool {
+	return r.pkg != nil
+}
+
+// GroupID returns the ID of the release group this release belongs to.
+func (r *Release) GroupID() ReleaseGroupID {
+	return r.groupID
+}
+
 // CreatedAt returns when the release was created.
 func (r *Release) CreatedAt() time.Time {
 	return r.createdAt
@@ -218,6 +362,16 @@ func (r *Release) Approval() *Approval {
 	}
 }
 
+// PluginExecutions returns the recorded plugin outcomes in execution order.
+func (r *Release) PluginExecutions() []PluginExecution {
+	if len(r.pluginExecutions) == 0 {
+		return nil
+	}
+	executions := make([]PluginExecution, len(r.pluginExecutions))
+	copy(executions, r.pluginExecutions)
+	return executions
+}
+
 // DomainEvents returns all uncommitted domain events.
 func (r *Release) DomainEvents() []DomainEvent {
 	return r.domainEvents
@@ -239,6 +393,23 @@ func (r *Release) SetRepositoryName(name string) {
 	r.updatedAt = time.Now()
 }
 
+// AssignPackage scopes the release to a monorepo package within a release group.
+// It can only be called before the release has been planned.
+func (r *Release) AssignPackage(pkg PackageRef, groupID ReleaseGroupID) error {
+	if r.state != StateInitialized {
+		return fmt.Errorf("%w: cannot assign package in state %s", ErrInvalidStateTransition, r.state)
+	}
+	if pkg.Name == "" {
+		return ErrInvalidPackage
+	}
+
+	r.pkg = &pkg
+	r.groupID = groupID
+	r.updatedAt = time.Now()
+
+	return nil
+}
+
 // SetPlan sets the release plan and transitions to StatePlanned.
 func (r *Release) SetPlan(plan *ReleasePlan) error {
 	if !r.state.CanTransitionTo(StatePlanned) {
@@ -297,6 +468,9 @@ func (r *Release) SetNotes(notes *ReleaseNotes) error {
 	r.state = StateNotesGenerated
 	r.updatedAt = time.Now()
 
+	// Approvals were given for the previous notes
+	r.resetApprovals()
+
 	r.addEvent(NewReleaseNotesGeneratedEvent(r.id, true, len(notes.Changelog)))
 
 	return nil
@@ -319,15 +493,86 @@ func (r *Release) UpdateNotes(changelog string) error {
 		Summary:     r.notes.Summary,
 		AIGenerated: false, // Mark as manually edited
 		GeneratedAt: r.notes.GeneratedAt,
+		// The migration guide follows the commits, not the notes text
+		MigrationGuide: r.notes.MigrationGuide,
 	}
 	r.updatedAt = time.Now()
 
+	// Earlier approvals did not see the edited notes
+	r.resetApprovals()
+
 	r.addEvent(NewReleaseNotesUpdatedEvent(r.id, len(changelog)))
 
 	return nil
 }
 
-// Approve approves the release and transitions to StateApproved.
+// SetLocalizedNotes replaces the translations of the release notes.
+// Translations are published with the notes, so earlier approvals did not
+// cover them: an approved release returns to StateNotesGenerated and its
+// approvals are discarded.
+func (r *Release) SetLocalizedNotes(localized []LocalizedNotes) error {
+	if r.state != StateNotesGenerated && r.state != StateApproved {
+		return fmt.Errorf("%w: can only localize notes in states %s and %s, current state is %s",
+			ErrInvalidStateTransition, StateNotesGenerated, StateApproved, r.state)
+	}
+
+	if r.notes == nil {
+		return ErrNilNotes
+	}
+
+	locales := make([]string, 0, len(localized))
+	for _, l := range localized {
+		if l.Locale == "" {
+			return ErrInvalidLocale
+		}
+		locales = append(locales, l.Locale)
+	}
+
+	r.notes.Localized = localized
+	r.updatedAt = time.Now()
+	r.reopenForReview()
+
+	r.addEvent(NewReleaseNotesLocalizedEvent(r.id, locales))
+
+	return nil
+}
+
+// SetMigrationGuide attaches a migration guide to the release notes. Like
+// translations, the guide is published with the notes, so an approved
+// release returns to StateNotesGenerated and its approvals are discarded.
+func (r *Release) SetMigrationGuide(guide *MigrationGuide) error {
+	if r.state != StateNotesGenerated && r.state != StateApproved {
+		return fmt.Errorf("%w: can only set a migration guide in states %s and %s, current state is %s",
+			ErrInvalidStateTransition, StateNotesGenerated, StateApproved, r.state)
+	}
+
+	if r.notes == nil {
+		return ErrNilNotes
+	}
+	if guide == nil {
+		return ErrNilMigrationGuide
+	}
+
+	r.notes.MigrationGuide = guide
+	r.updatedAt = time.Now()
+	r.reopenForReview()
+
+	r.addEvent(NewMigrationGuideGeneratedEvent(r.id, len(guide.Entries), guide.AIGenerated))
+
+	return nil
+}
+
+// reopenForReview discards the approvals of content that has changed since
+// and returns an approved release to StateNotesGenerated for review.
+func (r *Release) reopenForReview() {
+	r.resetApprovals()
+	if r.state == StateApproved {
+		r.state = StateNotesGenerated
+	}
+}
+
+// Approve approves the release and transitions to StateApproved, bypassing
+// any approval policy. Use AddApproval to enforce a policy.
 func (r *Release) Approve(approvedBy string, autoApproved bool) error {
 	if !r.state.CanTransitionTo(StateApproved) {
 		return fmt.Errorf("%w: cannot approve in state %s", ErrInvalidStateTransition, r.state)
@@ -338,6 +583,7 @@ func (r *Release) Approve(approvedBy string, autoApproved bool) error {
 		ApprovedAt:   time.Now(),
 		AutoApproved: autoApproved,
 	}
+	r.approvals = append(r.approvals, *r.approval)
 	r.state = StateApproved
 	r.updatedAt = time.Now()
 
@@ -420,6 +666,9 @@ func (r *Release) Retry() error {
 		r.state = StateInitialized
 	}
 	r.lastError = ""
+	r.checkpoints = nil
+	r.pluginOutputs = nil
+	r.schedule = nil
 	r.updatedAt = time.Now()
 
 	return nil
@@ -427,8 +676,23 @@ func (r *Release) Retry() error {
 
 // RecordPluginExecution records a plugin execution result.
 func (r *Release) RecordPluginExecution(pluginName, hook string, success bool, msg string, duration time.Duration) {
+	now := time.Now()
+	r.pluginExecutions = append(r.pluginExecutions, PluginExecution{
+		PluginName: pluginName,
+		Hook:       hook,
+		Success:    success,
+		Message:    msg,
+		Duration:   duration,
+		ExecutedAt: now,
+	})
 	r.addEvent(NewPluginExecutedEvent(r.id, pluginName, hook, success, msg, duration))
-	r.updatedAt = time.Now()
+	r.updatedAt = now
+}
+
+// RestorePluginExecutions restores recorded plugin outcomes from persisted data.
+// It should only be called by repository implementations.
+func (r *Release) RestorePluginExecutions(executions []PluginExecution) {
+	r.pluginExecutions = append([]PluginExecution(nil), executions...)
 }
 
 // ReconstructState reconstructs the release state from persisted data without
@@ -471,6 +735,7 @@ func (r *Release) CanProceedToPublish() bool {
 type ReleaseSummary struct {
 	ID             ReleaseID
 	State          ReleaseState
+	Package        string
 	Branch         string
 	Repository     string
 	CurrentVersion string
@@ -494,6 +759,10 @@ func (r *Release) Summary() ReleaseSummary {
 		UpdatedAt:  r.updatedAt,
 	}
 
+	if r.pkg != nil {
+		summary.Package = r.pkg.Name
+	}
+
 	if r.plan != nil {
 		summary.CurrentVersion = r.plan.CurrentVersion.String()
 		summary.NextVersion = r.plan.NextVersion.String()
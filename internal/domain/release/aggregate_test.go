@@ -2,6 +2,7 @@
 package release
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -459,6 +460,143 @@ func TestRelease_UpdateNotes_PreservesOriginalGeneratedAt(t *testing.T) {
 	}
 }
 
+func TestRelease_SetLocalizedNotes(t *testing.T) {
+	r := NewRelease("test-1", "main", "/repo")
+	_ = r.SetPlan(NewReleasePlan(
+		version.MustParse("1.0.0"),
+		version.MustParse("1.1.0"),
+		changes.ReleaseTypeMinor,
+		changes.NewChangeSet("cs-1", "v1.0.0", "HEAD"),
+		false,
+	))
+	_ = r.SetVersion(version.MustParse("1.1.0"), "v1.1.0")
+
+	if err := r.SetLocalizedNotes([]LocalizedNotes{{Locale: "de"}}); !errors.Is(err, ErrInvalidStateTransition) {
+		t.Errorf("SetLocalizedNotes() before notes error = %v, want ErrInvalidStateTransition", err)
+	}
+
+	_ = r.SetNotes(&ReleaseNotes{Changelog: "Added search", Summary: "Search is here"})
+	_ = r.Approve("alice", false)
+
+	if err := r.SetLocalizedNotes([]LocalizedNotes{{Changelog: "Suche"}}); !errors.Is(err, ErrInvalidLocale) {
+		t.Errorf("SetLocalizedNotes() without locale error = %v, want ErrInvalidLocale", err)
+	}
+
+	localized := []LocalizedNotes{
+		{Locale: "en", Changelog: "Added search", Summary: "Search is here"},
+		{Locale: "de", Changelog: "Suche hinzugefügt", Summary: "Die Suche ist da"},
+	}
+	if err := r.SetLocalizedNotes(localized); err != nil {
+		t.Fatalf("SetLocalizedNotes() error = %v", err)
+	}
+	if r.State() != StateNotesGenerated || r.Approval() != nil || len(r.Approvals()) != 0 {
+		t.Errorf("State = %s, approvals = %v, translations should need a new approval", r.State(), r.Approvals())
+	}
+	if got := r.Notes().ForLocale("DE"); got == nil || got.Summary != "Die Suche ist da" {
+		t.Errorf("ForLocale(DE) = %+v", got)
+	}
+	if got := r.Notes().ForLocale("ja"); got != nil {
+		t.Errorf("ForLocale(ja) = %+v, want nil", got)
+	}
+
+	found := false
+	for _, e := range r.DomainEvents() {
+		if e, ok := e.(ReleaseNotesLocalizedEvent); ok && len(e.Locales) == 2 {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("SetLocalizedNotes should generate release.notes_localized event")
+	}
+}
+
+func TestRelease_UpdateNotes_DropsLocalizedNotes(t *testing.T) {
+	r := NewRelease("test-1", "main", "/repo")
+	_ = r.SetPlan(NewReleasePlan(
+		version.MustParse("1.0.0"),
+		version.MustParse("1.1.0"),
+		changes.ReleaseTypeMinor,
+		changes.NewChangeSet("cs-1", "v1.0.0", "HEAD"),
+		false,
+	))
+	_ = r.SetVersion(version.MustParse("1.1.0"), "v1.1.0")
+	_ = r.SetNotes(&ReleaseNotes{Changelog: "Added search"})
+	_ = r.SetLocalizedNotes([]LocalizedNotes{{Locale: "de", Changelog: "Suche hinzugefügt"}})
+
+	_ = r.UpdateNotes("Added full-text search")
+
+	if len(r.Notes().Localized) != 0 {
+		t.Errorf("Localized = %+v, edited notes should drop stale translations", r.Notes().Localized)
+	}
+}
+
+func TestRelease_SetMigrationGuide(t *testing.T) {
+	r := NewRelease("test-1", "main", "/repo")
+	_ = r.SetPlan(NewReleasePlan(
+		version.MustParse("1.4.0"),
+		version.MustParse("2.0.0"),
+		changes.ReleaseTypeMajor,
+		changes.NewChangeSet("cs-1", "v1.4.0", "HEAD"),
+		false,
+	))
+	_ = r.SetVersion(version.MustParse("2.0.0"), "v2.0.0")
+
+	guide := &MigrationGuide{
+		Entries:  []MigrationEntry{{CommitHash: "abc123", Change: "Config moved", Migration: "Rename the file"}},
+		Markdown: "## Migrating to 2.0.0",
+		Provider: "template",
+	}
+	if err := r.SetMigrationGuide(guide); !errors.Is(err, ErrInvalidStateTransition) {
+		t.Errorf("SetMigrationGuide() before notes error = %v, want ErrInvalidStateTransition", err)
+	}
+
+	_ = r.SetNotes(&ReleaseNotes{Changelog: "Moved the config"})
+	if err := r.SetMigrationGuide(nil); !errors.Is(err, ErrNilMigrationGuide) {
+		t.Errorf("SetMigrationGuide(nil) error = %v, want ErrNilMigrationGuide", err)
+	}
+
+	_ = r.Approve("alice", false)
+	if err := r.SetMigrationGuide(guide); err != nil {
+		t.Fatalf("SetMigrationGuide() error = %v", err)
+	}
+	if r.State() != StateNotesGenerated || r.Approval() != nil || len(r.Approvals()) != 0 {
+		t.Errorf("State = %s, approvals = %v, the migration guide should need a new approval", r.State(), r.Approvals())
+	}
+	if r.Notes().MigrationGuide != guide {
+		t.Errorf("MigrationGuide = %+v, want %+v", r.Notes().MigrationGuide, guide)
+	}
+
+	found := false
+	for _, e := range r.DomainEvents() {
+		if e, ok := e.(MigrationGuideGeneratedEvent); ok && e.Entries == 1 {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("SetMigrationGuide should generate release.migration_guide_generated event")
+	}
+}
+
+func TestRelease_UpdateNotes_KeepsMigrationGuide(t *testing.T) {
+	r := NewRelease("test-1", "main", "/repo")
+	_ = r.SetPlan(NewReleasePlan(
+		version.MustParse("1.4.0"),
+		version.MustParse("2.0.0"),
+		changes.ReleaseTypeMajor,
+		changes.NewChangeSet("cs-1", "v1.4.0", "HEAD"),
+		false,
+	))
+	_ = r.SetVersion(version.MustParse("2.0.0"), "v2.0.0")
+	_ = r.SetNotes(&ReleaseNotes{Changelog: "Moved the config"})
+	_ = r.SetMigrationGuide(&MigrationGuide{Markdown: "## Migrating to 2.0.0"})
+
+	_ = r.UpdateNotes("Moved the config file")
+
+	if r.Notes().MigrationGuide == nil {
+		t.Error("MigrationGuide = nil, editing the notes should keep the guide")
+	}
+}
+
 func TestRelease_Approve(t *testing.T) {
 	r := NewRelease("test-1", "main", "/repo")
 
@@ -811,6 +949,57 @@ func TestRelease_SetRepositoryName(t *testing.T) {
 	}
 }
 
+func TestRelease_AssignPackage(t *testing.T) {
+	r := NewRelease("test-1", "main", "/repo")
+
+	if r.IsPackageRelease() {
+		t.Error("IsPackageRelease() = true, want false for new release")
+	}
+
+	pkg := PackageRef{Name: "api", Path: "services/api", TagPrefix: "api/v"}
+	if err := r.AssignPackage(pkg, "grp-1"); err != nil {
+		t.Fatalf("AssignPackage() error = %v", err)
+	}
+
+	if !r.IsPackageRelease() {
+		t.Error("IsPackageRelease() = false, want true")
+	}
+	if got := r.Package(); got == nil || *got != pkg {
+		t.Errorf("Package() = %v, want %v", got, pkg)
+	}
+	if r.GroupID() != "grp-1" {
+		t.Errorf("GroupID() = %v, want grp-1", r.GroupID())
+	}
+	if r.Summary().Package != "api" {
+		t.Errorf("Summary().Package = %v, want api", r.Summary().Package)
+	}
+
+	// Returned package is a copy
+	r.Package().TagPrefix = "changed/"
+	if r.Package().TagPrefix != "api/v" {
+		t.Error("Package() should return a copy")
+	}
+}
+
+func TestRelease_AssignPackage_Invalid(t *testing.T) {
+	r := NewRelease("test-1", "main", "/repo")
+	if err := r.AssignPackage(PackageRef{}, "grp-1"); err != ErrInvalidPackage {
+		t.Errorf("AssignPackage() error = %v, want ErrInvalidPackage", err)
+	}
+
+	planned := NewRelease("test-2", "main", "/repo")
+	_ = planned.SetPlan(NewReleasePlan(
+		version.MustParse("1.0.0"),
+		version.MustParse("1.0.1"),
+		changes.ReleaseTypePatch,
+		nil,
+		false,
+	))
+	if err := planned.AssignPackage(PackageRef{Name: "api"}, "grp-1"); err == nil {
+		t.Error("AssignPackage() should fail after planning")
+	}
+}
+
 func TestRelease_Summary(t *testing.T) {
 	r := NewRelease("test-1", "main", "/repo")
 	r.SetRepositoryName("my-repo")
@@ -1023,3 +1212,27 @@ func TestRelease_FullWorkflow(t *testing.T) {
 		}
 	}
 }
+
+func TestRelease_PluginExecutions(t *testing.T) {
+	r := NewRelease("release-1", "main", "/repo")
+
+	r.RecordPluginExecution("github", "post_publish", true, "Created release", 2*time.Second)
+	r.RecordPluginExecution("slack", "on_success", false, "webhook returned 500", time.Second)
+
+	executions := r.PluginExecutions()
+	if len(executions) != 2 {
+		t.Fatalf("PluginExecutions() count = %d, want 2", len(executions))
+	}
+	if executions[0].PluginName != "github" || !executions[0].Success {
+		t.Errorf("PluginExecutions()[0] = %+v, want successful github execution", executions[0])
+	}
+	if executions[1].PluginName != "slack" || executions[1].Success {
+		t.Errorf("PluginExecutions()[1] = %+v, want failed slack execution", executions[1])
+	}
+
+	// The returned slice must be a copy
+	executions[0].PluginName = "modified"
+	if r.PluginExecutions()[0].PluginName != "github" {
+		t.Error("PluginExecutions() should return a copy")
+	}
+}
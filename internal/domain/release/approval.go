@@ -0,0 +1,277 @@
+// Package release provides domain types for release management.
+package release
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ApprovalGroup is a named set of people whose approval counts towards a
+// group requirement, e.g. "release-managers".
+type ApprovalGroup struct {
+	Name    string
+	Members []string
+	// MinApprovals is the number of approvals required from the members.
+	MinApprovals int
+}
+
+// ApprovalPolicy describes the approvals a release needs before it can be
+// published.
+type ApprovalPolicy struct {
+	// RequiredApprovals is the number of distinct approvers required.
+	RequiredApprovals int
+	// Groups must each contribute at least their MinApprovals.
+	Groups []ApprovalGroup
+	// ExcludeBreakingAuthors rejects approvals from authors of breaking commits.
+	ExcludeBreakingAuthors bool
+	// IdentityDomains lists the email domains whose addresses match bare user
+	// names, so "alice@example.com" counts as "alice" for "example.com".
+	// Other emails only match the same email.
+	IdentityDomains []string
+}
+
+// DefaultApprovalPolicy returns the policy used when none is configured:
+// a single approval from anyone.
+func DefaultApprovalPolicy() ApprovalPolicy {
+	return ApprovalPolicy{RequiredApprovals: 1}
+}
+
+// ApprovalGroupStatus reports the progress of a single group requirement.
+type ApprovalGroupStatus struct {
+	Name      string
+	Required  int
+	Approvals int
+	// Pending lists the members who have not approved yet.
+	Pending []string
+}
+
+// Satisfied returns true if the group has given enough approvals.
+func (s ApprovalGroupStatus) Satisfied() bool {
+	return s.Approvals >= s.Required
+}
+
+// ApprovalStatus reports the progress towards satisfying an approval policy.
+type ApprovalStatus struct {
+	Satisfied  bool
+	Approvals  int
+	Required   int
+	ApprovedBy []string
+	Groups     []ApprovalGroupStatus
+}
+
+// Remaining returns the number of approvals still required overall.
+func (s ApprovalStatus) Remaining() int {
+	return max(s.Required-s.Approvals, 0)
+}
+
+// String describes what is still required, e.g.
+// "1 more approval required; release-managers: 0/1 (pending: alice, bob)".
+func (s ApprovalStatus) String() string {
+	if s.Satisfied {
+		return fmt.Sprintf("approved by %s", strings.Join(s.ApprovedBy, ", "))
+	}
+
+	var parts []string
+	if n := s.Remaining(); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d more %s required", n, pluralize(n, "approval", "approvals")))
+	}
+	for _, g := range s.Groups {
+		if g.Satisfied() {
+			continue
+		}
+		part := fmt.Sprintf("%s: %d/%d", g.Name, g.Approvals, g.Required)
+		if len(g.Pending) > 0 {
+			part += fmt.Sprintf(" (pending: %s)", strings.Join(g.Pending, ", "))
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Evaluate checks recorded approvals against the policy. Approvals from
+// authors of breaking commits are not counted when the policy excludes them,
+// and an operator's further approvals are not counted under other names.
+func (p ApprovalPolicy) Evaluate(approvals []Approval, breakingAuthors []string) ApprovalStatus {
+	status := ApprovalStatus{Required: max(p.RequiredApprovals, 1)}
+
+	var operators []string
+	for _, a := range approvals {
+		if p.ExcludeBreakingAuthors && p.matchesAnyIdentity(a.ApprovedBy, breakingAuthors) {
+			continue
+		}
+		if p.matchesAnyIdentity(a.ApprovedBy, status.ApprovedBy) || slices.Contains(operators, a.Operator) {
+			continue
+		}
+		if a.Operator != "" {
+			operators = append(operators, a.Operator)
+		}
+		status.ApprovedBy = append(status.ApprovedBy, a.ApprovedBy)
+	}
+	status.Approvals = len(status.ApprovedBy)
+
+	groupsSatisfied := true
+	for _, g := range p.Groups {
+		gs := ApprovalGroupStatus{Name: g.Name, Required: max(g.MinApprovals, 1)}
+		for _, member := range g.Members {
+			if p.matchesAnyIdentity(member, status.ApprovedBy) {
+				gs.Approvals++
+			} else {
+				gs.Pending = append(gs.Pending, member)
+			}
+		}
+		groupsSatisfied = groupsSatisfied && gs.Satisfied()
+		status.Groups = append(status.Groups, gs)
+	}
+
+	status.Satisfied = status.Approvals >= status.Required && groupsSatisfied
+	return status
+}
+
+// CheckApprover returns an error if the approver may not approve under the policy.
+func (p ApprovalPolicy) CheckApprover(approver string, breakingAuthors []string) error {
+	if strings.TrimSpace(approver) == "" {
+		return fmt.Errorf("%w: approver is required", ErrApproverNotEligible)
+	}
+	if p.ExcludeBreakingAuthors && p.matchesAnyIdentity(approver, breakingAuthors) {
+		return fmt.Errorf("%w: %s authored a breaking change in this release", ErrApproverNotEligible, approver)
+	}
+	return nil
+}
+
+// Approvals returns a copy of all recorded approvals, oldest first.
+func (r *Release) Approvals() []Approval {
+	if len(r.approvals) == 0 {
+		return nil
+	}
+	approvals := make([]Approval, len(r.approvals))
+	copy(approvals, r.approvals)
+	return approvals
+}
+
+// BreakingChangeAuthors returns the names and emails of the authors of
+// breaking commits in the release plan.
+func (r *Release) BreakingChangeAuthors() []string {
+	if r.plan == nil || r.plan.GetChangeSet() == nil {
+		return nil
+	}
+
+	var authors []string
+	for _, c := range r.plan.GetChangeSet().Commits() {
+		if !c.IsBreaking() {
+			continue
+		}
+		for _, identity := range []string{c.Author(), c.AuthorEmail()} {
+			if identity != "" && !slices.Contains(authors, identity) {
+				authors = append(authors, identity)
+			}
+		}
+	}
+	return authors
+}
+
+// ApprovalStatus evaluates the recorded approvals against a policy.
+func (r *Release) ApprovalStatus(policy ApprovalPolicy) ApprovalStatus {
+	return policy.Evaluate(r.approvals, r.BreakingChangeAuthors())
+}
+
+// AddApproval records an approval by approvedBy, run by operator. Once the
+// policy is satisfied the release transitions to StateApproved; until then it
+// stays in StateNotesGenerated.
+func (r *Release) AddApproval(approvedBy, operator string, autoApproved bool, policy ApprovalPolicy) (ApprovalStatus, error) {
+	if r.state != StateNotesGenerated {
+		return ApprovalStatus{}, fmt.Errorf("%w: cannot approve in state %s", ErrInvalidStateTransition, r.state)
+	}
+
+	breakingAuthors := r.BreakingChangeAuthors()
+	if err := policy.CheckApprover(approvedBy, breakingAuthors); err != nil {
+		return ApprovalStatus{}, err
+	}
+	for _, a := range r.approvals {
+		if policy.sameIdentity(a.ApprovedBy, approvedBy) {
+			return ApprovalStatus{}, fmt.Errorf("%w: %s", ErrAlreadyApprovedBy, approvedBy)
+		}
+		if operator != "" && a.Operator == operator {
+			return ApprovalStatus{}, fmt.Errorf("%w: %s (recorded as %s)", ErrAlreadyApprovedBy, operator, a.ApprovedBy)
+		}
+	}
+
+	approval := Approval{
+		ApprovedBy:   approvedBy,
+		Operator:     operator,
+		ApprovedAt:   time.Now(),
+		AutoApproved: autoApproved,
+	}
+	r.approvals = append(r.approvals, approval)
+	r.updatedAt = approval.ApprovedAt
+
+	status := policy.Evaluate(r.approvals, breakingAuthors)
+	r.addEvent(NewReleaseApprovalRecordedEvent(r.id, approvedBy, status.Approvals, status.Required))
+
+	if status.Satisfied {
+		r.approval = &approval
+		r.state = StateApproved
+		r.addEvent(NewReleaseApprovedEvent(r.id, approvedBy))
+	}
+
+	return status, nil
+}
+
+// RestoreApprovals restores recorded approvals from persisted data.
+// It should only be called by repository implementations.
+func (r *Release) RestoreApprovals(approvals []Approval) {
+	r.approvals = append([]Approval(nil), approvals...)
+}
+
+// resetApprovals discards recorded approvals, e.g. because the notes they
+// approved have changed. A publish schedule set after approval goes with them.
+func (r *Release) resetApprovals() {
+	r.approvals = nil
+	r.approval = nil
+	r.schedule = nil
+}
+
+// matchesAnyIdentity reports whether the identity matches any of the others.
+func (p ApprovalPolicy) matchesAnyIdentity(identity string, others []string) bool {
+	for _, other := range others {
+		if p.sameIdentity(identity, other) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameIdentity compares user names and emails case-insensitively. A user
+// name also matches an email whose domain is one of the policy's identity
+// domains, so "alice" matches "alice@example.com" for "example.com".
+func (p ApprovalPolicy) sameIdentity(a, b string) bool {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	if a == "" || b == "" {
+		return false
+	}
+	if strings.EqualFold(a, b) {
+		return true
+	}
+	name, email := a, b
+	if strings.Contains(name, "@") {
+		name, email = email, name
+	}
+	if strings.Contains(name, "@") {
+		return false
+	}
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok || !strings.EqualFold(local, name) {
+		return false
+	}
+	return slices.ContainsFunc(p.IdentityDomains, func(d string) bool {
+		return strings.EqualFold(strings.TrimSpace(d), domain)
+	})
+}
+
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
@@ -0,0 +1,241 @@
+// Package release provides domain types for release management.
+package release
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+)
+
+// newReleaseAwaitingApproval creates a release with notes whose changeset
+// contains a breaking commit by carol.
+func newReleaseAwaitingApproval() *Release {
+	r := NewRelease("test-1", "main", "/repo")
+	changeSet := changes.NewChangeSet("cs-1", "v1.0.0", "HEAD")
+	changeSet.AddCommit(changes.NewConventionalCommit("abc123", changes.CommitTypeFeat, "new api",
+		changes.WithBreaking("old api removed"),
+		changes.WithAuthor("Carol", "carol@example.com")))
+	changeSet.AddCommit(changes.NewConventionalCommit("def456", changes.CommitTypeFix, "typo",
+		changes.WithAuthor("Dave", "dave@example.com")))
+	plan := NewReleasePlan(
+		version.MustParse("1.0.0"),
+		version.MustParse("2.0.0"),
+		changes.ReleaseTypeMajor,
+		changeSet,
+		false,
+	)
+	_ = r.SetPlan(plan)
+	_ = r.SetVersion(version.MustParse("2.0.0"), "v2.0.0")
+	_ = r.SetNotes(&ReleaseNotes{Changelog: "test"})
+	r.ClearDomainEvents()
+	return r
+}
+
+func regulatedPolicy() ApprovalPolicy {
+	return ApprovalPolicy{
+		RequiredApprovals: 2,
+		Groups: []ApprovalGroup{
+			{Name: "release-managers", Members: []string{"alice", "bob"}, MinApprovals: 1},
+		},
+		ExcludeBreakingAuthors: true,
+	}
+}
+
+func TestApprovalPolicy_Evaluate(t *testing.T) {
+	breaking := []string{"Carol", "carol@example.com"}
+
+	tests := []struct {
+		name          string
+		approvers     []string
+		wantSatisfied bool
+		wantApprovals int
+	}{
+		{"no approvals", nil, false, 0},
+		{"one manager", []string{"alice"}, false, 1},
+		{"two without manager", []string{"dave", "erin"}, false, 2},
+		{"manager and another", []string{"dave", "alice"}, true, 2},
+		{"duplicate approver counted once", []string{"alice", "ALICE"}, false, 1},
+		{"breaking author not counted", []string{"alice", "carol"}, false, 1},
+		{"email matches user name", []string{"alice", "carol@example.com"}, false, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var approvals []Approval
+			for _, a := range tt.approvers {
+				approvals = append(approvals, Approval{ApprovedBy: a})
+			}
+
+			status := regulatedPolicy().Evaluate(approvals, breaking)
+			if status.Satisfied != tt.wantSatisfied {
+				t.Errorf("Satisfied = %v, want %v (%s)", status.Satisfied, tt.wantSatisfied, status)
+			}
+			if status.Approvals != tt.wantApprovals {
+				t.Errorf("Approvals = %d, want %d", status.Approvals, tt.wantApprovals)
+			}
+		})
+	}
+}
+
+func TestApprovalPolicy_IdentityDomains(t *testing.T) {
+	policy := regulatedPolicy()
+	policy.IdentityDomains = []string{"example.com"}
+
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"same name", "alice", "ALICE", true},
+		{"same email", "alice@evil.example", "Alice@evil.example", true},
+		{"name and email on identity domain", "alice", "alice@example.com", true},
+		{"name and foreign email", "alice", "alice@evil.example", false},
+		{"different emails with the same name", "alice@example.com", "alice@evil.example", false},
+		{"different names", "alice", "bob@example.com", false},
+		{"empty", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.sameIdentity(tt.a, tt.b); got != tt.want {
+				t.Errorf("sameIdentity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+
+	// A foreign email does not count as the required group member
+	status := policy.Evaluate([]Approval{{ApprovedBy: "alice@evil.example"}, {ApprovedBy: "dave"}}, nil)
+	if status.Satisfied || status.Groups[0].Approvals != 0 {
+		t.Errorf("Evaluate() = %+v, want release-managers still pending", status)
+	}
+	status = policy.Evaluate([]Approval{{ApprovedBy: "alice@example.com"}, {ApprovedBy: "dave"}}, nil)
+	if !status.Satisfied {
+		t.Errorf("Evaluate() = %s, want satisfied", status)
+	}
+
+	// Without identity domains emails only match themselves
+	if regulatedPolicy().sameIdentity("alice", "alice@example.com") {
+		t.Error("a user name should not match an email without identity domains")
+	}
+}
+
+func TestApprovalStatus_String(t *testing.T) {
+	status := regulatedPolicy().Evaluate([]Approval{{ApprovedBy: "dave"}}, nil)
+
+	want := "1 more approval required; release-managers: 0/1 (pending: alice, bob)"
+	if got := status.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestRelease_AddApproval(t *testing.T) {
+	r := newReleaseAwaitingApproval()
+	policy := regulatedPolicy()
+
+	status, err := r.AddApproval("alice", "alice", false, policy)
+	if err != nil {
+		t.Fatalf("AddApproval(alice) error = %v", err)
+	}
+	if status.Satisfied || r.State() != StateNotesGenerated || r.IsApproved() {
+		t.Fatalf("release should still await approvals, state = %s", r.State())
+	}
+
+	if _, err := r.AddApproval("alice", "alice", false, policy); !errors.Is(err, ErrAlreadyApprovedBy) {
+		t.Errorf("AddApproval(alice) again error = %v, want ErrAlreadyApprovedBy", err)
+	}
+	if _, err := r.AddApproval("carol", "carol", false, policy); !errors.Is(err, ErrApproverNotEligible) {
+		t.Errorf("AddApproval(carol) error = %v, want ErrApproverNotEligible", err)
+	}
+
+	status, err = r.AddApproval("dave", "dave", false, policy)
+	if err != nil {
+		t.Fatalf("AddApproval(dave) error = %v", err)
+	}
+	if !status.Satisfied || r.State() != StateApproved {
+		t.Fatalf("release should be approved, state = %s (%s)", r.State(), status)
+	}
+	if r.Approval().ApprovedBy != "dave" {
+		t.Errorf("Approval().ApprovedBy = %q, want dave", r.Approval().ApprovedBy)
+	}
+	if len(r.Approvals()) != 2 {
+		t.Errorf("Approvals() count = %d, want 2", len(r.Approvals()))
+	}
+
+	var names []string
+	for _, e := range r.DomainEvents() {
+		names = append(names, e.EventName())
+	}
+	want := []string{"release.approval_recorded", "release.approval_recorded", "release.approved"}
+	if len(names) != len(want) {
+		t.Fatalf("events = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("events = %v, want %v", names, want)
+			break
+		}
+	}
+}
+
+func TestRelease_AddApproval_OneOperator(t *testing.T) {
+	r := newReleaseAwaitingApproval()
+	policy := ApprovalPolicy{RequiredApprovals: 2}
+
+	if _, err := r.AddApproval("alice", "felix", false, policy); err != nil {
+		t.Fatalf("AddApproval(alice) error = %v", err)
+	}
+	// The same account cannot approve again under another name
+	if _, err := r.AddApproval("bob", "felix", false, policy); !errors.Is(err, ErrAlreadyApprovedBy) {
+		t.Errorf("AddApproval(bob) by the same operator error = %v, want ErrAlreadyApprovedBy", err)
+	}
+	if r.State() != StateNotesGenerated {
+		t.Errorf("State() = %s, want %s", r.State(), StateNotesGenerated)
+	}
+
+	// Approvals recorded elsewhere are counted once per operator as well
+	status := policy.Evaluate([]Approval{
+		{ApprovedBy: "alice", Operator: "felix"},
+		{ApprovedBy: "bob", Operator: "felix"},
+	}, nil)
+	if status.Satisfied || status.Approvals != 1 {
+		t.Errorf("Evaluate() = %+v, want one approval", status)
+	}
+
+	if _, err := r.AddApproval("bob", "bob", false, policy); err != nil {
+		t.Fatalf("AddApproval(bob) error = %v", err)
+	}
+	if r.State() != StateApproved {
+		t.Errorf("State() = %s, want %s", r.State(), StateApproved)
+	}
+}
+
+func TestRelease_AddApproval_InvalidState(t *testing.T) {
+	r := NewRelease("test-1", "main", "/repo")
+	if _, err := r.AddApproval("alice", "alice", false, DefaultApprovalPolicy()); !errors.Is(err, ErrInvalidStateTransition) {
+		t.Errorf("AddApproval() error = %v, want ErrInvalidStateTransition", err)
+	}
+}
+
+func TestRelease_UpdateNotes_ResetsApprovals(t *testing.T) {
+	r := newReleaseAwaitingApproval()
+	if _, err := r.AddApproval("alice", "alice", false, regulatedPolicy()); err != nil {
+		t.Fatalf("AddApproval() error = %v", err)
+	}
+
+	if err := r.UpdateNotes("edited"); err != nil {
+		t.Fatalf("UpdateNotes() error = %v", err)
+	}
+	if len(r.Approvals()) != 0 {
+		t.Errorf("Approvals() = %v, want none after editing notes", r.Approvals())
+	}
+}
+
+func TestRelease_BreakingChangeAuthors(t *testing.T) {
+	r := newReleaseAwaitingApproval()
+
+	authors := r.BreakingChangeAuthors()
+	if len(authors) != 2 || authors[0] != "Carol" || authors[1] != "carol@example.com" {
+		t.Errorf("BreakingChangeAuthors() = %v, want [Carol carol@example.com]", authors)
+	}
+}
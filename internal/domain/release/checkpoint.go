@@ -0,0 +1,141 @@
+// Package release provides domain types for release management.
+package release
+
+import (
+	"fmt"
+	"maps"
+	"time"
+)
+
+// PublishStep identifies a step of the publish workflow that can be checkpointed.
+type PublishStep string
+
+const (
+	// StepTagCreated records that the release tag was created.
+	StepTagCreated PublishStep = "tag_created"
+	// StepTagPushed records that the release tag was pushed to the remote.
+	StepTagPushed PublishStep = "tag_pushed"
+	// StepPlugin records that a plugin succeeded for a hook.
+	StepPlugin PublishStep = "plugin"
+	// StepHook records that every plugin for a hook was run, whether or not
+	// it succeeded. Failed plugins are tracked through their executions.
+	StepHook PublishStep = "hook"
+)
+
+// Checkpoint records a publish step that completed successfully,
+// so that a resumed publish can skip it.
+type Checkpoint struct {
+	Step        PublishStep
+	PluginName  string         // Set for plugin steps
+	Hook        string         // Set for plugin and hook steps
+	Outputs     map[string]any // Outputs reported by the plugin, if any
+	CompletedAt time.Time
+}
+
+// matches reports whether the checkpoint is for the given step.
+func (c Checkpoint) matches(step PublishStep, pluginName, hook string) bool {
+	return c.Step == step && c.PluginName == pluginName && c.Hook == hook
+}
+
+// Checkpoints returns the completed publish steps in completion order.
+func (r *Release) Checkpoints() []Checkpoint {
+	if len(r.checkpoints) == 0 {
+		return nil
+	}
+	checkpoints := make([]Checkpoint, len(r.checkpoints))
+	copy(checkpoints, r.checkpoints)
+	return checkpoints
+}
+
+// RecordCheckpoint records a completed publish step.
+// Recording the same step again replaces the earlier checkpoint.
+func (r *Release) RecordCheckpoint(cp Checkpoint) {
+	if cp.CompletedAt.IsZero() {
+		cp.CompletedAt = time.Now()
+	}
+	cp.Outputs = maps.Clone(cp.Outputs)
+
+	for i, existing := range r.checkpoints {
+		if existing.matches(cp.Step, cp.PluginName, cp.Hook) {
+			r.checkpoints[i] = cp
+			r.updatedAt = cp.CompletedAt
+			return
+		}
+	}
+	r.checkpoints = append(r.checkpoints, cp)
+	r.updatedAt = cp.CompletedAt
+}
+
+// HasCheckpoint returns true if the given publish step already completed.
+// Tag steps are identified by step alone, hook steps by the hook, and plugin
+// steps by both the plugin name and hook.
+func (r *Release) HasCheckpoint(step PublishStep, pluginName, hook string) bool {
+	for _, cp := range r.checkpoints {
+		if cp.matches(step, pluginName, hook) {
+			return true
+		}
+	}
+	return false
+}
+
+// RestoreCheckpoints restores completed publish steps from persisted data.
+// It should only be called by repository implementations.
+func (r *Release) RestoreCheckpoints(checkpoints []Checkpoint) {
+	r.checkpoints = append([]Checkpoint(nil), checkpoints...)
+}
+
+// FailedPluginSteps returns the latest failed execution of every plugin and hook
+// that has not succeeded since, in execution order.
+func (r *Release) FailedPluginSteps() []PluginExecution {
+	type stepKey struct{ plugin, hook string }
+
+	latest := make(map[stepKey]int, len(r.pluginExecutions))
+	for i, exec := range r.pluginExecutions {
+		latest[stepKey{exec.PluginName, exec.Hook}] = i
+	}
+
+	var failed []PluginExecution
+	for i, exec := range r.pluginExecutions {
+		if latest[stepKey{exec.PluginName, exec.Hook}] != i || exec.Success {
+			continue
+		}
+		if r.HasCheckpoint(StepPlugin, exec.PluginName, exec.Hook) {
+			continue
+		}
+		failed = append(failed, exec)
+	}
+	return failed
+}
+
+// CanResumePublish returns true if the release has an interrupted or
+// partially failed publish that can be resumed.
+func (r *Release) CanResumePublish() bool {
+	switch r.state {
+	case StateFailed, StatePublishing:
+		return r.plan != nil && r.version != nil
+	case StatePublished:
+		return len(r.FailedPluginSteps()) > 0
+	default:
+		return false
+	}
+}
+
+// ResumePublishing prepares the release to resume an interrupted publish.
+// A failed release moves back to StatePublishing; a published release with
+// failed plugin steps keeps its state so only those steps are re-run.
+func (r *Release) ResumePublishing() error {
+	if !r.CanResumePublish() {
+		return fmt.Errorf("%w: no publish to resume in state %s", ErrInvalidStateTransition, r.state)
+	}
+
+	previousState := r.state
+	if r.state == StateFailed {
+		r.state = StatePublishing
+		r.lastError = ""
+	}
+	r.updatedAt = time.Now()
+
+	r.addEvent(NewReleasePublishingResumedEvent(r.id, previousState, len(r.checkpoints)))
+
+	return nil
+}
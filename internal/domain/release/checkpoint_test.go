@@ -0,0 +1,202 @@
+// Package release provides domain types for release management.
+package release
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+)
+
+// newPublishingRelease creates a release in the publishing state.
+func newPublishingRelease() *Release {
+	r := NewRelease("test-1", "main", "/repo")
+	changeSet := changes.NewChangeSet("cs-1", "v1.0.0", "HEAD")
+	plan := NewReleasePlan(
+		version.MustParse("1.0.0"),
+		version.MustParse("1.1.0"),
+		changes.ReleaseTypeMinor,
+		changeSet,
+		false,
+	)
+	_ = r.SetPlan(plan)
+	_ = r.SetVersion(version.MustParse("1.1.0"), "v1.1.0")
+	_ = r.SetNotes(&ReleaseNotes{Changelog: "test"})
+	_ = r.Approve("user", false)
+	_ = r.StartPublishing(nil)
+	return r
+}
+
+func TestRelease_RecordCheckpoint(t *testing.T) {
+	r := newPublishingRelease()
+
+	r.RecordCheckpoint(Checkpoint{Step: StepTagCreated})
+	r.RecordCheckpoint(Checkpoint{Step: StepPlugin, PluginName: "github", Hook: "post_publish", Outputs: map[string]any{"id": 1}})
+	r.RecordCheckpoint(Checkpoint{Step: StepPlugin, PluginName: "github", Hook: "post_publish", Outputs: map[string]any{"id": 2}})
+
+	checkpoints := r.Checkpoints()
+	if len(checkpoints) != 2 {
+		t.Fatalf("Checkpoints() count = %d, want 2", len(checkpoints))
+	}
+	if checkpoints[1].Outputs["id"] != 2 {
+		t.Errorf("recording a step again should replace it, got outputs %v", checkpoints[1].Outputs)
+	}
+	if checkpoints[0].CompletedAt.IsZero() {
+		t.Error("CompletedAt should be set")
+	}
+
+	if !r.HasCheckpoint(StepTagCreated, "", "") {
+		t.Error("HasCheckpoint(tag_created) = false, want true")
+	}
+	if r.HasCheckpoint(StepTagPushed, "", "") {
+		t.Error("HasCheckpoint(tag_pushed) = true, want false")
+	}
+	if r.HasCheckpoint(StepPlugin, "github", "on_success") {
+		t.Error("HasCheckpoint(github, on_success) = true, want false")
+	}
+}
+
+func TestRelease_FailedPluginSteps(t *testing.T) {
+	r := newPublishingRelease()
+
+	r.RecordPluginExecution("github", "post_publish", true, "ok", time.Second)
+	r.RecordCheckpoint(Checkpoint{Step: StepPlugin, PluginName: "github", Hook: "post_publish"})
+	r.RecordPluginExecution("npm", "post_publish", false, "timeout", time.Second)
+	r.RecordPluginExecution("slack", "on_success", false, "500", time.Second)
+	r.RecordPluginExecution("slack", "on_success", true, "ok", time.Second)
+	r.RecordCheckpoint(Checkpoint{Step: StepPlugin, PluginName: "slack", Hook: "on_success"})
+
+	failed := r.FailedPluginSteps()
+	if len(failed) != 1 {
+		t.Fatalf("FailedPluginSteps() count = %d, want 1: %+v", len(failed), failed)
+	}
+	if failed[0].PluginName != "npm" || failed[0].Hook != "post_publish" {
+		t.Errorf("FailedPluginSteps()[0] = %+v, want npm post_publish", failed[0])
+	}
+}
+
+func TestRelease_ResumePublishing(t *testing.T) {
+	t.Run("from failed", func(t *testing.T) {
+		r := newPublishingRelease()
+		r.RecordCheckpoint(Checkpoint{Step: StepTagCreated})
+		_ = r.MarkFailed("push failed", true)
+		r.ClearDomainEvents()
+
+		if !r.CanResumePublish() {
+			t.Fatal("CanResumePublish() = false, want true")
+		}
+		if err := r.ResumePublishing(); err != nil {
+			t.Fatalf("ResumePublishing() error = %v", err)
+		}
+		if r.State() != StatePublishing {
+			t.Errorf("State() = %v, want %v", r.State(), StatePublishing)
+		}
+		if r.LastError() != "" {
+			t.Errorf("LastError() = %q, want empty", r.LastError())
+		}
+		if !r.HasCheckpoint(StepTagCreated, "", "") {
+			t.Error("resuming should keep checkpoints")
+		}
+
+		events := r.DomainEvents()
+		if len(events) != 1 || events[0].EventName() != "release.publishing_resumed" {
+			t.Errorf("DomainEvents() = %v, want release.publishing_resumed", events)
+		}
+	})
+
+	t.Run("from interrupted publish", func(t *testing.T) {
+		r := newPublishingRelease()
+		if err := r.ResumePublishing(); err != nil {
+			t.Fatalf("ResumePublishing() error = %v", err)
+		}
+		if r.State() != StatePublishing {
+			t.Errorf("State() = %v, want %v", r.State(), StatePublishing)
+		}
+	})
+
+	t.Run("published with failed plugins", func(t *testing.T) {
+		r := newPublishingRelease()
+		r.RecordPluginExecution("npm", "post_publish", false, "timeout", time.Second)
+		_ = r.MarkPublished("")
+
+		if err := r.ResumePublishing(); err != nil {
+			t.Fatalf("ResumePublishing() error = %v", err)
+		}
+		if r.State() != StatePublished {
+			t.Errorf("State() = %v, want %v", r.State(), StatePublished)
+		}
+	})
+
+	t.Run("published without failures", func(t *testing.T) {
+		r := newPublishingRelease()
+		_ = r.MarkPublished("")
+
+		if err := r.ResumePublishing(); !errors.Is(err, ErrInvalidStateTransition) {
+			t.Errorf("ResumePublishing() error = %v, want %v", err, ErrInvalidStateTransition)
+		}
+	})
+
+	t.Run("not started", func(t *testing.T) {
+		r := NewRelease("test-1", "main", "/repo")
+		if err := r.ResumePublishing(); !errors.Is(err, ErrInvalidStateTransition) {
+			t.Errorf("ResumePublishing() error = %v, want %v", err, ErrInvalidStateTransition)
+		}
+	})
+}
+
+func TestRelease_Retry_ClearsCheckpoints(t *testing.T) {
+	r := newPublishingRelease()
+	r.RecordCheckpoint(Checkpoint{Step: StepTagCreated})
+	_ = r.MarkFailed("push failed", true)
+
+	if err := r.Retry(); err != nil {
+		t.Fatalf("Retry() error = %v", err)
+	}
+	if len(r.Checkpoints()) != 0 {
+		t.Errorf("Checkpoints() = %v, want none after retry", r.Checkpoints())
+	}
+}
+
+func TestRelease_RecordPluginOutput(t *testing.T) {
+	r := newPublishingRelease()
+	r.RecordPluginOutput("slack", PluginOutput{})
+	if r.PluginOutputs() != nil {
+		t.Errorf("PluginOutputs() = %v, want nil for empty outputs", r.PluginOutputs())
+	}
+
+	r.RecordPluginOutput("github", PluginOutput{
+		Outputs:   map[string]any{"release_id": 1, "release_url": "https://example.com/v1"},
+		Artifacts: []PluginArtifact{{Name: "app.tar.gz", Path: "/tmp/app.tar.gz"}},
+	})
+	r.RecordPluginOutput("github", PluginOutput{
+		Outputs: map[string]any{"release_url": "https://example.com/v1.1"},
+		Artifacts: []PluginArtifact{
+			{Name: "app.tar.gz", Path: "https://example.com/app.tar.gz"},
+			{Name: "app.zip", Path: "https://example.com/app.zip"},
+		},
+	})
+
+	github := r.PluginOutputs()["github"]
+	if github.Outputs["release_id"] != 1 || github.Outputs["release_url"] != "https://example.com/v1.1" {
+		t.Errorf("Outputs = %v, want merged outputs", github.Outputs)
+	}
+	if len(github.Artifacts) != 2 || github.Artifacts[0].Path != "https://example.com/app.tar.gz" {
+		t.Errorf("Artifacts = %+v, want the replaced and the added artifact", github.Artifacts)
+	}
+
+	// Callers get a copy
+	github.Outputs["release_url"] = "changed"
+	if r.PluginOutputs()["github"].Outputs["release_url"] != "https://example.com/v1.1" {
+		t.Error("PluginOutputs() exposed the release's outputs")
+	}
+
+	_ = r.MarkFailed("push failed", true)
+	if err := r.Retry(); err != nil {
+		t.Fatal(err)
+	}
+	if r.PluginOutputs() != nil {
+		t.Errorf("PluginOutputs() = %v, want none after retry", r.PluginOutputs())
+	}
+}
@@ -20,6 +20,12 @@ var (
 	// ErrNilNotes indicates nil release notes were provided.
 	ErrNilNotes = errors.New("release notes cannot be nil")
 
+	// ErrInvalidLocale indicates localized notes without a locale.
+	ErrInvalidLocale = errors.New("localized notes need a locale")
+
+	// ErrNilMigrationGuide indicates a nil migration guide was provided.
+	ErrNilMigrationGuide = errors.New("migration guide cannot be nil")
+
 	// ErrNotApproved indicates the release is not approved.
 	ErrNotApproved = errors.New("release is not approved")
 
@@ -32,6 +38,24 @@ var (
 	// ErrCannotCancel indicates the release cannot be canceled.
 	ErrCannotCancel = errors.New("release cannot be canceled in current state")
 
+	// ErrInvalidPackage indicates an invalid package reference was provided.
+	ErrInvalidPackage = errors.New("package name cannot be empty")
+
+	// ErrApproverNotEligible indicates the approver may not approve the release.
+	ErrApproverNotEligible = errors.New("approver is not eligible")
+
+	// ErrAlreadyApprovedBy indicates the approver has already approved the release.
+	ErrAlreadyApprovedBy = errors.New("release already approved by")
+
+	// ErrApprovalPolicyNotSatisfied indicates the release lacks required approvals.
+	ErrApprovalPolicyNotSatisfied = errors.New("approval policy not satisfied")
+
+	// ErrReleaseFrozen indicates publishing is blocked by a freeze window.
+	ErrReleaseFrozen = errors.New("release freeze in effect")
+
+	// ErrFreezeOverrideReasonRequired indicates a freeze override lacks a reason.
+	ErrFreezeOverrideReasonRequired = errors.New("a reason is required to override a release freeze")
+
 	// ErrCannotRetry indicates the release cannot be retried.
 	ErrCannotRetry = errors.New("release cannot be retried in current state")
 )
@@ -155,6 +155,53 @@ func NewReleaseNotesUpdatedEvent(id ReleaseID, notesLen int) ReleaseNotesUpdated
 	}
 }
 
+// ReleaseNotesLocalizedEvent is raised when release notes are translated.
+type ReleaseNotesLocalizedEvent struct {
+	BaseEvent
+	Locales []string
+}
+
+// EventName returns the event name.
+func (e ReleaseNotesLocalizedEvent) EventName() string {
+	return "release.notes_localized"
+}
+
+// NewReleaseNotesLocalizedEvent creates a new ReleaseNotesLocalizedEvent.
+func NewReleaseNotesLocalizedEvent(id ReleaseID, locales []string) ReleaseNotesLocalizedEvent {
+	return ReleaseNotesLocalizedEvent{
+		BaseEvent: BaseEvent{
+			occurredAt:  time.Now(),
+			aggregateID: id,
+		},
+		Locales: locales,
+	}
+}
+
+// MigrationGuideGeneratedEvent is raised when a migration guide is attached
+// to the release notes.
+type MigrationGuideGeneratedEvent struct {
+	BaseEvent
+	Entries     int
+	AIGenerated bool
+}
+
+// EventName returns the event name.
+func (e MigrationGuideGeneratedEvent) EventName() string {
+	return "release.migration_guide_generated"
+}
+
+// NewMigrationGuideGeneratedEvent creates a new MigrationGuideGeneratedEvent.
+func NewMigrationGuideGeneratedEvent(id ReleaseID, entries int, aiGenerated bool) MigrationGuideGeneratedEvent {
+	return MigrationGuideGeneratedEvent{
+		BaseEvent: BaseEvent{
+			occurredAt:  time.Now(),
+			aggregateID: id,
+		},
+		Entries:     entries,
+		AIGenerated: aiGenerated,
+	}
+}
+
 // ReleaseApprovedEvent is raised when a release is approved.
 type ReleaseApprovedEvent struct {
 	BaseEvent
@@ -177,6 +224,83 @@ func NewReleaseApprovedEvent(id ReleaseID, approvedBy string) ReleaseApprovedEve
 	}
 }
 
+// ReleaseApprovalRecordedEvent is raised when an approval is recorded,
+// whether or not it satisfies the approval policy.
+type ReleaseApprovalRecordedEvent struct {
+	BaseEvent
+	ApprovedBy string
+	Approvals  int
+	Required   int
+}
+
+// EventName returns the event name.
+func (e ReleaseApprovalRecordedEvent) EventName() string {
+	return "release.approval_recorded"
+}
+
+// NewReleaseApprovalRecordedEvent creates a new ReleaseApprovalRecordedEvent.
+func NewReleaseApprovalRecordedEvent(id ReleaseID, approvedBy string, approvals, required int) ReleaseApprovalRecordedEvent {
+	return ReleaseApprovalRecordedEvent{
+		BaseEvent: BaseEvent{
+			occurredAt:  time.Now(),
+			aggregateID: id,
+		},
+		ApprovedBy: approvedBy,
+		Approvals:  approvals,
+		Required:   required,
+	}
+}
+
+// ReleaseScheduledEvent is raised when an approved release is scheduled for publishing.
+type ReleaseScheduledEvent struct {
+	BaseEvent
+	PublishAt   time.Time
+	ScheduledBy string
+}
+
+// EventName returns the event name.
+func (e ReleaseScheduledEvent) EventName() string {
+	return "release.scheduled"
+}
+
+// NewReleaseScheduledEvent creates a new ReleaseScheduledEvent.
+func NewReleaseScheduledEvent(id ReleaseID, publishAt time.Time, scheduledBy string) ReleaseScheduledEvent {
+	return ReleaseScheduledEvent{
+		BaseEvent: BaseEvent{
+			occurredAt:  time.Now(),
+			aggregateID: id,
+		},
+		PublishAt:   publishAt,
+		ScheduledBy: scheduledBy,
+	}
+}
+
+// ReleaseFreezeOverriddenEvent is raised when a release is published during a freeze window.
+type ReleaseFreezeOverriddenEvent struct {
+	BaseEvent
+	Window       string
+	Reason       string
+	OverriddenBy string
+}
+
+// EventName returns the event name.
+func (e ReleaseFreezeOverriddenEvent) EventName() string {
+	return "release.freeze_overridden"
+}
+
+// NewReleaseFreezeOverriddenEvent creates a new ReleaseFreezeOverriddenEvent.
+func NewReleaseFreezeOverriddenEvent(id ReleaseID, window, reason, overriddenBy string) ReleaseFreezeOverriddenEvent {
+	return ReleaseFreezeOverriddenEvent{
+		BaseEvent: BaseEvent{
+			occurredAt:  time.Now(),
+			aggregateID: id,
+		},
+		Window:       window,
+		Reason:       reason,
+		OverriddenBy: overriddenBy,
+	}
+}
+
 // ReleasePublishingStartedEvent is raised when publishing starts.
 type ReleasePublishingStartedEvent struct {
 	BaseEvent
@@ -199,6 +323,30 @@ func NewReleasePublishingStartedEvent(id ReleaseID, plugins []string) ReleasePub
 	}
 }
 
+// ReleasePublishingResumedEvent is raised when an interrupted publish is resumed.
+type ReleasePublishingResumedEvent struct {
+	BaseEvent
+	PreviousState  ReleaseState
+	CompletedSteps int
+}
+
+// EventName returns the event name.
+func (e ReleasePublishingResumedEvent) EventName() string {
+	return "release.publishing_resumed"
+}
+
+// NewReleasePublishingResumedEvent creates a new ReleasePublishingResumedEvent.
+func NewReleasePublishingResumedEvent(id ReleaseID, previousState ReleaseState, completedSteps int) ReleasePublishingResumedEvent {
+	return ReleasePublishingResumedEvent{
+		BaseEvent: BaseEvent{
+			occurredAt:  time.Now(),
+			aggregateID: id,
+		},
+		PreviousState:  previousState,
+		CompletedSteps: completedSteps,
+	}
+}
+
 // ReleasePublishedEvent is raised when a release is successfully published.
 type ReleasePublishedEvent struct {
 	BaseEvent
@@ -275,6 +423,34 @@ func NewReleaseCanceledEvent(id ReleaseID, reason, canceledBy string) ReleaseCan
 	}
 }
 
+// ReleaseRolledBackEvent is raised when a release is rolled back.
+type ReleaseRolledBackEvent struct {
+	BaseEvent
+	PreviousState ReleaseState
+	TagName       string
+	Reason        string
+	RolledBackBy  string
+}
+
+// EventName returns the event name.
+func (e ReleaseRolledBackEvent) EventName() string {
+	return "release.rolled_back"
+}
+
+// NewReleaseRolledBackEvent creates a new ReleaseRolledBackEvent.
+func NewReleaseRolledBackEvent(id ReleaseID, previousState ReleaseState, tagName, reason, rolledBackBy string) ReleaseRolledBackEvent {
+	return ReleaseRolledBackEvent{
+		BaseEvent: BaseEvent{
+			occurredAt:  time.Now(),
+			aggregateID: id,
+		},
+		PreviousState: previousState,
+		TagName:       tagName,
+		Reason:        reason,
+		RolledBackBy:  rolledBackBy,
+	}
+}
+
 // PluginExecutedEvent is raised when a plugin completes execution.
 type PluginExecutedEvent struct {
 	BaseEvent
@@ -304,3 +480,74 @@ func NewPluginExecutedEvent(id ReleaseID, pluginName, hook string, success bool,
 		Duration:   duration,
 	}
 }
+
+// NewEventRecord converts a domain event to an event record, e.g. to
+// persist it or send it to external systems.
+func NewEventRecord(event DomainEvent) EventRecord {
+	return EventRecord{
+		Name:       event.EventName(),
+		ReleaseID:  event.AggregateID(),
+		OccurredAt: event.OccurredAt(),
+		Data:       eventData(event),
+	}
+}
+
+// eventData returns the attributes of the known domain events.
+func eventData(event DomainEvent) map[string]any {
+	switch e := event.(type) {
+	case ReleaseInitializedEvent:
+		return map[string]any{"branch": e.Branch, "repository": e.Repository}
+	case ReleasePlannedEvent:
+		return map[string]any{
+			"current_version": e.CurrentVersion.String(),
+			"next_version":    e.NextVersion.String(),
+			"release_type":    e.ReleaseType,
+			"commit_count":    e.CommitCount,
+		}
+	case ReleaseVersionedEvent:
+		return map[string]any{"version": e.Version.String(), "tag_name": e.TagName}
+	case ReleaseNotesGeneratedEvent:
+		return map[string]any{"changelog_updated": e.ChangelogUpdated, "notes_length": e.NotesLength}
+	case ReleaseNotesUpdatedEvent:
+		return map[string]any{"notes_length": e.NotesLength}
+	case ReleaseNotesLocalizedEvent:
+		return map[string]any{"locales": e.Locales}
+	case MigrationGuideGeneratedEvent:
+		return map[string]any{"entries": e.Entries, "ai_generated": e.AIGenerated}
+	case ReleaseApprovedEvent:
+		return map[string]any{"approved_by": e.ApprovedBy}
+	case ReleaseApprovalRecordedEvent:
+		return map[string]any{"approved_by": e.ApprovedBy, "approvals": e.Approvals, "required": e.Required}
+	case ReleaseScheduledEvent:
+		return map[string]any{"publish_at": e.PublishAt.UTC().Format(time.RFC3339), "scheduled_by": e.ScheduledBy}
+	case ReleaseFreezeOverriddenEvent:
+		return map[string]any{"window": e.Window, "reason": e.Reason, "overridden_by": e.OverriddenBy}
+	case ReleasePublishingStartedEvent:
+		return map[string]any{"plugins": e.Plugins}
+	case ReleasePublishingResumedEvent:
+		return map[string]any{"previous_state": string(e.PreviousState), "completed_steps": e.CompletedSteps}
+	case ReleasePublishedEvent:
+		return map[string]any{"version": e.Version.String(), "tag_name": e.TagName, "release_url": e.ReleaseURL}
+	case ReleaseFailedEvent:
+		return map[string]any{"reason": e.Reason, "failed_at": string(e.FailedAt), "recoverable": e.IsRecoverable}
+	case ReleaseCanceledEvent:
+		return map[string]any{"reason": e.Reason, "canceled_by": e.CanceledBy}
+	case ReleaseRolledBackEvent:
+		return map[string]any{
+			"previous_state": string(e.PreviousState),
+			"tag_name":       e.TagName,
+			"reason":         e.Reason,
+			"rolled_back_by": e.RolledBackBy,
+		}
+	case PluginExecutedEvent:
+		return map[string]any{
+			"plugin":      e.PluginName,
+			"hook":        e.Hook,
+			"success":     e.Success,
+			"message":     e.Message,
+			"duration_ms": e.Duration.Milliseconds(),
+		}
+	default:
+		return nil
+	}
+}
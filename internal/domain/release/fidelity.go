@@ -0,0 +1,257 @@
+// Package release provides domain types for release management.
+package release
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+)
+
+// FidelityReport records how well AI-written release notes are backed by
+// the commits of the release, so a reviewer knows what to double-check.
+type FidelityReport struct {
+	Claims          int // Number of statements checked
+	Unsupported     []UnsupportedClaim
+	OmittedBreaking []OmittedChange
+}
+
+// UnsupportedClaim is a statement in the notes that no commit supports.
+type UnsupportedClaim struct {
+	Text   string
+	Reason string
+}
+
+// OmittedChange is a breaking commit the notes do not mention.
+type OmittedChange struct {
+	Hash    string
+	Subject string
+}
+
+// IsClean returns true if every statement is supported and every breaking
+// change is mentioned.
+func (r *FidelityReport) IsClean() bool {
+	return len(r.Unsupported) == 0 && len(r.OmittedBreaking) == 0
+}
+
+// Supported returns the number of statements backed by commits.
+func (r *FidelityReport) Supported() int {
+	return r.Claims - len(r.Unsupported)
+}
+
+// String returns a one-line summary of the report.
+func (r *FidelityReport) String() string {
+	s := fmt.Sprintf("%d of %d statements supported by commits", r.Supported(), r.Claims)
+	if len(r.OmittedBreaking) > 0 {
+		s += fmt.Sprintf(", %d breaking change(s) not mentioned", len(r.OmittedBreaking))
+	}
+	return s
+}
+
+var (
+	// hashRefRegex matches abbreviated or full commit hashes.
+	hashRefRegex = regexp.MustCompile(`\b[0-9a-f]{7,40}\b`)
+	// issueRefRegex matches GitHub-style (#123) and Jira-style (ABC-123) issue references.
+	issueRefRegex = regexp.MustCompile(`#\d+\b|\b[A-Z][A-Z0-9]+-\d+\b`)
+	// scopeRefRegex matches a "**scope:**" prefix, as rendered for commit subjects.
+	scopeRefRegex = regexp.MustCompile(`^\*\*([\w./-]+):\*\*`)
+	// sentenceEndRegex splits a summary into sentences.
+	sentenceEndRegex = regexp.MustCompile(`[.!?]+(\s+|$)`)
+	wordRegex        = regexp.MustCompile(`[a-z0-9]+`)
+)
+
+// Thresholds for the lexical support check. A statement is supported if a
+// single commit covers minCommitOverlap of its words, or the commits as a
+// whole cover minTotalOverlap of them (summaries paraphrase several commits).
+const (
+	minCommitOverlap = 1.0 / 3
+	minTotalOverlap  = 1.0 / 2
+)
+
+// stopWords are ignored when matching statements to commits: they carry no
+// information about what changed.
+var stopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "this": true, "that": true,
+	"from": true, "into": true, "are": true, "was": true, "were": true, "has": true,
+	"have": true, "now": true, "can": true, "will": true, "you": true, "your": true,
+	"our": true, "its": true, "all": true, "more": true, "also": true, "when": true,
+	"release": true, "version": true, "includes": true, "include": true,
+	"new": true, "feature": true, "fix": true, "bug": true, "change": true,
+	"update": true, "improvement": true, "improve": true, "several": true,
+	"various": true, "minor": true, "major": true, "patch": true, "add": true,
+	"support": true, "better": true, "user": true, "users": true, "brings": true,
+	"breaking": true, "performance": true, "make": true, "makes": true,
+}
+
+// VerifyNotes cross-checks the statements of the release notes against the
+// commits of the changeset. Every summary sentence, highlight, section item
+// and migration note is a statement. A statement is unsupported if it
+// references a commit hash, issue or scope no commit has, or if too few of
+// its words appear in the commits. Breaking commits that no statement
+// mentions are reported as omitted. The check is lexical, so a report is a
+// list of things to double-check rather than proof of an error.
+func VerifyNotes(notes *ReleaseNotes, cs *changes.ChangeSet) *FidelityReport {
+	report := &FidelityReport{}
+	if notes == nil || cs == nil {
+		return report
+	}
+
+	commits := cs.Commits()
+	commitWords := make([]map[string]bool, len(commits))
+	allWords := make(map[string]bool)
+	scopes := make(map[string]bool)
+	var commitText strings.Builder
+	for i, c := range commits {
+		text := commitSearchText(c)
+		commitText.WriteString(text)
+		commitText.WriteByte('\n')
+		commitWords[i] = contentWords(text)
+		for w := range commitWords[i] {
+			allWords[w] = true
+		}
+		if c.Scope() != "" {
+			scopes[strings.ToLower(c.Scope())] = true
+		}
+	}
+	searchText := commitText.String()
+
+	claims := noteClaims(notes)
+	report.Claims = len(claims)
+	for _, claim := range claims {
+		if reason := unsupportedReason(claim, commits, commitWords, allWords, scopes, searchText); reason != "" {
+			report.Unsupported = append(report.Unsupported, UnsupportedClaim{Text: claim, Reason: reason})
+		}
+	}
+
+	for _, c := range commits {
+		if c.IsBreaking() && !mentioned(c, claims) {
+			report.OmittedBreaking = append(report.OmittedBreaking, OmittedChange{Hash: c.ShortHash(), Subject: c.Subject()})
+		}
+	}
+
+	return report
+}
+
+// noteClaims splits the notes into the statements to verify.
+func noteClaims(notes *ReleaseNotes) []string {
+	var claims []string
+	for _, sentence := range sentenceEndRegex.Split(notes.Summary, -1) {
+		if s := strings.TrimSpace(sentence); s != "" {
+			claims = append(claims, s)
+		}
+	}
+	claims = append(claims, notes.Highlights...)
+	for _, section := range notes.Sections {
+		claims = append(claims, section.Items...)
+	}
+	for _, m := range notes.MigrationNotes {
+		claims = append(claims, m.Change)
+	}
+	return claims
+}
+
+// unsupportedReason returns why a statement is not supported by the commits,
+// or an empty string if it is.
+func unsupportedReason(claim string, commits []*changes.ConventionalCommit, commitWords []map[string]bool, allWords, scopes map[string]bool, searchText string) string {
+	for _, ref := range hashRefRegex.FindAllString(claim, -1) {
+		if !strings.ContainsAny(ref, "0123456789") {
+			continue // an ordinary word made of hex letters, e.g. "deadbeef"
+		}
+		if !hasCommit(commits, ref) {
+			return fmt.Sprintf("references commit %s, which is not part of the release", ref)
+		}
+	}
+	for _, ref := range issueRefRegex.FindAllString(claim, -1) {
+		if !strings.Contains(searchText, ref) {
+			return fmt.Sprintf("references %s, which no commit mentions", ref)
+		}
+	}
+	if m := scopeRefRegex.FindStringSubmatch(claim); m != nil && !scopes[strings.ToLower(m[1])] {
+		return fmt.Sprintf("mentions scope %q, which no commit has", m[1])
+	}
+
+	words := contentWords(claim)
+	if len(words) == 0 {
+		return "" // nothing specific to verify
+	}
+	if overlap(words, allWords) >= minTotalOverlap {
+		return ""
+	}
+	for _, cw := range commitWords {
+		if overlap(words, cw) >= minCommitOverlap {
+			return ""
+		}
+	}
+	return "no commit matches this statement"
+}
+
+// mentioned returns true if a statement refers to the commit by hash or
+// covers at least half of the words of its subject and breaking message.
+func mentioned(c *changes.ConventionalCommit, claims []string) bool {
+	subject := contentWords(c.Subject() + " " + c.BreakingMessage())
+	for _, claim := range claims {
+		if strings.Contains(claim, c.ShortHash()) {
+			return true
+		}
+		if len(subject) > 0 && overlap(subject, contentWords(claim)) >= 0.5 {
+			return true
+		}
+	}
+	return false
+}
+
+// hasCommit returns true if a commit hash starts with ref.
+func hasCommit(commits []*changes.ConventionalCommit, ref string) bool {
+	for _, c := range commits {
+		if strings.HasPrefix(c.Hash(), ref) {
+			return true
+		}
+	}
+	return false
+}
+
+// commitSearchText returns the commit text statements are matched against.
+func commitSearchText(c *changes.ConventionalCommit) string {
+	return strings.Join([]string{c.Scope(), c.Subject(), c.Body(), c.Footer(), c.BreakingMessage(), c.RawMessage()}, "\n")
+}
+
+// contentWords returns the normalized words of s, without stop words.
+func contentWords(s string) map[string]bool {
+	words := make(map[string]bool)
+	for _, w := range wordRegex.FindAllString(strings.ToLower(s), -1) {
+		if len(w) < 3 || stopWords[w] {
+			continue
+		}
+		w = stem(w)
+		if !stopWords[w] {
+			words[w] = true
+		}
+	}
+	return words
+}
+
+// stem strips common English suffixes so that "adds", "added" and
+// "adding" match.
+func stem(w string) string {
+	for _, suffix := range []string{"ing", "ed", "es", "s"} {
+		if len(w) > len(suffix)+3 && strings.HasSuffix(w, suffix) {
+			return strings.TrimSuffix(w, suffix)
+		}
+	}
+	return w
+}
+
+// overlap returns the fraction of words that appear in set.
+func overlap(words, set map[string]bool) float64 {
+	if len(words) == 0 {
+		return 0
+	}
+	matched := 0
+	for w := range words {
+		if set[w] {
+			matched++
+		}
+	}
+	return float64(matched) / float64(len(words))
+}
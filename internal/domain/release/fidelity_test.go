@@ -0,0 +1,102 @@
+// Package release provides domain types for release management.
+package release
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+)
+
+func newFidelityChangeSet() *changes.ChangeSet {
+	cs := changes.NewChangeSet("cs-1", "v1.0.0", "HEAD")
+	cs.AddCommit(changes.NewConventionalCommit("a1b2c3d4e5f6", changes.CommitTypeFeat, "add full-text search to the catalog",
+		changes.WithScope("search"),
+		changes.WithFooter("Closes #42")))
+	cs.AddCommit(changes.NewConventionalCommit("0f1e2d3c4b5a", changes.CommitTypeFix, "handle expired sessions on login",
+		changes.WithScope("auth")))
+	cs.AddCommit(changes.NewConventionalCommit("9988776655aa", changes.CommitTypeFeat, "remove the legacy export endpoint",
+		changes.WithScope("api"),
+		changes.WithBreaking("the /export endpoint is gone")))
+	return cs
+}
+
+func TestVerifyNotes_Claims(t *testing.T) {
+	tests := []struct {
+		name       string
+		claim      string
+		supported  bool
+		wantReason string
+	}{
+		{"paraphrased commit", "Added full-text search for the catalog", true, ""},
+		{"generic statement", "This release includes several improvements.", true, ""},
+		{"known commit hash", "Fix expired sessions (0f1e2d3)", true, ""},
+		{"known issue", "Search the catalog (#42)", true, ""},
+		{"known scope", "**auth:** handle expired sessions", true, ""},
+		{"unknown commit hash", "Handle expired sessions (1234567)", false, "commit 1234567"},
+		{"unknown issue", "Search the catalog, closes #77", false, "#77"},
+		{"unknown jira issue", "Search the catalog (SHOP-12)", false, "SHOP-12"},
+		{"unknown scope", "**billing:** handle expired sessions", false, `scope "billing"`},
+		{"invented feature", "Dark mode across the dashboard widgets", false, "no commit matches"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notes := &ReleaseNotes{
+				Highlights:     []string{tt.claim},
+				MigrationNotes: []MigrationNote{{Change: "The legacy export endpoint was removed"}},
+			}
+			report := VerifyNotes(notes, newFidelityChangeSet())
+
+			if report.Claims != 2 {
+				t.Errorf("Claims = %d, want 2", report.Claims)
+			}
+			if len(report.OmittedBreaking) != 0 {
+				t.Errorf("OmittedBreaking = %+v, want none", report.OmittedBreaking)
+			}
+			if tt.supported {
+				if !report.IsClean() {
+					t.Errorf("Unsupported = %+v, want the claim supported", report.Unsupported)
+				}
+				return
+			}
+			if len(report.Unsupported) != 1 || report.Unsupported[0].Text != tt.claim {
+				t.Fatalf("Unsupported = %+v, want %q", report.Unsupported, tt.claim)
+			}
+			if !strings.Contains(report.Unsupported[0].Reason, tt.wantReason) {
+				t.Errorf("Reason = %q, want it to mention %q", report.Unsupported[0].Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestVerifyNotes_OmittedBreaking(t *testing.T) {
+	notes := &ReleaseNotes{
+		Summary:  "Search lands in the catalog. Expired sessions no longer break login.",
+		Sections: []NotesSection{{Title: "Features", Items: []string{"Full-text search"}}},
+	}
+
+	report := VerifyNotes(notes, newFidelityChangeSet())
+
+	if report.Claims != 3 {
+		t.Errorf("Claims = %d, want 3", report.Claims)
+	}
+	if len(report.Unsupported) != 0 {
+		t.Errorf("Unsupported = %+v, want none", report.Unsupported)
+	}
+	if len(report.OmittedBreaking) != 1 || report.OmittedBreaking[0].Hash != "9988776" {
+		t.Fatalf("OmittedBreaking = %+v, want the export endpoint removal", report.OmittedBreaking)
+	}
+	if report.IsClean() {
+		t.Error("IsClean() = true, want false")
+	}
+	if got := report.String(); got != "3 of 3 statements supported by commits, 1 breaking change(s) not mentioned" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestVerifyNotes_Nil(t *testing.T) {
+	if report := VerifyNotes(nil, newFidelityChangeSet()); !report.IsClean() || report.Claims != 0 {
+		t.Errorf("VerifyNotes(nil) = %+v, want an empty report", report)
+	}
+}
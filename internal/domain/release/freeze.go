@@ -0,0 +1,173 @@
+// Package release provides domain types for release management.
+package release
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// FreezeWindow is a period during which releases must not be published.
+// A window either recurs weekly on Days between StartTime and EndTime, or
+// covers the absolute range from Start to End.
+type FreezeWindow struct {
+	Name string
+
+	// Days the weekly window recurs on.
+	Days []time.Weekday
+	// StartTime and EndTime are offsets from midnight. A zero EndTime means
+	// the end of the day; an EndTime before StartTime spans midnight.
+	StartTime time.Duration
+	EndTime   time.Duration
+
+	// Start (inclusive) and End (exclusive) bound an absolute window.
+	Start time.Time
+	End   time.Time
+
+	// Location is the timezone the window is defined in (default: local time).
+	Location *time.Location
+}
+
+// IsRecurring returns true if the window recurs weekly.
+func (w FreezeWindow) IsRecurring() bool {
+	return len(w.Days) > 0
+}
+
+// Contains returns true if t falls within the window.
+func (w FreezeWindow) Contains(t time.Time) bool {
+	_, ok := w.Until(t)
+	return ok
+}
+
+// Until returns when the occurrence of the window containing t ends.
+// The second result is false if t is outside the window.
+func (w FreezeWindow) Until(t time.Time) (time.Time, bool) {
+	if !w.IsRecurring() {
+		if !t.Before(w.Start) && t.Before(w.End) {
+			return w.End, true
+		}
+		return time.Time{}, false
+	}
+
+	t = t.In(w.location())
+	sinceMidnight := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	end := w.EndTime
+	if end == 0 {
+		end = 24 * time.Hour
+	}
+
+	if w.StartTime < end {
+		if slices.Contains(w.Days, t.Weekday()) && sinceMidnight >= w.StartTime && sinceMidnight < end {
+			return clockTime(t, 0, end), true
+		}
+		return time.Time{}, false
+	}
+
+	// The window spans midnight: it starts on a listed day and ends the next day
+	if slices.Contains(w.Days, t.Weekday()) && sinceMidnight >= w.StartTime {
+		return clockTime(t, 1, end), true
+	}
+	if slices.Contains(w.Days, (t.Weekday()+6)%7) && sinceMidnight < end {
+		return clockTime(t, 0, end), true
+	}
+	return time.Time{}, false
+}
+
+// String describes the window, e.g. "fridays (Fri 15:00-24:00 Europe/Berlin)".
+func (w FreezeWindow) String() string {
+	var span string
+	if w.IsRecurring() {
+		days := make([]string, 0, len(w.Days))
+		for _, d := range w.Days {
+			days = append(days, d.String()[:3])
+		}
+		end := w.EndTime
+		if end == 0 {
+			end = 24 * time.Hour
+		}
+		span = fmt.Sprintf("%s %s-%s %s", strings.Join(days, ","), formatClock(w.StartTime), formatClock(end), w.location())
+	} else {
+		loc := w.location()
+		span = fmt.Sprintf("%s to %s", w.Start.In(loc).Format("2006-01-02 15:04"), w.End.In(loc).Format("2006-01-02 15:04 MST"))
+	}
+
+	if w.Name == "" {
+		return span
+	}
+	return fmt.Sprintf("%s (%s)", w.Name, span)
+}
+
+func (w FreezeWindow) location() *time.Location {
+	if w.Location == nil {
+		return time.Local
+	}
+	return w.Location
+}
+
+// ActiveFreezeWindow returns the first window that contains t.
+func ActiveFreezeWindow(windows []FreezeWindow, t time.Time) (FreezeWindow, bool) {
+	for _, w := range windows {
+		if w.Contains(t) {
+			return w, true
+		}
+	}
+	return FreezeWindow{}, false
+}
+
+// clockTime returns the time at the given offset from midnight, days after t.
+func clockTime(t time.Time, days int, offset time.Duration) time.Time {
+	y, m, d := t.Date()
+	hours := int(offset / time.Hour)
+	minutes := int((offset % time.Hour) / time.Minute)
+	return time.Date(y, m, d+days, hours, minutes, 0, 0, t.Location())
+}
+
+func formatClock(offset time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(offset/time.Hour), int((offset%time.Hour)/time.Minute))
+}
+
+// FreezeOverride records that a release was published during a freeze window.
+type FreezeOverride struct {
+	Window       string
+	Reason       string
+	OverriddenBy string
+	OverriddenAt time.Time
+}
+
+// FreezeOverrides returns a copy of the recorded freeze overrides.
+func (r *Release) FreezeOverrides() []FreezeOverride {
+	if len(r.freezeOverrides) == 0 {
+		return nil
+	}
+	overrides := make([]FreezeOverride, len(r.freezeOverrides))
+	copy(overrides, r.freezeOverrides)
+	return overrides
+}
+
+// OverrideFreeze records that the release is published despite an active
+// freeze window. A reason is required so the decision can be audited.
+func (r *Release) OverrideFreeze(window FreezeWindow, reason, overriddenBy string) error {
+	if strings.TrimSpace(reason) == "" {
+		return ErrFreezeOverrideReasonRequired
+	}
+
+	now := time.Now()
+	r.freezeOverrides = append(r.freezeOverrides, FreezeOverride{
+		Window:       window.String(),
+		Reason:       reason,
+		OverriddenBy: overriddenBy,
+		OverriddenAt: now,
+	})
+	r.updatedAt = now
+
+	r.addEvent(NewReleaseFreezeOverriddenEvent(r.id, window.String(), reason, overriddenBy))
+
+	return nil
+}
+
+// RestoreFreezeOverrides restores freeze overrides from persisted data.
+// It should only be called by repository implementations.
+func (r *Release) RestoreFreezeOverrides(overrides []FreezeOverride) {
+	r.freezeOverrides = append([]FreezeOverride(nil), overrides...)
+}
@@ -0,0 +1,119 @@
+// Package release provides domain types for release management.
+package release
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFreezeWindow_Contains(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+
+	fridayAfternoon := FreezeWindow{
+		Name:      "friday-afternoon",
+		Days:      []time.Weekday{time.Friday},
+		StartTime: 15 * time.Hour,
+		Location:  berlin,
+	}
+	overnight := FreezeWindow{
+		Name:      "maintenance",
+		Days:      []time.Weekday{time.Wednesday},
+		StartTime: 22 * time.Hour,
+		EndTime:   6 * time.Hour,
+		Location:  time.UTC,
+	}
+	holidays := FreezeWindow{
+		Name:  "holidays",
+		Start: time.Date(2025, 12, 20, 0, 0, 0, 0, berlin),
+		End:   time.Date(2026, 1, 3, 0, 0, 0, 0, berlin),
+	}
+
+	tests := []struct {
+		name   string
+		window FreezeWindow
+		t      time.Time
+		want   bool
+	}{
+		// 2025-06-13 is a Friday
+		{"friday before start", fridayAfternoon, time.Date(2025, 6, 13, 14, 59, 0, 0, berlin), false},
+		{"friday at start", fridayAfternoon, time.Date(2025, 6, 13, 15, 0, 0, 0, berlin), true},
+		{"friday late evening", fridayAfternoon, time.Date(2025, 6, 13, 23, 59, 0, 0, berlin), true},
+		{"saturday", fridayAfternoon, time.Date(2025, 6, 14, 9, 0, 0, 0, berlin), false},
+		{"friday in UTC uses window timezone", fridayAfternoon, time.Date(2025, 6, 13, 13, 30, 0, 0, time.UTC), true},
+		// 2025-06-11 is a Wednesday
+		{"overnight before start", overnight, time.Date(2025, 6, 11, 21, 0, 0, 0, time.UTC), false},
+		{"overnight after start", overnight, time.Date(2025, 6, 11, 23, 0, 0, 0, time.UTC), true},
+		{"overnight next morning", overnight, time.Date(2025, 6, 12, 5, 59, 0, 0, time.UTC), true},
+		{"overnight after end", overnight, time.Date(2025, 6, 12, 6, 0, 0, 0, time.UTC), false},
+		{"range before", holidays, time.Date(2025, 12, 19, 23, 0, 0, 0, berlin), false},
+		{"range start", holidays, time.Date(2025, 12, 20, 0, 0, 0, 0, berlin), true},
+		{"range end is exclusive", holidays, time.Date(2026, 1, 3, 0, 0, 0, 0, berlin), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Contains(tt.t); got != tt.want {
+				t.Errorf("Contains(%s) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFreezeWindow_Until(t *testing.T) {
+	w := FreezeWindow{Days: []time.Weekday{time.Wednesday}, StartTime: 22 * time.Hour, EndTime: 6 * time.Hour, Location: time.UTC}
+
+	until, ok := w.Until(time.Date(2025, 6, 11, 23, 0, 0, 0, time.UTC))
+	if !ok {
+		t.Fatal("Until() ok = false, want true")
+	}
+	if want := time.Date(2025, 6, 12, 6, 0, 0, 0, time.UTC); !until.Equal(want) {
+		t.Errorf("Until() = %s, want %s", until, want)
+	}
+}
+
+func TestFreezeWindow_String(t *testing.T) {
+	w := FreezeWindow{Name: "fridays", Days: []time.Weekday{time.Friday}, StartTime: 15 * time.Hour, Location: time.UTC}
+	if got, want := w.String(), "fridays (Fri 15:00-24:00 UTC)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestActiveFreezeWindow(t *testing.T) {
+	windows := []FreezeWindow{
+		{Name: "mondays", Days: []time.Weekday{time.Monday}, Location: time.UTC},
+		{Name: "fridays", Days: []time.Weekday{time.Friday}, Location: time.UTC},
+	}
+
+	w, ok := ActiveFreezeWindow(windows, time.Date(2025, 6, 13, 10, 0, 0, 0, time.UTC))
+	if !ok || w.Name != "fridays" {
+		t.Errorf("ActiveFreezeWindow() = %q, %v, want fridays", w.Name, ok)
+	}
+	if _, ok := ActiveFreezeWindow(windows, time.Date(2025, 6, 12, 10, 0, 0, 0, time.UTC)); ok {
+		t.Error("ActiveFreezeWindow() on a thursday should find no window")
+	}
+}
+
+func TestRelease_OverrideFreeze(t *testing.T) {
+	r := newPublishingRelease()
+	r.ClearDomainEvents()
+	w := FreezeWindow{Name: "fridays", Days: []time.Weekday{time.Friday}, Location: time.UTC}
+
+	if err := r.OverrideFreeze(w, " ", "alice"); !errors.Is(err, ErrFreezeOverrideReasonRequired) {
+		t.Errorf("OverrideFreeze() without reason error = %v, want ErrFreezeOverrideReasonRequired", err)
+	}
+
+	if err := r.OverrideFreeze(w, "security fix", "alice"); err != nil {
+		t.Fatalf("OverrideFreeze() error = %v", err)
+	}
+	overrides := r.FreezeOverrides()
+	if len(overrides) != 1 || overrides[0].Reason != "security fix" || overrides[0].OverriddenBy != "alice" {
+		t.Errorf("FreezeOverrides() = %+v", overrides)
+	}
+	if events := r.DomainEvents(); len(events) != 1 || events[0].EventName() != "release.freeze_overridden" {
+		t.Errorf("DomainEvents() = %v, want release.freeze_overridden", events)
+	}
+}
@@ -0,0 +1,85 @@
+// Package release provides domain types for release management.
+package release
+
+import (
+	"maps"
+	"slices"
+	"time"
+)
+
+// PluginOutput holds what a plugin reported while publishing a release,
+// such as the URL of a GitHub release and its assets. Later plugins receive
+// it, so they can build on what earlier ones published.
+type PluginOutput struct {
+	Outputs   map[string]any
+	Artifacts []PluginArtifact
+}
+
+// PluginArtifact is a file or resource a plugin created.
+type PluginArtifact struct {
+	Name string
+	Path string // Local file, if any
+	Type string
+	Size int64
+	URL  string // Where the artifact was published, if anywhere
+}
+
+// clone returns a deep enough copy of o to keep callers from changing the
+// release's outputs.
+func (o PluginOutput) clone() PluginOutput {
+	return PluginOutput{
+		Outputs:   maps.Clone(o.Outputs),
+		Artifacts: slices.Clone(o.Artifacts),
+	}
+}
+
+// PluginOutputs returns the outputs reported by each plugin, keyed by
+// plugin name, or nil if no plugin reported any.
+func (r *Release) PluginOutputs() map[string]PluginOutput {
+	if len(r.pluginOutputs) == 0 {
+		return nil
+	}
+	outputs := make(map[string]PluginOutput, len(r.pluginOutputs))
+	for name, o := range r.pluginOutputs {
+		outputs[name] = o.clone()
+	}
+	return outputs
+}
+
+// RecordPluginOutput records what a plugin reported. A plugin that runs on
+// several hooks adds to its earlier output: outputs with the same key and
+// artifacts with the same name are replaced.
+func (r *Release) RecordPluginOutput(pluginName string, output PluginOutput) {
+	if len(output.Outputs) == 0 && len(output.Artifacts) == 0 {
+		return
+	}
+	if r.pluginOutputs == nil {
+		r.pluginOutputs = make(map[string]PluginOutput)
+	}
+
+	existing := r.pluginOutputs[pluginName].clone()
+	if existing.Outputs == nil && len(output.Outputs) > 0 {
+		existing.Outputs = make(map[string]any, len(output.Outputs))
+	}
+	maps.Copy(existing.Outputs, output.Outputs)
+	for _, a := range output.Artifacts {
+		i := slices.IndexFunc(existing.Artifacts, func(e PluginArtifact) bool { return e.Name == a.Name })
+		if i >= 0 {
+			existing.Artifacts[i] = a
+		} else {
+			existing.Artifacts = append(existing.Artifacts, a)
+		}
+	}
+
+	r.pluginOutputs[pluginName] = existing
+	r.updatedAt = time.Now()
+}
+
+// RestorePluginOutputs restores plugin outputs from persisted data.
+// It should only be called by repository implementations.
+func (r *Release) RestorePluginOutputs(outputs map[string]PluginOutput) {
+	r.pluginOutputs = make(map[string]PluginOutput, len(outputs))
+	for name, o := range outputs {
+		r.pluginOutputs[name] = o.clone()
+	}
+}
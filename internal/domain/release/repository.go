@@ -3,6 +3,8 @@ package release
 
 import (
 	"context"
+	"slices"
+	"time"
 )
 
 // Repository defines the interface for persisting and retrieving releases.
@@ -23,16 +25,114 @@ type Repository interface {
 	// FindActive retrieves all active (non-final) releases.
 	FindActive(ctx context.Context) ([]*Release, error)
 
+	// FindByGroup retrieves all releases belonging to a release group.
+	FindByGroup(ctx context.Context, groupID ReleaseGroupID) ([]*Release, error)
+
 	// Delete removes a release.
 	Delete(ctx context.Context, id ReleaseID) error
 }
 
+// HistoryFilter selects releases from the release history.
+// Zero values match everything.
+type HistoryFilter struct {
+	RepositoryPath string
+	States         []ReleaseState
+	Branch         string
+	Since          time.Time
+	Until          time.Time
+	// Offset and Limit page through the results, newest first.
+	// A Limit of zero returns all remaining releases.
+	Offset int
+	Limit  int
+}
+
+// Matches returns true if a release with the given attributes passes the filter.
+func (f HistoryFilter) Matches(repoPath, branch string, state ReleaseState, createdAt time.Time) bool {
+	if f.RepositoryPath != "" && repoPath != f.RepositoryPath {
+		return false
+	}
+	if f.Branch != "" && branch != f.Branch {
+		return false
+	}
+	if len(f.States) > 0 && !slices.Contains(f.States, state) {
+		return false
+	}
+	if !f.Since.IsZero() && createdAt.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && createdAt.After(f.Until) {
+		return false
+	}
+	return true
+}
+
+// HistoryPage is a page of releases from the release history.
+type HistoryPage struct {
+	Releases []*Release
+	// Total is the number of releases matching the filter across all pages.
+	Total int
+}
+
+// HistoryReader provides paginated access to past releases.
+type HistoryReader interface {
+	// FindHistory retrieves releases matching the filter, newest first.
+	FindHistory(ctx context.Context, filter HistoryFilter) (*HistoryPage, error)
+}
+
 // EventPublisher defines the interface for publishing domain events.
 type EventPublisher interface {
 	// Publish publishes domain events.
 	Publish(ctx context.Context, events ...DomainEvent) error
 }
 
+// EventRecord is a domain event as read back from an event log.
+// Data holds the event's attributes, keyed by snake_case name.
+type EventRecord struct {
+	Name       string
+	ReleaseID  ReleaseID
+	OccurredAt time.Time
+	Data       map[string]any
+}
+
+// EventFilter selects records from an event log.
+// Zero values match everything.
+type EventFilter struct {
+	ReleaseID ReleaseID
+	// Names are event names, with or without the "release." prefix
+	// (e.g. "release.published" or "published").
+	Names []string
+	Since time.Time
+	Until time.Time
+	// Limit keeps only the most recent records. Zero returns all of them.
+	Limit int
+}
+
+// Matches returns true if the record passes the filter.
+func (f EventFilter) Matches(rec EventRecord) bool {
+	if f.ReleaseID != "" && rec.ReleaseID != f.ReleaseID {
+		return false
+	}
+	if len(f.Names) > 0 && !slices.ContainsFunc(f.Names, func(name string) bool {
+		return rec.Name == name || rec.Name == "release."+name
+	}) {
+		return false
+	}
+	if !f.Since.IsZero() && rec.OccurredAt.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && rec.OccurredAt.After(f.Until) {
+		return false
+	}
+	return true
+}
+
+// EventLog provides read access to persisted domain events, e.g. to
+// reconstruct the timeline of a release for audits.
+type EventLog interface {
+	// ReadEvents retrieves the records matching the filter, oldest first.
+	ReadEvents(ctx context.Context, filter EventFilter) ([]EventRecord, error)
+}
+
 // UnitOfWork defines the interface for transactional operations.
 type UnitOfWork interface {
 	// Begin starts a new unit of work.
@@ -0,0 +1,80 @@
+// Package release provides domain types for release management.
+package release
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHistoryFilter_Matches(t *testing.T) {
+	created := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		filter HistoryFilter
+		want   bool
+	}{
+		{"empty filter", HistoryFilter{}, true},
+		{"matching repository", HistoryFilter{RepositoryPath: "/repo"}, true},
+		{"other repository", HistoryFilter{RepositoryPath: "/other"}, false},
+		{"matching branch", HistoryFilter{Branch: "main"}, true},
+		{"other branch", HistoryFilter{Branch: "develop"}, false},
+		{"matching state", HistoryFilter{States: []ReleaseState{StateFailed, StatePublished}}, true},
+		{"other state", HistoryFilter{States: []ReleaseState{StateCanceled}}, false},
+		{"since before", HistoryFilter{Since: created.Add(-time.Hour)}, true},
+		{"since after", HistoryFilter{Since: created.Add(time.Hour)}, false},
+		{"until after", HistoryFilter{Until: created.Add(time.Hour)}, true},
+		{"until before", HistoryFilter{Until: created.Add(-time.Hour)}, false},
+		{"inclusive bounds", HistoryFilter{Since: created, Until: created}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches("/repo", "main", StatePublished, created); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventFilter_Matches(t *testing.T) {
+	occurred := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	rec := EventRecord{Name: "release.published", ReleaseID: "rel-1", OccurredAt: occurred}
+
+	tests := []struct {
+		name   string
+		filter EventFilter
+		want   bool
+	}{
+		{"empty filter", EventFilter{}, true},
+		{"matching release", EventFilter{ReleaseID: "rel-1"}, true},
+		{"other release", EventFilter{ReleaseID: "rel-2"}, false},
+		{"full name", EventFilter{Names: []string{"release.published"}}, true},
+		{"short name", EventFilter{Names: []string{"approved", "published"}}, true},
+		{"other name", EventFilter{Names: []string{"failed"}}, false},
+		{"since before", EventFilter{Since: occurred.Add(-time.Hour)}, true},
+		{"since after", EventFilter{Since: occurred.Add(time.Hour)}, false},
+		{"until before", EventFilter{Until: occurred.Add(-time.Hour)}, false},
+		{"inclusive bounds", EventFilter{Since: occurred, Until: occurred}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(rec); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewEventRecord(t *testing.T) {
+	event := NewReleaseFailedEvent("rel-1", "npm publish failed", StatePublishing, true)
+
+	rec := NewEventRecord(event)
+	if rec.Name != "release.failed" || rec.ReleaseID != "rel-1" || !rec.OccurredAt.Equal(event.OccurredAt()) {
+		t.Errorf("NewEventRecord() = %+v", rec)
+	}
+	if rec.Data["reason"] != "npm publish failed" || rec.Data["failed_at"] != "publishing" || rec.Data["recoverable"] != true {
+		t.Errorf("NewEventRecord().Data = %+v", rec.Data)
+	}
+}
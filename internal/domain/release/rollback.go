@@ -0,0 +1,68 @@
+// Package release provides domain types for release management.
+package release
+
+import (
+	"fmt"
+	"time"
+)
+
+// Rollback holds the details of a release rollback.
+type Rollback struct {
+	Reason        string
+	RolledBackBy  string
+	RolledBackAt  time.Time
+	PreviousState ReleaseState
+}
+
+// Rollback returns a copy of the rollback details.
+// Returns nil if the release has not been rolled back.
+func (r *Release) Rollback() *Rollback {
+	if r.rollback == nil {
+		return nil
+	}
+	rollback := *r.rollback
+	return &rollback
+}
+
+// CanRollBack returns true if the release was (at least partly) published
+// and can be rolled back.
+func (r *Release) CanRollBack() bool {
+	return r.state.CanTransitionTo(StateRolledBack) && r.version != nil
+}
+
+// RollBack marks the release as rolled back. The caller is responsible for
+// undoing the published artifacts (tags, plugin side effects) beforehand.
+func (r *Release) RollBack(reason, rolledBackBy string) error {
+	if !r.state.CanTransitionTo(StateRolledBack) {
+		return fmt.Errorf("%w: cannot roll back in state %s", ErrInvalidStateTransition, r.state)
+	}
+	if r.version == nil {
+		return fmt.Errorf("%w: release has no version to roll back", ErrInvalidStateTransition)
+	}
+
+	now := time.Now()
+	previousState := r.state
+	r.rollback = &Rollback{
+		Reason:        reason,
+		RolledBackBy:  rolledBackBy,
+		RolledBackAt:  now,
+		PreviousState: previousState,
+	}
+	r.state = StateRolledBack
+	r.updatedAt = now
+
+	r.addEvent(NewReleaseRolledBackEvent(r.id, previousState, r.tagName, reason, rolledBackBy))
+
+	return nil
+}
+
+// RestoreRollback restores rollback details from persisted data.
+// It should only be called by repository implementations.
+func (r *Release) RestoreRollback(rollback *Rollback) {
+	if rollback == nil {
+		r.rollback = nil
+		return
+	}
+	restored := *rollback
+	r.rollback = &restored
+}
@@ -0,0 +1,79 @@
+// Package release provides domain types for release management.
+package release
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRelease_RollBack(t *testing.T) {
+	t.Run("published", func(t *testing.T) {
+		r := newPublishingRelease()
+		_ = r.MarkPublished("")
+		r.ClearDomainEvents()
+
+		if !r.CanRollBack() {
+			t.Fatal("CanRollBack() = false, want true")
+		}
+		if err := r.RollBack("broken build", "alice"); err != nil {
+			t.Fatalf("RollBack() error = %v", err)
+		}
+		if r.State() != StateRolledBack {
+			t.Errorf("State() = %v, want %v", r.State(), StateRolledBack)
+		}
+
+		rollback := r.Rollback()
+		if rollback == nil {
+			t.Fatal("Rollback() = nil, want details")
+		}
+		if rollback.Reason != "broken build" || rollback.RolledBackBy != "alice" {
+			t.Errorf("Rollback() = %+v, want reason and actor set", rollback)
+		}
+		if rollback.PreviousState != StatePublished {
+			t.Errorf("Rollback().PreviousState = %v, want %v", rollback.PreviousState, StatePublished)
+		}
+		if rollback.RolledBackAt.IsZero() {
+			t.Error("Rollback().RolledBackAt should be set")
+		}
+
+		events := r.DomainEvents()
+		if len(events) != 1 || events[0].EventName() != "release.rolled_back" {
+			t.Errorf("DomainEvents() = %v, want release.rolled_back", events)
+		}
+	})
+
+	t.Run("failed publish", func(t *testing.T) {
+		r := newPublishingRelease()
+		_ = r.MarkFailed("npm publish failed", true)
+
+		if err := r.RollBack("", "ci"); err != nil {
+			t.Fatalf("RollBack() error = %v", err)
+		}
+		if r.Rollback().PreviousState != StateFailed {
+			t.Errorf("Rollback().PreviousState = %v, want %v", r.Rollback().PreviousState, StateFailed)
+		}
+	})
+
+	t.Run("already rolled back", func(t *testing.T) {
+		r := newPublishingRelease()
+		_ = r.MarkPublished("")
+		_ = r.RollBack("", "alice")
+
+		if r.CanRollBack() {
+			t.Error("CanRollBack() = true, want false")
+		}
+		if err := r.RollBack("", "alice"); !errors.Is(err, ErrInvalidStateTransition) {
+			t.Errorf("RollBack() error = %v, want %v", err, ErrInvalidStateTransition)
+		}
+	})
+
+	t.Run("not published", func(t *testing.T) {
+		r := NewRelease("test-1", "main", "/repo")
+		if r.CanRollBack() {
+			t.Error("CanRollBack() = true, want false")
+		}
+		if err := r.RollBack("", "alice"); !errors.Is(err, ErrInvalidStateTransition) {
+			t.Errorf("RollBack() error = %v, want %v", err, ErrInvalidStateTransition)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+// Package release provides domain types for release management.
+package release
+
+import (
+	"fmt"
+	"time"
+)
+
+// PublishSchedule holds the time an approved release is to be published.
+type PublishSchedule struct {
+	PublishAt   time.Time
+	ScheduledBy string
+	ScheduledAt time.Time
+}
+
+// PublishSchedule returns a copy of the publish schedule.
+// Returns nil if the release is not scheduled.
+func (r *Release) PublishSchedule() *PublishSchedule {
+	if r.schedule == nil {
+		return nil
+	}
+	schedule := *r.schedule
+	return &schedule
+}
+
+// SchedulePublish schedules an approved release to be published at the
+// given time. Scheduling again replaces the previous schedule.
+func (r *Release) SchedulePublish(publishAt time.Time, scheduledBy string) error {
+	if r.state != StateApproved {
+		return fmt.Errorf("%w: can only schedule approved releases, current state is %s", ErrInvalidStateTransition, r.state)
+	}
+	if publishAt.IsZero() {
+		return fmt.Errorf("publish time is required")
+	}
+
+	now := time.Now()
+	r.schedule = &PublishSchedule{
+		PublishAt:   publishAt,
+		ScheduledBy: scheduledBy,
+		ScheduledAt: now,
+	}
+	r.updatedAt = now
+
+	r.addEvent(NewReleaseScheduledEvent(r.id, publishAt, scheduledBy))
+
+	return nil
+}
+
+// IsDue returns true if the release is scheduled and its publish time has come.
+func (r *Release) IsDue(now time.Time) bool {
+	return r.state == StateApproved && r.schedule != nil && !now.Before(r.schedule.PublishAt)
+}
+
+// RestorePublishSchedule restores the publish schedule from persisted data.
+// It should only be called by repository implementations.
+func (r *Release) RestorePublishSchedule(schedule *PublishSchedule) {
+	if schedule == nil {
+		r.schedule = nil
+		return
+	}
+	restored := *schedule
+	r.schedule = &restored
+}
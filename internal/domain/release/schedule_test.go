@@ -0,0 +1,51 @@
+// Package release provides domain types for release management.
+package release
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRelease_SchedulePublish(t *testing.T) {
+	r := newReleaseAwaitingApproval()
+	publishAt := time.Now().Add(2 * time.Hour)
+
+	if err := r.SchedulePublish(publishAt, "alice"); !errors.Is(err, ErrInvalidStateTransition) {
+		t.Errorf("SchedulePublish() before approval error = %v, want ErrInvalidStateTransition", err)
+	}
+
+	_ = r.Approve("alice", false)
+	if err := r.SchedulePublish(publishAt, "alice"); err != nil {
+		t.Fatalf("SchedulePublish() error = %v", err)
+	}
+
+	schedule := r.PublishSchedule()
+	if schedule == nil || !schedule.PublishAt.Equal(publishAt) || schedule.ScheduledBy != "alice" {
+		t.Fatalf("PublishSchedule() = %+v", schedule)
+	}
+
+	if r.IsDue(time.Now()) {
+		t.Error("IsDue() before the publish time = true, want false")
+	}
+	if !r.IsDue(publishAt) {
+		t.Error("IsDue() at the publish time = false, want true")
+	}
+
+	_ = r.StartPublishing(nil)
+	if r.IsDue(publishAt) {
+		t.Error("IsDue() while publishing = true, want false")
+	}
+}
+
+func TestRelease_UpdateNotes_ClearsSchedule(t *testing.T) {
+	r := newReleaseAwaitingApproval()
+	_ = r.Approve("alice", false)
+	_ = r.SchedulePublish(time.Now().Add(time.Hour), "alice")
+
+	// Regenerating notes requires a new approval, which invalidates the schedule
+	_ = r.SetNotes(&ReleaseNotes{Changelog: "new notes"})
+	if r.PublishSchedule() != nil {
+		t.Error("PublishSchedule() should be cleared when the notes change")
+	}
+}
@@ -29,6 +29,8 @@ const (
 	StateFailed ReleaseState = "failed"
 	// StateCanceled indicates the release was canceled.
 	StateCanceled ReleaseState = "canceled"
+	// StateRolledBack indicates a published release was rolled back.
+	StateRolledBack ReleaseState = "rolled_back"
 )
 
 // AllStates returns all valid release states.
@@ -43,6 +45,7 @@ func AllStates() []ReleaseState {
 		StatePublished,
 		StateFailed,
 		StateCanceled,
+		StateRolledBack,
 	}
 }
 
@@ -55,7 +58,8 @@ func (s ReleaseState) String() string {
 func (s ReleaseState) IsValid() bool {
 	switch s {
 	case StateInitialized, StatePlanned, StateVersioned, StateNotesGenerated,
-		StateApproved, StatePublishing, StatePublished, StateFailed, StateCanceled:
+		StateApproved, StatePublishing, StatePublished, StateFailed, StateCanceled,
+		StateRolledBack:
 		return true
 	default:
 		return false
@@ -64,7 +68,7 @@ func (s ReleaseState) IsValid() bool {
 
 // IsFinal returns true if this is a final (terminal) state.
 func (s ReleaseState) IsFinal() bool {
-	return s == StatePublished || s == StateFailed || s == StateCanceled
+	return s == StatePublished || s == StateFailed || s == StateCanceled || s == StateRolledBack
 }
 
 // IsActive returns true if the release is actively in progress.
@@ -97,9 +101,10 @@ func validTransitions() map[ReleaseState][]ReleaseState {
 		StateNotesGenerated: {StateApproved, StateCanceled, StateVersioned},
 		StateApproved:       {StatePublishing, StateCanceled, StateNotesGenerated},
 		StatePublishing:     {StatePublished, StateFailed},
-		StatePublished:      {},                               // Terminal state
-		StateFailed:         {StateInitialized, StatePlanned}, // Can retry
-		StateCanceled:       {StateInitialized},               // Can restart
+		StatePublished:      {StateRolledBack},                                                  // Can only be undone
+		StateFailed:         {StateInitialized, StatePlanned, StatePublishing, StateRolledBack}, // Can retry, resume or undo
+		StateCanceled:       {StateInitialized},                                                 // Can restart
+		StateRolledBack:     {},                                                                 // Terminal state
 	}
 }
 
@@ -142,6 +147,8 @@ func (s ReleaseState) Description() string {
 		return "Release failed"
 	case StateCanceled:
 		return "Release canceled"
+	case StateRolledBack:
+		return "Release rolled back"
 	default:
 		return "Unknown state"
 	}
@@ -168,6 +175,8 @@ func (s ReleaseState) Icon() string {
 		return "❌"
 	case StateCanceled:
 		return "⏹️"
+	case StateRolledBack:
+		return "↩️"
 	default:
 		return "❓"
 	}
@@ -19,6 +19,7 @@ func TestReleaseState_String(t *testing.T) {
 		{StatePublished, "published"},
 		{StateFailed, "failed"},
 		{StateCanceled, "canceled"},
+		{StateRolledBack, "rolled_back"},
 	}
 
 	for _, tt := range tests {
@@ -41,6 +42,7 @@ func TestReleaseState_IsValid(t *testing.T) {
 		StatePublished,
 		StateFailed,
 		StateCanceled,
+		StateRolledBack,
 	}
 
 	for _, state := range validStates {
@@ -68,6 +70,7 @@ func TestReleaseState_IsFinal(t *testing.T) {
 		StatePublished,
 		StateFailed,
 		StateCanceled,
+		StateRolledBack,
 	}
 
 	for _, state := range finalStates {
@@ -162,18 +165,26 @@ func TestReleaseState_CanTransitionTo(t *testing.T) {
 		{StatePublishing, StateFailed, true},
 		{StatePublishing, StateCanceled, false}, // Can't cancel during publish
 
-		// From Published (terminal)
+		// From Published (can only be rolled back)
+		{StatePublished, StateRolledBack, true},
 		{StatePublished, StateInitialized, false},
 		{StatePublished, StateFailed, false},
 
 		// From Failed
 		{StateFailed, StateInitialized, true},
 		{StateFailed, StatePlanned, true},
+		{StateFailed, StatePublishing, true},
+		{StateFailed, StateRolledBack, true},
 		{StateFailed, StatePublished, false},
 
 		// From Canceled
 		{StateCanceled, StateInitialized, true},
 		{StateCanceled, StatePlanned, false},
+		{StateCanceled, StateRolledBack, false},
+
+		// From RolledBack (terminal)
+		{StateRolledBack, StateInitialized, false},
+		{StateRolledBack, StatePublished, false},
 	}
 
 	for _, tt := range tests {
@@ -193,7 +204,8 @@ func TestReleaseState_NextValidStates(t *testing.T) {
 	}{
 		{StateInitialized, []ReleaseState{StatePlanned, StateCanceled}},
 		{StatePublishing, []ReleaseState{StatePublished, StateFailed}},
-		{StatePublished, nil}, // Terminal state
+		{StatePublished, []ReleaseState{StateRolledBack}},
+		{StateRolledBack, nil}, // Terminal state
 	}
 
 	for _, tt := range tests {
@@ -280,7 +292,7 @@ func TestReleaseState_Icon(t *testing.T) {
 func TestAllStates(t *testing.T) {
 	states := AllStates()
 
-	expectedCount := 9
+	expectedCount := 10
 	if len(states) != expectedCount {
 		t.Errorf("AllStates() length = %d, want %d", len(states), expectedCount)
 	}
@@ -51,6 +51,21 @@ type CommitReader interface {
 	GetLatestCommit(ctx context.Context, branch string) (*Commit, error)
 }
 
+// CommitFileReader provides access to the files touched by a commit.
+// Use this interface when commits need to be attributed to paths,
+// e.g. to scope changes to a package in a monorepo.
+type CommitFileReader interface {
+	GetCommitFiles(ctx context.Context, hash CommitHash) ([]string, error)
+}
+
+// CommitDiffReader provides access to the changes a commit made to files.
+// Use this interface when the content of a change matters, e.g. to
+// describe how a public API changed.
+type CommitDiffReader interface {
+	CommitFileReader
+	GetCommitDiff(ctx context.Context, hash CommitHash, paths []string) (string, error)
+}
+
 // TagReader provides read access to tags.
 // Use this interface when you only need to read tag information.
 type TagReader interface {
@@ -65,6 +80,7 @@ type TagWriter interface {
 	CreateTag(ctx context.Context, name string, hash CommitHash, message string) (*Tag, error)
 	DeleteTag(ctx context.Context, name string) error
 	PushTag(ctx context.Context, name string, remote string) error
+	DeleteRemoteTag(ctx context.Context, name string, remote string) error
 }
 
 // TagManager combines read and write access to tags.
@@ -133,6 +149,7 @@ const (
 // VersionDiscovery provides methods for discovering versions from tags.
 type VersionDiscovery struct {
 	tagPrefix string
+	scheme    version.Scheme
 }
 
 // NewVersionDiscovery creates a new VersionDiscovery.
@@ -140,18 +157,59 @@ func NewVersionDiscovery(tagPrefix string) *VersionDiscovery {
 	return &VersionDiscovery{tagPrefix: tagPrefix}
 }
 
+// WithScheme sets the version scheme used to read tags.
+// Without a scheme, tags are read as semantic versions.
+func (vd *VersionDiscovery) WithScheme(scheme version.Scheme) *VersionDiscovery {
+	vd.scheme = scheme
+	return vd
+}
+
 // DiscoverCurrentVersion finds the current version from tags.
 func (vd *VersionDiscovery) DiscoverCurrentVersion(ctx context.Context, repo GitRepository) (version.SemanticVersion, error) {
-	tag, err := repo.GetLatestVersionTag(ctx, vd.tagPrefix)
+	tag, err := vd.LatestTag(ctx, repo)
 	if err != nil {
 		return version.Zero, err
 	}
 
-	if tag == nil || tag.Version() == nil {
+	if tag == nil {
 		return version.Initial, nil
 	}
 
-	return *tag.Version(), nil
+	v := vd.versionOf(tag)
+	if v == nil {
+		return version.Initial, nil
+	}
+
+	return *v, nil
+}
+
+// LatestTag returns the tag carrying the highest version, or nil if no tag
+// with the prefix is a version. Semantic versions are read by the
+// repository; other schemes list the tags with the prefix and read them
+// with the scheme, as their tags need not be valid semantic versions
+// (e.g., "2025.07.1").
+func (vd *VersionDiscovery) LatestTag(ctx context.Context, repo TagReader) (*Tag, error) {
+	if vd.scheme == nil || vd.scheme.Name() == version.SchemeSemVer {
+		return repo.GetLatestVersionTag(ctx, vd.tagPrefix)
+	}
+
+	tags, err := repo.GetTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *Tag
+	var latestVersion version.SemanticVersion
+	for _, t := range tags.FilterByPrefix(vd.tagPrefix) {
+		v := vd.versionOf(t)
+		if v == nil {
+			continue
+		}
+		if latest == nil || v.GreaterThan(latestVersion) {
+			latest, latestVersion = t, *v
+		}
+	}
+	return latest, nil
 }
 
 // DiscoverAllVersions finds all versions from tags.
@@ -161,13 +219,40 @@ func (vd *VersionDiscovery) DiscoverAllVersions(ctx context.Context, repo GitRep
 		return nil, err
 	}
 
-	versionTags := tags.FilterByPrefix(vd.tagPrefix).VersionTags()
-	versions := make([]version.SemanticVersion, 0, len(versionTags))
-	for _, t := range versionTags {
-		if t.Version() != nil {
-			versions = append(versions, *t.Version())
+	prefixed := tags.FilterByPrefix(vd.tagPrefix)
+	versions := make([]version.SemanticVersion, 0, len(prefixed))
+	for _, t := range prefixed {
+		if v := vd.versionOf(t); v != nil {
+			versions = append(versions, *v)
 		}
 	}
 
 	return versions, nil
 }
+
+// versionOf returns the version encoded in a tag, stripping the discovery prefix.
+// Prefixes such as "api/v" cannot be parsed by the tag itself, so the remainder
+// after the prefix is parsed when the tag carries no version of its own.
+func (vd *VersionDiscovery) versionOf(tag *Tag) *version.SemanticVersion {
+	if vd.scheme != nil && vd.scheme.Name() != version.SchemeSemVer {
+		if !tag.HasPrefix(vd.tagPrefix) {
+			return nil
+		}
+		v, err := vd.scheme.Parse(tag.WithoutPrefix(vd.tagPrefix))
+		if err != nil {
+			return nil
+		}
+		return &v
+	}
+	if tag.Version() != nil {
+		return tag.Version()
+	}
+	if vd.tagPrefix == "" || !tag.HasPrefix(vd.tagPrefix) {
+		return nil
+	}
+	v, err := version.Parse(tag.WithoutPrefix(vd.tagPrefix))
+	if err != nil {
+		return nil
+	}
+	return &v
+}
@@ -3,6 +3,8 @@ package sourcecontrol
 
 import (
 	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
 )
 
 func TestRepositoryInfo_Fields(t *testing.T) {
@@ -159,3 +161,75 @@ func TestNewVersionDiscovery_EmptyPrefix(t *testing.T) {
 		t.Errorf("tagPrefix = %v, want empty string", vd.tagPrefix)
 	}
 }
+
+func TestVersionDiscovery_versionOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefix  string
+		tagName string
+		want    string
+	}{
+		{"plain v prefix", "v", "v1.2.3", "1.2.3"},
+		{"package prefix", "api/v", "api/v1.2.0", "1.2.0"},
+		{"package prefix without v", "web@", "web@2.0.1", "2.0.1"},
+		{"other package", "api/v", "web/v1.0.0", ""},
+		{"not a version", "api/v", "api/vnext", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vd := NewVersionDiscovery(tt.prefix)
+			got := vd.versionOf(NewTag(tt.tagName, "abc123"))
+			if tt.want == "" {
+				if got != nil {
+					t.Errorf("versionOf(%q) = %v, want nil", tt.tagName, got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("versionOf(%q) = nil, want %s", tt.tagName, tt.want)
+			}
+			if got.String() != tt.want {
+				t.Errorf("versionOf(%q) = %s, want %s", tt.tagName, got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionDiscovery_versionOf_Scheme(t *testing.T) {
+	calver, err := version.NewCalVerScheme("YYYY.0M.MICRO")
+	if err != nil {
+		t.Fatalf("NewCalVerScheme() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		prefix  string
+		tagName string
+		want    string
+	}{
+		{"calver tag", "v", "v2025.07.2", "2025.7.2"},
+		{"calver package tag", "api/", "api/2025.01.0-beta", "2025.1.0-beta"},
+		{"semver tag", "v", "v1.2.3", ""},
+		{"other prefix", "v", "release-2025.07.0", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vd := NewVersionDiscovery(tt.prefix).WithScheme(calver)
+			got := vd.versionOf(NewTag(tt.tagName, "abc123"))
+			if tt.want == "" {
+				if got != nil {
+					t.Errorf("versionOf(%q) = %v, want nil", tt.tagName, got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("versionOf(%q) = nil, want %s", tt.tagName, tt.want)
+			}
+			if got.String() != tt.want {
+				t.Errorf("versionOf(%q) = %s, want %s", tt.tagName, got.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+// Package version provides domain types for semantic versioning.
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CalVer format tokens, following https://calver.org.
+const (
+	calverFullYear  = "YYYY"  // 2006
+	calverShortYear = "YY"    // 6, 16, 106
+	calverZeroYear  = "0Y"    // 06, 16, 106
+	calverMonth     = "MM"    // 1, 2 ... 12
+	calverZeroMonth = "0M"    // 01, 02 ... 12
+	calverWeek      = "WW"    // 1, 2 ... 52 (ISO week)
+	calverZeroWeek  = "0W"    // 01, 02 ... 52 (ISO week)
+	calverDay       = "DD"    // 1, 2 ... 31
+	calverZeroDay   = "0D"    // 01, 02 ... 31
+	calverMicro     = "MICRO" // 0, 1, 2 ... (resets every period)
+)
+
+// DefaultCalVerFormat is the default calendar versioning format.
+const DefaultCalVerFormat = "YYYY.MM.MICRO"
+
+// CalVerScheme is a calendar versioning scheme.
+//
+// The format is a dot-separated list of up to three tokens: a year token,
+// optionally followed by a month, week or day token, and optionally ending
+// with MICRO. Token values are mapped onto the major, minor and patch
+// components in order. MICRO counts releases within the same period and
+// resets when the period changes. Formats without MICRO get an implicit
+// micro component that is only written when a period has several releases
+// (e.g., "25.07", then "25.07.1").
+type CalVerScheme struct {
+	format   string
+	tokens   []string
+	modifier Prerelease
+	now      func() time.Time
+}
+
+// CalVerOption configures a CalVerScheme.
+type CalVerOption func(*CalVerScheme)
+
+// WithCalVerModifier sets a semver-style modifier (e.g., "beta") appended to new versions.
+func WithCalVerModifier(modifier Prerelease) CalVerOption {
+	return func(s *CalVerScheme) {
+		s.modifier = modifier
+	}
+}
+
+// WithCalVerClock sets the clock used to determine the current period.
+func WithCalVerClock(now func() time.Time) CalVerOption {
+	return func(s *CalVerScheme) {
+		s.now = now
+	}
+}
+
+// NewCalVerScheme creates a calendar versioning scheme for the given format.
+func NewCalVerScheme(format string, opts ...CalVerOption) (*CalVerScheme, error) {
+	if err := ValidateCalVerFormat(format); err != nil {
+		return nil, err
+	}
+
+	s := &CalVerScheme{
+		format: format,
+		tokens: strings.Split(format, "."),
+		now:    time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+// ValidateCalVerFormat checks that a CalVer format is supported.
+func ValidateCalVerFormat(format string) error {
+	tokens := strings.Split(format, ".")
+	if len(tokens) == 0 || len(tokens) > 3 {
+		return fmt.Errorf("%w: calver format must have 1 to 3 tokens: %q", ErrInvalidVersion, format)
+	}
+
+	for i, tok := range tokens {
+		switch tok {
+		case calverFullYear, calverShortYear, calverZeroYear:
+			if i != 0 {
+				return fmt.Errorf("%w: calver year token must come first: %q", ErrInvalidVersion, format)
+			}
+		case calverMonth, calverZeroMonth, calverWeek, calverZeroWeek, calverDay, calverZeroDay:
+			if i == 0 {
+				return fmt.Errorf("%w: calver format must start with a year token: %q", ErrInvalidVersion, format)
+			}
+			if i == 2 {
+				return fmt.Errorf("%w: calver format supports one token after the year, then MICRO: %q", ErrInvalidVersion, format)
+			}
+		case calverMicro:
+			if i == 0 {
+				return fmt.Errorf("%w: calver format must start with a year token: %q", ErrInvalidVersion, format)
+			}
+			if i != len(tokens)-1 {
+				return fmt.Errorf("%w: calver MICRO token must come last: %q", ErrInvalidVersion, format)
+			}
+		default:
+			return fmt.Errorf("%w: unknown calver token %q in %q", ErrInvalidVersion, tok, format)
+		}
+	}
+
+	return nil
+}
+
+// Name returns the scheme name.
+func (s *CalVerScheme) Name() string {
+	return SchemeCalVer
+}
+
+// FormatString returns the format string of the scheme.
+func (s *CalVerScheme) FormatString() string {
+	return s.format
+}
+
+// Modifier returns the modifier appended to new versions.
+func (s *CalVerScheme) Modifier() Prerelease {
+	return s.modifier
+}
+
+// Parse parses a calendar version such as "2024.06.3" or "24.07-beta".
+func (s *CalVerScheme) Parse(str string) (SemanticVersion, error) {
+	str = strings.TrimPrefix(str, "v")
+
+	var meta BuildMetadata
+	if i := strings.IndexByte(str, '+'); i >= 0 {
+		meta = BuildMetadata(str[i+1:])
+		str = str[:i]
+	}
+	var pre Prerelease
+	if i := strings.IndexByte(str, '-'); i >= 0 {
+		pre = Prerelease(str[i+1:])
+		str = str[:i]
+	}
+
+	parts := strings.Split(str, ".")
+	maxParts := len(s.tokens)
+	if !s.hasMicroToken() {
+		maxParts++ // implicit micro
+	}
+	if len(parts) < len(s.tokens) || len(parts) > maxParts {
+		return Zero, fmt.Errorf("%w: %q does not match calver format %s", ErrInvalidVersion, str, s.format)
+	}
+
+	var components [3]uint64
+	for i, part := range parts {
+		tok := calverMicro
+		if i < len(s.tokens) {
+			tok = s.tokens[i]
+		}
+		value, err := parseCalVerToken(tok, part)
+		if err != nil {
+			return Zero, fmt.Errorf("%w: %q does not match calver format %s: %v", ErrInvalidVersion, str, s.format, err)
+		}
+		components[i] = value
+	}
+
+	return SemanticVersion{
+		major:      components[0],
+		minor:      components[1],
+		patch:      components[2],
+		prerelease: pre,
+		metadata:   meta,
+	}, nil
+}
+
+// Format writes a version in the scheme's format.
+func (s *CalVerScheme) Format(v SemanticVersion) string {
+	components := [3]uint64{v.major, v.minor, v.patch}
+
+	parts := make([]string, 0, 3)
+	for i, tok := range s.tokens {
+		parts = append(parts, formatCalVerToken(tok, components[i]))
+	}
+	if !s.hasMicroToken() && len(s.tokens) < 3 && components[len(s.tokens)] > 0 {
+		parts = append(parts, strconv.FormatUint(components[len(s.tokens)], 10))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.Join(parts, "."))
+	if v.prerelease != "" {
+		sb.WriteString("-")
+		sb.WriteString(string(v.prerelease))
+	}
+	if v.metadata != "" {
+		sb.WriteString("+")
+		sb.WriteString(string(v.metadata))
+	}
+	return sb.String()
+}
+
+// CalculateNextVersion returns the version for the current period.
+// The bump type is ignored: calendar versions advance with time, and the
+// micro counter increments for further releases within the same period.
+func (s *CalVerScheme) CalculateNextVersion(current SemanticVersion, _ BumpType) SemanticVersion {
+	current = current.WithoutMetadata()
+	period := s.period(s.now())
+	microIdx := s.microIndex()
+
+	var components [3]uint64
+	copy(components[:], period)
+
+	currentComponents := [3]uint64{current.major, current.minor, current.patch}
+	if comparePeriods(currentComponents[:len(period)], period) >= 0 {
+		// Same period (or a clock behind the latest release): stay in the
+		// current period and count up. Promoting a prerelease to its final
+		// version keeps the micro number.
+		copy(components[:], currentComponents[:len(period)])
+		components[microIdx] = currentComponents[microIdx]
+		if !current.IsPrerelease() || s.modifier != "" {
+			components[microIdx]++
+		}
+	}
+
+	return SemanticVersion{
+		major:      components[0],
+		minor:      components[1],
+		patch:      components[2],
+		prerelease: s.modifier,
+	}
+}
+
+// DetermineRequiredBump analyzes changes and determines the required bump type.
+// Calendar versions do not depend on it, but it is reported for information.
+func (s *CalVerScheme) DetermineRequiredBump(hasBreaking, hasFeature, hasFix bool) BumpType {
+	return NewDefaultVersionCalculator().DetermineRequiredBump(hasBreaking, hasFeature, hasFix)
+}
+
+// period returns the values of the date tokens at time t.
+func (s *CalVerScheme) period(t time.Time) []uint64 {
+	year := t.Year()
+	_, week := t.ISOWeek()
+	if s.usesWeeks() {
+		// Weeks belong to their ISO year, so the last days of December
+		// may already count as week 1 of the next year.
+		year, week = t.ISOWeek()
+	}
+
+	values := make([]uint64, 0, len(s.tokens))
+	for _, tok := range s.tokens {
+		switch tok {
+		case calverFullYear:
+			values = append(values, uint64(year))
+		case calverShortYear, calverZeroYear:
+			values = append(values, uint64(year-2000))
+		case calverMonth, calverZeroMonth:
+			values = append(values, uint64(t.Month()))
+		case calverWeek, calverZeroWeek:
+			values = append(values, uint64(week))
+		case calverDay, calverZeroDay:
+			values = append(values, uint64(t.Day()))
+		}
+	}
+	return values
+}
+
+// microIndex returns the component index holding the micro counter.
+func (s *CalVerScheme) microIndex() int {
+	if s.hasMicroToken() {
+		return len(s.tokens) - 1
+	}
+	return len(s.tokens)
+}
+
+func (s *CalVerScheme) hasMicroToken() bool {
+	return s.tokens[len(s.tokens)-1] == calverMicro
+}
+
+func (s *CalVerScheme) usesWeeks() bool {
+	for _, tok := range s.tokens {
+		if tok == calverWeek || tok == calverZeroWeek {
+			return true
+		}
+	}
+	return false
+}
+
+// comparePeriods compares two periods component by component.
+func comparePeriods(a, b []uint64) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// parseCalVerToken parses the value of a single token.
+func parseCalVerToken(tok, part string) (uint64, error) {
+	if part == "" {
+		return 0, fmt.Errorf("empty component")
+	}
+	value, err := strconv.ParseUint(part, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	zeroPadded := strings.HasPrefix(tok, "0")
+	if !zeroPadded && len(part) > 1 && part[0] == '0' {
+		return 0, fmt.Errorf("%s must not have leading zeros", tok)
+	}
+	if zeroPadded && len(part) < 2 {
+		return 0, fmt.Errorf("%s must have two digits", tok)
+	}
+
+	switch tok {
+	case calverFullYear:
+		if len(part) != 4 {
+			return 0, fmt.Errorf("YYYY must have four digits")
+		}
+	case calverMonth, calverZeroMonth:
+		if value < 1 || value > 12 {
+			return 0, fmt.Errorf("month out of range")
+		}
+	case calverWeek, calverZeroWeek:
+		if value < 1 || value > 53 {
+			return 0, fmt.Errorf("week out of range")
+		}
+	case calverDay, calverZeroDay:
+		if value < 1 || value > 31 {
+			return 0, fmt.Errorf("day out of range")
+		}
+	}
+
+	return value, nil
+}
+
+// formatCalVerToken formats the value of a single token.
+func formatCalVerToken(tok string, value uint64) string {
+	if strings.HasPrefix(tok, "0") {
+		return fmt.Sprintf("%02d", value)
+	}
+	return strconv.FormatUint(value, 10)
+}
@@ -0,0 +1,148 @@
+// Package version provides domain types for semantic versioning.
+package version
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func fixedClock(year int, month time.Month, day int) func() time.Time {
+	return func() time.Time {
+		return time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+	}
+}
+
+func TestValidateCalVerFormat(t *testing.T) {
+	tests := []struct {
+		format  string
+		wantErr bool
+	}{
+		{"YYYY.MM.MICRO", false},
+		{"YYYY.0M.MICRO", false},
+		{"YY.0W", false},
+		{"0Y.MM", false},
+		{"YYYY.MICRO", false},
+		{"YYYY", false},
+		{"", true},
+		{"MM.YYYY", true},
+		{"YYYY.MM.DD", true},
+		{"YYYY.MICRO.MM", true},
+		{"YYYY.QQ", true},
+		{"YYYY.MM.MICRO.MICRO", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			err := ValidateCalVerFormat(tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCalVerFormat(%q) error = %v, wantErr %v", tt.format, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidVersion) {
+				t.Errorf("error should wrap ErrInvalidVersion, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCalVerScheme_ParseFormat(t *testing.T) {
+	tests := []struct {
+		format  string
+		input   string
+		want    string // semantic representation
+		wantErr bool
+	}{
+		{"YYYY.MM.MICRO", "2025.7.0", "2025.7.0", false},
+		{"YYYY.0M.MICRO", "2025.07.3", "2025.7.3", false},
+		{"YYYY.0M.MICRO", "2025.07.0-beta", "2025.7.0-beta", false},
+		{"YY.0W", "25.07", "25.7.0", false},
+		{"YY.0W", "25.07.2", "25.7.2", false},
+		{"YYYY.MM.MICRO", "1.2.3", "", true},     // semver tag
+		{"YYYY.MM.MICRO", "2025.13.0", "", true}, // month out of range
+		{"YYYY.MM.MICRO", "2025.07.0", "", true}, // MM has no padding
+		{"YYYY.0M.MICRO", "2025.7.0", "", true},  // 0M requires padding
+		{"YY.0W", "25.54", "", true},             // week out of range
+		{"YYYY.MM.MICRO", "2025.7", "", true},    // missing MICRO
+		{"YY.0W", "25.07.1.1", "", true},         // too many components
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format+"/"+tt.input, func(t *testing.T) {
+			s, err := NewCalVerScheme(tt.format)
+			if err != nil {
+				t.Fatalf("NewCalVerScheme() error = %v", err)
+			}
+
+			got, err := s.Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s", tt.input, got.String(), tt.want)
+			}
+			if formatted := s.Format(got); formatted != tt.input {
+				t.Errorf("Format(Parse(%q)) = %q", tt.input, formatted)
+			}
+		})
+	}
+}
+
+func TestCalVerScheme_CalculateNextVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		modifier Prerelease
+		now      func() time.Time
+		current  string
+		want     string
+	}{
+		{"first release", "YYYY.0M.MICRO", "", fixedClock(2025, time.July, 14), "0.1.0", "2025.07.0"},
+		{"same period", "YYYY.0M.MICRO", "", fixedClock(2025, time.July, 14), "2025.7.0", "2025.07.1"},
+		{"new period resets micro", "YYYY.0M.MICRO", "", fixedClock(2025, time.August, 1), "2025.7.4", "2025.08.0"},
+		{"new year", "YYYY.MM.MICRO", "", fixedClock(2026, time.January, 2), "2025.12.3", "2026.1.0"},
+		{"with modifier", "YYYY.0M.MICRO", "beta", fixedClock(2025, time.July, 14), "2025.7.0", "2025.07.1-beta"},
+		{"modifier new period", "YYYY.0M.MICRO", "beta", fixedClock(2025, time.August, 1), "2025.7.0", "2025.08.0-beta"},
+		{"promote prerelease", "YYYY.0M.MICRO", "", fixedClock(2025, time.July, 14), "2025.7.1-beta", "2025.07.1"},
+		{"implicit micro", "YY.0W", "", fixedClock(2025, time.February, 12), "25.7.0", "25.07.1"},
+		{"week period", "YY.0W", "", fixedClock(2025, time.February, 12), "25.6.1", "25.07"},
+		{"iso week year", "YY.0W", "", fixedClock(2024, time.December, 30), "24.52.0", "25.01"},
+		{"clock behind latest", "YYYY.0M.MICRO", "", fixedClock(2025, time.June, 30), "2025.7.0", "2025.07.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewCalVerScheme(tt.format, WithCalVerModifier(tt.modifier), WithCalVerClock(tt.now))
+			if err != nil {
+				t.Fatalf("NewCalVerScheme() error = %v", err)
+			}
+
+			next := s.CalculateNextVersion(MustParse(tt.current), BumpMajor)
+			if got := s.Format(next); got != tt.want {
+				t.Errorf("CalculateNextVersion(%s) = %s, want %s", tt.current, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchemeOf(t *testing.T) {
+	if got := SchemeOf(NewDefaultVersionCalculator()).Name(); got != SchemeSemVer {
+		t.Errorf("SchemeOf(default) = %s, want %s", got, SchemeSemVer)
+	}
+
+	calver, err := NewCalVerScheme(DefaultCalVerFormat)
+	if err != nil {
+		t.Fatalf("NewCalVerScheme() error = %v", err)
+	}
+	if got := SchemeOf(calver).Name(); got != SchemeCalVer {
+		t.Errorf("SchemeOf(calver) = %s, want %s", got, SchemeCalVer)
+	}
+
+	semver := NewSemVerScheme()
+	v := semver.CalculateNextVersion(MustParse("1.2.3"), BumpMinor)
+	if semver.Format(v) != "1.3.0" {
+		t.Errorf("semver next = %s, want 1.3.0", semver.Format(v))
+	}
+}
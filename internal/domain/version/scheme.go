@@ -0,0 +1,61 @@
+// Package version provides domain types for semantic versioning.
+package version
+
+// Version scheme names.
+const (
+	// SchemeSemVer is the semantic versioning scheme (MAJOR.MINOR.PATCH).
+	SchemeSemVer = "semver"
+	// SchemeCalVer is the calendar versioning scheme (e.g., YYYY.MM.MICRO).
+	SchemeCalVer = "calver"
+)
+
+// Scheme is a pluggable versioning scheme.
+// Versions are always held as SemanticVersion values so they can be compared
+// and persisted uniformly; a scheme decides how they are written in tags and
+// changelogs, how they are read back, and how the next version is chosen.
+type Scheme interface {
+	VersionCalculator
+
+	// Name returns the scheme name (e.g., "semver", "calver").
+	Name() string
+
+	// Parse parses a version written in this scheme (without tag prefix).
+	Parse(s string) (SemanticVersion, error)
+
+	// Format writes a version in this scheme (without tag prefix).
+	Format(v SemanticVersion) string
+}
+
+// SemVerScheme is the default semantic versioning scheme.
+type SemVerScheme struct {
+	DefaultVersionCalculator
+}
+
+// NewSemVerScheme creates a new SemVerScheme.
+func NewSemVerScheme() *SemVerScheme {
+	return &SemVerScheme{}
+}
+
+// Name returns the scheme name.
+func (s *SemVerScheme) Name() string {
+	return SchemeSemVer
+}
+
+// Parse parses a semantic version string.
+func (s *SemVerScheme) Parse(str string) (SemanticVersion, error) {
+	return Parse(str)
+}
+
+// Format returns the semantic version string.
+func (s *SemVerScheme) Format(v SemanticVersion) string {
+	return v.String()
+}
+
+// SchemeOf returns the scheme implemented by a version calculator.
+// Calculators that are not schemes are treated as semantic versioning.
+func SchemeOf(calc VersionCalculator) Scheme {
+	if scheme, ok := calc.(Scheme); ok {
+		return scheme
+	}
+	return NewSemVerScheme()
+}
@@ -126,6 +126,22 @@ func (a *Adapter) GetCommit(ctx context.Context, hash sourcecontrol.CommitHash)
 	return convertCommit(commit), nil
 }
 
+// GetCommitFiles retrieves the paths of files changed by a commit.
+func (a *Adapter) GetCommitFiles(ctx context.Context, hash sourcecontrol.CommitHash) ([]string, error) {
+	ctx, cancel := withLocalTimeout(ctx)
+	defer cancel()
+
+	return a.svc.GetCommitFiles(ctx, string(hash))
+}
+
+// GetCommitDiff retrieves the unified diff of a commit for the given paths.
+func (a *Adapter) GetCommitDiff(ctx context.Context, hash sourcecontrol.CommitHash, paths []string) (string, error) {
+	ctx, cancel := withLocalTimeout(ctx)
+	defer cancel()
+
+	return a.svc.GetCommitDiff(ctx, string(hash), paths)
+}
+
 // GetCommitsBetween retrieves commits between two references.
 func (a *Adapter) GetCommitsBetween(ctx context.Context, from, to string) ([]*sourcecontrol.Commit, error) {
 	ctx, cancel := withLocalTimeout(ctx)
@@ -243,6 +259,14 @@ func (a *Adapter) PushTag(ctx context.Context, name string, remote string) error
 	return a.svc.PushTag(ctx, name, opts)
 }
 
+// DeleteRemoteTag deletes a tag from a remote.
+func (a *Adapter) DeleteRemoteTag(ctx context.Context, name string, remote string) error {
+	ctx, cancel := withRemoteTimeout(ctx)
+	defer cancel()
+
+	return a.svc.DeleteRemoteTag(ctx, name, gitservice.PushOptions{Remote: remote})
+}
+
 // IsDirty checks if the working tree is dirty.
 func (a *Adapter) IsDirty(ctx context.Context) (bool, error) {
 	clean, err := a.svc.IsClean(ctx)
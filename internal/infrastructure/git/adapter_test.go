@@ -3,13 +3,18 @@ package git
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/felixgeelhaar/release-pilot/internal/domain/sourcecontrol"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
 	gitservice "github.com/felixgeelhaar/release-pilot/internal/service/git"
 )
 
@@ -29,6 +34,8 @@ type mockGitService struct {
 	tag            *gitservice.Tag
 	isClean        bool
 	diffStats      *gitservice.DiffStats
+	commitFiles    map[string][]string
+	commitDiffs    map[string]string
 	remoteURL      string
 	err            error
 	createTagError error
@@ -72,6 +79,20 @@ func (m *mockGitService) GetCommit(ctx context.Context, hash string) (*gitservic
 	return nil, nil
 }
 
+func (m *mockGitService) GetCommitFiles(ctx context.Context, hash string) ([]string, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.commitFiles[hash], nil
+}
+
+func (m *mockGitService) GetCommitDiff(ctx context.Context, hash string, paths []string) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	return m.commitDiffs[hash], nil
+}
+
 func (m *mockGitService) GetCommitsSince(ctx context.Context, ref string) ([]gitservice.Commit, error) {
 	if m.err != nil {
 		return nil, m.err
@@ -156,6 +177,13 @@ func (m *mockGitService) PushTag(ctx context.Context, name string, opts gitservi
 	return nil
 }
 
+func (m *mockGitService) DeleteRemoteTag(ctx context.Context, name string, opts gitservice.PushOptions) error {
+	if m.pushTagError != nil {
+		return m.pushTagError
+	}
+	return nil
+}
+
 func (m *mockGitService) GetCurrentBranch(ctx context.Context) (string, error) {
 	if m.err != nil {
 		return "", m.err
@@ -349,6 +377,34 @@ func TestAdapterGetCommit(t *testing.T) {
 	assert.Equal(t, "John Doe", commit.Author().Name)
 }
 
+// TestAdapterGetCommitFiles tests the Adapter.GetCommitFiles method.
+func TestAdapterGetCommitFiles(t *testing.T) {
+	mockSvc := &mockGitService{
+		commitFiles: map[string][]string{
+			"abc123": {"services/api/main.go", "README.md"},
+		},
+	}
+
+	adapter := NewAdapter(mockSvc)
+
+	files, err := adapter.GetCommitFiles(context.Background(), "abc123")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"services/api/main.go", "README.md"}, files)
+}
+
+// TestAdapterGetCommitDiff tests the Adapter.GetCommitDiff method.
+func TestAdapterGetCommitDiff(t *testing.T) {
+	mockSvc := &mockGitService{
+		commitDiffs: map[string]string{"abc123": "-func Old()\n+func New()\n"},
+	}
+
+	adapter := NewAdapter(mockSvc)
+
+	diff, err := adapter.GetCommitDiff(context.Background(), "abc123", []string{"api.go"})
+	require.NoError(t, err)
+	assert.Equal(t, "-func Old()\n+func New()\n", diff)
+}
+
 // TestAdapterGetCommitsBetween tests the Adapter.GetCommitsBetween method.
 func TestAdapterGetCommitsBetween(t *testing.T) {
 	now := time.Now()
@@ -555,6 +611,17 @@ func TestAdapterPushTag(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestAdapterDeleteRemoteTag tests the Adapter.DeleteRemoteTag method.
+func TestAdapterDeleteRemoteTag(t *testing.T) {
+	mockSvc := &mockGitService{}
+
+	adapter := NewAdapter(mockSvc)
+	ctx := context.Background()
+
+	err := adapter.DeleteRemoteTag(ctx, "v1.0.0", "origin")
+	require.NoError(t, err)
+}
+
 // TestAdapterIsDirty tests the Adapter.IsDirty method.
 func TestAdapterIsDirty(t *testing.T) {
 	t.Run("clean repo", func(t *testing.T) {
@@ -768,3 +835,89 @@ func TestConvertCommits(t *testing.T) {
 	assert.Equal(t, sourcecontrol.CommitHash("abc123"), result[0].Hash())
 	assert.Equal(t, sourcecontrol.CommitHash("def456"), result[1].Hash())
 }
+
+// TestVersionDiscovery_CalVerTags discovers zero-padded CalVer tags through
+// the git service, which only lists tags that are valid semantic versions.
+func TestVersionDiscovery_CalVerTags(t *testing.T) {
+	tests := []struct {
+		name        string
+		format      string
+		prefix      string
+		tags        []string
+		wantTag     string
+		wantVersion string
+	}{
+		{
+			name:        "zero-padded month",
+			format:      "YYYY.0M.MICRO",
+			prefix:      "v",
+			tags:        []string{"v2025.06.3", "v2025.07.0", "v2025.07.1", "v1.2.3"},
+			wantTag:     "v2025.07.1",
+			wantVersion: "2025.7.1",
+		},
+		{
+			name:        "implicit micro",
+			format:      "YY.0W",
+			prefix:      "",
+			tags:        []string{"25.06", "25.07", "25.07.1"},
+			wantTag:     "25.07.1",
+			wantVersion: "25.7.1",
+		},
+		{
+			name:        "tags outside the format",
+			format:      "YYYY.0M.MICRO",
+			prefix:      "v",
+			tags:        []string{"v2025.07.1", "v2025.8.0", "v3000.1.0"},
+			wantTag:     "v2025.07.1",
+			wantVersion: "2025.7.1",
+		},
+		{
+			name:   "no calver tags",
+			format: "YYYY.0M.MICRO",
+			prefix: "v",
+			tags:   []string{"v1.2.3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			repo, err := gogit.PlainInit(dir, false)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("test"), 0o644))
+			worktree, err := repo.Worktree()
+			require.NoError(t, err)
+			_, err = worktree.Add("README.md")
+			require.NoError(t, err)
+			head, err := worktree.Commit("chore: initial commit", &gogit.CommitOptions{
+				Author: &object.Signature{Name: "Test", Email: "test@example.com", When: time.Now()},
+			})
+			require.NoError(t, err)
+			for _, tag := range tt.tags {
+				_, err := repo.CreateTag(tag, head, nil)
+				require.NoError(t, err)
+			}
+
+			svc, err := gitservice.NewService(gitservice.WithRepoPath(dir))
+			require.NoError(t, err)
+			adapter := NewAdapter(svc)
+			scheme, err := version.NewCalVerScheme(tt.format)
+			require.NoError(t, err)
+			vd := sourcecontrol.NewVersionDiscovery(tt.prefix).WithScheme(scheme)
+
+			tag, err := vd.LatestTag(context.Background(), adapter)
+			require.NoError(t, err)
+			current, err := vd.DiscoverCurrentVersion(context.Background(), adapter)
+			require.NoError(t, err)
+
+			if tt.wantTag == "" {
+				assert.Nil(t, tag)
+				assert.Equal(t, version.Initial, current)
+				return
+			}
+			require.NotNil(t, tag)
+			assert.Equal(t, tt.wantTag, tag.Name())
+			assert.Equal(t, tt.wantVersion, current.String())
+		})
+	}
+}
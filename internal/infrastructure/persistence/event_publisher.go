@@ -3,6 +3,7 @@ package persistence
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
@@ -110,3 +111,26 @@ func NewNoOpEventPublisher() *NoOpEventPublisher {
 func (p *NoOpEventPublisher) Publish(ctx context.Context, events ...release.DomainEvent) error {
 	return nil
 }
+
+// MultiEventPublisher publishes events to several publishers, e.g. the
+// event store and webhooks.
+type MultiEventPublisher struct {
+	publishers []release.EventPublisher
+}
+
+// NewMultiEventPublisher creates a publisher that fans out to all publishers.
+func NewMultiEventPublisher(publishers ...release.EventPublisher) *MultiEventPublisher {
+	return &MultiEventPublisher{publishers: publishers}
+}
+
+// Publish publishes the events to every publisher, even if some fail.
+// Failures are returned together.
+func (p *MultiEventPublisher) Publish(ctx context.Context, events ...release.DomainEvent) error {
+	var errs []error
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, events...); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -3,6 +3,8 @@ package persistence
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -264,3 +266,27 @@ func TestNoOpEventPublisher_Publish(t *testing.T) {
 		t.Errorf("Publish() error = %v, want nil", err)
 	}
 }
+
+// failingEventPublisher always fails to publish.
+type failingEventPublisher struct{}
+
+func (failingEventPublisher) Publish(ctx context.Context, events ...release.DomainEvent) error {
+	return errors.New("endpoint unavailable")
+}
+
+func TestMultiEventPublisher_Publish(t *testing.T) {
+	first := NewInMemoryEventPublisher()
+	second := NewInMemoryEventPublisher()
+	publisher := NewMultiEventPublisher(first, failingEventPublisher{}, second)
+
+	event := release.NewReleaseInitializedEvent("test-1", "main", "/repo")
+	err := publisher.Publish(context.Background(), event)
+
+	if err == nil || !strings.Contains(err.Error(), "endpoint unavailable") {
+		t.Errorf("Publish() error = %v, want endpoint unavailable", err)
+	}
+	// A failing publisher does not stop the others
+	if len(first.GetEvents()) != 1 || len(second.GetEvents()) != 1 {
+		t.Errorf("events = %d/%d, want 1/1", len(first.GetEvents()), len(second.GetEvents()))
+	}
+}
@@ -0,0 +1,221 @@
+// Package persistence provides infrastructure implementations for data persistence.
+package persistence
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+)
+
+// eventLogExtension is the file extension of per-release event logs.
+const eventLogExtension = ".jsonl"
+
+// FileEventStore implements release.EventPublisher and release.EventLog
+// with one append-only JSON lines file per release.
+type FileEventStore struct {
+	basePath string
+	mu       sync.RWMutex
+}
+
+// NewFileEventStore creates a new file-based event store.
+func NewFileEventStore(basePath string) (*FileEventStore, error) {
+	// 0700 for the directory since events may contain sensitive release data
+	if err := os.MkdirAll(basePath, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create event store directory: %w", err)
+	}
+
+	return &FileEventStore{basePath: basePath}, nil
+}
+
+// eventDTO is a data transfer object for a single event log line.
+type eventDTO struct {
+	Event      string         `json:"event"`
+	ReleaseID  string         `json:"release_id"`
+	OccurredAt string         `json:"occurred_at"`
+	Data       map[string]any `json:"data,omitempty"`
+}
+
+// Publish appends the events to the logs of their releases.
+func (s *FileEventStore) Publish(ctx context.Context, events ...release.DomainEvent) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	// Group lines per release so each log is written with a single append
+	lines := make(map[release.ReleaseID]*bytes.Buffer)
+	order := make([]release.ReleaseID, 0, 1)
+	for _, event := range events {
+		id := event.AggregateID()
+		if !isValidEventLogID(id) {
+			return fmt.Errorf("invalid release ID for event %s: %q", event.EventName(), id)
+		}
+
+		data, err := json.Marshal(toEventDTO(event))
+		if err != nil {
+			return fmt.Errorf("failed to marshal event %s: %w", event.EventName(), err)
+		}
+
+		buf, ok := lines[id]
+		if !ok {
+			buf = &bytes.Buffer{}
+			lines[id] = buf
+			order = append(order, id)
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range order {
+		if err := s.appendLog(id, lines[id].Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendLog appends data to the event log of a release.
+// This method must be called with the write lock held.
+func (s *FileEventStore) appendLog(id release.ReleaseID, data []byte) error {
+	// 0600 for event logs, matching the release files
+	f, err := os.OpenFile(s.logFilePath(id), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open event log: %w", err)
+	}
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write event log: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to sync event log: %w", err)
+	}
+	return f.Close()
+}
+
+// ReadEvents retrieves the records matching the filter, oldest first.
+// Lines that cannot be parsed, such as a line torn by a crash, are skipped.
+func (s *FileEventStore) ReadEvents(ctx context.Context, filter release.EventFilter) ([]release.EventRecord, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var files []string
+	if filter.ReleaseID != "" {
+		if !isValidEventLogID(filter.ReleaseID) {
+			return nil, fmt.Errorf("invalid release ID: %q", filter.ReleaseID)
+		}
+		files = []string{s.logFilePath(filter.ReleaseID)}
+	} else {
+		var err error
+		files, err = filepath.Glob(filepath.Join(s.basePath, "*"+eventLogExtension))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list event logs: %w", err)
+		}
+	}
+
+	var records []release.EventRecord
+	for _, file := range files {
+		fileRecords, err := readEventLog(file)
+		if err != nil {
+			return nil, err
+		}
+		for _, rec := range fileRecords {
+			if filter.Matches(rec) {
+				records = append(records, rec)
+			}
+		}
+	}
+
+	// Stable so events of one release that share a timestamp keep log order
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].OccurredAt.Before(records[j].OccurredAt)
+	})
+
+	if filter.Limit > 0 && len(records) > filter.Limit {
+		records = records[len(records)-filter.Limit:]
+	}
+
+	return records, nil
+}
+
+// readEventLog reads all records from an event log file.
+// A missing file holds no records.
+func readEventLog(path string) ([]release.EventRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open event log: %w", err)
+	}
+	defer f.Close()
+
+	var records []release.EventRecord
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxReleaseFileSize)
+	for scanner.Scan() {
+		var dto eventDTO
+		if err := json.Unmarshal(scanner.Bytes(), &dto); err != nil {
+			continue
+		}
+		occurredAt, err := time.Parse(time.RFC3339Nano, dto.OccurredAt)
+		if err != nil {
+			continue
+		}
+		records = append(records, release.EventRecord{
+			Name:       dto.Event,
+			ReleaseID:  release.ReleaseID(dto.ReleaseID),
+			OccurredAt: occurredAt,
+			Data:       dto.Data,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read event log %s: %w", filepath.Base(path), err)
+	}
+
+	return records, nil
+}
+
+func (s *FileEventStore) logFilePath(id release.ReleaseID) string {
+	return filepath.Join(s.basePath, string(id)+eventLogExtension)
+}
+
+// isValidEventLogID reports whether a release ID can be used as a log file
+// name without escaping the store directory.
+func isValidEventLogID(id release.ReleaseID) bool {
+	s := string(id)
+	return s != "" && s != "." && s != ".." && !strings.ContainsAny(s, `/\`)
+}
+
+// toEventDTO converts a domain event to its log representation.
+func toEventDTO(event release.DomainEvent) *eventDTO {
+	rec := release.NewEventRecord(event)
+	return &eventDTO{
+		Event:      rec.Name,
+		ReleaseID:  string(rec.ReleaseID),
+		OccurredAt: rec.OccurredAt.UTC().Format(time.RFC3339Nano),
+		Data:       rec.Data,
+	}
+}
@@ -0,0 +1,159 @@
+// Package persistence provides infrastructure implementations for data persistence.
+package persistence
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+)
+
+func TestFileEventStore_PublishAndRead(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileEventStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileEventStore() error = %v", err)
+	}
+	ctx := context.Background()
+
+	err = store.Publish(ctx,
+		release.NewReleaseInitializedEvent("rel-1", "main", "/repo"),
+		release.NewReleasePlannedEvent("rel-1", version.MustParse("1.0.0"), version.MustParse("1.1.0"), changes.ReleaseTypeMinor.String(), 5),
+		release.NewPluginExecutedEvent("rel-1", "github", "post-publish", true, "release created", 1500*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	// A new store on the same directory sees the events
+	reopened, err := NewFileEventStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileEventStore() error = %v", err)
+	}
+	records, err := reopened.ReadEvents(ctx, release.EventFilter{ReleaseID: "rel-1"})
+	if err != nil {
+		t.Fatalf("ReadEvents() error = %v", err)
+	}
+
+	if len(records) != 3 {
+		t.Fatalf("ReadEvents() returned %d records, want 3", len(records))
+	}
+	if records[0].Name != "release.initialized" || records[0].Data["branch"] != "main" {
+		t.Errorf("records[0] = %+v", records[0])
+	}
+	if records[1].Data["next_version"] != "1.1.0" || records[1].Data["commit_count"] != float64(5) {
+		t.Errorf("records[1].Data = %+v", records[1].Data)
+	}
+	if records[2].Data["plugin"] != "github" || records[2].Data["duration_ms"] != float64(1500) {
+		t.Errorf("records[2].Data = %+v", records[2].Data)
+	}
+	if records[0].ReleaseID != "rel-1" || records[0].OccurredAt.IsZero() {
+		t.Errorf("records[0] = %+v, want release ID and timestamp", records[0])
+	}
+
+	info, err := os.Stat(filepath.Join(dir, "rel-1.jsonl"))
+	if err != nil {
+		t.Fatalf("event log not written: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("event log permissions = %o, want 600", perm)
+	}
+}
+
+func TestFileEventStore_ReadEventsFilter(t *testing.T) {
+	store, err := NewFileEventStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileEventStore() error = %v", err)
+	}
+	ctx := context.Background()
+
+	events := []release.DomainEvent{
+		release.NewReleaseInitializedEvent("rel-1", "main", "/repo"),
+		release.NewReleaseApprovedEvent("rel-1", "alice"),
+		release.NewReleaseInitializedEvent("rel-2", "main", "/repo"),
+		release.NewReleaseFailedEvent("rel-2", "npm publish failed", release.StatePublishing, true),
+	}
+	for _, event := range events {
+		if err := store.Publish(ctx, event); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter release.EventFilter
+		want   []string
+	}{
+		{"all releases", release.EventFilter{}, []string{"release.initialized", "release.approved", "release.initialized", "release.failed"}},
+		{"one release", release.EventFilter{ReleaseID: "rel-2"}, []string{"release.initialized", "release.failed"}},
+		{"by name", release.EventFilter{Names: []string{"initialized"}}, []string{"release.initialized", "release.initialized"}},
+		{"limit keeps latest", release.EventFilter{Limit: 2}, []string{"release.initialized", "release.failed"}},
+		{"unknown release", release.EventFilter{ReleaseID: "rel-3"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, err := store.ReadEvents(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("ReadEvents() error = %v", err)
+			}
+			if len(records) != len(tt.want) {
+				t.Fatalf("ReadEvents() returned %d records, want %d", len(records), len(tt.want))
+			}
+			for i, name := range tt.want {
+				if records[i].Name != name {
+					t.Errorf("records[%d].Name = %q, want %q", i, records[i].Name, name)
+				}
+			}
+		})
+	}
+}
+
+func TestFileEventStore_SkipsTornLines(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileEventStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileEventStore() error = %v", err)
+	}
+	ctx := context.Background()
+
+	if err := store.Publish(ctx, release.NewReleaseApprovedEvent("rel-1", "alice")); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	// Simulate a crash in the middle of an append
+	f, err := os.OpenFile(filepath.Join(dir, "rel-1.jsonl"), os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatalf("failed to open event log: %v", err)
+	}
+	_, _ = f.WriteString(`{"event":"release.publ`)
+	_ = f.Close()
+
+	records, err := store.ReadEvents(ctx, release.EventFilter{ReleaseID: "rel-1"})
+	if err != nil {
+		t.Fatalf("ReadEvents() error = %v", err)
+	}
+	if len(records) != 1 || records[0].Data["approved_by"] != "alice" {
+		t.Errorf("ReadEvents() = %+v, want only the approval", records)
+	}
+}
+
+func TestFileEventStore_InvalidReleaseID(t *testing.T) {
+	store, err := NewFileEventStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileEventStore() error = %v", err)
+	}
+	ctx := context.Background()
+
+	if err := store.Publish(ctx, release.NewReleaseApprovedEvent("../escape", "alice")); err == nil {
+		t.Error("Publish() expected error for path traversal release ID")
+	}
+	if _, err := store.ReadEvents(ctx, release.EventFilter{ReleaseID: "a/b"}); err == nil {
+		t.Error("ReadEvents() expected error for release ID with separator")
+	}
+}
@@ -0,0 +1,268 @@
+// Package persistence provides infrastructure implementations for data persistence.
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/fileutil"
+)
+
+// releaseIndexFile is the name of the release index file.
+// It does not use the .json extension so release scans skip it.
+const releaseIndexFile = "index.idx"
+
+// releaseIndexVersion is bumped whenever the index layout changes,
+// forcing existing indexes to be rebuilt.
+const releaseIndexVersion = 1
+
+// releaseIndex holds the attributes needed to filter and page the release
+// history without reading every release file.
+type releaseIndex struct {
+	Version int                    `json:"version"`
+	Entries map[string]*indexEntry `json:"entries"`
+	// Files records the release files the index was built from, including
+	// those that could not be indexed, so changes made outside the
+	// repository are detected.
+	Files map[string]fileStamp `json:"files"`
+}
+
+// fileStamp identifies the version of a release file on disk.
+type fileStamp struct {
+	ModTime int64 `json:"mod_time"`
+	Size    int64 `json:"size"`
+}
+
+// indexEntry is the indexed view of a single release.
+type indexEntry struct {
+	State          string `json:"state"`
+	Branch         string `json:"branch"`
+	RepositoryPath string `json:"repository_path"`
+	CreatedAt      string `json:"created_at"`
+}
+
+func newIndexEntry(dto *releaseDTO) *indexEntry {
+	return &indexEntry{
+		State:          dto.State,
+		Branch:         dto.Branch,
+		RepositoryPath: dto.RepositoryPath,
+		CreatedAt:      dto.CreatedAt,
+	}
+}
+
+// FindHistory retrieves releases matching the filter, newest first.
+// Only the releases on the requested page are read from disk.
+func (r *FileReleaseRepository) FindHistory(ctx context.Context, filter release.HistoryFilter) (*release.HistoryPage, error) {
+	// Check context cancellation before acquiring lock
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	// Loading the index may rebuild it, which writes to disk
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	idx, err := r.loadIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type match struct {
+		id        string
+		createdAt time.Time
+	}
+	matches := make([]match, 0, len(idx.Entries))
+	for id, entry := range idx.Entries {
+		createdAt, err := time.Parse(time.RFC3339, entry.CreatedAt)
+		if err != nil {
+			continue
+		}
+		if filter.Matches(entry.RepositoryPath, entry.Branch, release.ReleaseState(entry.State), createdAt) {
+			matches = append(matches, match{id: id, createdAt: createdAt})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if !matches[i].createdAt.Equal(matches[j].createdAt) {
+			return matches[i].createdAt.After(matches[j].createdAt)
+		}
+		return matches[i].id > matches[j].id
+	})
+
+	page := &release.HistoryPage{Total: len(matches)}
+
+	start := min(max(filter.Offset, 0), len(matches))
+	end := len(matches)
+	if filter.Limit > 0 {
+		end = min(start+filter.Limit, len(matches))
+	}
+
+	page.Releases = make([]*release.Release, 0, end-start)
+	for _, m := range matches[start:end] {
+		rel, err := r.readRelease(release.ReleaseID(m.id))
+		if err != nil {
+			// Skip releases that can no longer be read
+			continue
+		}
+		page.Releases = append(page.Releases, rel)
+	}
+
+	return page, nil
+}
+
+// loadIndex reads the release index, rebuilding it when it is missing,
+// unreadable or out of sync with the release files on disk (for example,
+// when releases were added, edited or removed by an older version or by hand).
+// This method must be called with the write lock held.
+func (r *FileReleaseRepository) loadIndex(ctx context.Context) (*releaseIndex, error) {
+	files, err := r.releaseFiles()
+	if err != nil {
+		return nil, err
+	}
+	stamps := releaseFileStamps(files)
+
+	if idx := r.readIndex(); idx != nil && maps.Equal(idx.Files, stamps) {
+		return idx, nil
+	}
+
+	return r.rebuildIndex(ctx, files, stamps)
+}
+
+// readIndex reads the index file, returning nil if it is missing or invalid.
+func (r *FileReleaseRepository) readIndex() *releaseIndex {
+	data, err := fileutil.ReadFileLimited(r.indexFilePath(), MaxReleaseFileSize)
+	if err != nil {
+		return nil
+	}
+	var idx releaseIndex
+	if err := json.Unmarshal(data, &idx); err != nil || idx.Version != releaseIndexVersion || idx.Entries == nil || idx.Files == nil {
+		return nil
+	}
+	return &idx
+}
+
+// rebuildIndex builds the index by reading every release file once.
+// The stamps are taken before the files are read, so a file changed in
+// between is indexed again by the next load.
+// This method must be called with the write lock held.
+func (r *FileReleaseRepository) rebuildIndex(ctx context.Context, files []string, stamps map[string]fileStamp) (*releaseIndex, error) {
+	idx := &releaseIndex{
+		Version: releaseIndexVersion,
+		Entries: make(map[string]*indexEntry, len(files)),
+		Files:   stamps,
+	}
+
+	for _, filePath := range files {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		data, err := fileutil.ReadFileLimited(filePath, MaxReleaseFileSize)
+		if err != nil {
+			continue
+		}
+		var dto releaseDTO
+		if err := json.Unmarshal(data, &dto); err != nil || dto.ID == "" {
+			continue
+		}
+		idx.Entries[dto.ID] = newIndexEntry(&dto)
+	}
+
+	if err := r.writeIndex(idx); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// updateIndex applies a change to the index after the release file at
+// filePath was written or removed.
+// Index maintenance is best effort: if it fails, the index is removed so that
+// the next history query rebuilds it from the release files.
+// This method must be called with the write lock held.
+func (r *FileReleaseRepository) updateIndex(ctx context.Context, filePath string, update func(*releaseIndex)) {
+	var err error
+	idx := r.readIndex()
+	if idx == nil {
+		// No usable index yet: build it from disk, which already reflects the change
+		idx, err = r.loadIndex(ctx)
+	}
+	if err == nil {
+		update(idx)
+		name := filepath.Base(filePath)
+		if stamp, ok := statReleaseFile(filePath); ok {
+			idx.Files[name] = stamp
+		} else {
+			delete(idx.Files, name)
+		}
+		err = r.writeIndex(idx)
+	}
+	if err != nil {
+		_ = os.Remove(r.indexFilePath())
+	}
+}
+
+// writeIndex persists the index atomically.
+func (r *FileReleaseRepository) writeIndex(idx *releaseIndex) error {
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return fmt.Errorf("failed to marshal release index: %w", err)
+	}
+	if err := fileutil.AtomicWriteFile(r.indexFilePath(), data, 0600); err != nil {
+		return fmt.Errorf("failed to write release index: %w", err)
+	}
+	return nil
+}
+
+// releaseFiles lists the release files in the repository directory.
+func (r *FileReleaseRepository) releaseFiles() ([]string, error) {
+	entries, err := os.ReadDir(r.basePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read repository directory: %w", err)
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".json" {
+			files = append(files, filepath.Join(r.basePath, entry.Name()))
+		}
+	}
+	return files, nil
+}
+
+// releaseFileStamps returns the stamps of the release files, keyed by file name.
+// Files that cannot be stat'ed are left out, so the index is rebuilt once they
+// can be.
+func releaseFileStamps(files []string) map[string]fileStamp {
+	stamps := make(map[string]fileStamp, len(files))
+	for _, filePath := range files {
+		if stamp, ok := statReleaseFile(filePath); ok {
+			stamps[filepath.Base(filePath)] = stamp
+		}
+	}
+	return stamps
+}
+
+// statReleaseFile returns the stamp of a release file, or false if it cannot
+// be stat'ed.
+func statReleaseFile(filePath string) (fileStamp, bool) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return fileStamp{}, false
+	}
+	return fileStamp{ModTime: info.ModTime().UnixNano(), Size: info.Size()}, true
+}
+
+func (r *FileReleaseRepository) indexFilePath() string {
+	return filepath.Join(r.basePath, releaseIndexFile)
+}
@@ -0,0 +1,201 @@
+// Package persistence provides infrastructure implementations for data persistence.
+package persistence
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+)
+
+// saveHistoryRelease saves a planned release created at the given time.
+func saveHistoryRelease(t *testing.T, repo *FileReleaseRepository, id, branch string, createdAt time.Time, publish bool) {
+	t.Helper()
+
+	rel := release.NewRelease(release.ReleaseID(id), branch, "/repo")
+	plan := release.NewReleasePlan(version.MustParse("1.0.0"), version.MustParse("1.1.0"), changes.ReleaseTypeMinor, changes.NewChangeSet(changes.ChangeSetID("cs-"+id), "v1.0.0", "HEAD"), false)
+	if err := rel.SetPlan(plan); err != nil {
+		t.Fatalf("SetPlan() error = %v", err)
+	}
+
+	var ver *version.SemanticVersion
+	state := release.StatePlanned
+	var publishedAt *time.Time
+	if publish {
+		v := version.MustParse("1.1.0")
+		ver = &v
+		state = release.StatePublished
+		publishedAt = &createdAt
+	}
+	rel.ReconstructState(state, plan, ver, "v1.1.0", nil, nil, createdAt, createdAt, publishedAt, "")
+	if publish {
+		rel.RecordPluginExecution("github", "post_publish", true, "release created", 1500*time.Millisecond)
+	}
+
+	if err := repo.Save(context.Background(), rel); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+}
+
+func TestFileReleaseRepository_FindHistory(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFileReleaseRepository(tmpDir)
+	ctx := context.Background()
+
+	base := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	saveHistoryRelease(t, repo, "rel-1", "main", base, true)
+	saveHistoryRelease(t, repo, "rel-2", "main", base.Add(24*time.Hour), true)
+	saveHistoryRelease(t, repo, "rel-3", "develop", base.Add(48*time.Hour), false)
+	saveHistoryRelease(t, repo, "rel-4", "main", base.Add(72*time.Hour), false)
+
+	tests := []struct {
+		name      string
+		filter    release.HistoryFilter
+		wantIDs   []string
+		wantTotal int
+	}{
+		{name: "all newest first", filter: release.HistoryFilter{}, wantIDs: []string{"rel-4", "rel-3", "rel-2", "rel-1"}, wantTotal: 4},
+		{name: "by state", filter: release.HistoryFilter{States: []release.ReleaseState{release.StatePublished}}, wantIDs: []string{"rel-2", "rel-1"}, wantTotal: 2},
+		{name: "by branch", filter: release.HistoryFilter{Branch: "develop"}, wantIDs: []string{"rel-3"}, wantTotal: 1},
+		{name: "by date", filter: release.HistoryFilter{Since: base.Add(12 * time.Hour), Until: base.Add(60 * time.Hour)}, wantIDs: []string{"rel-3", "rel-2"}, wantTotal: 2},
+		{name: "paged", filter: release.HistoryFilter{Offset: 1, Limit: 2}, wantIDs: []string{"rel-3", "rel-2"}, wantTotal: 4},
+		{name: "offset past end", filter: release.HistoryFilter{Offset: 10}, wantIDs: nil, wantTotal: 4},
+		{name: "other repository", filter: release.HistoryFilter{RepositoryPath: "/other"}, wantIDs: nil, wantTotal: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := repo.FindHistory(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("FindHistory() error = %v", err)
+			}
+			if page.Total != tt.wantTotal {
+				t.Errorf("Total = %d, want %d", page.Total, tt.wantTotal)
+			}
+			if len(page.Releases) != len(tt.wantIDs) {
+				t.Fatalf("len(Releases) = %d, want %d", len(page.Releases), len(tt.wantIDs))
+			}
+			for i, rel := range page.Releases {
+				if string(rel.ID()) != tt.wantIDs[i] {
+					t.Errorf("Releases[%d] = %s, want %s", i, rel.ID(), tt.wantIDs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFileReleaseRepository_FindHistory_PluginExecutions(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFileReleaseRepository(tmpDir)
+
+	saveHistoryRelease(t, repo, "rel-1", "main", time.Now(), true)
+
+	page, err := repo.FindHistory(context.Background(), release.HistoryFilter{})
+	if err != nil {
+		t.Fatalf("FindHistory() error = %v", err)
+	}
+	if len(page.Releases) != 1 {
+		t.Fatalf("len(Releases) = %d, want 1", len(page.Releases))
+	}
+
+	executions := page.Releases[0].PluginExecutions()
+	if len(executions) != 1 {
+		t.Fatalf("len(PluginExecutions) = %d, want 1", len(executions))
+	}
+	if executions[0].PluginName != "github" || !executions[0].Success || executions[0].Duration != 1500*time.Millisecond {
+		t.Errorf("PluginExecutions[0] = %+v", executions[0])
+	}
+}
+
+func TestFileReleaseRepository_FindHistory_RebuildsIndex(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFileReleaseRepository(tmpDir)
+	ctx := context.Background()
+
+	base := time.Now()
+	saveHistoryRelease(t, repo, "rel-1", "main", base, false)
+	saveHistoryRelease(t, repo, "rel-2", "main", base.Add(time.Hour), false)
+
+	// Simulate releases written before the index existed
+	if err := os.Remove(filepath.Join(tmpDir, releaseIndexFile)); err != nil {
+		t.Fatalf("failed to remove index: %v", err)
+	}
+	// A corrupt release file must not break the index
+	if err := os.WriteFile(filepath.Join(tmpDir, "broken.json"), []byte("{"), 0600); err != nil {
+		t.Fatalf("failed to write corrupt file: %v", err)
+	}
+
+	page, err := repo.FindHistory(ctx, release.HistoryFilter{})
+	if err != nil {
+		t.Fatalf("FindHistory() error = %v", err)
+	}
+	if page.Total != 2 {
+		t.Errorf("Total = %d, want 2", page.Total)
+	}
+
+	idx := repo.readIndex()
+	if idx == nil || len(idx.Entries) != 2 || len(idx.Files) != 3 {
+		t.Fatalf("index not rebuilt correctly: %+v", idx)
+	}
+
+	// Deletions keep the index in sync
+	if err := repo.Delete(ctx, "rel-1"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	page, err = repo.FindHistory(ctx, release.HistoryFilter{})
+	if err != nil {
+		t.Fatalf("FindHistory() error = %v", err)
+	}
+	if page.Total != 1 || string(page.Releases[0].ID()) != "rel-2" {
+		t.Errorf("after delete: Total = %d", page.Total)
+	}
+}
+
+func TestFileReleaseRepository_FindHistory_EditedFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFileReleaseRepository(tmpDir)
+	ctx := context.Background()
+
+	base := time.Now()
+	saveHistoryRelease(t, repo, "rel-1", "main", base, false)
+	saveHistoryRelease(t, repo, "rel-2", "main", base.Add(time.Hour), false)
+
+	// Edit a release file by hand, keeping the number of files the same
+	filePath := filepath.Join(tmpDir, "rel-1.json")
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("failed to read release file: %v", err)
+	}
+	edited := strings.Replace(string(data), `"branch": "main"`, `"branch": "hotfix"`, 1)
+	if edited == string(data) {
+		t.Fatal("release file has no branch to edit")
+	}
+	if err := os.WriteFile(filePath, []byte(edited), 0600); err != nil {
+		t.Fatalf("failed to write release file: %v", err)
+	}
+	// Coarse file system clocks may not move between writes
+	later := base.Add(time.Minute)
+	if err := os.Chtimes(filePath, later, later); err != nil {
+		t.Fatalf("failed to touch release file: %v", err)
+	}
+
+	page, err := repo.FindHistory(ctx, release.HistoryFilter{Branch: "hotfix"})
+	if err != nil {
+		t.Fatalf("FindHistory() error = %v", err)
+	}
+	if page.Total != 1 || string(page.Releases[0].ID()) != "rel-1" {
+		t.Errorf("Total = %d, want the edited release", page.Total)
+	}
+
+	// The index was rebuilt from the edited file
+	idx := repo.readIndex()
+	if idx == nil || idx.Entries["rel-1"].Branch != "hotfix" {
+		t.Fatalf("index not rebuilt: %+v", idx)
+	}
+}
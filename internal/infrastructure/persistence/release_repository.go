@@ -38,20 +38,85 @@ func NewFileReleaseRepository(basePath string) (*FileReleaseRepository, error) {
 
 // releaseDTO is a data transfer object for serializing releases.
 type releaseDTO struct {
-	ID             string       `json:"id"`
-	State          string       `json:"state"`
-	Branch         string       `json:"branch"`
-	RepositoryPath string       `json:"repository_path"`
-	RepositoryName string       `json:"repository_name"`
-	TagName        string       `json:"tag_name"`
-	Plan           *planDTO     `json:"plan,omitempty"`
-	Version        *versionDTO  `json:"version,omitempty"`
-	Notes          *notesDTO    `json:"notes,omitempty"`
-	Approval       *approvalDTO `json:"approval,omitempty"`
-	CreatedAt      string       `json:"created_at"`
-	UpdatedAt      string       `json:"updated_at"`
-	PublishedAt    *string      `json:"published_at,omitempty"`
-	LastError      string       `json:"last_error,omitempty"`
+	ID              string                      `json:"id"`
+	State           string                      `json:"state"`
+	Branch          string                      `json:"branch"`
+	RepositoryPath  string                      `json:"repository_path"`
+	RepositoryName  string                      `json:"repository_name"`
+	TagName         string                      `json:"tag_name"`
+	Package         *packageDTO                 `json:"package,omitempty"`
+	GroupID         string                      `json:"group_id,omitempty"`
+	Plan            *planDTO                    `json:"plan,omitempty"`
+	Version         *versionDTO                 `json:"version,omitempty"`
+	Notes           *notesDTO                   `json:"notes,omitempty"`
+	Approval        *approvalDTO                `json:"approval,omitempty"`
+	Approvals       []*approvalDTO              `json:"approvals,omitempty"`
+	CreatedAt       string                      `json:"created_at"`
+	UpdatedAt       string                      `json:"updated_at"`
+	PublishedAt     *string                     `json:"published_at,omitempty"`
+	LastError       string                      `json:"last_error,omitempty"`
+	Plugins         []*pluginDTO                `json:"plugins,omitempty"`
+	Checkpoints     []*checkpointDTO            `json:"checkpoints,omitempty"`
+	PluginOutputs   map[string]*pluginOutputDTO `json:"plugin_outputs,omitempty"`
+	Rollback        *rollbackDTO                `json:"rollback,omitempty"`
+	Schedule        *scheduleDTO                `json:"schedule,omitempty"`
+	FreezeOverrides []*freezeOverrideDTO        `json:"freeze_overrides,omitempty"`
+}
+
+type pluginDTO struct {
+	Name       string `json:"name"`
+	Hook       string `json:"hook"`
+	Success    bool   `json:"success"`
+	Message    string `json:"message,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+	ExecutedAt string `json:"executed_at"`
+}
+
+type checkpointDTO struct {
+	Step        string         `json:"step"`
+	Plugin      string         `json:"plugin,omitempty"`
+	Hook        string         `json:"hook,omitempty"`
+	Outputs     map[string]any `json:"outputs,omitempty"`
+	CompletedAt string         `json:"completed_at"`
+}
+
+type pluginOutputDTO struct {
+	Outputs   map[string]any       `json:"outputs,omitempty"`
+	Artifacts []*pluginArtifactDTO `json:"artifacts,omitempty"`
+}
+
+type pluginArtifactDTO struct {
+	Name string `json:"name"`
+	Path string `json:"path,omitempty"`
+	Type string `json:"type,omitempty"`
+	Size int64  `json:"size,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+type rollbackDTO struct {
+	Reason        string `json:"reason,omitempty"`
+	RolledBackBy  string `json:"rolled_back_by"`
+	RolledBackAt  string `json:"rolled_back_at"`
+	PreviousState string `json:"previous_state"`
+}
+
+type scheduleDTO struct {
+	PublishAt   string `json:"publish_at"`
+	ScheduledBy string `json:"scheduled_by"`
+	ScheduledAt string `json:"scheduled_at"`
+}
+
+type freezeOverrideDTO struct {
+	Window       string `json:"window"`
+	Reason       string `json:"reason"`
+	OverriddenBy string `json:"overridden_by"`
+	OverriddenAt string `json:"overridden_at"`
+}
+
+type packageDTO struct {
+	Name      string `json:"name"`
+	Path      string `json:"path"`
+	TagPrefix string `json:"tag_prefix"`
 }
 
 type planDTO struct {
@@ -85,6 +150,7 @@ type commitDTO struct {
 	AuthorEmail string `json:"author_email,omitempty"`
 	Date        string `json:"date,omitempty"`
 	RawMessage  string `json:"raw_message,omitempty"`
+	Inferred    bool   `json:"inferred,omitempty"`
 }
 
 type versionDTO struct {
@@ -96,14 +162,71 @@ type versionDTO struct {
 }
 
 type notesDTO struct {
-	Changelog   string `json:"changelog"`
-	Summary     string `json:"summary"`
-	AIGenerated bool   `json:"ai_generated"`
-	GeneratedAt string `json:"generated_at"`
+	Changelog      string              `json:"changelog"`
+	Summary        string              `json:"summary"`
+	AIGenerated    bool                `json:"ai_generated"`
+	Provider       string              `json:"provider,omitempty"`
+	GeneratedAt    string              `json:"generated_at"`
+	Title          string              `json:"title,omitempty"`
+	Highlights     []string            `json:"highlights,omitempty"`
+	Sections       []notesSectionDTO   `json:"sections,omitempty"`
+	MigrationNotes []migrationNoteDTO  `json:"migration_notes,omitempty"`
+	Fidelity       *fidelityDTO        `json:"fidelity,omitempty"`
+	Localized      []localizedNotesDTO `json:"localized,omitempty"`
+	MigrationGuide *migrationGuideDTO  `json:"migration_guide,omitempty"`
+}
+
+type notesSectionDTO struct {
+	Title string   `json:"title"`
+	Items []string `json:"items,omitempty"`
+}
+
+type migrationNoteDTO struct {
+	Change    string `json:"change"`
+	Migration string `json:"migration"`
+}
+
+type fidelityDTO struct {
+	Claims          int                   `json:"claims"`
+	Unsupported     []unsupportedClaimDTO `json:"unsupported,omitempty"`
+	OmittedBreaking []omittedChangeDTO    `json:"omitted_breaking,omitempty"`
+}
+
+type unsupportedClaimDTO struct {
+	Text   string `json:"text"`
+	Reason string `json:"reason"`
+}
+
+type omittedChangeDTO struct {
+	Hash    string `json:"hash"`
+	Subject string `json:"subject"`
+}
+
+type localizedNotesDTO struct {
+	Locale    string `json:"locale"`
+	Changelog string `json:"changelog"`
+	Summary   string `json:"summary"`
+}
+
+type migrationGuideDTO struct {
+	Entries     []migrationEntryDTO `json:"entries,omitempty"`
+	Markdown    string              `json:"markdown"`
+	AIGenerated bool                `json:"ai_generated"`
+	Provider    string              `json:"provider,omitempty"`
+}
+
+type migrationEntryDTO struct {
+	CommitHash string `json:"commit_hash"`
+	Scope      string `json:"scope,omitempty"`
+	Change     string `json:"change"`
+	Before     string `json:"before,omitempty"`
+	After      string `json:"after,omitempty"`
+	Migration  string `json:"migration,omitempty"`
 }
 
 type approvalDTO struct {
 	ApprovedBy   string `json:"approved_by"`
+	Operator     string `json:"operator,omitempty"`
 	ApprovedAt   string `json:"approved_at"`
 	AutoApproved bool   `json:"auto_approved"`
 }
@@ -133,6 +256,10 @@ func (r *FileReleaseRepository) Save(ctx context.Context, rel *release.Release)
 		return fmt.Errorf("failed to write release file: %w", err)
 	}
 
+	r.updateIndex(ctx, filePath, func(idx *releaseIndex) {
+		idx.Entries[dto.ID] = newIndexEntry(dto)
+	})
+
 	return nil
 }
 
@@ -148,6 +275,12 @@ func (r *FileReleaseRepository) FindByID(ctx context.Context, id release.Release
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
+	return r.readRelease(id)
+}
+
+// readRelease reads and reconstructs a single release.
+// This method must be called with the read lock held.
+func (r *FileReleaseRepository) readRelease(id release.ReleaseID) (*release.Release, error) {
 	filePath := r.releaseFilePath(id)
 	data, err := fileutil.ReadFileLimited(filePath, MaxReleaseFileSize)
 	if err != nil {
@@ -180,17 +313,9 @@ type scanResult struct {
 // This method must be called with the read lock held.
 // It uses concurrent file reading for better performance with many files.
 func (r *FileReleaseRepository) scanReleases(ctx context.Context, filter func(*releaseDTO) bool) ([]*release.Release, error) {
-	entries, err := os.ReadDir(r.basePath)
+	jsonFiles, err := r.releaseFiles()
 	if err != nil {
-		return nil, fmt.Errorf("failed to read repository directory: %w", err)
-	}
-
-	// Filter to only JSON files first
-	jsonFiles := make([]string, 0, len(entries))
-	for _, entry := range entries {
-		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".json" {
-			jsonFiles = append(jsonFiles, filepath.Join(r.basePath, entry.Name()))
-		}
+		return nil, err
 	}
 
 	// For small numbers of files, use sequential scanning
@@ -397,6 +522,23 @@ func (r *FileReleaseRepository) FindActive(ctx context.Context) ([]*release.Rele
 	})
 }
 
+// FindByGroup retrieves all releases belonging to a release group.
+func (r *FileReleaseRepository) FindByGroup(ctx context.Context, groupID release.ReleaseGroupID) ([]*release.Release, error) {
+	// Check context cancellation before acquiring lock
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.scanReleases(ctx, func(dto *releaseDTO) bool {
+		return groupID != "" && dto.GroupID == string(groupID)
+	})
+}
+
 // Delete removes a release.
 func (r *FileReleaseRepository) Delete(ctx context.Context, id release.ReleaseID) error {
 	// Check context cancellation before acquiring lock
@@ -417,6 +559,10 @@ func (r *FileReleaseRepository) Delete(ctx context.Context, id release.ReleaseID
 		return fmt.Errorf("failed to delete release file: %w", err)
 	}
 
+	r.updateIndex(ctx, filePath, func(idx *releaseIndex) {
+		delete(idx.Entries, string(id))
+	})
+
 	return nil
 }
 
@@ -437,6 +583,15 @@ func (r *FileReleaseRepository) toDTO(rel *release.Release) *releaseDTO {
 		CreatedAt:      rel.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:      rel.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
 		LastError:      rel.LastError(),
+		GroupID:        string(rel.GroupID()),
+	}
+
+	if pkg := rel.Package(); pkg != nil {
+		dto.Package = &packageDTO{
+			Name:      pkg.Name,
+			Path:      pkg.Path,
+			TagPrefix: pkg.TagPrefix,
+		}
 	}
 
 	if rel.Plan() != nil {
@@ -468,6 +623,7 @@ func (r *FileReleaseRepository) toDTO(rel *release.Release) *releaseDTO {
 					AuthorEmail: c.AuthorEmail(),
 					Date:        c.Date().Format(time.RFC3339),
 					RawMessage:  c.RawMessage(),
+					Inferred:    c.IsInferred(),
 				})
 			}
 			dto.Plan.ChangeSet = &changeSetDTO{
@@ -497,7 +653,34 @@ func (r *FileReleaseRepository) toDTO(rel *release.Release) *releaseDTO {
 			Changelog:   notes.Changelog,
 			Summary:     notes.Summary,
 			AIGenerated: notes.AIGenerated,
+			Provider:    notes.Provider,
 			GeneratedAt: notes.GeneratedAt.Format("2006-01-02T15:04:05Z07:00"),
+			Title:       notes.Title,
+			Highlights:  notes.Highlights,
+		}
+		for _, s := range notes.Sections {
+			dto.Notes.Sections = append(dto.Notes.Sections, notesSectionDTO{Title: s.Title, Items: s.Items})
+		}
+		for _, m := range notes.MigrationNotes {
+			dto.Notes.MigrationNotes = append(dto.Notes.MigrationNotes, migrationNoteDTO{Change: m.Change, Migration: m.Migration})
+		}
+		if f := notes.Fidelity; f != nil {
+			dto.Notes.Fidelity = &fidelityDTO{Claims: f.Claims}
+			for _, u := range f.Unsupported {
+				dto.Notes.Fidelity.Unsupported = append(dto.Notes.Fidelity.Unsupported, unsupportedClaimDTO{Text: u.Text, Reason: u.Reason})
+			}
+			for _, o := range f.OmittedBreaking {
+				dto.Notes.Fidelity.OmittedBreaking = append(dto.Notes.Fidelity.OmittedBreaking, omittedChangeDTO{Hash: o.Hash, Subject: o.Subject})
+			}
+		}
+		for _, l := range notes.Localized {
+			dto.Notes.Localized = append(dto.Notes.Localized, localizedNotesDTO{Locale: l.Locale, Changelog: l.Changelog, Summary: l.Summary})
+		}
+		if g := notes.MigrationGuide; g != nil {
+			dto.Notes.MigrationGuide = &migrationGuideDTO{Markdown: g.Markdown, AIGenerated: g.AIGenerated, Provider: g.Provider}
+			for _, e := range g.Entries {
+				dto.Notes.MigrationGuide.Entries = append(dto.Notes.MigrationGuide.Entries, migrationEntryDTO(e))
+			}
 		}
 	}
 
@@ -510,11 +693,84 @@ func (r *FileReleaseRepository) toDTO(rel *release.Release) *releaseDTO {
 		}
 	}
 
+	for _, approval := range rel.Approvals() {
+		dto.Approvals = append(dto.Approvals, &approvalDTO{
+			ApprovedBy:   approval.ApprovedBy,
+			Operator:     approval.Operator,
+			ApprovedAt:   approval.ApprovedAt.Format(time.RFC3339),
+			AutoApproved: approval.AutoApproved,
+		})
+	}
+
 	if rel.PublishedAt() != nil {
 		publishedAt := rel.PublishedAt().Format("2006-01-02T15:04:05Z07:00")
 		dto.PublishedAt = &publishedAt
 	}
 
+	for _, exec := range rel.PluginExecutions() {
+		dto.Plugins = append(dto.Plugins, &pluginDTO{
+			Name:       exec.PluginName,
+			Hook:       exec.Hook,
+			Success:    exec.Success,
+			Message:    exec.Message,
+			DurationMS: exec.Duration.Milliseconds(),
+			ExecutedAt: exec.ExecutedAt.Format(time.RFC3339),
+		})
+	}
+
+	for _, cp := range rel.Checkpoints() {
+		dto.Checkpoints = append(dto.Checkpoints, &checkpointDTO{
+			Step:        string(cp.Step),
+			Plugin:      cp.PluginName,
+			Hook:        cp.Hook,
+			Outputs:     cp.Outputs,
+			CompletedAt: cp.CompletedAt.Format(time.RFC3339),
+		})
+	}
+
+	for name, output := range rel.PluginOutputs() {
+		if dto.PluginOutputs == nil {
+			dto.PluginOutputs = make(map[string]*pluginOutputDTO)
+		}
+		outputDTO := &pluginOutputDTO{Outputs: output.Outputs}
+		for _, a := range output.Artifacts {
+			outputDTO.Artifacts = append(outputDTO.Artifacts, &pluginArtifactDTO{
+				Name: a.Name,
+				Path: a.Path,
+				Type: a.Type,
+				Size: a.Size,
+				URL:  a.URL,
+			})
+		}
+		dto.PluginOutputs[name] = outputDTO
+	}
+
+	if rollback := rel.Rollback(); rollback != nil {
+		dto.Rollback = &rollbackDTO{
+			Reason:        rollback.Reason,
+			RolledBackBy:  rollback.RolledBackBy,
+			RolledBackAt:  rollback.RolledBackAt.Format(time.RFC3339),
+			PreviousState: string(rollback.PreviousState),
+		}
+	}
+
+	if schedule := rel.PublishSchedule(); schedule != nil {
+		dto.Schedule = &scheduleDTO{
+			PublishAt:   schedule.PublishAt.Format(time.RFC3339),
+			ScheduledBy: schedule.ScheduledBy,
+			ScheduledAt: schedule.ScheduledAt.Format(time.RFC3339),
+		}
+	}
+
+	for _, override := range rel.FreezeOverrides() {
+		dto.FreezeOverrides = append(dto.FreezeOverrides, &freezeOverrideDTO{
+			Window:       override.Window,
+			Reason:       override.Reason,
+			OverriddenBy: override.OverriddenBy,
+			OverriddenAt: override.OverriddenAt.Format(time.RFC3339),
+		})
+	}
+
 	return dto
 }
 
@@ -523,6 +779,18 @@ func (r *FileReleaseRepository) fromDTO(dto *releaseDTO) (*release.Release, erro
 	rel := release.NewRelease(release.ReleaseID(dto.ID), dto.Branch, dto.RepositoryPath)
 	rel.SetRepositoryName(dto.RepositoryName)
 
+	// Restore package scoping (must happen while the release is still initialized)
+	if dto.Package != nil {
+		pkg := release.PackageRef{
+			Name:      dto.Package.Name,
+			Path:      dto.Package.Path,
+			TagPrefix: dto.Package.TagPrefix,
+		}
+		if err := rel.AssignPackage(pkg, release.ReleaseGroupID(dto.GroupID)); err != nil {
+			return nil, fmt.Errorf("failed to restore package: %w", err)
+		}
+	}
+
 	// Parse timestamps
 	createdAt, err := time.Parse(time.RFC3339, dto.CreatedAt)
 	if err != nil {
@@ -594,6 +862,10 @@ func (r *FileReleaseRepository) fromDTO(dto *releaseDTO) (*release.Release, erro
 					opts = append(opts, changes.WithRawMessage(cDTO.RawMessage))
 				}
 
+				if cDTO.Inferred {
+					opts = append(opts, changes.WithInferred())
+				}
+
 				commit := changes.NewConventionalCommit(cDTO.Hash, commitType, cDTO.Subject, opts...)
 				changeSet.AddCommit(commit)
 			}
@@ -630,7 +902,34 @@ func (r *FileReleaseRepository) fromDTO(dto *releaseDTO) (*release.Release, erro
 			Changelog:   dto.Notes.Changelog,
 			Summary:     dto.Notes.Summary,
 			AIGenerated: dto.Notes.AIGenerated,
+			Provider:    dto.Notes.Provider,
 			GeneratedAt: generatedAt,
+			Title:       dto.Notes.Title,
+			Highlights:  dto.Notes.Highlights,
+		}
+		for _, s := range dto.Notes.Sections {
+			notes.Sections = append(notes.Sections, release.NotesSection{Title: s.Title, Items: s.Items})
+		}
+		for _, m := range dto.Notes.MigrationNotes {
+			notes.MigrationNotes = append(notes.MigrationNotes, release.MigrationNote{Change: m.Change, Migration: m.Migration})
+		}
+		if f := dto.Notes.Fidelity; f != nil {
+			notes.Fidelity = &release.FidelityReport{Claims: f.Claims}
+			for _, u := range f.Unsupported {
+				notes.Fidelity.Unsupported = append(notes.Fidelity.Unsupported, release.UnsupportedClaim{Text: u.Text, Reason: u.Reason})
+			}
+			for _, o := range f.OmittedBreaking {
+				notes.Fidelity.OmittedBreaking = append(notes.Fidelity.OmittedBreaking, release.OmittedChange{Hash: o.Hash, Subject: o.Subject})
+			}
+		}
+		for _, l := range dto.Notes.Localized {
+			notes.Localized = append(notes.Localized, release.LocalizedNotes{Locale: l.Locale, Changelog: l.Changelog, Summary: l.Summary})
+		}
+		if g := dto.Notes.MigrationGuide; g != nil {
+			notes.MigrationGuide = &release.MigrationGuide{Markdown: g.Markdown, AIGenerated: g.AIGenerated, Provider: g.Provider}
+			for _, e := range g.Entries {
+				notes.MigrationGuide.Entries = append(notes.MigrationGuide.Entries, release.MigrationEntry(e))
+			}
 		}
 	}
 
@@ -662,5 +961,111 @@ func (r *FileReleaseRepository) fromDTO(dto *releaseDTO) (*release.Release, erro
 		dto.LastError,
 	)
 
+	if len(dto.Approvals) > 0 {
+		approvals := make([]release.Approval, 0, len(dto.Approvals))
+		for _, a := range dto.Approvals {
+			approvedAt, _ := time.Parse(time.RFC3339, a.ApprovedAt)
+			approvals = append(approvals, release.Approval{
+				ApprovedBy:   a.ApprovedBy,
+				Operator:     a.Operator,
+				ApprovedAt:   approvedAt,
+				AutoApproved: a.AutoApproved,
+			})
+		}
+		rel.RestoreApprovals(approvals)
+	} else if approval != nil {
+		// Releases saved before approvals were recorded individually
+		rel.RestoreApprovals([]release.Approval{*approval})
+	}
+
+	if len(dto.Plugins) > 0 {
+		executions := make([]release.PluginExecution, 0, len(dto.Plugins))
+		for _, p := range dto.Plugins {
+			executedAt, _ := time.Parse(time.RFC3339, p.ExecutedAt)
+			executions = append(executions, release.PluginExecution{
+				PluginName: p.Name,
+				Hook:       p.Hook,
+				Success:    p.Success,
+				Message:    p.Message,
+				Duration:   time.Duration(p.DurationMS) * time.Millisecond,
+				ExecutedAt: executedAt,
+			})
+		}
+		rel.RestorePluginExecutions(executions)
+	}
+
+	if len(dto.Checkpoints) > 0 {
+		checkpoints := make([]release.Checkpoint, 0, len(dto.Checkpoints))
+		for _, cp := range dto.Checkpoints {
+			completedAt, _ := time.Parse(time.RFC3339, cp.CompletedAt)
+			checkpoints = append(checkpoints, release.Checkpoint{
+				Step:        release.PublishStep(cp.Step),
+				PluginName:  cp.Plugin,
+				Hook:        cp.Hook,
+				Outputs:     cp.Outputs,
+				CompletedAt: completedAt,
+			})
+		}
+		rel.RestoreCheckpoints(checkpoints)
+	}
+
+	if len(dto.PluginOutputs) > 0 {
+		outputs := make(map[string]release.PluginOutput, len(dto.PluginOutputs))
+		for name, o := range dto.PluginOutputs {
+			if o == nil {
+				continue
+			}
+			output := release.PluginOutput{Outputs: o.Outputs}
+			for _, a := range o.Artifacts {
+				output.Artifacts = append(output.Artifacts, release.PluginArtifact{
+					Name: a.Name,
+					Path: a.Path,
+					Type: a.Type,
+					Size: a.Size,
+					URL:  a.URL,
+				})
+			}
+			outputs[name] = output
+		}
+		rel.RestorePluginOutputs(outputs)
+	}
+
+	if dto.Rollback != nil {
+		rolledBackAt, _ := time.Parse(time.RFC3339, dto.Rollback.RolledBackAt)
+		rel.RestoreRollback(&release.Rollback{
+			Reason:        dto.Rollback.Reason,
+			RolledBackBy:  dto.Rollback.RolledBackBy,
+			RolledBackAt:  rolledBackAt,
+			PreviousState: release.ReleaseState(dto.Rollback.PreviousState),
+		})
+	}
+
+	if dto.Schedule != nil {
+		publishAt, err := time.Parse(time.RFC3339, dto.Schedule.PublishAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse schedule publish_at: %w", err)
+		}
+		scheduledAt, _ := time.Parse(time.RFC3339, dto.Schedule.ScheduledAt)
+		rel.RestorePublishSchedule(&release.PublishSchedule{
+			PublishAt:   publishAt,
+			ScheduledBy: dto.Schedule.ScheduledBy,
+			ScheduledAt: scheduledAt,
+		})
+	}
+
+	if len(dto.FreezeOverrides) > 0 {
+		overrides := make([]release.FreezeOverride, 0, len(dto.FreezeOverrides))
+		for _, o := range dto.FreezeOverrides {
+			overriddenAt, _ := time.Parse(time.RFC3339, o.OverriddenAt)
+			overrides = append(overrides, release.FreezeOverride{
+				Window:       o.Window,
+				Reason:       o.Reason,
+				OverriddenBy: o.OverriddenBy,
+				OverriddenAt: overriddenAt,
+			})
+		}
+		rel.RestoreFreezeOverrides(overrides)
+	}
+
 	return rel, nil
 }
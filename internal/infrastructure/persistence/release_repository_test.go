@@ -57,6 +57,7 @@ func TestFileReleaseRepository_SaveAndFindByID(t *testing.T) {
 	changeSet := changes.NewChangeSet("cs-1", "v1.0.0", "HEAD")
 	commit := changes.NewConventionalCommit("abc123", changes.CommitTypeFeat, "add feature")
 	changeSet.AddCommit(commit)
+	changeSet.AddCommit(changes.NewConventionalCommit("def456", changes.CommitTypeFix, "Fix crash", changes.WithInferred()))
 
 	plan := release.NewReleasePlan(
 		version.MustParse("1.0.0"),
@@ -91,6 +92,9 @@ func TestFileReleaseRepository_SaveAndFindByID(t *testing.T) {
 	if found.State() != rel.State() {
 		t.Errorf("State mismatch: got %v, want %v", found.State(), rel.State())
 	}
+	if commits := found.Plan().GetChangeSet().Commits(); len(commits) != 2 || commits[0].IsInferred() || !commits[1].IsInferred() {
+		t.Errorf("inferred commits were not restored")
+	}
 }
 
 func TestFileReleaseRepository_FindByID_NotFound(t *testing.T) {
@@ -132,10 +136,27 @@ func TestFileReleaseRepository_SaveFullRelease(t *testing.T) {
 		Changelog:   "## 2.0.0\n\n- Breaking changes",
 		Summary:     "Major release",
 		AIGenerated: true,
+		Provider:    "anthropic",
 		GeneratedAt: time.Now(),
+		Title:       "Release 2.0.0",
+		Sections:    []release.NotesSection{{Title: "Breaking Changes", Items: []string{"new API"}}},
+		MigrationNotes: []release.MigrationNote{
+			{Change: "new API", Migration: "Call Run instead of Execute."},
+		},
+		Fidelity: &release.FidelityReport{
+			Claims:      3,
+			Unsupported: []release.UnsupportedClaim{{Text: "Fixes #42", Reason: "references #42, which no commit mentions"}},
+		},
 	}
 	_ = rel.SetNotes(notes)
 
+	_ = rel.SetLocalizedNotes([]release.LocalizedNotes{{Locale: "de", Changelog: "## 2.0.0\n\n- Inkompatible Änderungen", Summary: "Major-Release"}})
+	_ = rel.SetMigrationGuide(&release.MigrationGuide{
+		Entries:  []release.MigrationEntry{{CommitHash: "abc123", Change: "new API", Before: "Execute()", After: "Run()", Migration: "Call Run instead of Execute."}},
+		Markdown: "## Migrating to 2.0.0",
+		Provider: "template",
+	})
+
 	// Approve
 	_ = rel.Approve("admin", false)
 
@@ -166,6 +187,24 @@ func TestFileReleaseRepository_SaveFullRelease(t *testing.T) {
 	if loaded.Notes().Changelog != notes.Changelog {
 		t.Errorf("Changelog mismatch")
 	}
+	if loaded.Notes().Provider != "anthropic" {
+		t.Errorf("Provider = %q, want anthropic", loaded.Notes().Provider)
+	}
+	if loaded.Notes().Title != "Release 2.0.0" || len(loaded.Notes().Sections) != 1 || loaded.Notes().Sections[0].Items[0] != "new API" {
+		t.Errorf("structured notes not restored: %+v", loaded.Notes())
+	}
+	if m := loaded.Notes().MigrationNotes; len(m) != 1 || m[0].Migration != "Call Run instead of Execute." {
+		t.Errorf("MigrationNotes = %+v", m)
+	}
+	if f := loaded.Notes().Fidelity; f == nil || f.Claims != 3 || len(f.Unsupported) != 1 || f.Unsupported[0].Text != "Fixes #42" {
+		t.Errorf("Fidelity = %+v", f)
+	}
+	if de := loaded.Notes().ForLocale("de"); de == nil || de.Summary != "Major-Release" {
+		t.Errorf("Localized = %+v", loaded.Notes().Localized)
+	}
+	if g := loaded.Notes().MigrationGuide; g == nil || g.Provider != "template" || len(g.Entries) != 1 || g.Entries[0].After != "Run()" {
+		t.Errorf("MigrationGuide = %+v", g)
+	}
 	if !loaded.IsApproved() {
 		t.Error("Should be approved")
 	}
@@ -373,6 +412,51 @@ func TestFileReleaseRepository_FindActive(t *testing.T) {
 	}
 }
 
+func TestFileReleaseRepository_FindByGroup(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFileReleaseRepository(tmpDir)
+	ctx := context.Background()
+
+	api := release.NewRelease("rel-api", "main", "/repo")
+	_ = api.AssignPackage(release.PackageRef{Name: "api", Path: "services/api", TagPrefix: "api/v"}, "grp-1")
+	web := release.NewRelease("rel-web", "main", "/repo")
+	_ = web.AssignPackage(release.PackageRef{Name: "web", Path: "apps/web", TagPrefix: "web/v"}, "grp-1")
+	other := release.NewRelease("rel-other", "main", "/repo")
+
+	for _, rel := range []*release.Release{api, web, other} {
+		if err := repo.Save(ctx, rel); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	grouped, err := repo.FindByGroup(ctx, "grp-1")
+	if err != nil {
+		t.Fatalf("FindByGroup() error = %v", err)
+	}
+	if len(grouped) != 2 {
+		t.Fatalf("FindByGroup() returned %d releases, want 2", len(grouped))
+	}
+
+	// Package scoping survives a round trip
+	loaded, err := repo.FindByID(ctx, "rel-api")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	pkg := loaded.Package()
+	if pkg == nil || pkg.Name != "api" || pkg.Path != "services/api" || pkg.TagPrefix != "api/v" {
+		t.Errorf("Package() = %+v, want api package", pkg)
+	}
+	if loaded.GroupID() != "grp-1" {
+		t.Errorf("GroupID() = %v, want grp-1", loaded.GroupID())
+	}
+
+	// Ungrouped releases never match the empty group
+	ungrouped, _ := repo.FindByGroup(ctx, "")
+	if len(ungrouped) != 0 {
+		t.Errorf("FindByGroup(\"\") returned %d releases, want 0", len(ungrouped))
+	}
+}
+
 func TestFileReleaseRepository_Delete(t *testing.T) {
 	tmpDir := t.TempDir()
 	repo, _ := NewFileReleaseRepository(tmpDir)
@@ -537,6 +621,214 @@ func TestFileReleaseRepository_FailedRelease(t *testing.T) {
 	}
 }
 
+func TestFileReleaseRepository_RolledBackRelease(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFileReleaseRepository(tmpDir)
+	ctx := context.Background()
+
+	rel := release.NewRelease("rollback-test", "main", "/repo")
+
+	changeSet := changes.NewChangeSet("cs-1", "v1.0.0", "HEAD")
+	plan := release.NewReleasePlan(
+		version.MustParse("1.0.0"),
+		version.MustParse("1.1.0"),
+		changes.ReleaseTypeMinor,
+		changeSet,
+		false,
+	)
+	_ = rel.SetPlan(plan)
+	_ = rel.SetVersion(version.MustParse("1.1.0"), "v1.1.0")
+	_ = rel.SetNotes(&release.ReleaseNotes{Changelog: "test", GeneratedAt: time.Now()})
+	_ = rel.Approve("user", false)
+	_ = rel.StartPublishing([]string{"github"})
+	_ = rel.MarkPublished("")
+	_ = rel.RollBack("regression in parser", "alice")
+
+	_ = repo.Save(ctx, rel)
+
+	loaded, err := repo.FindByID(ctx, "rollback-test")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if loaded.State() != release.StateRolledBack {
+		t.Errorf("State = %v, want %v", loaded.State(), release.StateRolledBack)
+	}
+	rollback := loaded.Rollback()
+	if rollback == nil {
+		t.Fatal("Rollback should be restored")
+	}
+	if rollback.Reason != "regression in parser" || rollback.RolledBackBy != "alice" {
+		t.Errorf("Rollback = %+v", rollback)
+	}
+	if rollback.PreviousState != release.StatePublished {
+		t.Errorf("Rollback.PreviousState = %v, want %v", rollback.PreviousState, release.StatePublished)
+	}
+	if rollback.RolledBackAt.IsZero() {
+		t.Error("Rollback.RolledBackAt should be restored")
+	}
+}
+
+func TestFileReleaseRepository_Checkpoints(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFileReleaseRepository(tmpDir)
+	ctx := context.Background()
+
+	rel := release.NewRelease("checkpoint-test", "main", "/repo")
+	rel.RecordCheckpoint(release.Checkpoint{Step: release.StepTagCreated})
+	rel.RecordCheckpoint(release.Checkpoint{
+		Step:       release.StepPlugin,
+		PluginName: "github",
+		Hook:       "post_publish",
+		Outputs:    map[string]any{"release_url": "https://github.com/owner/repo/releases/v1.1.0"},
+	})
+
+	if err := repo.Save(ctx, rel); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := repo.FindByID(ctx, "checkpoint-test")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+
+	if !loaded.HasCheckpoint(release.StepTagCreated, "", "") {
+		t.Error("tag_created checkpoint was not restored")
+	}
+	if loaded.HasCheckpoint(release.StepTagPushed, "", "") {
+		t.Error("tag_pushed checkpoint should not exist")
+	}
+	if !loaded.HasCheckpoint(release.StepPlugin, "github", "post_publish") {
+		t.Fatal("plugin checkpoint was not restored")
+	}
+	checkpoints := loaded.Checkpoints()
+	if got := checkpoints[1].Outputs["release_url"]; got != "https://github.com/owner/repo/releases/v1.1.0" {
+		t.Errorf("Outputs[release_url] = %v", got)
+	}
+	if checkpoints[1].CompletedAt.IsZero() {
+		t.Error("CompletedAt should be restored")
+	}
+}
+
+func TestFileReleaseRepository_PluginOutputs(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFileReleaseRepository(tmpDir)
+	ctx := context.Background()
+
+	rel := release.NewRelease("outputs-test", "main", "/repo")
+	rel.RecordPluginOutput("github", release.PluginOutput{
+		Outputs: map[string]any{"release_url": "https://github.com/owner/repo/releases/v1.1.0"},
+		Artifacts: []release.PluginArtifact{
+			{Name: "app_darwin_arm64.tar.gz", Path: "dist/app_darwin_arm64.tar.gz", Size: 1024, URL: "https://github.com/owner/repo/releases/download/v1.1.0/app_darwin_arm64.tar.gz"},
+		},
+	})
+
+	if err := repo.Save(ctx, rel); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := repo.FindByID(ctx, "outputs-test")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+
+	github, ok := loaded.PluginOutputs()["github"]
+	if !ok {
+		t.Fatal("github outputs were not restored")
+	}
+	if got := github.Outputs["release_url"]; got != "https://github.com/owner/repo/releases/v1.1.0" {
+		t.Errorf("Outputs[release_url] = %v", got)
+	}
+	want := release.PluginArtifact{
+		Name: "app_darwin_arm64.tar.gz",
+		Path: "dist/app_darwin_arm64.tar.gz",
+		Size: 1024,
+		URL:  "https://github.com/owner/repo/releases/download/v1.1.0/app_darwin_arm64.tar.gz",
+	}
+	if len(github.Artifacts) != 1 || github.Artifacts[0] != want {
+		t.Errorf("Artifacts = %+v, want [%+v]", github.Artifacts, want)
+	}
+}
+
+func TestFileReleaseRepository_Approvals(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFileReleaseRepository(tmpDir)
+	ctx := context.Background()
+
+	rel := release.NewRelease("approvals-test", "main", "/repo")
+	changeSet := changes.NewChangeSet("cs-1", "v1.0.0", "HEAD")
+	plan := release.NewReleasePlan(
+		version.MustParse("1.0.0"),
+		version.MustParse("1.1.0"),
+		changes.ReleaseTypeMinor,
+		changeSet,
+		false,
+	)
+	_ = rel.SetPlan(plan)
+	_ = rel.SetVersion(version.MustParse("1.1.0"), "v1.1.0")
+	_ = rel.SetNotes(&release.ReleaseNotes{Changelog: "test", GeneratedAt: time.Now()})
+
+	policy := release.ApprovalPolicy{RequiredApprovals: 2}
+	if _, err := rel.AddApproval("alice", "felix", false, policy); err != nil {
+		t.Fatalf("AddApproval() error = %v", err)
+	}
+	_ = repo.Save(ctx, rel)
+
+	loaded, err := repo.FindByID(ctx, "approvals-test")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	approvals := loaded.Approvals()
+	if len(approvals) != 1 || approvals[0].ApprovedBy != "alice" || approvals[0].Operator != "felix" || approvals[0].ApprovedAt.IsZero() {
+		t.Fatalf("Approvals() = %+v, want alice run by felix", approvals)
+	}
+
+	// The second approval satisfies the policy after a reload
+	status, err := loaded.AddApproval("bob", "bob", false, policy)
+	if err != nil {
+		t.Fatalf("AddApproval() error = %v", err)
+	}
+	if !status.Satisfied || loaded.State() != release.StateApproved {
+		t.Errorf("release should be approved, state = %s (%s)", loaded.State(), status)
+	}
+}
+
+func TestFileReleaseRepository_ScheduleAndFreezeOverrides(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFileReleaseRepository(tmpDir)
+	ctx := context.Background()
+
+	rel := release.NewRelease("schedule-test", "main", "/repo")
+	changeSet := changes.NewChangeSet("cs-1", "v1.0.0", "HEAD")
+	_ = rel.SetPlan(release.NewReleasePlan(version.MustParse("1.0.0"), version.MustParse("1.1.0"), changes.ReleaseTypeMinor, changeSet, false))
+	_ = rel.SetVersion(version.MustParse("1.1.0"), "v1.1.0")
+	_ = rel.SetNotes(&release.ReleaseNotes{Changelog: "test", GeneratedAt: time.Now()})
+	_ = rel.Approve("alice", false)
+
+	publishAt := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	if err := rel.SchedulePublish(publishAt, "alice"); err != nil {
+		t.Fatalf("SchedulePublish() error = %v", err)
+	}
+	window := release.FreezeWindow{Name: "fridays", Days: []time.Weekday{time.Friday}, Location: time.UTC}
+	if err := rel.OverrideFreeze(window, "security fix", "bob"); err != nil {
+		t.Fatalf("OverrideFreeze() error = %v", err)
+	}
+	_ = repo.Save(ctx, rel)
+
+	loaded, err := repo.FindByID(ctx, "schedule-test")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+
+	schedule := loaded.PublishSchedule()
+	if schedule == nil || !schedule.PublishAt.Equal(publishAt) || schedule.ScheduledBy != "alice" {
+		t.Errorf("PublishSchedule() = %+v", schedule)
+	}
+	overrides := loaded.FreezeOverrides()
+	if len(overrides) != 1 || overrides[0].Reason != "security fix" || overrides[0].OverriddenBy != "bob" || overrides[0].Window != window.String() {
+		t.Errorf("FreezeOverrides() = %+v", overrides)
+	}
+}
+
 func TestFileReleaseRepository_ConcurrentScanReleases(t *testing.T) {
 	tmpDir := t.TempDir()
 	repo, _ := NewFileReleaseRepository(tmpDir)
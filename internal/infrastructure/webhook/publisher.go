@@ -0,0 +1,252 @@
+// Package webhook provides an event publisher that delivers domain events
+// to HTTP endpoints.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/felixgeelhaar/fortify/retry"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+)
+
+// Request headers sent with every delivery.
+const (
+	HeaderEvent     = "X-ReleasePilot-Event"
+	HeaderDelivery  = "X-ReleasePilot-Delivery"
+	HeaderSignature = "X-ReleasePilot-Signature"
+)
+
+// Default delivery settings.
+const (
+	DefaultTimeout        = 10 * time.Second
+	DefaultInitialBackoff = 500 * time.Millisecond
+	DefaultMaxBackoff     = 10 * time.Second
+)
+
+// maxResponseBody bounds how much of an error response is read.
+const maxResponseBody = 4 << 10 // 4KB
+
+// Endpoint is an HTTP endpoint receiving domain events.
+type Endpoint struct {
+	URL string
+	// Secret signs payloads with HMAC-SHA256 when set.
+	Secret string
+	// Events limits delivery to these event names. Empty delivers all events.
+	Events []string
+	// Timeout is the timeout per delivery attempt.
+	Timeout time.Duration
+	// MaxRetries is the number of retries after a failed attempt.
+	MaxRetries int
+}
+
+// Payload is the JSON body of a delivery.
+type Payload struct {
+	Event      string         `json:"event"`
+	ReleaseID  string         `json:"release_id"`
+	OccurredAt string         `json:"occurred_at"`
+	Data       map[string]any `json:"data,omitempty"`
+}
+
+// Publisher implements release.EventPublisher by POSTing events to webhooks.
+// Deliveries that fail with a network error, 429 or 5xx are retried with
+// exponential backoff.
+type Publisher struct {
+	endpoints      []Endpoint
+	client         *http.Client
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	logger         *slog.Logger
+}
+
+// PublisherOption configures a Publisher.
+type PublisherOption func(*Publisher)
+
+// WithHTTPClient sets the HTTP client used for deliveries.
+func WithHTTPClient(client *http.Client) PublisherOption {
+	return func(p *Publisher) {
+		p.client = client
+	}
+}
+
+// WithBackoff sets the initial and maximum delay between retries.
+func WithBackoff(initial, maxDelay time.Duration) PublisherOption {
+	return func(p *Publisher) {
+		p.initialBackoff = initial
+		p.maxBackoff = maxDelay
+	}
+}
+
+// NewPublisher creates a new webhook publisher.
+func NewPublisher(endpoints []Endpoint, opts ...PublisherOption) *Publisher {
+	p := &Publisher{
+		endpoints:      endpoints,
+		client:         &http.Client{},
+		initialBackoff: DefaultInitialBackoff,
+		maxBackoff:     DefaultMaxBackoff,
+		logger:         slog.Default().With("component", "webhook"),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Publish delivers each event to the endpoints subscribed to it.
+// All deliveries are attempted; failures are returned together.
+func (p *Publisher) Publish(ctx context.Context, events ...release.DomainEvent) error {
+	var errs []error
+	for _, event := range events {
+		rec := release.NewEventRecord(event)
+
+		body, err := json.Marshal(Payload{
+			Event:      rec.Name,
+			ReleaseID:  string(rec.ReleaseID),
+			OccurredAt: rec.OccurredAt.UTC().Format(time.RFC3339Nano),
+			Data:       rec.Data,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to marshal event %s: %w", rec.Name, err))
+			continue
+		}
+
+		for _, endpoint := range p.endpoints {
+			if !(release.EventFilter{Names: endpoint.Events}).Matches(rec) {
+				continue
+			}
+			if err := p.deliver(ctx, endpoint, rec.Name, body); err != nil {
+				p.logger.Warn("webhook delivery failed",
+					"url", redactURL(endpoint.URL),
+					"event", rec.Name,
+					"error", err)
+				errs = append(errs, fmt.Errorf("webhook %s: event %s: %w", redactURL(endpoint.URL), rec.Name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// deliver POSTs a payload to an endpoint, retrying transient failures.
+func (p *Publisher) deliver(ctx context.Context, endpoint Endpoint, eventName string, body []byte) error {
+	deliveryID, err := newDeliveryID()
+	if err != nil {
+		return err
+	}
+
+	timeout := endpoint.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	r := retry.New[struct{}](retry.Config{
+		MaxAttempts:   endpoint.MaxRetries + 1,
+		InitialDelay:  p.initialBackoff,
+		MaxDelay:      p.maxBackoff,
+		BackoffPolicy: retry.BackoffExponential,
+		Multiplier:    2.0,
+		Jitter:        true,
+		IsRetryable:   isRetryable,
+	})
+
+	_, err = r.Do(ctx, func(ctx context.Context) (struct{}, error) {
+		attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return struct{}{}, p.post(attemptCtx, endpoint, eventName, deliveryID, body)
+	})
+	return err
+}
+
+// post makes a single delivery attempt.
+func (p *Publisher) post(ctx context.Context, endpoint Endpoint, eventName, deliveryID string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return &deliveryError{err: fmt.Errorf("invalid request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "ReleasePilot-Webhook")
+	req.Header.Set(HeaderEvent, eventName)
+	req.Header.Set(HeaderDelivery, deliveryID)
+	if endpoint.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(endpoint.Secret, body))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		// Network errors and timeouts are worth retrying, unless the run
+		// itself was canceled
+		return &deliveryError{err: err, retryable: ctx.Err() == nil || errors.Is(ctx.Err(), context.DeadlineExceeded)}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+		return nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	return &deliveryError{
+		err:       fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(msg)),
+		retryable: resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500,
+	}
+}
+
+// Sign returns the signature header value for a payload:
+// "sha256=" followed by the hex-encoded HMAC-SHA256 of the body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// deliveryError is a failed delivery attempt.
+type deliveryError struct {
+	err       error
+	retryable bool
+}
+
+func (e *deliveryError) Error() string {
+	return e.err.Error()
+}
+
+func (e *deliveryError) Unwrap() error {
+	return e.err
+}
+
+// isRetryable reports whether a delivery attempt should be retried.
+func isRetryable(err error) bool {
+	var de *deliveryError
+	return errors.As(err, &de) && de.retryable
+}
+
+// newDeliveryID returns a random ID shared by all attempts of a delivery,
+// so receivers can deduplicate retries.
+func newDeliveryID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate delivery ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// redactURL strips credentials and query parameters, which often carry
+// tokens, from a URL before it is logged.
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "<invalid url>"
+	}
+	return u.Scheme + "://" + u.Host + u.Path
+}
@@ -0,0 +1,191 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/domain/version"
+)
+
+type receivedRequest struct {
+	headers http.Header
+	body    []byte
+}
+
+// newRecordingServer returns a server that records requests and answers
+// with the given status codes in turn (the last one repeats).
+func newRecordingServer(t *testing.T, statuses ...int) (*httptest.Server, *[]receivedRequest) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var received []receivedRequest
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		received = append(received, receivedRequest{headers: r.Header.Clone(), body: body})
+		mu.Unlock()
+
+		status := http.StatusOK
+		if len(statuses) > 0 {
+			i := min(int(calls.Add(1))-1, len(statuses)-1)
+			status = statuses[i]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &received
+}
+
+func TestPublisher_DeliversSignedPayload(t *testing.T) {
+	server, received := newRecordingServer(t)
+
+	p := NewPublisher([]Endpoint{{URL: server.URL, Secret: "s3cret"}})
+	event := release.NewReleasePublishedEvent("rel-1", version.MustParse("1.2.0"), "v1.2.0", "https://example.com/releases/v1.2.0")
+
+	if err := p.Publish(context.Background(), event); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	if len(*received) != 1 {
+		t.Fatalf("received %d requests, want 1", len(*received))
+	}
+	req := (*received)[0]
+
+	if got := req.headers.Get(HeaderEvent); got != "release.published" {
+		t.Errorf("%s = %q, want release.published", HeaderEvent, got)
+	}
+	if req.headers.Get(HeaderDelivery) == "" {
+		t.Errorf("%s header missing", HeaderDelivery)
+	}
+	if got, want := req.headers.Get(HeaderSignature), Sign("s3cret", req.body); got != want {
+		t.Errorf("%s = %q, want %q", HeaderSignature, got, want)
+	}
+	if !strings.HasPrefix(req.headers.Get(HeaderSignature), "sha256=") {
+		t.Errorf("signature should be prefixed with sha256=")
+	}
+
+	var payload Payload
+	if err := json.Unmarshal(req.body, &payload); err != nil {
+		t.Fatalf("invalid payload: %v", err)
+	}
+	if payload.Event != "release.published" || payload.ReleaseID != "rel-1" {
+		t.Errorf("payload = %+v", payload)
+	}
+	if payload.Data["tag_name"] != "v1.2.0" || payload.Data["version"] != "1.2.0" {
+		t.Errorf("payload.Data = %+v", payload.Data)
+	}
+}
+
+func TestPublisher_UnsignedWithoutSecret(t *testing.T) {
+	server, received := newRecordingServer(t)
+
+	p := NewPublisher([]Endpoint{{URL: server.URL}})
+	if err := p.Publish(context.Background(), release.NewReleaseApprovedEvent("rel-1", "alice")); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	if got := (*received)[0].headers.Get(HeaderSignature); got != "" {
+		t.Errorf("%s = %q, want no signature", HeaderSignature, got)
+	}
+}
+
+func TestPublisher_EventFilter(t *testing.T) {
+	all, allReceived := newRecordingServer(t)
+	filtered, filteredReceived := newRecordingServer(t)
+
+	p := NewPublisher([]Endpoint{
+		{URL: all.URL},
+		{URL: filtered.URL, Events: []string{"published", "release.failed"}},
+	})
+
+	err := p.Publish(context.Background(),
+		release.NewReleaseApprovedEvent("rel-1", "alice"),
+		release.NewReleasePublishedEvent("rel-1", version.MustParse("1.2.0"), "v1.2.0", ""),
+		release.NewReleaseFailedEvent("rel-2", "boom", release.StatePublishing, true),
+	)
+	if err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	if len(*allReceived) != 3 {
+		t.Errorf("unfiltered endpoint received %d events, want 3", len(*allReceived))
+	}
+	if len(*filteredReceived) != 2 {
+		t.Fatalf("filtered endpoint received %d events, want 2", len(*filteredReceived))
+	}
+	for _, req := range *filteredReceived {
+		if name := req.headers.Get(HeaderEvent); name != "release.published" && name != "release.failed" {
+			t.Errorf("filtered endpoint received %s", name)
+		}
+	}
+}
+
+func TestPublisher_Retries(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int
+		maxRetries   int
+		wantAttempts int
+		wantErr      bool
+	}{
+		{"succeeds after transient failures", []int{503, 429, 200}, 3, 3, false},
+		{"gives up after max retries", []int{500}, 2, 3, true},
+		{"does not retry client errors", []int{400}, 3, 1, true},
+		{"no retries configured", []int{502}, 0, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, received := newRecordingServer(t, tt.statuses...)
+
+			p := NewPublisher(
+				[]Endpoint{{URL: server.URL, MaxRetries: tt.maxRetries}},
+				WithBackoff(time.Millisecond, 5*time.Millisecond),
+			)
+			err := p.Publish(context.Background(), release.NewReleaseApprovedEvent("rel-1", "alice"))
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Publish() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(*received) != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", len(*received), tt.wantAttempts)
+			}
+
+			// Retries reuse the delivery ID so receivers can deduplicate
+			id := (*received)[0].headers.Get(HeaderDelivery)
+			for _, req := range *received {
+				if req.headers.Get(HeaderDelivery) != id {
+					t.Errorf("delivery ID changed between attempts")
+				}
+			}
+		})
+	}
+}
+
+func TestPublisher_ErrorRedactsURL(t *testing.T) {
+	server, _ := newRecordingServer(t, http.StatusForbidden)
+
+	p := NewPublisher([]Endpoint{{URL: server.URL + "/hook?token=secret-token"}})
+	err := p.Publish(context.Background(), release.NewReleaseApprovedEvent("rel-1", "alice"))
+	if err == nil {
+		t.Fatal("Publish() expected error")
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("error leaks URL query: %v", err)
+	}
+	if !strings.Contains(err.Error(), "403") {
+		t.Errorf("error should mention status: %v", err)
+	}
+}
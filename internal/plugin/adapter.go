@@ -3,7 +3,6 @@ package plugin
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
 	"github.com/felixgeelhaar/release-pilot/internal/domain/integration"
@@ -29,21 +28,53 @@ func (a *ExecutorAdapter) ExecuteHook(ctx context.Context, hook integration.Hook
 	// Convert domain context to plugin context
 	pluginCtx := toPluginReleaseContext(releaseCtx)
 
-	// Execute via manager
-	responses, err := a.manager.ExecuteHook(ctx, pluginHook, pluginCtx)
-	if err != nil {
-		return nil, err
+	// Execute via manager, keeping track of which plugin produced each response
+	results := a.manager.executeHook(withEventSink(ctx), pluginHook, pluginCtx)
+	if results == nil {
+		return nil, nil
 	}
 
 	// Convert responses back to domain types
-	return toIntegrationResponses(responses), nil
+	responses := make([]plugin.ExecuteResponse, len(results))
+	for i, r := range results {
+		responses[i] = r.response
+	}
+	converted := toIntegrationResponses(responses)
+	for i, r := range results {
+		converted[i].PluginID = integration.PluginID(r.name)
+	}
+	return converted, nil
 }
 
-// ExecutePlugin executes a specific plugin.
-// Note: Individual plugin execution by ID is not currently supported.
-// Use ExecuteHook to execute all plugins for a given hook instead.
+// ExecutePlugin executes a specific plugin by name for the hook in the request.
 func (a *ExecutorAdapter) ExecutePlugin(ctx context.Context, id integration.PluginID, req integration.ExecuteRequest) (*integration.ExecuteResponse, error) {
-	return nil, fmt.Errorf("individual plugin execution by ID is not supported; use ExecuteHook instead")
+	resp, err := a.manager.ExecutePlugin(withEventSink(ctx), string(id), plugin.Hook(req.Hook), toPluginReleaseContext(req.Context))
+	if err != nil {
+		return nil, err
+	}
+
+	result := toIntegrationResponse(resp)
+	result.PluginID = id
+	return result, nil
+}
+
+// withEventSink streams plugin events to the context's domain event
+// handler, if it has one.
+func withEventSink(ctx context.Context) context.Context {
+	handler := integration.PluginEventHandlerFrom(ctx)
+	if handler == nil {
+		return ctx
+	}
+	return plugin.WithEventSink(ctx, func(e plugin.ExecuteEvent) {
+		handler(integration.PluginEvent{
+			PluginID: integration.PluginID(e.Plugin),
+			Hook:     integration.Hook(e.Hook),
+			Log:      e.Log,
+			Level:    e.Level,
+			Step:     e.Step,
+			Progress: e.Progress,
+		})
+	})
 }
 
 // toPluginReleaseContext converts domain ReleaseContext to plugin ReleaseContext.
@@ -58,16 +89,64 @@ func toPluginReleaseContext(ctx integration.ReleaseContext) plugin.ReleaseContex
 		TagName:         ctx.TagName,
 		Changelog:       ctx.Changelog,
 		ReleaseNotes:    ctx.ReleaseNotes,
+		MigrationGuide:  ctx.MigrationGuide,
 	}
 
 	// Convert changes if present
 	if ctx.Changes != nil {
 		result.Changes = toCategorizedChanges(ctx.Changes)
 	}
+	if ctx.Notes != nil {
+		result.Notes = toPluginNotes(ctx.Notes)
+	}
+	for _, l := range ctx.LocalizedNotes {
+		result.LocalizedNotes = append(result.LocalizedNotes, plugin.LocalizedNotes{
+			Locale:       l.Locale,
+			Changelog:    l.Changelog,
+			ReleaseNotes: l.ReleaseNotes,
+		})
+	}
+	if len(ctx.PluginOutputs) > 0 {
+		result.PluginOutputs = make(map[string]plugin.PluginOutput, len(ctx.PluginOutputs))
+		for name, o := range ctx.PluginOutputs {
+			result.PluginOutputs[name] = toPluginOutput(o)
+		}
+	}
 
 	return result
 }
 
+// toPluginOutput converts a domain plugin output to a plugin output.
+func toPluginOutput(o integration.PluginOutput) plugin.PluginOutput {
+	output := plugin.PluginOutput{Outputs: o.Outputs}
+	for _, a := range o.Artifacts {
+		output.Artifacts = append(output.Artifacts, plugin.Artifact{
+			Name: a.Name,
+			Path: a.Path,
+			Type: a.Type,
+			Size: a.Size,
+			URL:  a.URL,
+		})
+	}
+	return output
+}
+
+// toPluginNotes converts domain structured notes to plugin structured notes.
+func toPluginNotes(n *integration.StructuredNotes) *plugin.StructuredNotes {
+	notes := &plugin.StructuredNotes{
+		Title:      n.Title,
+		Summary:    n.Summary,
+		Highlights: n.Highlights,
+	}
+	for _, s := range n.Sections {
+		notes.Sections = append(notes.Sections, plugin.NotesSection{Title: s.Title, Items: s.Items})
+	}
+	for _, m := range n.MigrationNotes {
+		notes.MigrationNotes = append(notes.MigrationNotes, plugin.MigrationNote{Change: m.Change, Migration: m.Migration})
+	}
+	return notes
+}
+
 // toCategorizedChanges converts a ChangeSet to plugin CategorizedChanges.
 // Note: Plugin API has fewer categories than domain, so Tests, Build, CI, Chores, Reverts
 // are merged into the Other category.
@@ -141,6 +220,7 @@ func toIntegrationResponses(responses []plugin.ExecuteResponse) []integration.Ex
 					Path: a.Path,
 					Type: a.Type,
 					Size: a.Size,
+					URL:  a.URL,
 				}
 			}
 		}
@@ -170,6 +250,7 @@ func toIntegrationResponse(r *plugin.ExecuteResponse) *integration.ExecuteRespon
 				Path: a.Path,
 				Type: a.Type,
 				Size: a.Size,
+				URL:  a.URL,
 			}
 		}
 	}
@@ -3,6 +3,7 @@ package plugin
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"testing"
 
@@ -73,6 +74,45 @@ func TestToPluginReleaseContext(t *testing.T) {
 	if result.Changes != nil {
 		t.Error("Changes should be nil when no ChangeSet provided")
 	}
+	if result.Notes != nil {
+		t.Error("Notes should be nil when no structured notes provided")
+	}
+}
+
+func TestToPluginReleaseContext_WithNotes(t *testing.T) {
+	ctx := integration.ReleaseContext{
+		Version: version.MustParse("2.0.0"),
+		Notes: &integration.StructuredNotes{
+			Title:          "Release 2.0.0",
+			Summary:        "A major release.",
+			Sections:       []integration.NotesSection{{Title: "Features", Items: []string{"new api"}}},
+			MigrationNotes: []integration.MigrationNote{{Change: "old api removed", Migration: "Use the new api."}},
+		},
+		LocalizedNotes: []integration.LocalizedNotes{{Locale: "fr", Changelog: "- nouvelle api", ReleaseNotes: "Une version majeure."}},
+		MigrationGuide: "## Migrating to 2.0.0",
+	}
+
+	result := toPluginReleaseContext(ctx)
+	if result.MigrationGuide != "## Migrating to 2.0.0" {
+		t.Errorf("MigrationGuide = %q", result.MigrationGuide)
+	}
+	if l := result.LocalizedNotes; len(l) != 1 || l[0].Locale != "fr" || l[0].ReleaseNotes != "Une version majeure." {
+		t.Errorf("LocalizedNotes = %+v", l)
+	}
+
+	notes := result.Notes
+	if notes == nil {
+		t.Fatal("Notes should be converted")
+	}
+	if notes.Title != "Release 2.0.0" || notes.Summary != "A major release." {
+		t.Errorf("Notes = %+v", notes)
+	}
+	if len(notes.Sections) != 1 || notes.Sections[0].Items[0] != "new api" {
+		t.Errorf("Sections = %+v", notes.Sections)
+	}
+	if len(notes.MigrationNotes) != 1 || notes.MigrationNotes[0].Migration != "Use the new api." {
+		t.Errorf("MigrationNotes = %+v", notes.MigrationNotes)
+	}
 }
 
 func TestToPluginReleaseContext_WithChanges(t *testing.T) {
@@ -312,18 +352,18 @@ func TestToIntegrationResponse_Success(t *testing.T) {
 	}
 }
 
-func TestExecutorAdapter_ExecutePlugin_NotSupported(t *testing.T) {
+func TestExecutorAdapter_ExecutePlugin_NotFound(t *testing.T) {
 	adapter := NewExecutorAdapter(&Manager{})
 
 	result, err := adapter.ExecutePlugin(context.Background(), integration.PluginID("test"), integration.ExecuteRequest{})
 
 	if err == nil {
-		t.Error("Expected error for unsupported method")
+		t.Error("Expected error for unknown plugin")
 	}
 	if result != nil {
-		t.Error("Expected nil result for unsupported method")
+		t.Error("Expected nil result for unknown plugin")
 	}
-	expectedMsg := "individual plugin execution by ID is not supported"
+	expectedMsg := "plugin not found: test"
 	if err != nil && !strings.Contains(err.Error(), expectedMsg) {
 		t.Errorf("Expected error to contain %q, got %v", expectedMsg, err)
 	}
@@ -543,3 +583,61 @@ func TestToIntegrationResponses_Mixed(t *testing.T) {
 		t.Errorf("Third response should have 1 artifact, got %d", len(result[2].Artifacts))
 	}
 }
+
+func TestWithEventSink_TagsPluginEvents(t *testing.T) {
+	var got []integration.PluginEvent
+	ctx := integration.WithPluginEventHandler(context.Background(), func(e integration.PluginEvent) {
+		got = append(got, e)
+	})
+
+	// The adapter converts events for the domain; the manager tags them per plugin
+	ctx = withPluginEvents(withEventSink(ctx), "docker", plugin.HookPrePublish)
+	plugin.StartStep(ctx, "build linux/arm64")
+	plugin.Log(ctx, plugin.LogLevelWarn, "cache miss")
+
+	want := []integration.PluginEvent{
+		{PluginID: "docker", Hook: integration.HookPrePublish, Step: "build linux/arm64"},
+		{PluginID: "docker", Hook: integration.HookPrePublish, Log: "cache miss", Level: "warn"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("events = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("event %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWithEventSink_NoHandler(t *testing.T) {
+	ctx := context.Background()
+	if withEventSink(ctx) != ctx || withPluginEvents(ctx, "docker", plugin.HookPrePublish) != ctx {
+		t.Error("contexts without an event handler should be returned unchanged")
+	}
+}
+
+func TestToPluginReleaseContext_PluginOutputs(t *testing.T) {
+	result := toPluginReleaseContext(integration.ReleaseContext{
+		PluginOutputs: map[string]integration.PluginOutput{
+			"github": {
+				Outputs: map[string]any{"release_id": 42},
+				Artifacts: []integration.Artifact{
+					{Name: "app.zip", Path: "dist/app.zip", URL: "https://example.com/app.zip", Size: 10},
+					{Name: "checksums.txt", Path: "dist/checksums.txt"},
+				},
+			},
+		},
+	})
+
+	github := result.PluginOutputs["github"]
+	if github.Outputs["release_id"] != 42 {
+		t.Errorf("Outputs = %v", github.Outputs)
+	}
+	want := []plugin.Artifact{
+		{Name: "app.zip", Path: "dist/app.zip", URL: "https://example.com/app.zip", Size: 10},
+		{Name: "checksums.txt", Path: "dist/checksums.txt"},
+	}
+	if !slices.Equal(github.Artifacts, want) {
+		t.Errorf("Artifacts = %+v, want %+v", github.Artifacts, want)
+	}
+}
@@ -0,0 +1,127 @@
+// Package plugin provides plugin management for ReleasePilot.
+package plugin
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/felixgeelhaar/release-pilot/pkg/plugin"
+)
+
+// pluginDependency is an edge of the execution graph of a hook: a plugin
+// waits for the plugin at index before it runs.
+type pluginDependency struct {
+	index int
+	// required skips the waiting plugin if the plugin at index fails
+	// (depends_on); otherwise the edge only orders them (after).
+	required bool
+}
+
+// buildHookGraph returns the dependencies of each plugin of a hook,
+// indexed like toExecute. Edges to plugins that do not run on the hook are
+// ignored. The second result marks the plugins that are on a dependency
+// cycle, or wait for one; configuration validation rejects cycles, so it
+// only guards against deadlocks.
+func buildHookGraph(toExecute []pluginExecInfo) ([][]pluginDependency, []bool) {
+	indexes := make(map[string]int, len(toExecute))
+	for i, exec := range toExecute {
+		indexes[exec.name] = i
+	}
+
+	deps := make([][]pluginDependency, len(toExecute))
+	for i, exec := range toExecute {
+		for _, ref := range []struct {
+			names    []string
+			required bool
+		}{
+			{exec.dependsOn, true},
+			{exec.after, false},
+		} {
+			for _, name := range ref.names {
+				j, ok := indexes[name]
+				if !ok || j == i {
+					continue
+				}
+				deps[i] = append(deps[i], pluginDependency{index: j, required: ref.required})
+			}
+		}
+	}
+
+	// Kahn's algorithm: whatever cannot be ordered is blocked by a cycle
+	pending := make([]int, len(toExecute))
+	dependents := make([][]int, len(toExecute))
+	for i, ds := range deps {
+		pending[i] = len(ds)
+		for _, d := range ds {
+			dependents[d.index] = append(dependents[d.index], i)
+		}
+	}
+	var ready []int
+	for i, n := range pending {
+		if n == 0 {
+			ready = append(ready, i)
+		}
+	}
+	blocked := slices.Repeat([]bool{true}, len(toExecute))
+	for len(ready) > 0 {
+		i := ready[0]
+		ready = ready[1:]
+		blocked[i] = false
+		for _, j := range dependents[i] {
+			if pending[j]--; pending[j] == 0 {
+				ready = append(ready, j)
+			}
+		}
+	}
+
+	return deps, blocked
+}
+
+// upstreamOutputs returns the plugin outputs a plugin receives: those of
+// earlier hooks and runs, plus those of the plugins it waited for on this
+// hook and what they received in turn. seen holds what each plugin
+// received, results what it returned.
+func upstreamOutputs(base map[string]plugin.PluginOutput, deps []pluginDependency, seen []map[string]plugin.PluginOutput, results []pluginResult) map[string]plugin.PluginOutput {
+	if len(deps) == 0 {
+		return base
+	}
+
+	outputs := maps.Clone(base)
+	if outputs == nil {
+		outputs = make(map[string]plugin.PluginOutput)
+	}
+	for _, dep := range deps {
+		for name, o := range seen[dep.index] {
+			outputs[name] = mergePluginOutput(outputs[name], o)
+		}
+		if r := results[dep.index]; r.response.Success {
+			outputs[r.name] = mergePluginOutput(outputs[r.name], plugin.PluginOutput{
+				Outputs:   r.response.Outputs,
+				Artifacts: r.response.Artifacts,
+			})
+		}
+	}
+	return outputs
+}
+
+// mergePluginOutput adds the outputs and artifacts of next to prev; outputs
+// with the same key and artifacts with the same name are replaced.
+func mergePluginOutput(prev, next plugin.PluginOutput) plugin.PluginOutput {
+	merged := plugin.PluginOutput{
+		Outputs:   maps.Clone(prev.Outputs),
+		Artifacts: slices.Clone(prev.Artifacts),
+	}
+	if merged.Outputs == nil && len(next.Outputs) > 0 {
+		merged.Outputs = make(map[string]any, len(next.Outputs))
+	}
+	maps.Copy(merged.Outputs, next.Outputs)
+	for _, a := range next.Artifacts {
+		i := slices.IndexFunc(merged.Artifacts, func(e plugin.Artifact) bool { return e.Name == a.Name })
+		if i >= 0 {
+			merged.Artifacts[i] = a
+		} else {
+			merged.Artifacts = append(merged.Artifacts, a)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,151 @@
+// Package plugin provides plugin management for ReleasePilot.
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+	"github.com/felixgeelhaar/release-pilot/internal/service/template"
+	"github.com/felixgeelhaar/release-pilot/pkg/plugin"
+)
+
+// pluginHost is the plugin.Host offered to one plugin while it executes.
+// Git access is read-only, and secrets are limited to the environment
+// variables listed under secrets in the plugin's configuration.
+type pluginHost struct {
+	git       git.Service
+	templates template.Service
+	logger    hclog.Logger
+	secrets   []string
+}
+
+// newPluginHost returns the host services for a plugin.
+func (m *Manager) newPluginHost(name string, secrets []string) *pluginHost {
+	return &pluginHost{
+		git:       m.gitService,
+		templates: m.templateService,
+		logger:    m.logger.Named(name),
+		secrets:   secrets,
+	}
+}
+
+// GitRepository returns information about the repository being released.
+func (h *pluginHost) GitRepository(ctx context.Context) (*plugin.GitRepository, error) {
+	if h.git == nil {
+		return nil, fmt.Errorf("%w: git", plugin.ErrHostUnavailable)
+	}
+	info, err := h.git.GetRepositoryInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := &plugin.GitRepository{
+		Root:          info.Root,
+		CurrentBranch: info.CurrentBranch,
+		DefaultBranch: info.DefaultBranch,
+		HeadCommit:    info.HeadCommit,
+		IsDirty:       info.IsDirty,
+	}
+	for _, r := range info.Remotes {
+		if r.Name == "origin" {
+			repo.RemoteURL = r.URL
+		}
+	}
+	return repo, nil
+}
+
+// GitCommits returns the commits after from up to to (HEAD if empty).
+func (h *pluginHost) GitCommits(ctx context.Context, from, to string) ([]plugin.GitCommit, error) {
+	if h.git == nil {
+		return nil, fmt.Errorf("%w: git", plugin.ErrHostUnavailable)
+	}
+	if to == "" {
+		to = "HEAD"
+	}
+	commits, err := h.git.GetCommitsBetween(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]plugin.GitCommit, len(commits))
+	for i, c := range commits {
+		result[i] = plugin.GitCommit{
+			Hash:        c.Hash,
+			Subject:     c.Subject,
+			Body:        c.Body,
+			AuthorName:  c.Author.Name,
+			AuthorEmail: c.Author.Email,
+			Date:        c.Date,
+		}
+	}
+	return result, nil
+}
+
+// GitTags returns the version tags with the given prefix, newest first.
+func (h *pluginHost) GitTags(ctx context.Context, prefix string) ([]plugin.GitTag, error) {
+	if h.git == nil {
+		return nil, fmt.Errorf("%w: git", plugin.ErrHostUnavailable)
+	}
+	tags, err := h.git.ListVersionTags(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]plugin.GitTag, len(tags))
+	for i, t := range tags {
+		result[i] = plugin.GitTag{
+			Name:    t.Name,
+			Hash:    t.Hash,
+			Message: t.Message,
+			Date:    t.Date,
+		}
+	}
+	return result, nil
+}
+
+// RenderTemplate renders a Go template with the template service.
+func (h *pluginHost) RenderTemplate(_ context.Context, tmpl string, data any) (string, error) {
+	if h.templates == nil {
+		return "", fmt.Errorf("%w: templates", plugin.ErrHostUnavailable)
+	}
+	return h.templates.RenderString(tmpl, data)
+}
+
+// Log writes a log line to the plugin's logger.
+func (h *pluginHost) Log(_ context.Context, level, message string, fields map[string]any) error {
+	args := make([]any, 0, 2*len(fields))
+	for _, k := range slices.Sorted(maps.Keys(fields)) {
+		args = append(args, k, fields[k])
+	}
+
+	switch level {
+	case plugin.LogLevelDebug:
+		h.logger.Debug(message, args...)
+	case plugin.LogLevelWarn:
+		h.logger.Warn(message, args...)
+	case plugin.LogLevelError:
+		h.logger.Error(message, args...)
+	default:
+		h.logger.Info(message, args...)
+	}
+	return nil
+}
+
+// Secret returns the value of an environment variable the plugin is
+// allowed to read.
+func (h *pluginHost) Secret(_ context.Context, name string) (string, error) {
+	if !slices.Contains(h.secrets, name) {
+		return "", fmt.Errorf("%w: %s", plugin.ErrSecretNotAllowed, name)
+	}
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", plugin.ErrSecretNotFound, name)
+	}
+	return value, nil
+}
@@ -0,0 +1,147 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/felixgeelhaar/release-pilot/internal/config"
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+	"github.com/felixgeelhaar/release-pilot/internal/service/template"
+	"github.com/felixgeelhaar/release-pilot/pkg/plugin"
+)
+
+// stubGitService answers the read-only queries of the host.
+type stubGitService struct {
+	git.Service
+	from, to string
+}
+
+func (s *stubGitService) GetRepositoryInfo(context.Context) (*git.RepositoryInfo, error) {
+	return &git.RepositoryInfo{
+		Root:          "/repo",
+		CurrentBranch: "main",
+		Remotes: []git.RemoteInfo{
+			{Name: "upstream", URL: "https://github.com/acme/upstream"},
+			{Name: "origin", URL: "https://github.com/acme/tool"},
+		},
+	}, nil
+}
+
+func (s *stubGitService) GetCommitsBetween(_ context.Context, from, to string) ([]git.Commit, error) {
+	s.from, s.to = from, to
+	return []git.Commit{{
+		Hash:    "abc123",
+		Subject: "feat: tap",
+		Author:  git.Author{Name: "Jane", Email: "jane@example.com"},
+		Date:    time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+	}}, nil
+}
+
+func TestPluginHost_Git(t *testing.T) {
+	svc := &stubGitService{}
+	m := NewManager(&config.Config{}, WithGitService(svc))
+	host := m.newPluginHost("homebrew", nil)
+
+	repo, err := host.GitRepository(context.Background())
+	if err != nil {
+		t.Fatalf("GitRepository() error = %v", err)
+	}
+	if repo.RemoteURL != "https://github.com/acme/tool" || repo.CurrentBranch != "main" {
+		t.Errorf("GitRepository() = %+v, want origin remote and branch", repo)
+	}
+
+	commits, err := host.GitCommits(context.Background(), "v1.1.0", "")
+	if err != nil {
+		t.Fatalf("GitCommits() error = %v", err)
+	}
+	if svc.from != "v1.1.0" || svc.to != "HEAD" {
+		t.Errorf("GitCommits() queried %s..%s, want v1.1.0..HEAD", svc.from, svc.to)
+	}
+	if len(commits) != 1 || commits[0].AuthorEmail != "jane@example.com" {
+		t.Errorf("GitCommits() = %+v", commits)
+	}
+}
+
+func TestPluginHost_Unavailable(t *testing.T) {
+	host := NewManager(&config.Config{}).newPluginHost("homebrew", nil)
+
+	if _, err := host.GitTags(context.Background(), "v"); !errors.Is(err, plugin.ErrHostUnavailable) {
+		t.Errorf("GitTags() error = %v, want ErrHostUnavailable", err)
+	}
+	if _, err := host.RenderTemplate(context.Background(), "{{.}}", 1); !errors.Is(err, plugin.ErrHostUnavailable) {
+		t.Errorf("RenderTemplate() error = %v, want ErrHostUnavailable", err)
+	}
+}
+
+func TestPluginHost_RenderTemplate(t *testing.T) {
+	templates, err := template.NewService()
+	if err != nil {
+		t.Fatal(err)
+	}
+	host := NewManager(&config.Config{}, WithTemplateService(templates)).newPluginHost("homebrew", nil)
+
+	out, err := host.RenderTemplate(context.Background(), "v{{.Version}}", map[string]any{"Version": "1.2.0"})
+	if err != nil {
+		t.Fatalf("RenderTemplate() error = %v", err)
+	}
+	if out != "v1.2.0" {
+		t.Errorf("RenderTemplate() = %q, want v1.2.0", out)
+	}
+}
+
+func TestPluginHost_Secret(t *testing.T) {
+	t.Setenv("TAP_TOKEN", "s3cret")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "other")
+	host := &pluginHost{logger: hclog.NewNullLogger(), secrets: []string{"TAP_TOKEN", "UNSET_TOKEN"}}
+
+	tests := []struct {
+		name    string
+		secret  string
+		want    string
+		wantErr error
+	}{
+		{name: "allowed", secret: "TAP_TOKEN", want: "s3cret"},
+		{name: "not allowed", secret: "AWS_SECRET_ACCESS_KEY", wantErr: plugin.ErrSecretNotAllowed},
+		{name: "not set", secret: "UNSET_TOKEN", wantErr: plugin.ErrSecretNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := host.Secret(context.Background(), tt.secret)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Secret() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Secret() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteHook_OffersHost(t *testing.T) {
+	m := NewManager(&config.Config{})
+	host := m.newPluginHost("homebrew", nil)
+
+	var got plugin.Host
+	m.plugins["homebrew"] = &loadedPlugin{
+		name: "homebrew",
+		plugin: &mockPlugin{executeFunc: func(ctx context.Context, _ plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
+			got = plugin.HostFrom(ctx)
+			return &plugin.ExecuteResponse{Success: true}, nil
+		}},
+		info:    plugin.Info{Name: "homebrew", Hooks: []plugin.Hook{plugin.HookPostPublish}},
+		timeout: time.Second,
+		host:    host,
+	}
+
+	if _, err := m.ExecuteHook(context.Background(), plugin.HookPostPublish, plugin.ReleaseContext{}); err != nil {
+		t.Fatalf("ExecuteHook() error = %v", err)
+	}
+	if got != host {
+		t.Errorf("plugin got host %v, want the plugin's host", got)
+	}
+}
@@ -2,11 +2,13 @@
 package plugin
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -18,6 +20,8 @@ import (
 
 	"github.com/felixgeelhaar/release-pilot/internal/config"
 	"github.com/felixgeelhaar/release-pilot/internal/errors"
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+	"github.com/felixgeelhaar/release-pilot/internal/service/template"
 	"github.com/felixgeelhaar/release-pilot/pkg/plugin"
 )
 
@@ -39,6 +43,27 @@ type Manager struct {
 	logger           hclog.Logger
 	cfg              *config.Config
 	executionLimiter *semaphore.Weighted
+	// gitService and templateService back the host services plugins call
+	// while they execute; without them those services are unavailable.
+	gitService      git.Service
+	templateService template.Service
+}
+
+// ManagerOption configures a Manager.
+type ManagerOption func(*Manager)
+
+// WithGitService lets plugins query the repository through the host.
+func WithGitService(svc git.Service) ManagerOption {
+	return func(m *Manager) {
+		m.gitService = svc
+	}
+}
+
+// WithTemplateService lets plugins render templates through the host.
+func WithTemplateService(svc template.Service) ManagerOption {
+	return func(m *Manager) {
+		m.templateService = svc
+	}
 }
 
 // loadedPlugin represents a loaded and running plugin.
@@ -49,10 +74,15 @@ type loadedPlugin struct {
 	info    plugin.Info
 	config  map[string]any
 	timeout time.Duration
+	// dependsOn and after are the plugins this plugin waits for on a hook
+	dependsOn []string
+	after     []string
+	// host is offered to the plugin while it executes
+	host plugin.Host
 }
 
 // NewManager creates a new plugin manager.
-func NewManager(cfg *config.Config) *Manager {
+func NewManager(cfg *config.Config, opts ...ManagerOption) *Manager {
 	logger := hclog.New(&hclog.LoggerOptions{
 		Name:   "plugin",
 		Level:  hclog.Info,
@@ -65,12 +95,16 @@ func NewManager(cfg *config.Config) *Manager {
 		pluginCount = 4 // Default capacity for typical usage
 	}
 
-	return &Manager{
+	m := &Manager{
 		plugins:          make(map[string]*loadedPlugin, pluginCount),
 		logger:           logger,
 		cfg:              cfg,
 		executionLimiter: semaphore.NewWeighted(MaxConcurrentPluginExecutions),
 	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
 // LoadPlugins loads all configured plugins.
@@ -165,12 +199,15 @@ func (m *Manager) loadPlugin(ctx context.Context, cfg *config.PluginConfig) erro
 	// Store loaded plugin
 	m.mu.Lock()
 	m.plugins[cfg.Name] = &loadedPlugin{
-		name:    cfg.Name,
-		client:  client,
-		plugin:  p,
-		info:    info,
-		config:  cfg.Config,
-		timeout: timeout,
+		name:      cfg.Name,
+		client:    client,
+		plugin:    p,
+		info:      info,
+		config:    cfg.Config,
+		timeout:   timeout,
+		dependsOn: cfg.DependsOn,
+		after:     cfg.After,
+		host:      m.newPluginHost(cfg.Name, cfg.Secrets),
 	}
 	m.mu.Unlock()
 
@@ -335,28 +372,48 @@ func (m *Manager) findPluginBinary(cfg *config.PluginConfig) (string, error) {
 // pluginExecInfo contains the information needed to execute a plugin.
 // This allows us to release the lock before making expensive RPC calls.
 type pluginExecInfo struct {
-	name    string
-	plugin  plugin.Plugin
-	config  map[string]any
-	timeout time.Duration
+	name      string
+	plugin    plugin.Plugin
+	config    map[string]any
+	timeout   time.Duration
+	dependsOn []string
+	after     []string
+	host      plugin.Host
 }
 
 // pluginResult holds the result of a parallel plugin execution.
 type pluginResult struct {
 	index    int
+	name     string
 	response plugin.ExecuteResponse
 }
 
-// ExecuteHook executes all plugins for a given hook in parallel.
-// Plugins are executed concurrently for improved performance.
+// ExecuteHook executes all plugins for a given hook.
+// Plugins run concurrently unless they declare depends_on or after edges to
+// each other, in which case they wait for those plugins to finish.
 // Results are returned in a stable order (same order as plugin registration).
 // A global timeout is applied to prevent runaway execution.
 func (m *Manager) ExecuteHook(ctx context.Context, hook plugin.Hook, releaseCtx plugin.ReleaseContext) ([]plugin.ExecuteResponse, error) {
+	results := m.executeHook(ctx, hook, releaseCtx)
+	if results == nil {
+		return nil, nil
+	}
+
+	responses := make([]plugin.ExecuteResponse, len(results))
+	for i, r := range results {
+		responses[i] = r.response
+	}
+	return responses, nil
+}
+
+// executeHook executes all plugins for a given hook and returns their
+// responses together with the name of the plugin that produced each one.
+func (m *Manager) executeHook(ctx context.Context, hook plugin.Hook, releaseCtx plugin.ReleaseContext) []pluginResult {
 	// Collect plugins to execute while holding the lock briefly
 	toExecute := m.collectPluginsForHook(hook)
 
 	if len(toExecute) == 0 {
-		return nil, nil
+		return nil
 	}
 
 	// Apply global timeout for all plugin executions
@@ -367,129 +424,215 @@ func (m *Manager) ExecuteHook(ctx context.Context, hook plugin.Hook, releaseCtx
 	// Get dry run setting (read config while we have access)
 	dryRun := m.cfg.Workflow.DryRunByDefault
 
-	// Channel for collecting results from parallel execution
-	// Buffered to prevent goroutine leaks on context cancellation
-	resultsChan := make(chan pluginResult, len(toExecute))
+	// Each plugin writes only its own result and closes its done channel
+	// afterwards, so dependents can read the result once it is closed
+	results := make([]pluginResult, len(toExecute))
+	seen := make([]map[string]plugin.PluginOutput, len(toExecute))
+	done := make([]chan struct{}, len(toExecute))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	deps, blocked := buildHookGraph(toExecute)
 
 	// Use errgroup for coordinated parallel execution
 	g, gCtx := errgroup.WithContext(globalCtx)
 
-	// Execute plugins in parallel with rate limiting
 	for i, exec := range toExecute {
-		i, exec := i, exec // Capture loop variables
+		if blocked[i] {
+			m.logger.Error("plugin dependency cycle", "plugin", exec.name, "hook", hook)
+			results[i] = failedResult(i, exec.name, "plugin dependency cycle")
+			close(done[i])
+			continue
+		}
 		g.Go(func() error {
-			// Check for context cancellation before acquiring semaphore
-			select {
-			case <-gCtx.Done():
-				resultsChan <- pluginResult{
-					index: i,
-					response: plugin.ExecuteResponse{
-						Success: false,
-						Error:   fmt.Sprintf("execution canceled: %v", gCtx.Err()),
-					},
+			defer close(done[i])
+
+			// Wait for the plugins this one depends on
+			for _, dep := range deps[i] {
+				select {
+				case <-done[dep.index]:
+				case <-gCtx.Done():
+					results[i] = failedResult(i, exec.name, fmt.Sprintf("execution canceled: %v", gCtx.Err()))
+					return nil
 				}
-				return nil
-			default:
-			}
-
-			// Acquire execution slot from semaphore (rate limiting)
-			if err := m.executionLimiter.Acquire(gCtx, 1); err != nil {
-				m.logger.Error("failed to acquire execution slot", "plugin", exec.name, "error", err)
-				resultsChan <- pluginResult{
-					index: i,
-					response: plugin.ExecuteResponse{
-						Success: false,
-						Error:   fmt.Sprintf("failed to acquire execution slot: %v", err),
-					},
+				if upstream := results[dep.index]; dep.required && !upstream.response.Success {
+					m.logger.Warn("skipping plugin after failed dependency", "plugin", exec.name, "hook", hook, "dependency", upstream.name)
+					results[i] = failedResult(i, exec.name, fmt.Sprintf("skipped: dependency %s failed", upstream.name))
+					return nil
 				}
-				return nil
 			}
-			defer m.executionLimiter.Release(1)
-
-			m.logger.Debug("executing hook", "plugin", exec.name, "hook", hook)
 
-			// Execute with per-plugin timeout (capped by global context)
-			execCtx, cancel := context.WithTimeout(gCtx, exec.timeout)
-			defer cancel()
-
-			resp, err := exec.plugin.Execute(execCtx, plugin.ExecuteRequest{
-				Hook:    hook,
-				Config:  exec.config,
-				Context: releaseCtx,
-				DryRun:  dryRun,
-			})
-
-			if err != nil {
-				m.logger.Error("plugin execution failed", "plugin", exec.name, "hook", hook, "error", err)
-				resultsChan <- pluginResult{
-					index: i,
-					response: plugin.ExecuteResponse{
-						Success: false,
-						Error:   err.Error(),
-					},
-				}
-				// Don't return error - allow other plugins to continue
-				return nil
-			}
+			// Plugins see the outputs of the plugins they waited for
+			pluginCtx := releaseCtx
+			pluginCtx.PluginOutputs = upstreamOutputs(releaseCtx.PluginOutputs, deps[i], seen, results)
+			seen[i] = pluginCtx.PluginOutputs
 
-			if resp != nil {
-				if resp.Success {
-					m.logger.Info("plugin executed successfully", "plugin", exec.name, "hook", hook)
-				} else {
-					m.logger.Warn("plugin execution returned error", "plugin", exec.name, "hook", hook, "error", resp.Error)
-				}
-				resultsChan <- pluginResult{
-					index:    i,
-					response: *resp,
-				}
-			} else {
-				// Ensure we always send a result to prevent deadlock
-				resultsChan <- pluginResult{
-					index: i,
-					response: plugin.ExecuteResponse{
-						Success: true,
-						Message: "plugin returned no response",
-					},
-				}
+			results[i] = pluginResult{
+				index:    i,
+				name:     exec.name,
+				response: m.executeScheduled(gCtx, hook, exec, pluginCtx, dryRun),
 			}
-
 			return nil
 		})
 	}
 
 	// Wait for all goroutines to complete
 	_ = g.Wait() // Errors are handled per-plugin, not propagated
-	close(resultsChan)
 
 	// Check if we timed out globally
 	if globalCtx.Err() != nil {
 		m.logger.Warn("global hook timeout reached", "hook", hook, "timeout", MaxGlobalHookTimeout)
 	}
 
-	// Collect results and sort by original index for stable ordering
-	indexedResults := make([]pluginResult, 0, len(toExecute))
-	for result := range resultsChan {
-		indexedResults = append(indexedResults, result)
+	// Filter out zero-value responses (from plugins that returned nil)
+	filteredResults := make([]pluginResult, 0, len(results))
+	for _, r := range results {
+		// Check if this is a real response (not zero value from unset index)
+		resp := r.response
+		if resp.Success || resp.Error != "" || resp.Message != "" || len(resp.Outputs) > 0 {
+			filteredResults = append(filteredResults, r)
+		}
 	}
 
-	// Sort by index to maintain stable order
-	results := make([]plugin.ExecuteResponse, len(toExecute))
-	for _, r := range indexedResults {
-		if r.index >= 0 && r.index < len(results) {
-			results[r.index] = r.response
+	return filteredResults
+}
+
+// executeScheduled executes one plugin of a hook once its dependencies have
+// finished, and converts execution errors into a failed response.
+func (m *Manager) executeScheduled(ctx context.Context, hook plugin.Hook, exec pluginExecInfo, releaseCtx plugin.ReleaseContext, dryRun bool) plugin.ExecuteResponse {
+	// Check for context cancellation before acquiring semaphore
+	select {
+	case <-ctx.Done():
+		return plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("execution canceled: %v", ctx.Err()),
 		}
+	default:
 	}
 
-	// Filter out zero-value responses (from plugins that returned nil)
-	filteredResults := make([]plugin.ExecuteResponse, 0, len(results))
-	for _, r := range results {
-		// Check if this is a real response (not zero value from unset index)
-		if r.Success || r.Error != "" || r.Message != "" || len(r.Outputs) > 0 {
-			filteredResults = append(filteredResults, r)
+	// Acquire execution slot from semaphore (rate limiting).
+	// Plugins acquire it only after their dependencies have finished, so
+	// waiting plugins never hold a slot.
+	if err := m.executionLimiter.Acquire(ctx, 1); err != nil {
+		m.logger.Error("failed to acquire execution slot", "plugin", exec.name, "error", err)
+		return plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("failed to acquire execution slot: %v", err),
 		}
 	}
+	defer m.executionLimiter.Release(1)
 
-	return filteredResults, nil
+	m.logger.Debug("executing hook", "plugin", exec.name, "hook", hook)
+
+	// Execute with per-plugin timeout (capped by global context)
+	execCtx, cancel := context.WithTimeout(withPluginHost(withPluginEvents(ctx, exec.name, hook), exec.host), exec.timeout)
+	defer cancel()
+
+	resp, err := exec.plugin.Execute(execCtx, plugin.ExecuteRequest{
+		Hook:    hook,
+		Config:  exec.config,
+		Context: releaseCtx,
+		DryRun:  dryRun,
+	})
+
+	if err != nil {
+		m.logger.Error("plugin execution failed", "plugin", exec.name, "hook", hook, "error", err)
+		// Don't return error - allow other plugins to continue
+		return plugin.ExecuteResponse{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+
+	if resp == nil {
+		return plugin.ExecuteResponse{
+			Success: true,
+			Message: "plugin returned no response",
+		}
+	}
+
+	if resp.Success {
+		m.logger.Info("plugin executed successfully", "plugin", exec.name, "hook", hook)
+	} else {
+		m.logger.Warn("plugin execution returned error", "plugin", exec.name, "hook", hook, "error", resp.Error)
+	}
+	return *resp
+}
+
+// failedResult returns the result of a plugin that did not run.
+func failedResult(index int, name, reason string) pluginResult {
+	return pluginResult{
+		index: index,
+		name:  name,
+		response: plugin.ExecuteResponse{
+			Success: false,
+			Error:   reason,
+		},
+	}
+}
+
+// ExecutePlugin executes a single plugin for a hook.
+// It is used to re-run individual plugins, for example when resuming a publish.
+func (m *Manager) ExecutePlugin(ctx context.Context, name string, hook plugin.Hook, releaseCtx plugin.ReleaseContext) (*plugin.ExecuteResponse, error) {
+	const op = "plugin.ExecutePlugin"
+
+	m.mu.RLock()
+	lp, ok := m.plugins[name]
+	m.mu.RUnlock()
+	if !ok {
+		return nil, errors.NotFound(op, fmt.Sprintf("plugin not found: %s", name))
+	}
+	if !m.pluginSupportsHook(lp, hook) {
+		return nil, errors.Validation(op, fmt.Sprintf("plugin %s does not handle hook %s", name, hook))
+	}
+
+	if err := m.executionLimiter.Acquire(ctx, 1); err != nil {
+		return nil, errors.PluginWrap(err, op, "failed to acquire execution slot")
+	}
+	defer m.executionLimiter.Release(1)
+
+	execCtx, cancel := context.WithTimeout(withPluginHost(withPluginEvents(ctx, name, hook), lp.host), lp.timeout)
+	defer cancel()
+
+	m.logger.Debug("executing plugin", "plugin", name, "hook", hook)
+
+	resp, err := lp.plugin.Execute(execCtx, plugin.ExecuteRequest{
+		Hook:    hook,
+		Config:  lp.config,
+		Context: releaseCtx,
+		DryRun:  m.cfg.Workflow.DryRunByDefault,
+	})
+	if err != nil {
+		m.logger.Error("plugin execution failed", "plugin", name, "hook", hook, "error", err)
+		return nil, errors.PluginWrap(err, op, fmt.Sprintf("plugin %s failed", name))
+	}
+	if resp == nil {
+		return &plugin.ExecuteResponse{Success: true, Message: "plugin returned no response"}, nil
+	}
+	return resp, nil
+}
+
+// withPluginEvents tags the events of one plugin execution with the plugin
+// name and hook, if ctx has an event sink.
+func withPluginEvents(ctx context.Context, name string, hook plugin.Hook) context.Context {
+	sink := plugin.EventSinkFrom(ctx)
+	if sink == nil {
+		return ctx
+	}
+	return plugin.WithEventSink(ctx, func(e plugin.ExecuteEvent) {
+		e.Plugin, e.Hook = name, hook
+		sink(e)
+	})
+}
+
+// withPluginHost offers the host services to a plugin execution, if the
+// plugin has them.
+func withPluginHost(ctx context.Context, host plugin.Host) context.Context {
+	if host == nil {
+		return ctx
+	}
+	return plugin.WithHost(ctx, host)
 }
 
 // collectPluginsForHook collects plugins that support the given hook.
@@ -507,13 +650,40 @@ func (m *Manager) collectPluginsForHook(hook plugin.Hook) []pluginExecInfo {
 		}
 
 		toExecute = append(toExecute, pluginExecInfo{
-			name:    lp.name,
-			plugin:  lp.plugin,
-			config:  lp.config,
-			timeout: lp.timeout,
+			name:      lp.name,
+			plugin:    lp.plugin,
+			config:    lp.config,
+			timeout:   lp.timeout,
+			dependsOn: lp.dependsOn,
+			after:     lp.after,
+			host:      lp.host,
 		})
 	}
 
+	// Keep the configured order, so results and logs are stable
+	order := make(map[string]int)
+	if m.cfg != nil {
+		for i, p := range m.cfg.Plugins {
+			order[p.Name] = i
+		}
+	}
+	slices.SortFunc(toExecute, func(a, b pluginExecInfo) int {
+		ai, aok := order[a.name]
+		bi, bok := order[b.name]
+		switch {
+		case aok && bok:
+			return cmp.Compare(ai, bi)
+		case aok != bok:
+			// Configured plugins come first
+			if aok {
+				return -1
+			}
+			return 1
+		default:
+			return strings.Compare(a.name, b.name)
+		}
+	})
+
 	return toExecute
 }
 
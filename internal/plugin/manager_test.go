@@ -4,8 +4,10 @@ package plugin
 import (
 	"context"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -1703,3 +1705,220 @@ func TestExecuteHook_PluginFailureWithMessage(t *testing.T) {
 		t.Errorf("Expected error to contain 'permission denied', got %q", responses[0].Error)
 	}
 }
+
+func TestManager_ExecutePlugin(t *testing.T) {
+	m := NewManager(&config.Config{})
+
+	var gotHook plugin.Hook
+	m.mu.Lock()
+	m.plugins["npm"] = &loadedPlugin{
+		name:    "npm",
+		timeout: 30 * time.Second,
+		plugin: &mockPlugin{
+			executeFunc: func(ctx context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
+				gotHook = req.Hook
+				return &plugin.ExecuteResponse{Success: true, Message: "published"}, nil
+			},
+		},
+		info: plugin.Info{
+			Name:  "npm",
+			Hooks: []plugin.Hook{plugin.HookPostPublish},
+		},
+	}
+	m.mu.Unlock()
+
+	ctx := context.Background()
+
+	resp, err := m.ExecutePlugin(ctx, "npm", plugin.HookPostPublish, plugin.ReleaseContext{})
+	if err != nil {
+		t.Fatalf("ExecutePlugin() error = %v", err)
+	}
+	if !resp.Success || resp.Message != "published" {
+		t.Errorf("ExecutePlugin() = %+v, want successful response", resp)
+	}
+	if gotHook != plugin.HookPostPublish {
+		t.Errorf("plugin received hook %s, want %s", gotHook, plugin.HookPostPublish)
+	}
+
+	if _, err := m.ExecutePlugin(ctx, "npm", plugin.HookPreInit, plugin.ReleaseContext{}); err == nil {
+		t.Error("ExecutePlugin() expected error for unsupported hook")
+	}
+	if _, err := m.ExecutePlugin(ctx, "missing", plugin.HookPostPublish, plugin.ReleaseContext{}); err == nil {
+		t.Error("ExecutePlugin() expected error for unknown plugin")
+	}
+}
+
+// scheduledPlugin records when it runs and fails if told to.
+type scheduledPlugin struct {
+	name  string
+	fail  bool
+	delay time.Duration
+
+	mu    *sync.Mutex
+	order *[]string
+	seen  *map[string]plugin.PluginOutput
+}
+
+func (p *scheduledPlugin) GetInfo() plugin.Info {
+	return plugin.Info{Name: p.name, Hooks: []plugin.Hook{plugin.HookPostPublish}}
+}
+
+func (p *scheduledPlugin) Execute(ctx context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
+	time.Sleep(p.delay)
+	p.mu.Lock()
+	*p.order = append(*p.order, p.name)
+	p.mu.Unlock()
+	if p.fail {
+		return nil, fmt.Errorf("%s failed", p.name)
+	}
+	if p.seen != nil {
+		*p.seen = req.Context.PluginOutputs
+	}
+	return &plugin.ExecuteResponse{Success: true, Outputs: map[string]any{"url": "https://example.com/" + p.name}}, nil
+}
+
+func (p *scheduledPlugin) Validate(ctx context.Context, config map[string]any) (*plugin.ValidateResponse, error) {
+	return &plugin.ValidateResponse{Valid: true}, nil
+}
+
+func TestExecuteHook_Dependencies(t *testing.T) {
+	tests := []struct {
+		name      string
+		plugins   []config.PluginConfig
+		failing   string
+		wantOrder []string
+		wantError map[string]string
+	}{
+		{
+			name: "depends_on runs after the dependency",
+			plugins: []config.PluginConfig{
+				{Name: "homebrew", DependsOn: []string{"github"}},
+				{Name: "github"},
+			},
+			wantOrder: []string{"github", "homebrew"},
+		},
+		{
+			name: "depends_on skips after a failure",
+			plugins: []config.PluginConfig{
+				{Name: "github"},
+				{Name: "homebrew", DependsOn: []string{"github"}},
+				{Name: "tap", DependsOn: []string{"homebrew"}},
+			},
+			failing:   "github",
+			wantOrder: []string{"github"},
+			wantError: map[string]string{
+				"homebrew": "skipped: dependency github failed",
+				"tap":      "skipped: dependency homebrew failed",
+			},
+		},
+		{
+			name: "after runs after a failure",
+			plugins: []config.PluginConfig{
+				{Name: "slack", After: []string{"github"}},
+				{Name: "github"},
+			},
+			failing:   "github",
+			wantOrder: []string{"github", "slack"},
+		},
+		{
+			name: "edges to plugins without the hook are ignored",
+			plugins: []config.PluginConfig{
+				{Name: "homebrew", DependsOn: []string{"missing"}},
+			},
+			wantOrder: []string{"homebrew"},
+		},
+		{
+			name: "cycles fail instead of deadlocking",
+			plugins: []config.PluginConfig{
+				{Name: "a", DependsOn: []string{"b"}},
+				{Name: "b", After: []string{"a"}},
+			},
+			wantError: map[string]string{
+				"a": "plugin dependency cycle",
+				"b": "plugin dependency cycle",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(&config.Config{Plugins: tt.plugins})
+			var mu sync.Mutex
+			var order []string
+			for i, cfg := range tt.plugins {
+				// Plugins listed first finish last unless they wait
+				p := &scheduledPlugin{
+					name:  cfg.Name,
+					fail:  cfg.Name == tt.failing,
+					delay: time.Duration(len(tt.plugins)-i) * 10 * time.Millisecond,
+					mu:    &mu,
+					order: &order,
+				}
+				m.plugins[cfg.Name] = &loadedPlugin{
+					name:      cfg.Name,
+					plugin:    p,
+					info:      p.GetInfo(),
+					timeout:   time.Second,
+					dependsOn: cfg.DependsOn,
+					after:     cfg.After,
+				}
+			}
+
+			results := m.executeHook(context.Background(), plugin.HookPostPublish, plugin.ReleaseContext{})
+			if len(results) != len(tt.plugins) {
+				t.Fatalf("got %d results, want %d", len(results), len(tt.plugins))
+			}
+			for i, r := range results {
+				if r.name != tt.plugins[i].Name {
+					t.Errorf("results[%d] = %s, want the configured order", i, r.name)
+				}
+				if want, ok := tt.wantError[r.name]; ok && r.response.Error != want {
+					t.Errorf("%s error = %q, want %q", r.name, r.response.Error, want)
+				}
+			}
+			if strings.Join(order, ",") != strings.Join(tt.wantOrder, ",") {
+				t.Errorf("execution order = %v, want %v", order, tt.wantOrder)
+			}
+		})
+	}
+}
+
+func TestExecuteHook_PassesUpstreamOutputs(t *testing.T) {
+	plugins := []config.PluginConfig{
+		{Name: "github"},
+		{Name: "homebrew", DependsOn: []string{"github"}},
+		{Name: "slack", After: []string{"homebrew"}},
+		{Name: "discord"},
+	}
+	m := NewManager(&config.Config{Plugins: plugins})
+	var mu sync.Mutex
+	var order []string
+	for _, cfg := range plugins {
+		p := &scheduledPlugin{name: cfg.Name, mu: &mu, order: &order, seen: new(map[string]plugin.PluginOutput)}
+		m.plugins[cfg.Name] = &loadedPlugin{
+			name:      cfg.Name,
+			plugin:    p,
+			info:      p.GetInfo(),
+			timeout:   time.Second,
+			dependsOn: cfg.DependsOn,
+			after:     cfg.After,
+		}
+	}
+
+	earlier := map[string]plugin.PluginOutput{"docker": {Outputs: map[string]any{"image": "ghcr.io/owner/app"}}}
+	m.executeHook(context.Background(), plugin.HookPostPublish, plugin.ReleaseContext{PluginOutputs: earlier})
+
+	// Each plugin reports its own outputs; check what the others saw
+	check := func(name string, want ...string) {
+		t.Helper()
+		p := m.plugins[name].plugin.(*scheduledPlugin)
+		got := slices.Sorted(maps.Keys(*p.seen))
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("%s saw outputs of %v, want %v", name, got, want)
+		}
+	}
+	check("github", "docker")
+	check("homebrew", "docker", "github")
+	check("slack", "docker", "github", "homebrew")
+	check("discord", "docker")
+}
@@ -40,6 +40,7 @@ const (
 	Hook_HOOK_POST_PUBLISH Hook = 12
 	Hook_HOOK_ON_SUCCESS   Hook = 13
 	Hook_HOOK_ON_ERROR     Hook = 14
+	Hook_HOOK_ON_ROLLBACK  Hook = 15
 )
 
 // Enum value maps for Hook.
@@ -60,6 +61,7 @@ var (
 		12: "HOOK_POST_PUBLISH",
 		13: "HOOK_ON_SUCCESS",
 		14: "HOOK_ON_ERROR",
+		15: "HOOK_ON_ROLLBACK",
 	}
 	Hook_value = map[string]int32{
 		"HOOK_UNSPECIFIED":  0,
@@ -77,6 +79,7 @@ var (
 		"HOOK_POST_PUBLISH": 12,
 		"HOOK_ON_SUCCESS":   13,
 		"HOOK_ON_ERROR":     14,
+		"HOOK_ON_ROLLBACK":  15,
 	}
 )
 
@@ -245,7 +248,10 @@ type ExecuteRequest struct {
 	// context contains the release context.
 	Context *ReleaseContext `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
 	// dry_run indicates if this is a dry run.
-	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// host_broker_id is the broker ID of the Host service the plugin can call
+	// while it executes, or 0 if the host does not offer it.
+	HostBrokerId  uint32 `protobuf:"varint,5,opt,name=host_broker_id,json=hostBrokerId,proto3" json:"host_broker_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -308,6 +314,13 @@ func (x *ExecuteRequest) GetDryRun() bool {
 	return false
 }
 
+func (x *ExecuteRequest) GetHostBrokerId() uint32 {
+	if x != nil {
+		return x.HostBrokerId
+	}
+	return 0
+}
+
 // ExecuteResponse is the response from plugin execution.
 type ExecuteResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -418,7 +431,17 @@ type ReleaseContext struct {
 	// changes contains the categorized changes.
 	Changes *CategorizedChanges `protobuf:"bytes,12,opt,name=changes,proto3" json:"changes,omitempty"`
 	// environment contains environment variables (filtered for security).
-	Environment   map[string]string `protobuf:"bytes,13,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Environment map[string]string `protobuf:"bytes,13,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// notes is the structured form of release_notes, if notes were generated.
+	Notes *StructuredNotes `protobuf:"bytes,14,opt,name=notes,proto3" json:"notes,omitempty"`
+	// localized_notes holds translations of the notes, one per locale.
+	LocalizedNotes []*LocalizedNotes `protobuf:"bytes,15,rep,name=localized_notes,json=localizedNotes,proto3" json:"localized_notes,omitempty"`
+	// migration_guide is the Markdown migration guide for the release's
+	// breaking changes, if one was generated.
+	MigrationGuide string `protobuf:"bytes,16,opt,name=migration_guide,json=migrationGuide,proto3" json:"migration_guide,omitempty"`
+	// plugin_outputs holds the outputs and artifacts of the plugins that ran
+	// earlier, keyed by plugin name, as JSON.
+	PluginOutputs string `protobuf:"bytes,17,opt,name=plugin_outputs,json=pluginOutputs,proto3" json:"plugin_outputs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -544,6 +567,34 @@ func (x *ReleaseContext) GetEnvironment() map[string]string {
 	return nil
 }
 
+func (x *ReleaseContext) GetNotes() *StructuredNotes {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *ReleaseContext) GetLocalizedNotes() []*LocalizedNotes {
+	if x != nil {
+		return x.LocalizedNotes
+	}
+	return nil
+}
+
+func (x *ReleaseContext) GetMigrationGuide() string {
+	if x != nil {
+		return x.MigrationGuide
+	}
+	return ""
+}
+
+func (x *ReleaseContext) GetPluginOutputs() string {
+	if x != nil {
+		return x.PluginOutputs
+	}
+	return ""
+}
+
 // CategorizedChanges contains commits grouped by category.
 type CategorizedChanges struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -776,14 +827,16 @@ type Artifact struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// name is the artifact name.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// path is the artifact path (local file or URL).
+	// path is the local path of the artifact, if it is a file.
 	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 	// type is the artifact type (file, url, etc.).
 	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
 	// size is the artifact size in bytes.
 	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
 	// checksum is the artifact checksum.
-	Checksum      string `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Checksum string `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// url is where the artifact was published, e.g. a release asset download URL.
+	Url           string `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -853,6 +906,13 @@ func (x *Artifact) GetChecksum() string {
 	return ""
 }
 
+func (x *Artifact) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
 // ValidateRequest is the request for validating plugin configuration.
 type ValidateRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1018,106 +1078,1234 @@ func (x *ValidationError) GetCode() string {
 	return ""
 }
 
-var File_internal_plugin_proto_plugin_proto protoreflect.FileDescriptor
+// StructuredNotes is the typed form of the release notes.
+type StructuredNotes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// title is the release notes title.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is the release summary.
+	Summary string `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	// highlights lists the most important changes.
+	Highlights []string `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	// sections groups the changes under titles.
+	Sections []*NotesSection `protobuf:"bytes,4,rep,name=sections,proto3" json:"sections,omitempty"`
+	// migration_notes explains how to migrate for each breaking change.
+	MigrationNotes []*MigrationNote `protobuf:"bytes,5,rep,name=migration_notes,json=migrationNotes,proto3" json:"migration_notes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StructuredNotes) Reset() {
+	*x = StructuredNotes{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-const file_internal_plugin_proto_plugin_proto_rawDesc = "" +
-	"\n" +
-	"\"internal/plugin/proto/plugin.proto\x12\freleasepilot\"\a\n" +
-	"\x05Empty\"\xaf\x01\n" +
-	"\n" +
-	"PluginInfo\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06author\x18\x04 \x01(\tR\x06author\x12\x14\n" +
-	"\x05hooks\x18\x05 \x03(\tR\x05hooks\x12#\n" +
-	"\rconfig_schema\x18\x06 \x01(\tR\fconfigSchema\"\xa1\x01\n" +
-	"\x0eExecuteRequest\x12&\n" +
-	"\x04hook\x18\x01 \x01(\x0e2\x12.releasepilot.HookR\x04hook\x12\x16\n" +
-	"\x06config\x18\x02 \x01(\tR\x06config\x126\n" +
-	"\acontext\x18\x03 \x01(\v2\x1c.releasepilot.ReleaseContextR\acontext\x12\x17\n" +
-	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\xab\x01\n" +
-	"\x0fExecuteResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
-	"\x05error\x18\x03 \x01(\tR\x05error\x12\x18\n" +
-	"\aoutputs\x18\x04 \x01(\tR\aoutputs\x124\n" +
-	"\tartifacts\x18\x05 \x03(\v2\x16.releasepilot.ArtifactR\tartifacts\"\xd5\x04\n" +
-	"\x0eReleaseContext\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\tR\aversion\x12)\n" +
-	"\x10previous_version\x18\x02 \x01(\tR\x0fpreviousVersion\x12\x19\n" +
-	"\btag_name\x18\x03 \x01(\tR\atagName\x12!\n" +
-	"\frelease_type\x18\x04 \x01(\tR\vreleaseType\x12%\n" +
-	"\x0erepository_url\x18\x05 \x01(\tR\rrepositoryUrl\x12)\n" +
-	"\x10repository_owner\x18\x06 \x01(\tR\x0frepositoryOwner\x12'\n" +
-	"\x0frepository_name\x18\a \x01(\tR\x0erepositoryName\x12\x16\n" +
-	"\x06branch\x18\b \x01(\tR\x06branch\x12\x1d\n" +
-	"\n" +
-	"commit_sha\x18\t \x01(\tR\tcommitSha\x12\x1c\n" +
-	"\tchangelog\x18\n" +
-	" \x01(\tR\tchangelog\x12#\n" +
-	"\rrelease_notes\x18\v \x01(\tR\freleaseNotes\x12:\n" +
-	"\achanges\x18\f \x01(\v2 .releasepilot.CategorizedChangesR\achanges\x12O\n" +
-	"\venvironment\x18\r \x03(\v2-.releasepilot.ReleaseContext.EnvironmentEntryR\venvironment\x1a>\n" +
-	"\x10EnvironmentEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb8\x03\n" +
-	"\x12CategorizedChanges\x12<\n" +
-	"\bfeatures\x18\x01 \x03(\v2 .releasepilot.ConventionalCommitR\bfeatures\x126\n" +
-	"\x05fixes\x18\x02 \x03(\v2 .releasepilot.ConventionalCommitR\x05fixes\x12<\n" +
-	"\bbreaking\x18\x03 \x03(\v2 .releasepilot.ConventionalCommitR\bbreaking\x12B\n" +
-	"\vperformance\x18\x04 \x03(\v2 .releasepilot.ConventionalCommitR\vperformance\x12<\n" +
-	"\brefactor\x18\x05 \x03(\v2 .releasepilot.ConventionalCommitR\brefactor\x124\n" +
-	"\x04docs\x18\x06 \x03(\v2 .releasepilot.ConventionalCommitR\x04docs\x126\n" +
-	"\x05other\x18\a \x03(\v2 .releasepilot.ConventionalCommitR\x05other\"\x9b\x02\n" +
-	"\x12ConventionalCommit\x12\x12\n" +
-	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x12\n" +
-	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
-	"\x05scope\x18\x03 \x01(\tR\x05scope\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
-	"\x04body\x18\x05 \x01(\tR\x04body\x12\x1a\n" +
-	"\bbreaking\x18\x06 \x01(\bR\bbreaking\x121\n" +
-	"\x14breaking_description\x18\a \x01(\tR\x13breakingDescription\x12\x16\n" +
-	"\x06issues\x18\b \x03(\tR\x06issues\x12\x16\n" +
-	"\x06author\x18\t \x01(\tR\x06author\x12\x12\n" +
-	"\x04date\x18\n" +
-	" \x01(\tR\x04date\"v\n" +
-	"\bArtifact\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
-	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
-	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x1a\n" +
-	"\bchecksum\x18\x05 \x01(\tR\bchecksum\")\n" +
-	"\x0fValidateRequest\x12\x16\n" +
-	"\x06config\x18\x01 \x01(\tR\x06config\"_\n" +
-	"\x10ValidateResponse\x12\x14\n" +
-	"\x05valid\x18\x01 \x01(\bR\x05valid\x125\n" +
-	"\x06errors\x18\x02 \x03(\v2\x1d.releasepilot.ValidationErrorR\x06errors\"U\n" +
-	"\x0fValidationError\x12\x14\n" +
-	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
-	"\x04code\x18\x03 \x01(\tR\x04code*\xc2\x02\n" +
-	"\x04Hook\x12\x14\n" +
-	"\x10HOOK_UNSPECIFIED\x10\x00\x12\x11\n" +
-	"\rHOOK_PRE_INIT\x10\x01\x12\x12\n" +
-	"\x0eHOOK_POST_INIT\x10\x02\x12\x11\n" +
-	"\rHOOK_PRE_PLAN\x10\x03\x12\x12\n" +
-	"\x0eHOOK_POST_PLAN\x10\x04\x12\x14\n" +
-	"\x10HOOK_PRE_VERSION\x10\x05\x12\x15\n" +
-	"\x11HOOK_POST_VERSION\x10\x06\x12\x12\n" +
-	"\x0eHOOK_PRE_NOTES\x10\a\x12\x13\n" +
-	"\x0fHOOK_POST_NOTES\x10\b\x12\x14\n" +
-	"\x10HOOK_PRE_APPROVE\x10\t\x12\x15\n" +
-	"\x11HOOK_POST_APPROVE\x10\n" +
-	"\x12\x14\n" +
-	"\x10HOOK_PRE_PUBLISH\x10\v\x12\x15\n" +
-	"\x11HOOK_POST_PUBLISH\x10\f\x12\x13\n" +
-	"\x0fHOOK_ON_SUCCESS\x10\r\x12\x11\n" +
-	"\rHOOK_ON_ERROR\x10\x0e2\xd5\x01\n" +
-	"\x06Plugin\x128\n" +
-	"\aGetInfo\x12\x13.releasepilot.Empty\x1a\x18.releasepilot.PluginInfo\x12F\n" +
-	"\aExecute\x12\x1c.releasepilot.ExecuteRequest\x1a\x1d.releasepilot.ExecuteResponse\x12I\n" +
-	"\bValidate\x12\x1d.releasepilot.ValidateRequest\x1a\x1e.releasepilot.ValidateResponseB>Z<github.com/felixgeelhaar/release-pilot/internal/plugin/protob\x06proto3"
+func (x *StructuredNotes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StructuredNotes) ProtoMessage() {}
+
+func (x *StructuredNotes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StructuredNotes.ProtoReflect.Descriptor instead.
+func (*StructuredNotes) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StructuredNotes) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *StructuredNotes) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *StructuredNotes) GetHighlights() []string {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+func (x *StructuredNotes) GetSections() []*NotesSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *StructuredNotes) GetMigrationNotes() []*MigrationNote {
+	if x != nil {
+		return x.MigrationNotes
+	}
+	return nil
+}
+
+// NotesSection is a titled list of release notes items.
+type NotesSection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// title is the section title.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// items lists the section entries.
+	Items         []string `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotesSection) Reset() {
+	*x = NotesSection{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotesSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotesSection) ProtoMessage() {}
+
+func (x *NotesSection) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotesSection.ProtoReflect.Descriptor instead.
+func (*NotesSection) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NotesSection) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NotesSection) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// MigrationNote explains a breaking change and how to migrate to it.
+type MigrationNote struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// change describes the breaking change.
+	Change string `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	// migration explains how to migrate.
+	Migration     string `protobuf:"bytes,2,opt,name=migration,proto3" json:"migration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrationNote) Reset() {
+	*x = MigrationNote{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrationNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationNote) ProtoMessage() {}
+
+func (x *MigrationNote) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationNote.ProtoReflect.Descriptor instead.
+func (*MigrationNote) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MigrationNote) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *MigrationNote) GetMigration() string {
+	if x != nil {
+		return x.Migration
+	}
+	return ""
+}
+
+// LocalizedNotes is the changelog and release notes in one locale.
+type LocalizedNotes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// locale is a BCP 47 language tag (e.g., "de", "pt-BR").
+	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	// changelog is the translated changelog content.
+	Changelog string `protobuf:"bytes,2,opt,name=changelog,proto3" json:"changelog,omitempty"`
+	// release_notes is the translated release notes.
+	ReleaseNotes  string `protobuf:"bytes,3,opt,name=release_notes,json=releaseNotes,proto3" json:"release_notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalizedNotes) Reset() {
+	*x = LocalizedNotes{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalizedNotes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalizedNotes) ProtoMessage() {}
+
+func (x *LocalizedNotes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalizedNotes.ProtoReflect.Descriptor instead.
+func (*LocalizedNotes) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LocalizedNotes) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *LocalizedNotes) GetChangelog() string {
+	if x != nil {
+		return x.Changelog
+	}
+	return ""
+}
+
+func (x *LocalizedNotes) GetReleaseNotes() string {
+	if x != nil {
+		return x.ReleaseNotes
+	}
+	return ""
+}
+
+// ExecuteEvent is an event of a streamed plugin execution.
+type ExecuteEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// log is a line of plugin output.
+	Log string `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	// level is the level of the log line (debug, info, warn, error).
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// step is the name of a step the plugin started.
+	Step string `protobuf:"bytes,3,opt,name=step,proto3" json:"step,omitempty"`
+	// progress is the percentage of the work done, from 0 to 100.
+	Progress float64 `protobuf:"fixed64,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	// response is the result of the execution, set on the last event only.
+	Response      *ExecuteResponse `protobuf:"bytes,5,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteEvent) Reset() {
+	*x = ExecuteEvent{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteEvent) ProtoMessage() {}
+
+func (x *ExecuteEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteEvent.ProtoReflect.Descriptor instead.
+func (*ExecuteEvent) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExecuteEvent) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+func (x *ExecuteEvent) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *ExecuteEvent) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *ExecuteEvent) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *ExecuteEvent) GetResponse() *ExecuteResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// GitRepository describes the repository being released.
+type GitRepository struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// root is the repository root directory.
+	Root string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// current_branch is the checked out branch.
+	CurrentBranch string `protobuf:"bytes,2,opt,name=current_branch,json=currentBranch,proto3" json:"current_branch,omitempty"`
+	// default_branch is the default branch (main/master).
+	DefaultBranch string `protobuf:"bytes,3,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`
+	// head_commit is the HEAD commit hash.
+	HeadCommit string `protobuf:"bytes,4,opt,name=head_commit,json=headCommit,proto3" json:"head_commit,omitempty"`
+	// remote_url is the URL of the origin remote.
+	RemoteUrl string `protobuf:"bytes,5,opt,name=remote_url,json=remoteUrl,proto3" json:"remote_url,omitempty"`
+	// is_dirty indicates if the working tree has uncommitted changes.
+	IsDirty       bool `protobuf:"varint,6,opt,name=is_dirty,json=isDirty,proto3" json:"is_dirty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitRepository) Reset() {
+	*x = GitRepository{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitRepository) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitRepository) ProtoMessage() {}
+
+func (x *GitRepository) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitRepository.ProtoReflect.Descriptor instead.
+func (*GitRepository) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GitRepository) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *GitRepository) GetCurrentBranch() string {
+	if x != nil {
+		return x.CurrentBranch
+	}
+	return ""
+}
+
+func (x *GitRepository) GetDefaultBranch() string {
+	if x != nil {
+		return x.DefaultBranch
+	}
+	return ""
+}
+
+func (x *GitRepository) GetHeadCommit() string {
+	if x != nil {
+		return x.HeadCommit
+	}
+	return ""
+}
+
+func (x *GitRepository) GetRemoteUrl() string {
+	if x != nil {
+		return x.RemoteUrl
+	}
+	return ""
+}
+
+func (x *GitRepository) GetIsDirty() bool {
+	if x != nil {
+		return x.IsDirty
+	}
+	return false
+}
+
+// GitCommitsRequest is the request for the commits between two references.
+type GitCommitsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// from is the reference to start after (exclusive).
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the reference to end at (inclusive); HEAD if empty.
+	To            string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitCommitsRequest) Reset() {
+	*x = GitCommitsRequest{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitCommitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitCommitsRequest) ProtoMessage() {}
+
+func (x *GitCommitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitCommitsRequest.ProtoReflect.Descriptor instead.
+func (*GitCommitsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GitCommitsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GitCommitsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// GitCommit is a commit of the repository being released.
+type GitCommit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// hash is the commit SHA.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// subject is the first line of the commit message.
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// body is the rest of the commit message.
+	Body string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// author_name is the commit author's name.
+	AuthorName string `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	// author_email is the commit author's email.
+	AuthorEmail string `protobuf:"bytes,5,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	// date is the commit date (RFC 3339).
+	Date          string `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitCommit) Reset() {
+	*x = GitCommit{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitCommit) ProtoMessage() {}
+
+func (x *GitCommit) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitCommit.ProtoReflect.Descriptor instead.
+func (*GitCommit) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GitCommit) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *GitCommit) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *GitCommit) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *GitCommit) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *GitCommit) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *GitCommit) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// GitCommitsResponse lists commits.
+type GitCommitsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// commits lists the commits, newest first.
+	Commits       []*GitCommit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitCommitsResponse) Reset() {
+	*x = GitCommitsResponse{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitCommitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitCommitsResponse) ProtoMessage() {}
+
+func (x *GitCommitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitCommitsResponse.ProtoReflect.Descriptor instead.
+func (*GitCommitsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GitCommitsResponse) GetCommits() []*GitCommit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+// GitTagsRequest is the request for the version tags of the repository.
+type GitTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// prefix is the tag prefix (e.g., "v").
+	Prefix        string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitTagsRequest) Reset() {
+	*x = GitTagsRequest{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitTagsRequest) ProtoMessage() {}
+
+func (x *GitTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitTagsRequest.ProtoReflect.Descriptor instead.
+func (*GitTagsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GitTagsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+// GitTag is a tag of the repository being released.
+type GitTag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the tag name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// hash is the commit hash the tag points to.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// message is the tag message, for annotated tags.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// date is the tag date (RFC 3339).
+	Date          string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitTag) Reset() {
+	*x = GitTag{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitTag) ProtoMessage() {}
+
+func (x *GitTag) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitTag.ProtoReflect.Descriptor instead.
+func (*GitTag) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GitTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GitTag) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *GitTag) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GitTag) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// GitTagsResponse lists tags.
+type GitTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tags lists the tags.
+	Tags          []*GitTag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitTagsResponse) Reset() {
+	*x = GitTagsResponse{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitTagsResponse) ProtoMessage() {}
+
+func (x *GitTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitTagsResponse.ProtoReflect.Descriptor instead.
+func (*GitTagsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GitTagsResponse) GetTags() []*GitTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// RenderTemplateRequest is the request for rendering a template.
+type RenderTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// template is the Go template text.
+	Template string `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// data is the template data as JSON.
+	Data          string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderTemplateRequest) Reset() {
+	*x = RenderTemplateRequest{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderTemplateRequest) ProtoMessage() {}
+
+func (x *RenderTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderTemplateRequest.ProtoReflect.Descriptor instead.
+func (*RenderTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RenderTemplateRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *RenderTemplateRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+// RenderTemplateResponse is the rendered template.
+type RenderTemplateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// output is the rendered text.
+	Output        string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderTemplateResponse) Reset() {
+	*x = RenderTemplateResponse{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderTemplateResponse) ProtoMessage() {}
+
+func (x *RenderTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderTemplateResponse.ProtoReflect.Descriptor instead.
+func (*RenderTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RenderTemplateResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+// LogRequest is a structured log line of a plugin.
+type LogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// level is the log level (debug, info, warn, error).
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	// message is the log message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// fields are the structured fields of the log line as a JSON object.
+	Fields        string `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogRequest) Reset() {
+	*x = LogRequest{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogRequest) ProtoMessage() {}
+
+func (x *LogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
+func (*LogRequest) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LogRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *LogRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LogRequest) GetFields() string {
+	if x != nil {
+		return x.Fields
+	}
+	return ""
+}
+
+// GetSecretRequest is the request for a secret.
+type GetSecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the secret.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretRequest) Reset() {
+	*x = GetSecretRequest{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretRequest) ProtoMessage() {}
+
+func (x *GetSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretRequest) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetSecretResponse contains a secret.
+type GetSecretResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// value is the secret value.
+	Value         string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretResponse) Reset() {
+	*x = GetSecretResponse{}
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretResponse) ProtoMessage() {}
+
+func (x *GetSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_plugin_proto_plugin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretResponse) Descriptor() ([]byte, []int) {
+	return file_internal_plugin_proto_plugin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetSecretResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_internal_plugin_proto_plugin_proto protoreflect.FileDescriptor
+
+const file_internal_plugin_proto_plugin_proto_rawDesc = "" +
+	"\n" +
+	"\"internal/plugin/proto/plugin.proto\x12\freleasepilot\"\a\n" +
+	"\x05Empty\"\xaf\x01\n" +
+	"\n" +
+	"PluginInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x12\x14\n" +
+	"\x05hooks\x18\x05 \x03(\tR\x05hooks\x12#\n" +
+	"\rconfig_schema\x18\x06 \x01(\tR\fconfigSchema\"\xc7\x01\n" +
+	"\x0eExecuteRequest\x12&\n" +
+	"\x04hook\x18\x01 \x01(\x0e2\x12.releasepilot.HookR\x04hook\x12\x16\n" +
+	"\x06config\x18\x02 \x01(\tR\x06config\x126\n" +
+	"\acontext\x18\x03 \x01(\v2\x1c.releasepilot.ReleaseContextR\acontext\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12$\n" +
+	"\x0ehost_broker_id\x18\x05 \x01(\rR\fhostBrokerId\"\xab\x01\n" +
+	"\x0fExecuteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x18\n" +
+	"\aoutputs\x18\x04 \x01(\tR\aoutputs\x124\n" +
+	"\tartifacts\x18\x05 \x03(\v2\x16.releasepilot.ArtifactR\tartifacts\"\xa1\x06\n" +
+	"\x0eReleaseContext\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12)\n" +
+	"\x10previous_version\x18\x02 \x01(\tR\x0fpreviousVersion\x12\x19\n" +
+	"\btag_name\x18\x03 \x01(\tR\atagName\x12!\n" +
+	"\frelease_type\x18\x04 \x01(\tR\vreleaseType\x12%\n" +
+	"\x0erepository_url\x18\x05 \x01(\tR\rrepositoryUrl\x12)\n" +
+	"\x10repository_owner\x18\x06 \x01(\tR\x0frepositoryOwner\x12'\n" +
+	"\x0frepository_name\x18\a \x01(\tR\x0erepositoryName\x12\x16\n" +
+	"\x06branch\x18\b \x01(\tR\x06branch\x12\x1d\n" +
+	"\n" +
+	"commit_sha\x18\t \x01(\tR\tcommitSha\x12\x1c\n" +
+	"\tchangelog\x18\n" +
+	" \x01(\tR\tchangelog\x12#\n" +
+	"\rrelease_notes\x18\v \x01(\tR\freleaseNotes\x12:\n" +
+	"\achanges\x18\f \x01(\v2 .releasepilot.CategorizedChangesR\achanges\x12O\n" +
+	"\venvironment\x18\r \x03(\v2-.releasepilot.ReleaseContext.EnvironmentEntryR\venvironment\x123\n" +
+	"\x05notes\x18\x0e \x01(\v2\x1d.releasepilot.StructuredNotesR\x05notes\x12E\n" +
+	"\x0flocalized_notes\x18\x0f \x03(\v2\x1c.releasepilot.LocalizedNotesR\x0elocalizedNotes\x12'\n" +
+	"\x0fmigration_guide\x18\x10 \x01(\tR\x0emigrationGuide\x12%\n" +
+	"\x0eplugin_outputs\x18\x11 \x01(\tR\rpluginOutputs\x1a>\n" +
+	"\x10EnvironmentEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb8\x03\n" +
+	"\x12CategorizedChanges\x12<\n" +
+	"\bfeatures\x18\x01 \x03(\v2 .releasepilot.ConventionalCommitR\bfeatures\x126\n" +
+	"\x05fixes\x18\x02 \x03(\v2 .releasepilot.ConventionalCommitR\x05fixes\x12<\n" +
+	"\bbreaking\x18\x03 \x03(\v2 .releasepilot.ConventionalCommitR\bbreaking\x12B\n" +
+	"\vperformance\x18\x04 \x03(\v2 .releasepilot.ConventionalCommitR\vperformance\x12<\n" +
+	"\brefactor\x18\x05 \x03(\v2 .releasepilot.ConventionalCommitR\brefactor\x124\n" +
+	"\x04docs\x18\x06 \x03(\v2 .releasepilot.ConventionalCommitR\x04docs\x126\n" +
+	"\x05other\x18\a \x03(\v2 .releasepilot.ConventionalCommitR\x05other\"\x9b\x02\n" +
+	"\x12ConventionalCommit\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05scope\x18\x03 \x01(\tR\x05scope\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x1a\n" +
+	"\bbreaking\x18\x06 \x01(\bR\bbreaking\x121\n" +
+	"\x14breaking_description\x18\a \x01(\tR\x13breakingDescription\x12\x16\n" +
+	"\x06issues\x18\b \x03(\tR\x06issues\x12\x16\n" +
+	"\x06author\x18\t \x01(\tR\x06author\x12\x12\n" +
+	"\x04date\x18\n" +
+	" \x01(\tR\x04date\"\x88\x01\n" +
+	"\bArtifact\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x1a\n" +
+	"\bchecksum\x18\x05 \x01(\tR\bchecksum\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\")\n" +
+	"\x0fValidateRequest\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\tR\x06config\"_\n" +
+	"\x10ValidateResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x125\n" +
+	"\x06errors\x18\x02 \x03(\v2\x1d.releasepilot.ValidationErrorR\x06errors\"U\n" +
+	"\x0fValidationError\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"\xdf\x01\n" +
+	"\x0fStructuredNotes\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\asummary\x18\x02 \x01(\tR\asummary\x12\x1e\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\tR\n" +
+	"highlights\x126\n" +
+	"\bsections\x18\x04 \x03(\v2\x1a.releasepilot.NotesSectionR\bsections\x12D\n" +
+	"\x0fmigration_notes\x18\x05 \x03(\v2\x1b.releasepilot.MigrationNoteR\x0emigrationNotes\":\n" +
+	"\fNotesSection\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x14\n" +
+	"\x05items\x18\x02 \x03(\tR\x05items\"E\n" +
+	"\rMigrationNote\x12\x16\n" +
+	"\x06change\x18\x01 \x01(\tR\x06change\x12\x1c\n" +
+	"\tmigration\x18\x02 \x01(\tR\tmigration\"k\n" +
+	"\x0eLocalizedNotes\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12\x1c\n" +
+	"\tchangelog\x18\x02 \x01(\tR\tchangelog\x12#\n" +
+	"\rrelease_notes\x18\x03 \x01(\tR\freleaseNotes\"\xa1\x01\n" +
+	"\fExecuteEvent\x12\x10\n" +
+	"\x03log\x18\x01 \x01(\tR\x03log\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level\x12\x12\n" +
+	"\x04step\x18\x03 \x01(\tR\x04step\x12\x1a\n" +
+	"\bprogress\x18\x04 \x01(\x01R\bprogress\x129\n" +
+	"\bresponse\x18\x05 \x01(\v2\x1d.releasepilot.ExecuteResponseR\bresponse\"\xcc\x01\n" +
+	"\rGitRepository\x12\x12\n" +
+	"\x04root\x18\x01 \x01(\tR\x04root\x12%\n" +
+	"\x0ecurrent_branch\x18\x02 \x01(\tR\rcurrentBranch\x12%\n" +
+	"\x0edefault_branch\x18\x03 \x01(\tR\rdefaultBranch\x12\x1f\n" +
+	"\vhead_commit\x18\x04 \x01(\tR\n" +
+	"headCommit\x12\x1d\n" +
+	"\n" +
+	"remote_url\x18\x05 \x01(\tR\tremoteUrl\x12\x19\n" +
+	"\bis_dirty\x18\x06 \x01(\bR\aisDirty\"7\n" +
+	"\x11GitCommitsRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"\xa5\x01\n" +
+	"\tGitCommit\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1f\n" +
+	"\vauthor_name\x18\x04 \x01(\tR\n" +
+	"authorName\x12!\n" +
+	"\fauthor_email\x18\x05 \x01(\tR\vauthorEmail\x12\x12\n" +
+	"\x04date\x18\x06 \x01(\tR\x04date\"G\n" +
+	"\x12GitCommitsResponse\x121\n" +
+	"\acommits\x18\x01 \x03(\v2\x17.releasepilot.GitCommitR\acommits\"(\n" +
+	"\x0eGitTagsRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\"^\n" +
+	"\x06GitTag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\";\n" +
+	"\x0fGitTagsResponse\x12(\n" +
+	"\x04tags\x18\x01 \x03(\v2\x14.releasepilot.GitTagR\x04tags\"G\n" +
+	"\x15RenderTemplateRequest\x12\x1a\n" +
+	"\btemplate\x18\x01 \x01(\tR\btemplate\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\"0\n" +
+	"\x16RenderTemplateResponse\x12\x16\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output\"T\n" +
+	"\n" +
+	"LogRequest\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06fields\x18\x03 \x01(\tR\x06fields\"&\n" +
+	"\x10GetSecretRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\")\n" +
+	"\x11GetSecretResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value*\xd8\x02\n" +
+	"\x04Hook\x12\x14\n" +
+	"\x10HOOK_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rHOOK_PRE_INIT\x10\x01\x12\x12\n" +
+	"\x0eHOOK_POST_INIT\x10\x02\x12\x11\n" +
+	"\rHOOK_PRE_PLAN\x10\x03\x12\x12\n" +
+	"\x0eHOOK_POST_PLAN\x10\x04\x12\x14\n" +
+	"\x10HOOK_PRE_VERSION\x10\x05\x12\x15\n" +
+	"\x11HOOK_POST_VERSION\x10\x06\x12\x12\n" +
+	"\x0eHOOK_PRE_NOTES\x10\a\x12\x13\n" +
+	"\x0fHOOK_POST_NOTES\x10\b\x12\x14\n" +
+	"\x10HOOK_PRE_APPROVE\x10\t\x12\x15\n" +
+	"\x11HOOK_POST_APPROVE\x10\n" +
+	"\x12\x14\n" +
+	"\x10HOOK_PRE_PUBLISH\x10\v\x12\x15\n" +
+	"\x11HOOK_POST_PUBLISH\x10\f\x12\x13\n" +
+	"\x0fHOOK_ON_SUCCESS\x10\r\x12\x11\n" +
+	"\rHOOK_ON_ERROR\x10\x0e\x12\x14\n" +
+	"\x10HOOK_ON_ROLLBACK\x10\x0f2\xa2\x02\n" +
+	"\x06Plugin\x128\n" +
+	"\aGetInfo\x12\x13.releasepilot.Empty\x1a\x18.releasepilot.PluginInfo\x12F\n" +
+	"\aExecute\x12\x1c.releasepilot.ExecuteRequest\x1a\x1d.releasepilot.ExecuteResponse\x12I\n" +
+	"\bValidate\x12\x1d.releasepilot.ValidateRequest\x1a\x1e.releasepilot.ValidateResponse\x12K\n" +
+	"\rExecuteStream\x12\x1c.releasepilot.ExecuteRequest\x1a\x1a.releasepilot.ExecuteEvent0\x012\xc3\x03\n" +
+	"\x04Host\x12A\n" +
+	"\rGitRepository\x12\x13.releasepilot.Empty\x1a\x1b.releasepilot.GitRepository\x12O\n" +
+	"\n" +
+	"GitCommits\x12\x1f.releasepilot.GitCommitsRequest\x1a .releasepilot.GitCommitsResponse\x12F\n" +
+	"\aGitTags\x12\x1c.releasepilot.GitTagsRequest\x1a\x1d.releasepilot.GitTagsResponse\x12[\n" +
+	"\x0eRenderTemplate\x12#.releasepilot.RenderTemplateRequest\x1a$.releasepilot.RenderTemplateResponse\x124\n" +
+	"\x03Log\x12\x18.releasepilot.LogRequest\x1a\x13.releasepilot.Empty\x12L\n" +
+	"\tGetSecret\x12\x1e.releasepilot.GetSecretRequest\x1a\x1f.releasepilot.GetSecretResponseB>Z<github.com/felixgeelhaar/release-pilot/internal/plugin/protob\x06proto3"
 
 var (
 	file_internal_plugin_proto_plugin_proto_rawDescOnce sync.Once
@@ -1132,47 +2320,85 @@ func file_internal_plugin_proto_plugin_proto_rawDescGZIP() []byte {
 }
 
 var file_internal_plugin_proto_plugin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_internal_plugin_proto_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_internal_plugin_proto_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_internal_plugin_proto_plugin_proto_goTypes = []any{
-	(Hook)(0),                  // 0: releasepilot.Hook
-	(*Empty)(nil),              // 1: releasepilot.Empty
-	(*PluginInfo)(nil),         // 2: releasepilot.PluginInfo
-	(*ExecuteRequest)(nil),     // 3: releasepilot.ExecuteRequest
-	(*ExecuteResponse)(nil),    // 4: releasepilot.ExecuteResponse
-	(*ReleaseContext)(nil),     // 5: releasepilot.ReleaseContext
-	(*CategorizedChanges)(nil), // 6: releasepilot.CategorizedChanges
-	(*ConventionalCommit)(nil), // 7: releasepilot.ConventionalCommit
-	(*Artifact)(nil),           // 8: releasepilot.Artifact
-	(*ValidateRequest)(nil),    // 9: releasepilot.ValidateRequest
-	(*ValidateResponse)(nil),   // 10: releasepilot.ValidateResponse
-	(*ValidationError)(nil),    // 11: releasepilot.ValidationError
-	nil,                        // 12: releasepilot.ReleaseContext.EnvironmentEntry
+	(Hook)(0),                      // 0: releasepilot.Hook
+	(*Empty)(nil),                  // 1: releasepilot.Empty
+	(*PluginInfo)(nil),             // 2: releasepilot.PluginInfo
+	(*ExecuteRequest)(nil),         // 3: releasepilot.ExecuteRequest
+	(*ExecuteResponse)(nil),        // 4: releasepilot.ExecuteResponse
+	(*ReleaseContext)(nil),         // 5: releasepilot.ReleaseContext
+	(*CategorizedChanges)(nil),     // 6: releasepilot.CategorizedChanges
+	(*ConventionalCommit)(nil),     // 7: releasepilot.ConventionalCommit
+	(*Artifact)(nil),               // 8: releasepilot.Artifact
+	(*ValidateRequest)(nil),        // 9: releasepilot.ValidateRequest
+	(*ValidateResponse)(nil),       // 10: releasepilot.ValidateResponse
+	(*ValidationError)(nil),        // 11: releasepilot.ValidationError
+	(*StructuredNotes)(nil),        // 12: releasepilot.StructuredNotes
+	(*NotesSection)(nil),           // 13: releasepilot.NotesSection
+	(*MigrationNote)(nil),          // 14: releasepilot.MigrationNote
+	(*LocalizedNotes)(nil),         // 15: releasepilot.LocalizedNotes
+	(*ExecuteEvent)(nil),           // 16: releasepilot.ExecuteEvent
+	(*GitRepository)(nil),          // 17: releasepilot.GitRepository
+	(*GitCommitsRequest)(nil),      // 18: releasepilot.GitCommitsRequest
+	(*GitCommit)(nil),              // 19: releasepilot.GitCommit
+	(*GitCommitsResponse)(nil),     // 20: releasepilot.GitCommitsResponse
+	(*GitTagsRequest)(nil),         // 21: releasepilot.GitTagsRequest
+	(*GitTag)(nil),                 // 22: releasepilot.GitTag
+	(*GitTagsResponse)(nil),        // 23: releasepilot.GitTagsResponse
+	(*RenderTemplateRequest)(nil),  // 24: releasepilot.RenderTemplateRequest
+	(*RenderTemplateResponse)(nil), // 25: releasepilot.RenderTemplateResponse
+	(*LogRequest)(nil),             // 26: releasepilot.LogRequest
+	(*GetSecretRequest)(nil),       // 27: releasepilot.GetSecretRequest
+	(*GetSecretResponse)(nil),      // 28: releasepilot.GetSecretResponse
+	nil,                            // 29: releasepilot.ReleaseContext.EnvironmentEntry
 }
 var file_internal_plugin_proto_plugin_proto_depIdxs = []int32{
 	0,  // 0: releasepilot.ExecuteRequest.hook:type_name -> releasepilot.Hook
 	5,  // 1: releasepilot.ExecuteRequest.context:type_name -> releasepilot.ReleaseContext
 	8,  // 2: releasepilot.ExecuteResponse.artifacts:type_name -> releasepilot.Artifact
 	6,  // 3: releasepilot.ReleaseContext.changes:type_name -> releasepilot.CategorizedChanges
-	12, // 4: releasepilot.ReleaseContext.environment:type_name -> releasepilot.ReleaseContext.EnvironmentEntry
-	7,  // 5: releasepilot.CategorizedChanges.features:type_name -> releasepilot.ConventionalCommit
-	7,  // 6: releasepilot.CategorizedChanges.fixes:type_name -> releasepilot.ConventionalCommit
-	7,  // 7: releasepilot.CategorizedChanges.breaking:type_name -> releasepilot.ConventionalCommit
-	7,  // 8: releasepilot.CategorizedChanges.performance:type_name -> releasepilot.ConventionalCommit
-	7,  // 9: releasepilot.CategorizedChanges.refactor:type_name -> releasepilot.ConventionalCommit
-	7,  // 10: releasepilot.CategorizedChanges.docs:type_name -> releasepilot.ConventionalCommit
-	7,  // 11: releasepilot.CategorizedChanges.other:type_name -> releasepilot.ConventionalCommit
-	11, // 12: releasepilot.ValidateResponse.errors:type_name -> releasepilot.ValidationError
-	1,  // 13: releasepilot.Plugin.GetInfo:input_type -> releasepilot.Empty
-	3,  // 14: releasepilot.Plugin.Execute:input_type -> releasepilot.ExecuteRequest
-	9,  // 15: releasepilot.Plugin.Validate:input_type -> releasepilot.ValidateRequest
-	2,  // 16: releasepilot.Plugin.GetInfo:output_type -> releasepilot.PluginInfo
-	4,  // 17: releasepilot.Plugin.Execute:output_type -> releasepilot.ExecuteResponse
-	10, // 18: releasepilot.Plugin.Validate:output_type -> releasepilot.ValidateResponse
-	16, // [16:19] is the sub-list for method output_type
-	13, // [13:16] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	29, // 4: releasepilot.ReleaseContext.environment:type_name -> releasepilot.ReleaseContext.EnvironmentEntry
+	12, // 5: releasepilot.ReleaseContext.notes:type_name -> releasepilot.StructuredNotes
+	15, // 6: releasepilot.ReleaseContext.localized_notes:type_name -> releasepilot.LocalizedNotes
+	7,  // 7: releasepilot.CategorizedChanges.features:type_name -> releasepilot.ConventionalCommit
+	7,  // 8: releasepilot.CategorizedChanges.fixes:type_name -> releasepilot.ConventionalCommit
+	7,  // 9: releasepilot.CategorizedChanges.breaking:type_name -> releasepilot.ConventionalCommit
+	7,  // 10: releasepilot.CategorizedChanges.performance:type_name -> releasepilot.ConventionalCommit
+	7,  // 11: releasepilot.CategorizedChanges.refactor:type_name -> releasepilot.ConventionalCommit
+	7,  // 12: releasepilot.CategorizedChanges.docs:type_name -> releasepilot.ConventionalCommit
+	7,  // 13: releasepilot.CategorizedChanges.other:type_name -> releasepilot.ConventionalCommit
+	11, // 14: releasepilot.ValidateResponse.errors:type_name -> releasepilot.ValidationError
+	13, // 15: releasepilot.StructuredNotes.sections:type_name -> releasepilot.NotesSection
+	14, // 16: releasepilot.StructuredNotes.migration_notes:type_name -> releasepilot.MigrationNote
+	4,  // 17: releasepilot.ExecuteEvent.response:type_name -> releasepilot.ExecuteResponse
+	19, // 18: releasepilot.GitCommitsResponse.commits:type_name -> releasepilot.GitCommit
+	22, // 19: releasepilot.GitTagsResponse.tags:type_name -> releasepilot.GitTag
+	1,  // 20: releasepilot.Plugin.GetInfo:input_type -> releasepilot.Empty
+	3,  // 21: releasepilot.Plugin.Execute:input_type -> releasepilot.ExecuteRequest
+	9,  // 22: releasepilot.Plugin.Validate:input_type -> releasepilot.ValidateRequest
+	3,  // 23: releasepilot.Plugin.ExecuteStream:input_type -> releasepilot.ExecuteRequest
+	1,  // 24: releasepilot.Host.GitRepository:input_type -> releasepilot.Empty
+	18, // 25: releasepilot.Host.GitCommits:input_type -> releasepilot.GitCommitsRequest
+	21, // 26: releasepilot.Host.GitTags:input_type -> releasepilot.GitTagsRequest
+	24, // 27: releasepilot.Host.RenderTemplate:input_type -> releasepilot.RenderTemplateRequest
+	26, // 28: releasepilot.Host.Log:input_type -> releasepilot.LogRequest
+	27, // 29: releasepilot.Host.GetSecret:input_type -> releasepilot.GetSecretRequest
+	2,  // 30: releasepilot.Plugin.GetInfo:output_type -> releasepilot.PluginInfo
+	4,  // 31: releasepilot.Plugin.Execute:output_type -> releasepilot.ExecuteResponse
+	10, // 32: releasepilot.Plugin.Validate:output_type -> releasepilot.ValidateResponse
+	16, // 33: releasepilot.Plugin.ExecuteStream:output_type -> releasepilot.ExecuteEvent
+	17, // 34: releasepilot.Host.GitRepository:output_type -> releasepilot.GitRepository
+	20, // 35: releasepilot.Host.GitCommits:output_type -> releasepilot.GitCommitsResponse
+	23, // 36: releasepilot.Host.GitTags:output_type -> releasepilot.GitTagsResponse
+	25, // 37: releasepilot.Host.RenderTemplate:output_type -> releasepilot.RenderTemplateResponse
+	1,  // 38: releasepilot.Host.Log:output_type -> releasepilot.Empty
+	28, // 39: releasepilot.Host.GetSecret:output_type -> releasepilot.GetSecretResponse
+	30, // [30:40] is the sub-list for method output_type
+	20, // [20:30] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_internal_plugin_proto_plugin_proto_init() }
@@ -1186,9 +2412,9 @@ func file_internal_plugin_proto_plugin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_plugin_proto_plugin_proto_rawDesc), len(file_internal_plugin_proto_plugin_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   12,
+			NumMessages:   29,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_internal_plugin_proto_plugin_proto_goTypes,
 		DependencyIndexes: file_internal_plugin_proto_plugin_proto_depIdxs,
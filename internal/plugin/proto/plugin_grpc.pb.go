@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Plugin_GetInfo_FullMethodName  = "/releasepilot.Plugin/GetInfo"
-	Plugin_Execute_FullMethodName  = "/releasepilot.Plugin/Execute"
-	Plugin_Validate_FullMethodName = "/releasepilot.Plugin/Validate"
+	Plugin_GetInfo_FullMethodName       = "/releasepilot.Plugin/GetInfo"
+	Plugin_Execute_FullMethodName       = "/releasepilot.Plugin/Execute"
+	Plugin_Validate_FullMethodName      = "/releasepilot.Plugin/Validate"
+	Plugin_ExecuteStream_FullMethodName = "/releasepilot.Plugin/ExecuteStream"
 )
 
 // PluginClient is the client API for Plugin service.
@@ -36,6 +37,11 @@ type PluginClient interface {
 	Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error)
 	// Validate validates the plugin configuration.
 	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	// ExecuteStream runs the plugin for a given hook like Execute, streaming
+	// log lines, steps and progress while it runs. The last event carries the
+	// response. Plugins built before ExecuteStream existed return
+	// Unimplemented, and the host falls back to Execute.
+	ExecuteStream(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExecuteEvent], error)
 }
 
 type pluginClient struct {
@@ -76,6 +82,25 @@ func (c *pluginClient) Validate(ctx context.Context, in *ValidateRequest, opts .
 	return out, nil
 }
 
+func (c *pluginClient) ExecuteStream(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExecuteEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Plugin_ServiceDesc.Streams[0], Plugin_ExecuteStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExecuteRequest, ExecuteEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Plugin_ExecuteStreamClient = grpc.ServerStreamingClient[ExecuteEvent]
+
 // PluginServer is the server API for Plugin service.
 // All implementations must embed UnimplementedPluginServer
 // for forward compatibility.
@@ -88,6 +113,11 @@ type PluginServer interface {
 	Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error)
 	// Validate validates the plugin configuration.
 	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	// ExecuteStream runs the plugin for a given hook like Execute, streaming
+	// log lines, steps and progress while it runs. The last event carries the
+	// response. Plugins built before ExecuteStream existed return
+	// Unimplemented, and the host falls back to Execute.
+	ExecuteStream(*ExecuteRequest, grpc.ServerStreamingServer[ExecuteEvent]) error
 	mustEmbedUnimplementedPluginServer()
 }
 
@@ -107,6 +137,9 @@ func (UnimplementedPluginServer) Execute(context.Context, *ExecuteRequest) (*Exe
 func (UnimplementedPluginServer) Validate(context.Context, *ValidateRequest) (*ValidateResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Validate not implemented")
 }
+func (UnimplementedPluginServer) ExecuteStream(*ExecuteRequest, grpc.ServerStreamingServer[ExecuteEvent]) error {
+	return status.Error(codes.Unimplemented, "method ExecuteStream not implemented")
+}
 func (UnimplementedPluginServer) mustEmbedUnimplementedPluginServer() {}
 func (UnimplementedPluginServer) testEmbeddedByValue()                {}
 
@@ -182,6 +215,17 @@ func _Plugin_Validate_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Plugin_ExecuteStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExecuteRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginServer).ExecuteStream(m, &grpc.GenericServerStream[ExecuteRequest, ExecuteEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Plugin_ExecuteStreamServer = grpc.ServerStreamingServer[ExecuteEvent]
+
 // Plugin_ServiceDesc is the grpc.ServiceDesc for Plugin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -202,6 +246,324 @@ var Plugin_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Plugin_Validate_Handler,
 		},
 	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExecuteStream",
+			Handler:       _Plugin_ExecuteStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "internal/plugin/proto/plugin.proto",
+}
+
+const (
+	Host_GitRepository_FullMethodName  = "/releasepilot.Host/GitRepository"
+	Host_GitCommits_FullMethodName     = "/releasepilot.Host/GitCommits"
+	Host_GitTags_FullMethodName        = "/releasepilot.Host/GitTags"
+	Host_RenderTemplate_FullMethodName = "/releasepilot.Host/RenderTemplate"
+	Host_Log_FullMethodName            = "/releasepilot.Host/Log"
+	Host_GetSecret_FullMethodName      = "/releasepilot.Host/GetSecret"
+)
+
+// HostClient is the client API for Host service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Host is the service the host offers to plugins while they execute, over
+// the go-plugin broker. It saves plugins from running git or reading
+// secrets themselves.
+type HostClient interface {
+	// GitRepository returns information about the repository being released.
+	GitRepository(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GitRepository, error)
+	// GitCommits returns the commits between two references.
+	GitCommits(ctx context.Context, in *GitCommitsRequest, opts ...grpc.CallOption) (*GitCommitsResponse, error)
+	// GitTags returns the version tags with a prefix.
+	GitTags(ctx context.Context, in *GitTagsRequest, opts ...grpc.CallOption) (*GitTagsResponse, error)
+	// RenderTemplate renders a Go template with the host's template functions.
+	RenderTemplate(ctx context.Context, in *RenderTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateResponse, error)
+	// Log writes a structured log line to the host's log.
+	Log(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*Empty, error)
+	// GetSecret returns a secret the plugin is allowed to read.
+	GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error)
+}
+
+type hostClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHostClient(cc grpc.ClientConnInterface) HostClient {
+	return &hostClient{cc}
+}
+
+func (c *hostClient) GitRepository(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GitRepository, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GitRepository)
+	err := c.cc.Invoke(ctx, Host_GitRepository_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostClient) GitCommits(ctx context.Context, in *GitCommitsRequest, opts ...grpc.CallOption) (*GitCommitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GitCommitsResponse)
+	err := c.cc.Invoke(ctx, Host_GitCommits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostClient) GitTags(ctx context.Context, in *GitTagsRequest, opts ...grpc.CallOption) (*GitTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GitTagsResponse)
+	err := c.cc.Invoke(ctx, Host_GitTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostClient) RenderTemplate(ctx context.Context, in *RenderTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderTemplateResponse)
+	err := c.cc.Invoke(ctx, Host_RenderTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostClient) Log(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Host_Log_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostClient) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSecretResponse)
+	err := c.cc.Invoke(ctx, Host_GetSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HostServer is the server API for Host service.
+// All implementations must embed UnimplementedHostServer
+// for forward compatibility.
+//
+// Host is the service the host offers to plugins while they execute, over
+// the go-plugin broker. It saves plugins from running git or reading
+// secrets themselves.
+type HostServer interface {
+	// GitRepository returns information about the repository being released.
+	GitRepository(context.Context, *Empty) (*GitRepository, error)
+	// GitCommits returns the commits between two references.
+	GitCommits(context.Context, *GitCommitsRequest) (*GitCommitsResponse, error)
+	// GitTags returns the version tags with a prefix.
+	GitTags(context.Context, *GitTagsRequest) (*GitTagsResponse, error)
+	// RenderTemplate renders a Go template with the host's template functions.
+	RenderTemplate(context.Context, *RenderTemplateRequest) (*RenderTemplateResponse, error)
+	// Log writes a structured log line to the host's log.
+	Log(context.Context, *LogRequest) (*Empty, error)
+	// GetSecret returns a secret the plugin is allowed to read.
+	GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error)
+	mustEmbedUnimplementedHostServer()
+}
+
+// UnimplementedHostServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHostServer struct{}
+
+func (UnimplementedHostServer) GitRepository(context.Context, *Empty) (*GitRepository, error) {
+	return nil, status.Error(codes.Unimplemented, "method GitRepository not implemented")
+}
+func (UnimplementedHostServer) GitCommits(context.Context, *GitCommitsRequest) (*GitCommitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GitCommits not implemented")
+}
+func (UnimplementedHostServer) GitTags(context.Context, *GitTagsRequest) (*GitTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GitTags not implemented")
+}
+func (UnimplementedHostServer) RenderTemplate(context.Context, *RenderTemplateRequest) (*RenderTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenderTemplate not implemented")
+}
+func (UnimplementedHostServer) Log(context.Context, *LogRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Log not implemented")
+}
+func (UnimplementedHostServer) GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSecret not implemented")
+}
+func (UnimplementedHostServer) mustEmbedUnimplementedHostServer() {}
+func (UnimplementedHostServer) testEmbeddedByValue()              {}
+
+// UnsafeHostServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HostServer will
+// result in compilation errors.
+type UnsafeHostServer interface {
+	mustEmbedUnimplementedHostServer()
+}
+
+func RegisterHostServer(s grpc.ServiceRegistrar, srv HostServer) {
+	// If the following call panics, it indicates UnimplementedHostServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Host_ServiceDesc, srv)
+}
+
+func _Host_GitRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostServer).GitRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Host_GitRepository_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostServer).GitRepository(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Host_GitCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GitCommitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostServer).GitCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Host_GitCommits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostServer).GitCommits(ctx, req.(*GitCommitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Host_GitTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GitTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostServer).GitTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Host_GitTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostServer).GitTags(ctx, req.(*GitTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Host_RenderTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostServer).RenderTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Host_RenderTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostServer).RenderTemplate(ctx, req.(*RenderTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Host_Log_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostServer).Log(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Host_Log_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostServer).Log(ctx, req.(*LogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Host_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostServer).GetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Host_GetSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostServer).GetSecret(ctx, req.(*GetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Host_ServiceDesc is the grpc.ServiceDesc for Host service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Host_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "releasepilot.Host",
+	HandlerType: (*HostServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GitRepository",
+			Handler:    _Host_GitRepository_Handler,
+		},
+		{
+			MethodName: "GitCommits",
+			Handler:    _Host_GitCommits_Handler,
+		},
+		{
+			MethodName: "GitTags",
+			Handler:    _Host_GitTags_Handler,
+		},
+		{
+			MethodName: "RenderTemplate",
+			Handler:    _Host_RenderTemplate_Handler,
+		},
+		{
+			MethodName: "Log",
+			Handler:    _Host_Log_Handler,
+		},
+		{
+			MethodName: "GetSecret",
+			Handler:    _Host_GetSecret_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/plugin/proto/plugin.proto",
 }
@@ -85,9 +85,13 @@ func (s *anthropicService) GenerateChangelog(ctx context.Context, changes *git.C
 		return "", nil
 	}
 
-	changesText := formatChangesForPrompt(changes)
-	userPrompt := buildUserPrompt(s.prompts.changelogUser, changesText, opts)
-	systemPrompt := buildSystemPrompt(s.prompts.changelogSystem, opts)
+	prompts := contextPrompts(ctx, s.prompts)
+	systemPrompt := buildSystemPrompt(prompts.changelogSystem, opts)
+	changesText, err := s.chunker(ctx).changesContent(ctx, changes, systemPrompt, prompts.changelogUser, opts)
+	if err != nil {
+		return "", err
+	}
+	userPrompt := buildUserPrompt(prompts.changelogUser, changesText, opts)
 
 	return s.complete(ctx, systemPrompt, userPrompt)
 }
@@ -98,8 +102,9 @@ func (s *anthropicService) GenerateReleaseNotes(ctx context.Context, changelog s
 		return "", nil
 	}
 
-	userPrompt := buildUserPrompt(s.prompts.releaseNotesUser, changelog, opts)
-	systemPrompt := buildSystemPrompt(s.prompts.releaseNotesSystem, opts)
+	prompts := contextPrompts(ctx, s.prompts)
+	userPrompt := buildUserPrompt(prompts.releaseNotesUser, changelog, opts)
+	systemPrompt := buildSystemPrompt(prompts.releaseNotesSystem, opts)
 
 	return s.complete(ctx, systemPrompt, userPrompt)
 }
@@ -110,8 +115,9 @@ func (s *anthropicService) GenerateMarketingBlurb(ctx context.Context, releaseNo
 		return "", nil
 	}
 
-	userPrompt := buildUserPrompt(s.prompts.marketingUser, releaseNotes, opts)
-	systemPrompt := buildSystemPrompt(s.prompts.marketingSystem, opts)
+	prompts := contextPrompts(ctx, s.prompts)
+	userPrompt := buildUserPrompt(prompts.marketingUser, releaseNotes, opts)
+	systemPrompt := buildSystemPrompt(prompts.marketingSystem, opts)
 
 	return s.complete(ctx, systemPrompt, userPrompt)
 }
@@ -122,13 +128,48 @@ func (s *anthropicService) SummarizeChanges(ctx context.Context, changes *git.Ca
 		return "", nil
 	}
 
-	changesText := formatChangesForPrompt(changes)
-	userPrompt := buildUserPrompt(s.prompts.summaryUser, changesText, opts)
-	systemPrompt := buildSystemPrompt(s.prompts.summarySystem, opts)
+	prompts := contextPrompts(ctx, s.prompts)
+	systemPrompt := buildSystemPrompt(prompts.summarySystem, opts)
+	changesText, err := s.chunker(ctx).changesContent(ctx, changes, systemPrompt, prompts.summaryUser, opts)
+	if err != nil {
+		return "", err
+	}
+	userPrompt := buildUserPrompt(prompts.summaryUser, changesText, opts)
 
 	return s.complete(ctx, systemPrompt, userPrompt)
 }
 
+// GenerateStructuredNotes generates schema-validated release notes using Anthropic.
+func (s *anthropicService) GenerateStructuredNotes(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (*StructuredNotes, error) {
+	return generateStructuredNotes(ctx, s.chunker(ctx), changes, opts)
+}
+
+// Translate translates release notes into opts.Language using Anthropic.
+func (s *anthropicService) Translate(ctx context.Context, text string, opts GenerateOptions) (string, error) {
+	return translate(ctx, s.complete, contextPrompts(ctx, s.prompts), text, opts)
+}
+
+// ClassifyCommit infers the conventional commit type of a commit using Anthropic.
+func (s *anthropicService) ClassifyCommit(ctx context.Context, commit UnclassifiedCommit, opts GenerateOptions) (*CommitClassification, error) {
+	return classifyCommit(ctx, s.complete, contextPrompts(ctx, s.prompts), commit, opts)
+}
+
+// GenerateMigrationGuide writes migration guidance for breaking changes using Anthropic.
+func (s *anthropicService) GenerateMigrationGuide(ctx context.Context, changes []BreakingChange, opts GenerateOptions) ([]MigrationGuideEntry, error) {
+	return generateMigrationGuide(ctx, s.complete, contextPrompts(ctx, s.prompts), changes, opts)
+}
+
+// chunker returns the chunker fitting changes into the model's context
+// window, using the prompts of ctx.
+func (s *anthropicService) chunker(ctx context.Context) chunker {
+	return newChunker(s.config, s.complete, contextPrompts(ctx, s.prompts))
+}
+
+// cacheIdentity identifies the model settings and prompts responses depend on.
+func (s *anthropicService) cacheIdentity() string {
+	return providerIdentity(s.config, s.prompts)
+}
+
 // IsAvailable returns true if the Anthropic service is available.
 func (s *anthropicService) IsAvailable() bool {
 	return s.client != nil && s.config.APIKey != ""
@@ -136,6 +177,10 @@ func (s *anthropicService) IsAvailable() bool {
 
 // complete sends a completion request to Anthropic using Fortify resilience patterns.
 func (s *anthropicService) complete(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+	if capturePrompt(ctx, systemPrompt, userPrompt) {
+		return "", ErrPromptCaptured
+	}
+
 	result, err := s.resilience.Execute(ctx, func(ctx context.Context) (string, error) {
 		resp, err := s.client.CreateMessages(
 			ctx,
@@ -0,0 +1,366 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/errors"
+	"github.com/felixgeelhaar/release-pilot/internal/fileutil"
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+)
+
+// DefaultCacheDir is the default directory of the AI response cache.
+const DefaultCacheDir = ".release-pilot/cache/ai"
+
+// CacheMode controls how calls made with a context use the response cache.
+type CacheMode int
+
+const (
+	// CacheReadWrite returns cached responses and caches new ones.
+	CacheReadWrite CacheMode = iota
+	// CacheRefresh ignores cached responses but caches new ones.
+	CacheRefresh
+	// CacheDisabled neither reads nor writes the cache.
+	CacheDisabled
+)
+
+type cacheModeKey struct{}
+
+// WithCacheMode returns a context whose calls use the cache in the given mode.
+func WithCacheMode(ctx context.Context, mode CacheMode) context.Context {
+	return context.WithValue(ctx, cacheModeKey{}, mode)
+}
+
+// cacheModeFrom returns the cache mode of a context (default: CacheReadWrite).
+func cacheModeFrom(ctx context.Context) CacheMode {
+	mode, _ := ctx.Value(cacheModeKey{}).(CacheMode)
+	return mode
+}
+
+// CacheStats reports cache usage: the lookups of this process and the
+// entries stored on disk.
+type CacheStats struct {
+	Hits    int   `json:"hits"`
+	Misses  int   `json:"misses"`
+	Writes  int   `json:"writes"`
+	Entries int   `json:"entries"`
+	Bytes   int64 `json:"bytes"`
+}
+
+// Cache is a content-addressed store of AI responses on disk. Entries are
+// keyed by a hash of everything that determines a response, so a repeated
+// request returns the same text without calling the provider again.
+type Cache struct {
+	dir string
+
+	mu     sync.Mutex
+	hits   int
+	misses int
+	writes int
+}
+
+// cacheEntry is the file format of a cached response.
+type cacheEntry struct {
+	Provider  string          `json:"provider,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+	Response  json.RawMessage `json:"response"`
+}
+
+// NewCache creates a cache storing responses in dir. Responses contain
+// unreleased changes, so the cache is only readable by the current user.
+func NewCache(dir string) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errors.AIWrap(err, "NewCache", "failed to create cache directory")
+	}
+	return &Cache{dir: dir}, nil
+}
+
+// Dir returns the cache directory.
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+// path returns the file of an entry, sharded by the first key byte.
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.dir, key[:2], key+".json")
+}
+
+// get returns the entry for key, counting a hit or miss.
+func (c *Cache) get(key string) (*cacheEntry, bool) {
+	var entry cacheEntry
+	data, err := os.ReadFile(c.path(key))
+	found := err == nil && json.Unmarshal(data, &entry) == nil
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !found {
+		c.misses++
+		return nil, false
+	}
+	c.hits++
+	return &entry, true
+}
+
+// put stores an entry for key. The file is written atomically through a
+// unique temporary file, so a concurrent reader never sees a partial entry
+// and concurrent writers of the same key do not clobber each other.
+func (c *Cache) put(key string, entry cacheEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	path := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	if err := fileutil.AtomicWriteFile(path, data, 0o600); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.writes++
+	c.mu.Unlock()
+	return nil
+}
+
+// Stats returns the lookups of this process and the size of the cache on disk.
+func (c *Cache) Stats() (CacheStats, error) {
+	c.mu.Lock()
+	stats := CacheStats{Hits: c.hits, Misses: c.misses, Writes: c.writes}
+	c.mu.Unlock()
+
+	err := filepath.WalkDir(c.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(path) != ".json" {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		stats.Entries++
+		stats.Bytes += info.Size()
+		return nil
+	})
+	if err != nil {
+		return stats, errors.AIWrap(err, "Stats", "failed to read cache directory")
+	}
+	return stats, nil
+}
+
+// Clear removes all cached responses.
+func (c *Cache) Clear() error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return errors.AIWrap(err, "Clear", "failed to read cache directory")
+	}
+	for _, e := range entries {
+		if err := os.RemoveAll(filepath.Join(c.dir, e.Name())); err != nil {
+			return errors.AIWrap(err, "Clear", "failed to remove cache entry")
+		}
+	}
+	return nil
+}
+
+// cacheIdentifier is implemented by services whose responses depend on
+// configuration that must be part of the cache key.
+type cacheIdentifier interface {
+	cacheIdentity() string
+}
+
+// providerIdentity identifies a provider's responses by its model settings
+// and prompt templates.
+func providerIdentity(cfg ServiceConfig, prompts promptTemplates) string {
+	h := sha256.New()
+	for _, s := range []string{
+		cfg.Provider, cfg.Model, cfg.BaseURL,
+		strconv.FormatFloat(cfg.Temperature, 'g', -1, 64),
+		strconv.Itoa(cfg.MaxTokens), strconv.Itoa(contextWindow(cfg)),
+		prompts.changelogSystem, prompts.changelogUser,
+		prompts.releaseNotesSystem, prompts.releaseNotesUser,
+		prompts.marketingSystem, prompts.marketingUser,
+		prompts.summarySystem, prompts.summaryUser,
+		prompts.structuredSystem, prompts.structuredUser,
+		prompts.chunkSystem, prompts.chunkUser,
+		prompts.translateSystem, prompts.translateUser,
+		prompts.classifySystem, prompts.classifyUser,
+		prompts.migrationSystem, prompts.migrationUser,
+	} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return cfg.Provider + "/" + cfg.Model + "@" + hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// cachingService implements the AI Service interface by serving repeated
+// requests from a Cache.
+type cachingService struct {
+	next     Service
+	cache    *Cache
+	identity string
+	logger   *slog.Logger
+}
+
+// NewCachingService wraps a service, usually a fallback chain, with a
+// response cache. The cache key covers the providers, their models and
+// prompt templates, the generate options and the input.
+func NewCachingService(svc Service, cache *Cache) Service {
+	identity := fmt.Sprintf("%T", svc)
+	if ci, ok := svc.(cacheIdentifier); ok {
+		identity = ci.cacheIdentity()
+	}
+	return &cachingService{
+		next:     svc,
+		cache:    cache,
+		identity: identity,
+		logger:   slog.Default().With("service", "ai_cache"),
+	}
+}
+
+// GenerateChangelog generates a changelog, or returns the cached one.
+func (s *cachingService) GenerateChangelog(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (string, error) {
+	return cached(ctx, s, "changelog", changes, opts, func() (string, error) {
+		return s.next.GenerateChangelog(ctx, changes, opts)
+	})
+}
+
+// GenerateReleaseNotes generates release notes, or returns the cached ones.
+func (s *cachingService) GenerateReleaseNotes(ctx context.Context, changelog string, opts GenerateOptions) (string, error) {
+	return cached(ctx, s, "release_notes", changelog, opts, func() (string, error) {
+		return s.next.GenerateReleaseNotes(ctx, changelog, opts)
+	})
+}
+
+// GenerateMarketingBlurb generates a marketing blurb, or returns the cached one.
+func (s *cachingService) GenerateMarketingBlurb(ctx context.Context, releaseNotes string, opts GenerateOptions) (string, error) {
+	return cached(ctx, s, "marketing", releaseNotes, opts, func() (string, error) {
+		return s.next.GenerateMarketingBlurb(ctx, releaseNotes, opts)
+	})
+}
+
+// SummarizeChanges summarizes changes, or returns the cached summary.
+func (s *cachingService) SummarizeChanges(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (string, error) {
+	return cached(ctx, s, "summary", changes, opts, func() (string, error) {
+		return s.next.SummarizeChanges(ctx, changes, opts)
+	})
+}
+
+// GenerateStructuredNotes generates structured notes, or returns the cached ones.
+func (s *cachingService) GenerateStructuredNotes(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (*StructuredNotes, error) {
+	return cached(ctx, s, "structured", changes, opts, func() (*StructuredNotes, error) {
+		return s.next.GenerateStructuredNotes(ctx, changes, opts)
+	})
+}
+
+// Translate translates text, or returns the cached translation.
+func (s *cachingService) Translate(ctx context.Context, text string, opts GenerateOptions) (string, error) {
+	return cached(ctx, s, "translate", text, opts, func() (string, error) {
+		return s.next.Translate(ctx, text, opts)
+	})
+}
+
+// ClassifyCommit classifies a commit, or returns the cached classification.
+func (s *cachingService) ClassifyCommit(ctx context.Context, commit UnclassifiedCommit, opts GenerateOptions) (*CommitClassification, error) {
+	return cached(ctx, s, "classify", commit, opts, func() (*CommitClassification, error) {
+		return s.next.ClassifyCommit(ctx, commit, opts)
+	})
+}
+
+// GenerateMigrationGuide generates a migration guide, or returns the cached guide.
+func (s *cachingService) GenerateMigrationGuide(ctx context.Context, changes []BreakingChange, opts GenerateOptions) ([]MigrationGuideEntry, error) {
+	return cached(ctx, s, "migration", changes, opts, func() ([]MigrationGuideEntry, error) {
+		return s.next.GenerateMigrationGuide(ctx, changes, opts)
+	})
+}
+
+// IsAvailable returns true if the wrapped service is available.
+func (s *cachingService) IsAvailable() bool {
+	return s.next.IsAvailable()
+}
+
+// cached returns the cached response for a request, or calls generate and
+// caches its response. A cache hit is recorded in the fallback trace as
+// produced by the provider that originally generated it.
+func cached[T any](ctx context.Context, s *cachingService, operation string, input any, opts GenerateOptions, generate func() (T, error)) (T, error) {
+	mode := cacheModeFrom(ctx)
+	if mode == CacheDisabled || capturing(ctx) {
+		return generate()
+	}
+
+	key, err := s.key(operation, input, opts, promptsFrom(ctx))
+	if err != nil {
+		s.logger.Warn("failed to compute AI cache key", "error", err)
+		return generate()
+	}
+
+	trace, _ := ctx.Value(fallbackTraceKey{}).(*FallbackTrace)
+	if mode == CacheReadWrite {
+		if entry, ok := s.cache.get(key); ok {
+			var result T
+			if err := json.Unmarshal(entry.Response, &result); err == nil {
+				if entry.Provider != "" {
+					trace.record(ProviderAttempt{Provider: entry.Provider})
+				}
+				return result, nil
+			}
+		}
+	}
+
+	result, err := generate()
+	if err != nil {
+		return result, err
+	}
+
+	entry := cacheEntry{CreatedAt: time.Now().UTC()}
+	if trace != nil {
+		entry.Provider = trace.Provider()
+	}
+	if entry.Response, err = json.Marshal(result); err == nil {
+		err = s.cache.put(key, entry)
+	}
+	if err != nil {
+		s.logger.Warn("failed to cache AI response", "operation", operation, "error", err)
+	}
+	return result, nil
+}
+
+// key returns the content address of a request. Prompts are the prompt
+// overrides the request is made with.
+func (s *cachingService) key(operation string, input any, opts GenerateOptions, prompts map[string]Prompt) (string, error) {
+	var ver string
+	if opts.Version != nil {
+		ver = opts.Version.String()
+	}
+	data, err := json.Marshal(struct {
+		Identity     string            `json:"identity"`
+		Operation    string            `json:"operation"`
+		Version      string            `json:"version"`
+		ProductName  string            `json:"product_name"`
+		Tone         Tone              `json:"tone"`
+		Audience     Audience          `json:"audience"`
+		MaxLength    int               `json:"max_length"`
+		IncludeEmoji bool              `json:"include_emoji"`
+		Context      string            `json:"context"`
+		Language     string            `json:"language"`
+		Input        any               `json:"input"`
+		Prompts      map[string]Prompt `json:"prompts,omitempty"`
+	}{
+		s.identity, operation, ver, opts.ProductName, opts.Tone, opts.Audience,
+		opts.MaxLength, opts.IncludeEmoji, opts.Context, opts.Language, input, prompts,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,198 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+)
+
+func newTestCache(t *testing.T) *Cache {
+	t.Helper()
+	cache, err := NewCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewCache() error = %v", err)
+	}
+	return cache
+}
+
+func TestCachingService_ServesRepeatedRequests(t *testing.T) {
+	provider := &stubService{result: "Search is here.", available: true}
+	cache := newTestCache(t)
+	svc := NewCachingService(NewFallbackService(Provider{Name: "openai", Service: provider}), cache)
+	changes := manyChanges(2, "search")
+
+	for i := 0; i < 2; i++ {
+		ctx, trace := WithFallbackTrace(context.Background())
+		got, err := svc.SummarizeChanges(ctx, changes, DefaultGenerateOptions())
+		if err != nil {
+			t.Fatalf("SummarizeChanges() error = %v", err)
+		}
+		if got != "Search is here." {
+			t.Errorf("SummarizeChanges() = %q", got)
+		}
+		if trace.Provider() != "openai" {
+			t.Errorf("run %d: Provider() = %q, want openai", i+1, trace.Provider())
+		}
+	}
+	if provider.calls != 1 {
+		t.Errorf("provider called %d times, want 1", provider.calls)
+	}
+
+	stats, err := cache.Stats()
+	if err != nil {
+		t.Fatalf("Stats() error = %v", err)
+	}
+	if stats.Hits != 1 || stats.Misses != 1 || stats.Writes != 1 || stats.Entries != 1 || stats.Bytes == 0 {
+		t.Errorf("Stats() = %+v", stats)
+	}
+}
+
+func TestCachingService_KeyCoversRequest(t *testing.T) {
+	provider := &stubService{result: "summary", available: true}
+	svc := NewCachingService(NewFallbackService(Provider{Name: "openai", Service: provider}), newTestCache(t))
+	ctx := context.Background()
+
+	friendly := DefaultGenerateOptions()
+	friendly.Tone = ToneFriendly
+
+	_, _ = svc.SummarizeChanges(ctx, manyChanges(2, "search"), DefaultGenerateOptions())
+	_, _ = svc.SummarizeChanges(ctx, manyChanges(3, "search"), DefaultGenerateOptions())
+	_, _ = svc.SummarizeChanges(ctx, manyChanges(2, "search"), friendly)
+	_, _ = svc.GenerateChangelog(ctx, manyChanges(2, "search"), DefaultGenerateOptions())
+	if provider.calls != 4 {
+		t.Errorf("provider called %d times, want 4 (different changes, options and operation)", provider.calls)
+	}
+
+	openai := providerIdentity(ServiceConfig{Provider: "openai", Model: "gpt-4o"}, newDefaultPromptTemplates())
+	custom := newDefaultPromptTemplates()
+	custom.applyCustomPrompts(CustomPrompts{ChangelogUser: "List {{CONTENT}}"})
+	if openai == providerIdentity(ServiceConfig{Provider: "openai", Model: "gpt-4o-mini"}, newDefaultPromptTemplates()) ||
+		openai == providerIdentity(ServiceConfig{Provider: "openai", Model: "gpt-4o"}, custom) {
+		t.Error("provider identity should change with the model and prompts")
+	}
+}
+
+func TestCachingService_Modes(t *testing.T) {
+	provider := &stubService{result: "first", available: true}
+	svc := NewCachingService(NewFallbackService(Provider{Name: "openai", Service: provider}), newTestCache(t))
+	changes := manyChanges(2, "search")
+	opts := DefaultGenerateOptions()
+
+	_, _ = svc.SummarizeChanges(context.Background(), changes, opts)
+
+	provider.result = "second"
+	got, _ := svc.SummarizeChanges(WithCacheMode(context.Background(), CacheDisabled), changes, opts)
+	if got != "second" {
+		t.Errorf("disabled cache returned %q, want a fresh response", got)
+	}
+	if got, _ := svc.SummarizeChanges(context.Background(), changes, opts); got != "first" {
+		t.Errorf("a disabled cache should not be written, got %q", got)
+	}
+
+	if got, _ := svc.SummarizeChanges(WithCacheMode(context.Background(), CacheRefresh), changes, opts); got != "second" {
+		t.Errorf("refresh returned %q, want a fresh response", got)
+	}
+	if got, _ := svc.SummarizeChanges(context.Background(), changes, opts); got != "second" {
+		t.Errorf("refresh should replace the cached response, got %q", got)
+	}
+}
+
+func TestCachingService_DoesNotCacheErrors(t *testing.T) {
+	failing := &stubService{err: ErrProviderUnavailable, available: true}
+	cache := newTestCache(t)
+	svc := NewCachingService(failing, cache)
+
+	for i := 0; i < 2; i++ {
+		if _, err := svc.GenerateStructuredNotes(context.Background(), &git.CategorizedChanges{}, DefaultGenerateOptions()); err == nil {
+			t.Fatal("GenerateStructuredNotes() should fail")
+		}
+	}
+	if failing.calls != 2 {
+		t.Errorf("provider called %d times, want 2", failing.calls)
+	}
+	if stats, _ := cache.Stats(); stats.Entries != 0 {
+		t.Errorf("Entries = %d, want 0", stats.Entries)
+	}
+}
+
+func TestCache_Clear(t *testing.T) {
+	cache := newTestCache(t)
+	svc := NewCachingService(&stubService{result: "summary", available: true}, cache)
+	_, _ = svc.SummarizeChanges(context.Background(), manyChanges(2, "search"), DefaultGenerateOptions())
+
+	if err := cache.Clear(); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+	if stats, _ := cache.Stats(); stats.Entries != 0 {
+		t.Errorf("Entries = %d after Clear(), want 0", stats.Entries)
+	}
+}
+
+func TestCache_PrivateFiles(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not enforced on Windows")
+	}
+	dir := filepath.Join(t.TempDir(), "cache", "ai")
+	cache, err := NewCache(dir)
+	if err != nil {
+		t.Fatalf("NewCache() error = %v", err)
+	}
+
+	key := strings.Repeat("ab", 32)
+	if err := cache.put(key, cacheEntry{CreatedAt: time.Now(), Response: json.RawMessage(`"summary"`)}); err != nil {
+		t.Fatalf("put() error = %v", err)
+	}
+
+	for path, want := range map[string]os.FileMode{
+		dir:                           0o700,
+		filepath.Dir(cache.path(key)): 0o700,
+		cache.path(key):               0o600,
+	} {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatalf("Stat(%s) error = %v", path, err)
+		}
+		if got := info.Mode().Perm(); got != want {
+			t.Errorf("mode of %s = %o, want %o", path, got, want)
+		}
+	}
+}
+
+func TestCache_ConcurrentWritesOfSameKey(t *testing.T) {
+	cache := newTestCache(t)
+	key := strings.Repeat("cd", 32)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- cache.put(key, cacheEntry{CreatedAt: time.Now(), Response: json.RawMessage(`"summary"`)})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("put() error = %v", err)
+		}
+	}
+
+	if entry, ok := cache.get(key); !ok || string(entry.Response) != `"summary"` {
+		t.Errorf("get() = %+v, %v, want the cached summary", entry, ok)
+	}
+	files, _ := os.ReadDir(filepath.Dir(cache.path(key)))
+	if len(files) != 1 {
+		t.Errorf("cache shard holds %d files, want only the entry", len(files))
+	}
+}
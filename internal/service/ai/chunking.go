@@ -0,0 +1,248 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/felixgeelhaar/release-pilot/internal/errors"
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+)
+
+// Limits for fitting large changesets into a provider's context window.
+const (
+	// minChangesBudget is the smallest number of prompt tokens given to the
+	// changes, even if the context window is nearly used up otherwise.
+	minChangesBudget = 512
+	// maxReduceRounds bounds how often partial summaries are condensed again.
+	maxReduceRounds = 3
+)
+
+// estimateTokens estimates the number of tokens text uses with the
+// provider's tokenizer. It deliberately errs on the high side.
+func estimateTokens(provider, text string) int {
+	charsPerToken := 4.0
+	switch provider {
+	case "anthropic", "claude", "ollama":
+		charsPerToken = 3.5
+	}
+	return int(math.Ceil(float64(utf8.RuneCountInString(text)) / charsPerToken))
+}
+
+// contextWindow returns the context window of the configured model in
+// tokens, unless it is configured explicitly.
+func contextWindow(cfg ServiceConfig) int {
+	if cfg.ContextWindow > 0 {
+		return cfg.ContextWindow
+	}
+
+	switch cfg.Provider {
+	case "anthropic", "claude":
+		return 200000
+	case "ollama":
+		return 4096 // Ollama's default num_ctx, whatever the model supports
+	}
+
+	model := strings.ToLower(cfg.Model)
+	switch {
+	case strings.HasPrefix(model, "gpt-4-32k"):
+		return 32768
+	case model == "gpt-4" || strings.HasPrefix(model, "gpt-4-0"):
+		return 8192
+	case strings.HasPrefix(model, "gpt-3.5"):
+		return 16385
+	default:
+		return 128000
+	}
+}
+
+// chunker fits categorized changes into a provider's context window. When
+// the changes are too large for one prompt, they are split into chunks by
+// category and scope, each chunk is condensed by the model (map), and the
+// condensed parts replace the raw changes in the final prompt (reduce).
+// Every request goes through the provider's complete function, and with it
+// through its Resilience wrapper.
+type chunker struct {
+	complete      completeFunc
+	prompts       promptTemplates
+	provider      string
+	contextWindow int
+	maxTokens     int // Reserved for the model's response
+}
+
+// newChunker creates a chunker for a provider's configuration.
+func newChunker(cfg ServiceConfig, complete completeFunc, prompts promptTemplates) chunker {
+	return chunker{
+		complete:      complete,
+		prompts:       prompts,
+		provider:      cfg.Provider,
+		contextWindow: contextWindow(cfg),
+		maxTokens:     cfg.MaxTokens,
+	}
+}
+
+// budget returns the number of tokens left for the changes in a prompt
+// built from the given system prompt and user prompt template.
+func (c chunker) budget(systemPrompt, userTemplate string) int {
+	used := c.maxTokens + estimateTokens(c.provider, systemPrompt+userTemplate)
+	budget := (c.contextWindow - used) * 9 / 10 // leave room for estimation error
+	return max(budget, minChangesBudget)
+}
+
+// changesContent returns the changes formatted for a prompt built from the
+// given system prompt and user prompt template. Changes that do not fit
+// are condensed chunk by chunk first.
+func (c chunker) changesContent(ctx context.Context, changes *git.CategorizedChanges, systemPrompt, userTemplate string, opts GenerateOptions) (string, error) {
+	content := formatChangesForPrompt(changes)
+	budget := c.budget(systemPrompt, userTemplate)
+	if estimateTokens(c.provider, content) <= budget {
+		return content, nil
+	}
+
+	chunks := c.chunkChanges(changes, c.budget(c.prompts.chunkSystem, c.prompts.chunkUser))
+	parts := make([]string, 0, len(chunks))
+	for i, chunk := range chunks {
+		part, err := c.condense(ctx, formatChangesForPrompt(chunk), i+1, len(chunks), opts)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, part)
+	}
+
+	reduceBudget := c.budget(c.prompts.chunkSystem, c.prompts.chunkUser)
+	for round := 0; estimateTokens(c.provider, joinParts(parts)) > budget; round++ {
+		if round == maxReduceRounds || len(parts) == 1 {
+			return "", errors.AI("changesContent",
+				"changes do not fit into the context window of "+strconv.Itoa(c.contextWindow)+" tokens")
+		}
+		batches := c.batchParts(parts, reduceBudget)
+		reduced := make([]string, 0, len(batches))
+		for i, batch := range batches {
+			part, err := c.condense(ctx, joinParts(batch), i+1, len(batches), opts)
+			if err != nil {
+				return "", err
+			}
+			reduced = append(reduced, part)
+		}
+		parts = reduced
+	}
+
+	return "The " + strconv.Itoa(changes.TotalCount()) + " changes of this release were condensed in " +
+		strconv.Itoa(len(parts)) + " part(s):\n\n" + joinParts(parts), nil
+}
+
+// condense asks the model to condense one part of the changes.
+func (c chunker) condense(ctx context.Context, content string, part, parts int, opts GenerateOptions) (string, error) {
+	userTemplate := strings.ReplaceAll(c.prompts.chunkUser, PartPlaceholder, strconv.Itoa(part)+" of "+strconv.Itoa(parts))
+	return c.complete(ctx, buildSystemPrompt(c.prompts.chunkSystem, opts), buildUserPrompt(userTemplate, content, opts))
+}
+
+// chunkChanges splits the changes into chunks that fit the budget. Commits
+// of the same category and scope stay in one chunk where possible.
+func (c chunker) chunkChanges(changes *git.CategorizedChanges, budget int) []*git.CategorizedChanges {
+	var (
+		chunks  []*git.CategorizedChanges
+		current []git.ConventionalCommit
+		used    int
+	)
+	flush := func() {
+		if len(current) > 0 {
+			chunks = append(chunks, git.CategorizeCommits(current))
+			current, used = nil, 0
+		}
+	}
+
+	for _, group := range groupChanges(changes) {
+		cost := c.cost(group)
+		if used+cost > budget {
+			flush()
+		}
+		if cost <= budget {
+			current = append(current, group...)
+			used += cost
+			continue
+		}
+		// The group alone is too large: split it by commit
+		for _, commit := range group {
+			cost := c.cost([]git.ConventionalCommit{commit})
+			if used+cost > budget {
+				flush()
+			}
+			current = append(current, commit)
+			used += cost
+		}
+	}
+	flush()
+
+	return chunks
+}
+
+// cost estimates the prompt tokens of a set of commits.
+func (c chunker) cost(commits []git.ConventionalCommit) int {
+	return estimateTokens(c.provider, formatChangesForPrompt(git.CategorizeCommits(commits)))
+}
+
+// batchParts groups partial summaries into batches that fit the budget.
+func (c chunker) batchParts(parts []string, budget int) [][]string {
+	var (
+		batches [][]string
+		current []string
+	)
+	for _, part := range parts {
+		if len(current) > 0 && estimateTokens(c.provider, joinParts(append(current, part))) > budget {
+			batches = append(batches, current)
+			current = nil
+		}
+		current = append(current, part)
+	}
+	return append(batches, current)
+}
+
+// groupChanges groups commits by category and scope, in the order in which
+// formatChangesForPrompt lists the categories.
+func groupChanges(changes *git.CategorizedChanges) [][]git.ConventionalCommit {
+	categories := []git.CommitType{git.CommitTypeFeat, git.CommitTypeFix, git.CommitTypePerf, git.CommitTypeDocs, git.CommitTypeRefactor}
+
+	// Breaking changes come first, then the categories above, then the rest
+	categoryOf := func(commit git.ConventionalCommit) int {
+		if commit.Breaking {
+			return 0
+		}
+		for i, t := range categories {
+			if commit.Type == t {
+				return i + 1
+			}
+		}
+		return len(categories) + 1
+	}
+
+	type groupKey struct {
+		category int
+		scope    string
+	}
+	var keys []groupKey
+	byKey := make(map[groupKey][]git.ConventionalCommit)
+	for _, commit := range changes.All {
+		key := groupKey{categoryOf(commit), commit.Scope}
+		if _, ok := byKey[key]; !ok {
+			keys = append(keys, key)
+		}
+		byKey[key] = append(byKey[key], commit)
+	}
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].category < keys[j].category })
+
+	groups := make([][]git.ConventionalCommit, 0, len(keys))
+	for _, key := range keys {
+		groups = append(groups, byKey[key])
+	}
+	return groups
+}
+
+// joinParts joins partial summaries for a prompt.
+func joinParts(parts []string) string {
+	return strings.Join(parts, "\n\n---\n\n")
+}
@@ -0,0 +1,161 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+)
+
+// manyChanges returns n feature commits spread over the given scopes and
+// one breaking change.
+func manyChanges(n int, scopes ...string) *git.CategorizedChanges {
+	var commits []git.ConventionalCommit
+	for i := 0; i < n; i++ {
+		commits = append(commits, git.ConventionalCommit{
+			Type:        git.CommitTypeFeat,
+			Scope:       scopes[i%len(scopes)],
+			Description: fmt.Sprintf("add capability number %d to the %s module", i, scopes[i%len(scopes)]),
+		})
+	}
+	commits = append(commits, git.ConventionalCommit{
+		Type:        git.CommitTypeFix,
+		Scope:       "api",
+		Description: "drop the v1 endpoints",
+		Breaking:    true,
+	})
+	return git.CategorizeCommits(commits)
+}
+
+func TestEstimateTokens(t *testing.T) {
+	text := strings.Repeat("a", 700)
+	if got := estimateTokens("openai", text); got != 175 {
+		t.Errorf("estimateTokens(openai) = %d, want 175", got)
+	}
+	if got := estimateTokens("anthropic", text); got != 200 {
+		t.Errorf("estimateTokens(anthropic) = %d, want 200", got)
+	}
+}
+
+func TestContextWindow(t *testing.T) {
+	tests := []struct {
+		cfg  ServiceConfig
+		want int
+	}{
+		{ServiceConfig{Provider: "openai", Model: "gpt-4"}, 8192},
+		{ServiceConfig{Provider: "openai", Model: "gpt-4o"}, 128000},
+		{ServiceConfig{Provider: "openai", Model: "gpt-3.5-turbo"}, 16385},
+		{ServiceConfig{Provider: "anthropic", Model: DefaultAnthropicModel}, 200000},
+		{ServiceConfig{Provider: "ollama", Model: "llama3.2"}, 4096},
+		{ServiceConfig{Provider: "ollama", Model: "llama3.2", ContextWindow: 32768}, 32768},
+	}
+	for _, tt := range tests {
+		if got := contextWindow(tt.cfg); got != tt.want {
+			t.Errorf("contextWindow(%s/%s) = %d, want %d", tt.cfg.Provider, tt.cfg.Model, got, tt.want)
+		}
+	}
+}
+
+func TestChunker_SmallChangesetIsNotChunked(t *testing.T) {
+	calls := 0
+	complete := func(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+		calls++
+		return "", nil
+	}
+	changes := manyChanges(3, "ui")
+
+	c := newChunker(ServiceConfig{Provider: "openai", Model: "gpt-4"}, complete, newDefaultPromptTemplates())
+	got, err := c.changesContent(context.Background(), changes, "system", "{{CONTENT}}", DefaultGenerateOptions())
+	if err != nil {
+		t.Fatalf("changesContent() error = %v", err)
+	}
+	if got != formatChangesForPrompt(changes) || calls != 0 {
+		t.Errorf("changesContent() = %q after %d calls, want the formatted changes without calls", got, calls)
+	}
+}
+
+func TestChunker_MapReduce(t *testing.T) {
+	var chunks []string
+	complete := func(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+		if !strings.Contains(systemPrompt, "Never drop a breaking change") {
+			t.Error("chunks should be condensed with the chunk prompt")
+		}
+		chunks = append(chunks, userPrompt)
+		return fmt.Sprintf("- condensed part %d", len(chunks)), nil
+	}
+	changes := manyChanges(300, "ui", "cli", "storage")
+
+	c := newChunker(ServiceConfig{Provider: "ollama", MaxTokens: 1024}, complete, newDefaultPromptTemplates())
+	got, err := c.changesContent(context.Background(), changes, "system", "{{CONTENT}}", DefaultGenerateOptions())
+	if err != nil {
+		t.Fatalf("changesContent() error = %v", err)
+	}
+
+	if len(chunks) < 2 {
+		t.Fatalf("made %d calls, want the changes split into several chunks", len(chunks))
+	}
+	if !strings.Contains(chunks[0], "part 1 of ") || !strings.Contains(chunks[0], "drop the v1 endpoints") {
+		t.Errorf("first chunk should hold the breaking change:\n%s", chunks[0])
+	}
+	for i, chunk := range chunks {
+		if tokens := estimateTokens("ollama", chunk); tokens > c.contextWindow-c.maxTokens {
+			t.Errorf("chunk %d uses %d tokens, more than the context window allows", i+1, tokens)
+		}
+	}
+	if !strings.Contains(got, "The 301 changes of this release were condensed in") ||
+		!strings.Contains(got, "- condensed part 1") ||
+		!strings.Contains(got, fmt.Sprintf("- condensed part %d", len(chunks))) {
+		t.Errorf("changesContent() = %q, want the condensed parts", got)
+	}
+}
+
+func TestChunker_ReducesPartsThatDoNotFit(t *testing.T) {
+	calls := 0
+	complete := func(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+		calls++
+		if !strings.Contains(userPrompt, "capability") {
+			return "- merged", nil // a reduce call over condensed parts
+		}
+		return strings.Repeat("- still long ", 400), nil
+	}
+	changes := manyChanges(300, "ui", "cli", "storage")
+
+	c := newChunker(ServiceConfig{Provider: "ollama", MaxTokens: 1024}, complete, newDefaultPromptTemplates())
+	got, err := c.changesContent(context.Background(), changes, "system", "{{CONTENT}}", DefaultGenerateOptions())
+	if err != nil {
+		t.Fatalf("changesContent() error = %v", err)
+	}
+	if !strings.Contains(got, "- merged") || strings.Contains(got, "still long") {
+		t.Errorf("changesContent() = %q, want the merged parts", got)
+	}
+	if calls < 4 {
+		t.Errorf("made %d calls, want map calls followed by reduce calls", calls)
+	}
+}
+
+func TestGroupChanges(t *testing.T) {
+	changes := git.CategorizeCommits([]git.ConventionalCommit{
+		{Type: git.CommitTypeFix, Scope: "ui", Description: "fix ui"},
+		{Type: git.CommitTypeFeat, Scope: "ui", Description: "ui feature"},
+		{Type: git.CommitTypeFeat, Scope: "cli", Description: "cli feature"},
+		{Type: git.CommitTypeFeat, Scope: "ui", Description: "another ui feature"},
+		{Type: git.CommitTypeFeat, Scope: "api", Description: "remove api", Breaking: true},
+	})
+
+	var got []string
+	for _, group := range groupChanges(changes) {
+		var descriptions []string
+		for _, c := range group {
+			descriptions = append(descriptions, c.Description)
+		}
+		got = append(got, strings.Join(descriptions, ","))
+	}
+
+	want := []string{"remove api", "ui feature,another ui feature", "cli feature", "fix ui"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("groupChanges() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,103 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"strings"
+
+	"github.com/felixgeelhaar/release-pilot/internal/domain/changes"
+	"github.com/felixgeelhaar/release-pilot/internal/errors"
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+)
+
+// ErrInvalidClassification is returned when the model keeps producing an
+// invalid commit classification after all repair attempts.
+var ErrInvalidClassification = stderrors.New("AI output is not a valid commit classification")
+
+// UnclassifiedCommit is a commit that does not follow the Conventional
+// Commits format.
+type UnclassifiedCommit struct {
+	Message string   `json:"message"`
+	Files   []string `json:"files,omitempty"`
+}
+
+// CommitClassification is the conventional commit type, scope and
+// breaking-ness the model infers for an UnclassifiedCommit.
+type CommitClassification struct {
+	Type     git.CommitType `json:"type"`
+	Scope    string         `json:"scope,omitempty"`
+	Breaking bool           `json:"breaking"`
+}
+
+// ParseCommitClassification decodes a model response. Markdown code fences
+// around the JSON are tolerated. The returned problems are suitable for a
+// repair prompt.
+func ParseCommitClassification(raw string) (*CommitClassification, []string) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(stripCodeFence(raw))))
+	dec.DisallowUnknownFields()
+
+	var c CommitClassification
+	if err := dec.Decode(&c); err != nil {
+		return nil, []string{"response is not a valid JSON object of the schema: " + err.Error()}
+	}
+	if dec.More() {
+		return nil, []string{"response must contain exactly one JSON object"}
+	}
+
+	commitType, ok := changes.ParseCommitType(string(c.Type))
+	if !ok {
+		return nil, []string{fmt.Sprintf("type %q is not a conventional commit type", c.Type)}
+	}
+	c.Type = commitType
+	c.Scope = strings.TrimSpace(c.Scope)
+	return &c, nil
+}
+
+// classifyCommit asks the model to classify a commit and sends a repair
+// prompt while the output is invalid. This is shared across all AI service
+// implementations.
+func classifyCommit(ctx context.Context, complete completeFunc, prompts promptTemplates, commit UnclassifiedCommit, opts GenerateOptions) (*CommitClassification, error) {
+	if strings.TrimSpace(commit.Message) == "" {
+		return nil, errors.AI("ClassifyCommit", "commit message is required")
+	}
+
+	var content strings.Builder
+	content.WriteString("Message:\n")
+	content.WriteString(strings.TrimSpace(commit.Message))
+	if len(commit.Files) > 0 {
+		content.WriteString("\n\nChanged files:\n")
+		for _, f := range commit.Files {
+			content.WriteString("- ")
+			content.WriteString(f)
+			content.WriteByte('\n')
+		}
+	}
+
+	systemPrompt := prompts.classifySystem
+	userPrompt := buildUserPrompt(prompts.classifyUser, strings.TrimSpace(content.String()), opts)
+
+	prompt := userPrompt
+	var problems []string
+	for attempt := 0; attempt <= maxStructuredRepairs; attempt++ {
+		raw, err := complete(ctx, systemPrompt, prompt)
+		if err != nil {
+			return nil, err
+		}
+
+		var c *CommitClassification
+		c, problems = ParseCommitClassification(raw)
+		if c != nil {
+			return c, nil
+		}
+		prompt = buildRepairPrompt(userPrompt, raw, problems)
+	}
+
+	return nil, errors.AIWrap(
+		fmt.Errorf("%w: %s", ErrInvalidClassification, strings.Join(problems, "; ")),
+		"ClassifyCommit",
+		fmt.Sprintf("invalid output after %d repair attempts", maxStructuredRepairs))
+}
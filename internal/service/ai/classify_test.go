@@ -0,0 +1,98 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+)
+
+func TestParseCommitClassification(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         string
+		want        *CommitClassification
+		wantProblem string
+	}{
+		{
+			name: "valid",
+			raw:  `{"type": "feat", "scope": " cli ", "breaking": true}`,
+			want: &CommitClassification{Type: git.CommitTypeFeat, Scope: "cli", Breaking: true},
+		},
+		{
+			name: "code fence and uppercase type",
+			raw:  "```json\n{\"type\": \"FIX\", \"breaking\": false}\n```",
+			want: &CommitClassification{Type: git.CommitTypeFix},
+		},
+		{name: "unknown type", raw: `{"type": "feature", "breaking": false}`, wantProblem: `type "feature" is not a conventional commit type`},
+		{name: "unknown field", raw: `{"type": "fix", "confidence": 0.9}`, wantProblem: "not a valid JSON object"},
+		{name: "not json", raw: "This is a fix.", wantProblem: "not a valid JSON object"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, problems := ParseCommitClassification(tt.raw)
+			if tt.want != nil {
+				if got == nil || *got != *tt.want {
+					t.Errorf("ParseCommitClassification() = %+v, %v, want %+v", got, problems, tt.want)
+				}
+				return
+			}
+			if got != nil || len(problems) == 0 || !strings.Contains(problems[0], tt.wantProblem) {
+				t.Errorf("ParseCommitClassification() = %+v, %v, want problem %q", got, problems, tt.wantProblem)
+			}
+		})
+	}
+}
+
+func TestClassifyCommit(t *testing.T) {
+	var prompts []string
+	responses := []string{`{"type": "feature"}`, `{"type": "feat", "scope": "gitlab", "breaking": false}`}
+	complete := func(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+		prompts = append(prompts, userPrompt)
+		r := responses[0]
+		responses = responses[1:]
+		return r, nil
+	}
+
+	commit := UnclassifiedCommit{Message: "Add GitLab support", Files: []string{"internal/gitlab/client.go"}}
+	got, err := classifyCommit(context.Background(), complete, newDefaultPromptTemplates(), commit, DefaultGenerateOptions())
+	if err != nil {
+		t.Fatalf("classifyCommit() error = %v", err)
+	}
+	if got.Type != git.CommitTypeFeat || got.Scope != "gitlab" {
+		t.Errorf("classifyCommit() = %+v", got)
+	}
+	if len(prompts) != 2 {
+		t.Fatalf("sent %d prompts, want a repair prompt after the invalid response", len(prompts))
+	}
+	if !strings.Contains(prompts[0], "Add GitLab support") || !strings.Contains(prompts[0], "- internal/gitlab/client.go") {
+		t.Errorf("prompt does not include the commit: %q", prompts[0])
+	}
+	if !strings.Contains(prompts[1], `type "feature" is not a conventional commit type`) {
+		t.Errorf("repair prompt does not list the problem: %q", prompts[1])
+	}
+}
+
+func TestClassifyCommit_GivesUp(t *testing.T) {
+	calls := 0
+	complete := func(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+		calls++
+		return "not json", nil
+	}
+
+	_, err := classifyCommit(context.Background(), complete, newDefaultPromptTemplates(), UnclassifiedCommit{Message: "Stuff"}, DefaultGenerateOptions())
+	if !stderrors.Is(err, ErrInvalidClassification) {
+		t.Errorf("classifyCommit() error = %v, want ErrInvalidClassification", err)
+	}
+	if calls != maxStructuredRepairs+1 {
+		t.Errorf("calls = %d, want %d", calls, maxStructuredRepairs+1)
+	}
+
+	if _, err := classifyCommit(context.Background(), complete, newDefaultPromptTemplates(), UnclassifiedCommit{}, DefaultGenerateOptions()); err == nil {
+		t.Error("classifyCommit() with an empty message should fail")
+	}
+}
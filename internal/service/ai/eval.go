@@ -0,0 +1,387 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/felixgeelhaar/release-pilot/internal/errors"
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+	"github.com/felixgeelhaar/release-pilot/internal/service/version"
+)
+
+// DefaultEvalDir is the default directory of evaluation fixtures.
+const DefaultEvalDir = ".release-pilot/eval"
+
+// goldenSuffix is the file suffix of a fixture's golden output.
+const goldenSuffix = ".golden.md"
+
+// Default thresholds of the evaluation checks.
+const (
+	DefaultMinCoverage   = 0.8
+	DefaultMinSimilarity = 0.5
+)
+
+// DefaultForbiddenPhrases are phrases release notes must never contain,
+// whatever the fixture. They give away that the model ignored the task.
+var DefaultForbiddenPhrases = []string{
+	"as an ai",
+	"language model",
+	"i cannot",
+	"i'm sorry",
+	"lorem ipsum",
+}
+
+// EvalFixture is a recorded changeset and what notes generated from it are
+// expected to look like. Fixtures are JSON files in the evaluation
+// directory; the golden output of <name>.json is <name>.golden.md.
+type EvalFixture struct {
+	// Name identifies the fixture (default: the file name).
+	Name string `json:"name,omitempty"`
+	// Version is the version of the release, e.g. "2.0.0".
+	Version string `json:"version,omitempty"`
+	// Tone and Audience override the configured ones.
+	Tone     Tone     `json:"tone,omitempty"`
+	Audience Audience `json:"audience,omitempty"`
+	// Commits is the recorded changeset.
+	Commits []EvalCommit `json:"commits"`
+	// Expect tunes the checks of this fixture.
+	Expect EvalExpectations `json:"expect,omitempty"`
+
+	// Golden is the golden output, or empty if the fixture has none yet.
+	Golden string `json:"-"`
+	// GoldenPath is where the golden output is read from and written to.
+	GoldenPath string `json:"-"`
+}
+
+// EvalCommit is a commit of a fixture's changeset.
+type EvalCommit struct {
+	Hash                string `json:"hash,omitempty"`
+	Type                string `json:"type"`
+	Scope               string `json:"scope,omitempty"`
+	Description         string `json:"description"`
+	Body                string `json:"body,omitempty"`
+	Breaking            bool   `json:"breaking,omitempty"`
+	BreakingDescription string `json:"breaking_description,omitempty"`
+}
+
+// EvalExpectations are the thresholds a fixture's output is held to.
+type EvalExpectations struct {
+	// MinLength and MaxLength bound the output length in characters
+	// (0 = unbounded).
+	MinLength int `json:"min_length,omitempty"`
+	MaxLength int `json:"max_length,omitempty"`
+	// MinCoverage is the share of commits the output must mention
+	// (default: DefaultMinCoverage).
+	MinCoverage float64 `json:"min_coverage,omitempty"`
+	// MinSimilarity is the word overlap with the golden output required
+	// (default: DefaultMinSimilarity).
+	MinSimilarity float64 `json:"min_similarity,omitempty"`
+	// Forbidden are phrases the output must not contain, in addition to
+	// DefaultForbiddenPhrases.
+	Forbidden []string `json:"forbidden,omitempty"`
+}
+
+// EvalCheck is the outcome of one check of a fixture's output.
+type EvalCheck struct {
+	Name   string  `json:"name"`
+	Score  float64 `json:"score"`
+	Passed bool    `json:"passed"`
+	Detail string  `json:"detail,omitempty"`
+}
+
+// EvalResult is the evaluation of one fixture.
+type EvalResult struct {
+	Fixture string      `json:"fixture"`
+	Output  string      `json:"output"`
+	Checks  []EvalCheck `json:"checks"`
+	// Score is the mean score of the checks, from 0 to 1.
+	Score  float64 `json:"score"`
+	Passed bool    `json:"passed"`
+}
+
+// LoadEvalFixtures loads the fixtures of a directory and their golden
+// outputs, ordered by file name.
+func LoadEvalFixtures(dir string) ([]*EvalFixture, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.IOWrap(err, "LoadEvalFixtures", "failed to read evaluation fixtures")
+	}
+
+	var fixtures []*EvalFixture
+	for _, entry := range entries {
+		base, ok := strings.CutSuffix(entry.Name(), ".json")
+		if entry.IsDir() || !ok {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.IOWrap(err, "LoadEvalFixtures", "failed to read fixture "+path)
+		}
+		var f EvalFixture
+		if err := json.Unmarshal(data, &f); err != nil {
+			return nil, errors.ConfigWrap(err, "LoadEvalFixtures", "invalid fixture "+path)
+		}
+		if len(f.Commits) == 0 {
+			return nil, errors.Config("LoadEvalFixtures", "fixture "+path+" has no commits")
+		}
+		if f.Name == "" {
+			f.Name = base
+		}
+
+		f.GoldenPath = filepath.Join(dir, base+goldenSuffix)
+		if golden, err := os.ReadFile(f.GoldenPath); err == nil {
+			f.Golden = string(golden)
+		} else if !os.IsNotExist(err) {
+			return nil, errors.IOWrap(err, "LoadEvalFixtures", "failed to read golden output "+f.GoldenPath)
+		}
+		fixtures = append(fixtures, &f)
+	}
+
+	if len(fixtures) == 0 {
+		return nil, errors.Config("LoadEvalFixtures", "no evaluation fixtures (*.json) in "+dir)
+	}
+	sort.Slice(fixtures, func(i, j int) bool { return fixtures[i].GoldenPath < fixtures[j].GoldenPath })
+	return fixtures, nil
+}
+
+// Changes returns the fixture's changeset as categorized changes.
+func (f *EvalFixture) Changes() *git.CategorizedChanges {
+	commits := make([]git.ConventionalCommit, 0, len(f.Commits))
+	for _, c := range f.Commits {
+		commits = append(commits, git.ConventionalCommit{
+			Commit:              git.Commit{Hash: c.Hash, Subject: c.Description, Body: c.Body},
+			Type:                git.CommitType(c.Type),
+			Scope:               c.Scope,
+			Description:         c.Description,
+			Body:                c.Body,
+			Breaking:            c.Breaking,
+			BreakingDescription: c.BreakingDescription,
+			IsConventional:      true,
+		})
+	}
+	return git.CategorizeCommits(commits)
+}
+
+// WriteGolden records output as the fixture's golden output.
+func (f *EvalFixture) WriteGolden(output string) error {
+	if err := os.WriteFile(f.GoldenPath, []byte(strings.TrimSpace(output)+"\n"), 0o644); err != nil {
+		return errors.IOWrap(err, "WriteGolden", "failed to write golden output "+f.GoldenPath)
+	}
+	f.Golden = output
+	return nil
+}
+
+// RunEval generates notes for a fixture the way 'notes --ai' does, a
+// summary or structured notes, and scores them. opts are the configured
+// generation options; the fixture's version, tone and audience override them.
+func RunEval(ctx context.Context, svc Service, f *EvalFixture, opts GenerateOptions, structured bool) (*EvalResult, error) {
+	if f.Version != "" {
+		v, err := version.Parse(f.Version)
+		if err != nil {
+			return nil, errors.ConfigWrap(err, "RunEval", "invalid version in fixture "+f.Name)
+		}
+		opts.Version = v
+		opts.Context = "The release version is " + f.Version + "."
+	}
+	if f.Tone != "" {
+		opts.Tone = f.Tone
+	}
+	if f.Audience != "" {
+		opts.Audience = f.Audience
+	}
+
+	var output string
+	if structured {
+		notes, err := svc.GenerateStructuredNotes(ctx, f.Changes(), opts)
+		if err != nil {
+			return nil, err
+		}
+		output = structuredNotesText(notes)
+	} else {
+		summary, err := svc.SummarizeChanges(ctx, f.Changes(), opts)
+		if err != nil {
+			return nil, err
+		}
+		output = summary
+	}
+	return ScoreEval(f, output), nil
+}
+
+// ScoreEval scores output against a fixture: coverage of its commits,
+// length, forbidden phrases, mention of breaking changes and, if the
+// fixture has one, similarity to the golden output.
+func ScoreEval(f *EvalFixture, output string) *EvalResult {
+	result := &EvalResult{Fixture: f.Name, Output: output}
+	words := stemmedWords(output)
+	lower := strings.ToLower(output)
+
+	// Coverage: the share of commits whose description the output mentions
+	minCoverage := f.Expect.MinCoverage
+	if minCoverage == 0 {
+		minCoverage = DefaultMinCoverage
+	}
+	var missing []string
+	for _, c := range f.Commits {
+		if !mentionsCommit(words, c) {
+			missing = append(missing, c.Description)
+		}
+	}
+	coverage := float64(len(f.Commits)-len(missing)) / float64(len(f.Commits))
+	check := EvalCheck{Name: "coverage", Score: coverage, Passed: coverage >= minCoverage,
+		Detail: fmt.Sprintf("%d of %d commits mentioned", len(f.Commits)-len(missing), len(f.Commits))}
+	if len(missing) > 0 {
+		check.Detail += "; missing: " + strings.Join(missing, "; ")
+	}
+	result.Checks = append(result.Checks, check)
+
+	// Length
+	length := utf8.RuneCountInString(strings.TrimSpace(output))
+	lengthOK := length > 0 && length >= f.Expect.MinLength && (f.Expect.MaxLength == 0 || length <= f.Expect.MaxLength)
+	result.Checks = append(result.Checks, EvalCheck{Name: "length", Score: boolScore(lengthOK), Passed: lengthOK,
+		Detail: fmt.Sprintf("%d characters", length)})
+
+	// Forbidden phrases
+	var found []string
+	for _, phrase := range append(append([]string(nil), DefaultForbiddenPhrases...), f.Expect.Forbidden...) {
+		if phrase != "" && strings.Contains(lower, strings.ToLower(phrase)) {
+			found = append(found, phrase)
+		}
+	}
+	check = EvalCheck{Name: "forbidden", Score: boolScore(len(found) == 0), Passed: len(found) == 0}
+	if len(found) > 0 {
+		check.Detail = "contains " + strings.Join(found, ", ")
+	}
+	result.Checks = append(result.Checks, check)
+
+	// Breaking changes must be called out as such
+	if f.Changes().HasBreakingChanges() {
+		mentioned := strings.Contains(lower, "breaking")
+		check = EvalCheck{Name: "breaking", Score: boolScore(mentioned), Passed: mentioned}
+		if !mentioned {
+			check.Detail = "breaking changes are not mentioned"
+		}
+		result.Checks = append(result.Checks, check)
+	}
+
+	// Golden output
+	if f.Golden != "" {
+		minSimilarity := f.Expect.MinSimilarity
+		if minSimilarity == 0 {
+			minSimilarity = DefaultMinSimilarity
+		}
+		similarity := wordSimilarity(words, stemmedWords(f.Golden))
+		result.Checks = append(result.Checks, EvalCheck{Name: "golden", Score: similarity, Passed: similarity >= minSimilarity,
+			Detail: fmt.Sprintf("%.0f%% word overlap", similarity*100)})
+	}
+
+	result.Passed = true
+	for _, c := range result.Checks {
+		result.Score += c.Score
+		result.Passed = result.Passed && c.Passed
+	}
+	result.Score = math.Round(result.Score/float64(len(result.Checks))*100) / 100
+	return result
+}
+
+// evalStopWords are words too common to show that a commit is mentioned.
+var evalStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "from": true,
+	"into": true, "when": true, "that": true, "this": true, "add": true,
+	"fix": true, "use": true, "make": true, "update": true, "remove": true,
+}
+
+// mentionsCommit reports whether at least half of the significant words of
+// a commit's description are among the output's words.
+func mentionsCommit(words map[string]bool, c EvalCommit) bool {
+	var significant, matched int
+	for _, w := range lowerWords(c.Description) {
+		if len(w) < 3 || evalStopWords[w] {
+			continue
+		}
+		significant++
+		if words[stem(w)] {
+			matched++
+		}
+	}
+	return significant == 0 || matched*2 >= significant
+}
+
+// stemmedWords returns the set of stemmed words of s.
+func stemmedWords(s string) map[string]bool {
+	words := make(map[string]bool)
+	for _, w := range lowerWords(s) {
+		words[stem(w)] = true
+	}
+	return words
+}
+
+// lowerWords splits s into lowercase words.
+func lowerWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// stem cuts a word to five letters, so that e.g. "paginate" and
+// "pagination" match.
+func stem(w string) string {
+	if r := []rune(w); len(r) > 5 {
+		return string(r[:5])
+	}
+	return w
+}
+
+// wordSimilarity returns the Dice coefficient of two word sets.
+func wordSimilarity(a, b map[string]bool) float64 {
+	if len(a)+len(b) == 0 {
+		return 1
+	}
+	common := 0
+	for w := range a {
+		if b[w] {
+			common++
+		}
+	}
+	return float64(2*common) / float64(len(a)+len(b))
+}
+
+// boolScore scores a pass/fail check.
+func boolScore(ok bool) float64 {
+	if ok {
+		return 1
+	}
+	return 0
+}
+
+// structuredNotesText renders structured notes as Markdown for scoring.
+func structuredNotesText(n *StructuredNotes) string {
+	var b strings.Builder
+	b.WriteString("## " + n.Title + "\n\n" + n.Summary + "\n")
+	for _, h := range n.Highlights {
+		b.WriteString("\n- " + h)
+	}
+	for _, s := range n.Sections {
+		b.WriteString("\n\n### " + s.Title + "\n")
+		for _, item := range s.Items {
+			b.WriteString("\n- " + item)
+		}
+	}
+	if len(n.BreakingChanges) > 0 {
+		b.WriteString("\n\n### Breaking Changes\n")
+		for _, m := range n.BreakingChanges {
+			b.WriteString("\n- " + m.Change + ": " + m.Migration)
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
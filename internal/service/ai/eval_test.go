@@ -0,0 +1,170 @@
+package ai
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testEvalFixture() *EvalFixture {
+	return &EvalFixture{
+		Name:    "release",
+		Version: "2.0.0",
+		Commits: []EvalCommit{
+			{Type: "feat", Scope: "api", Description: "add cursor pagination to list endpoints"},
+			{Type: "fix", Description: "retry uploads after connection resets"},
+			{Type: "feat", Description: "drop the legacy token endpoint", Breaking: true},
+		},
+	}
+}
+
+func TestScoreEval(t *testing.T) {
+	tests := []struct {
+		name       string
+		output     string
+		expect     EvalExpectations
+		golden     string
+		wantPassed bool
+		wantFailed []string
+	}{
+		{
+			name:       "covers every commit",
+			output:     "Lists now support cursor pagination. Uploads are retried after connection resets. Breaking: the legacy token endpoint was dropped.",
+			wantPassed: true,
+		},
+		{
+			name:       "missing commits",
+			output:     "Lists now support cursor pagination. Breaking changes included.",
+			wantFailed: []string{"coverage"},
+		},
+		{
+			name:       "breaking change not called out",
+			output:     "Cursor pagination for list endpoints, retried uploads after connection resets, and the legacy token endpoint was dropped.",
+			wantFailed: []string{"breaking"},
+		},
+		{
+			name:       "forbidden phrase",
+			output:     "As an AI language model, I added cursor pagination, retried uploads after connection resets and dropped the legacy token endpoint (breaking).",
+			expect:     EvalExpectations{Forbidden: []string{"revolutionary"}},
+			wantFailed: []string{"forbidden"},
+		},
+		{
+			name:       "too long",
+			output:     "Cursor pagination for list endpoints, uploads retried after connection resets, breaking: legacy token endpoint dropped.",
+			expect:     EvalExpectations{MaxLength: 50},
+			wantFailed: []string{"length"},
+		},
+		{
+			name:       "drifted from golden",
+			output:     "Cursor pagination for list endpoints, uploads retried after connection resets, breaking: legacy token endpoint dropped.",
+			golden:     "A completely different text about unrelated themes and subjects entirely, nothing shared whatsoever.",
+			wantFailed: []string{"golden"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := testEvalFixture()
+			f.Expect = tt.expect
+			f.Golden = tt.golden
+
+			result := ScoreEval(f, tt.output)
+			if result.Passed != tt.wantPassed {
+				t.Errorf("Passed = %v, want %v (checks %+v)", result.Passed, tt.wantPassed, result.Checks)
+			}
+			var failed []string
+			for _, c := range result.Checks {
+				if !c.Passed {
+					failed = append(failed, c.Name)
+				}
+			}
+			if strings.Join(failed, ",") != strings.Join(tt.wantFailed, ",") {
+				t.Errorf("failed checks = %v, want %v", failed, tt.wantFailed)
+			}
+			if result.Score < 0 || result.Score > 1 {
+				t.Errorf("Score = %v, want between 0 and 1", result.Score)
+			}
+		})
+	}
+}
+
+func TestRunEval_FakeProviderMatchesGolden(t *testing.T) {
+	dir := t.TempDir()
+	fixture := `{"version": "2.0.0", "commits": [
+		{"type": "feat", "scope": "api", "description": "add cursor pagination"},
+		{"type": "fix", "description": "drop the legacy token endpoint", "breaking": true}
+	]}`
+	if err := os.WriteFile(filepath.Join(dir, "breaking.json"), []byte(fixture), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.txt"), []byte("not a fixture"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fixtures, err := LoadEvalFixtures(dir)
+	if err != nil {
+		t.Fatalf("LoadEvalFixtures() error = %v", err)
+	}
+	if len(fixtures) != 1 || fixtures[0].Name != "breaking" || fixtures[0].Golden != "" {
+		t.Fatalf("fixtures = %+v, want one fixture named breaking without golden output", fixtures)
+	}
+
+	for _, structured := range []bool{false, true} {
+		result, err := RunEval(context.Background(), NewFakeService(), fixtures[0], DefaultGenerateOptions(), structured)
+		if err != nil {
+			t.Fatalf("RunEval(structured=%v) error = %v", structured, err)
+		}
+		if !result.Passed {
+			t.Errorf("RunEval(structured=%v) failed: %+v", structured, result.Checks)
+		}
+	}
+
+	// The fake provider is deterministic, so its output matches its golden output
+	result, err := RunEval(context.Background(), NewFakeService(), fixtures[0], DefaultGenerateOptions(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fixtures[0].WriteGolden(result.Output); err != nil {
+		t.Fatalf("WriteGolden() error = %v", err)
+	}
+	fixtures, err = LoadEvalFixtures(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err = RunEval(context.Background(), NewFakeService(), fixtures[0], DefaultGenerateOptions(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden := result.Checks[len(result.Checks)-1]
+	if golden.Name != "golden" || golden.Score != 1 {
+		t.Errorf("golden check = %+v, want a full match", golden)
+	}
+}
+
+func TestLoadEvalFixtures_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{"no fixtures", map[string]string{"notes.md": "x"}, "no evaluation fixtures"},
+		{"invalid JSON", map[string]string{"bad.json": "{"}, "invalid fixture"},
+		{"no commits", map[string]string{"empty.json": `{"commits": []}`}, "has no commits"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			_, err := LoadEvalFixtures(dir)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadEvalFixtures() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+)
+
+// FakeProviderName is the provider name of the fake service.
+const FakeProviderName = "fake"
+
+// fakeService is a deterministic, offline Service that writes its output
+// from the changes it is given. It lets the evaluation harness run in CI
+// without network access or API keys.
+type fakeService struct{}
+
+// NewFakeService creates a fake AI service.
+func NewFakeService() Service {
+	return &fakeService{}
+}
+
+// GenerateChangelog lists the commits by category.
+func (s *fakeService) GenerateChangelog(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (string, error) {
+	var b strings.Builder
+	for _, section := range []struct {
+		title   string
+		commits []git.ConventionalCommit
+	}{
+		{"Breaking Changes", changes.Breaking},
+		{"Features", changes.Features},
+		{"Bug Fixes", changes.Fixes},
+		{"Performance", changes.Performance},
+		{"Documentation", changes.Documentation},
+		{"Refactoring", changes.Refactoring},
+		{"Other", changes.Other},
+	} {
+		if len(section.commits) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "### %s\n\n", section.title)
+		for _, c := range section.commits {
+			b.WriteString("- " + fakeCommitLine(c) + "\n")
+		}
+		b.WriteString("\n")
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
+// GenerateReleaseNotes returns the changelog under a heading.
+func (s *fakeService) GenerateReleaseNotes(ctx context.Context, changelog string, opts GenerateOptions) (string, error) {
+	return "## " + fakeTitle(opts) + "\n\n" + changelog, nil
+}
+
+// GenerateMarketingBlurb returns the first line of the release notes.
+func (s *fakeService) GenerateMarketingBlurb(ctx context.Context, releaseNotes string, opts GenerateOptions) (string, error) {
+	first, _, _ := strings.Cut(strings.TrimSpace(releaseNotes), "\n")
+	return strings.TrimLeft(first, "# "), nil
+}
+
+// SummarizeChanges summarizes the changes with one sentence per commit,
+// mentioning breaking changes first.
+func (s *fakeService) SummarizeChanges(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (string, error) {
+	if !changes.HasChanges() {
+		return "This release contains no notable changes.", nil
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "This release contains %d change(s).", len(changes.All))
+	if n := len(changes.Breaking); n > 0 {
+		fmt.Fprintf(&b, " It includes %d breaking change(s) that require migration.", n)
+	}
+	for _, c := range changes.All {
+		b.WriteString(" " + fakeSentence(fakeCommitLine(c)))
+	}
+	return b.String(), nil
+}
+
+// GenerateStructuredNotes returns one section per commit category.
+func (s *fakeService) GenerateStructuredNotes(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (*StructuredNotes, error) {
+	summary, _ := s.SummarizeChanges(ctx, changes, opts)
+	notes := &StructuredNotes{Title: fakeTitle(opts), Summary: summary}
+	for _, section := range []struct {
+		title   string
+		commits []git.ConventionalCommit
+	}{
+		{"Features", changes.Features},
+		{"Bug Fixes", changes.Fixes},
+		{"Performance", changes.Performance},
+		{"Other", changes.Other},
+	} {
+		if len(section.commits) == 0 {
+			continue
+		}
+		items := make([]string, 0, len(section.commits))
+		for _, c := range section.commits {
+			items = append(items, fakeCommitLine(c))
+		}
+		notes.Sections = append(notes.Sections, StructuredSection{Title: section.title, Items: items})
+	}
+	for _, c := range changes.Breaking {
+		notes.BreakingChanges = append(notes.BreakingChanges, MigrationNote{
+			Change:    fakeCommitLine(c),
+			Migration: "See the commit for migration steps.",
+		})
+	}
+	return notes, nil
+}
+
+// Translate tags the text with the target language instead of translating it.
+func (s *fakeService) Translate(ctx context.Context, text string, opts GenerateOptions) (string, error) {
+	return "[" + opts.Language + "] " + text, nil
+}
+
+// ClassifyCommit classifies every commit as a chore.
+func (s *fakeService) ClassifyCommit(ctx context.Context, commit UnclassifiedCommit, opts GenerateOptions) (*CommitClassification, error) {
+	return &CommitClassification{Type: "chore"}, nil
+}
+
+// GenerateMigrationGuide returns a generic entry per breaking change.
+func (s *fakeService) GenerateMigrationGuide(ctx context.Context, changes []BreakingChange, opts GenerateOptions) ([]MigrationGuideEntry, error) {
+	entries := make([]MigrationGuideEntry, 0, len(changes))
+	for _, c := range changes {
+		change := c.Description
+		if change == "" {
+			change = c.Subject
+		}
+		entries = append(entries, MigrationGuideEntry{
+			Hash:      c.Hash,
+			Change:    change,
+			Migration: "Update callers to the new behavior.",
+		})
+	}
+	return entries, nil
+}
+
+// IsAvailable returns true; the fake service needs no configuration.
+func (s *fakeService) IsAvailable() bool {
+	return true
+}
+
+// cacheIdentity implements cacheIdentifier.
+func (s *fakeService) cacheIdentity() string {
+	return FakeProviderName
+}
+
+// fakeTitle returns a release title for opts.
+func fakeTitle(opts GenerateOptions) string {
+	if opts.Version != nil {
+		return "Release " + opts.Version.String()
+	}
+	return "Release"
+}
+
+// fakeCommitLine describes a commit, e.g. "api: add pagination".
+func fakeCommitLine(c git.ConventionalCommit) string {
+	line := c.Description
+	if line == "" {
+		line = c.Commit.Subject
+	}
+	if c.Scope != "" {
+		line = c.Scope + ": " + line
+	}
+	if c.Breaking && c.BreakingDescription != "" {
+		line += " (breaking: " + c.BreakingDescription + ")"
+	}
+	return line
+}
+
+// fakeSentence capitalizes s and ends it with a period.
+func fakeSentence(s string) string {
+	if s == "" {
+		return s
+	}
+	s = strings.ToUpper(s[:1]) + s[1:]
+	if !strings.HasSuffix(s, ".") {
+		s += "."
+	}
+	return s
+}
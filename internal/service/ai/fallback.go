@@ -0,0 +1,233 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/internal/errors"
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+)
+
+// ErrProviderUnavailable is recorded for providers skipped because they are
+// not configured (e.g., a missing API key).
+var ErrProviderUnavailable = stderrors.New("provider not configured")
+
+// Provider is a named AI service in a fallback chain.
+type Provider struct {
+	Name    string
+	Service Service
+}
+
+// ProviderAttempt records the outcome of calling one provider of a fallback chain.
+type ProviderAttempt struct {
+	Provider string
+	// Err is nil if the provider produced the result.
+	Err      error
+	Duration time.Duration
+}
+
+// FallbackTrace collects the provider attempts made by calls sharing a context.
+type FallbackTrace struct {
+	mu       sync.Mutex
+	attempts []ProviderAttempt
+}
+
+type fallbackTraceKey struct{}
+
+// WithFallbackTrace returns a context that records the provider attempts of
+// fallback chain calls made with it.
+func WithFallbackTrace(ctx context.Context) (context.Context, *FallbackTrace) {
+	trace := &FallbackTrace{}
+	return context.WithValue(ctx, fallbackTraceKey{}, trace), trace
+}
+
+// Attempts returns a copy of the recorded attempts, in call order.
+func (t *FallbackTrace) Attempts() []ProviderAttempt {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]ProviderAttempt(nil), t.attempts...)
+}
+
+// Provider returns the provider that produced the most recent result, or an
+// empty string if no provider succeeded.
+func (t *FallbackTrace) Provider() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i := len(t.attempts) - 1; i >= 0; i-- {
+		if t.attempts[i].Err == nil {
+			return t.attempts[i].Provider
+		}
+	}
+	return ""
+}
+
+// Fallbacks returns the failed attempts, i.e. the fallback decisions taken.
+func (t *FallbackTrace) Fallbacks() []ProviderAttempt {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var failed []ProviderAttempt
+	for _, a := range t.attempts {
+		if a.Err != nil {
+			failed = append(failed, a)
+		}
+	}
+	return failed
+}
+
+func (t *FallbackTrace) record(attempt ProviderAttempt) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.attempts = append(t.attempts, attempt)
+}
+
+// fallbackService implements the AI Service interface by trying an ordered
+// list of providers per call until one succeeds.
+type fallbackService struct {
+	providers []Provider
+	logger    *slog.Logger
+}
+
+// NewFallbackService creates a service that fails over between providers in
+// order. Every call starts with the first provider, so a provider whose
+// circuit breaker has opened is skipped quickly and picked up again once it
+// recovers.
+func NewFallbackService(providers ...Provider) Service {
+	return &fallbackService{
+		providers: providers,
+		logger:    slog.Default().With("service", "ai_fallback"),
+	}
+}
+
+// GenerateChangelog generates a changelog using the first provider that succeeds.
+func (s *fallbackService) GenerateChangelog(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (string, error) {
+	return s.try(ctx, func(svc Service) (string, error) {
+		return svc.GenerateChangelog(ctx, changes, opts)
+	})
+}
+
+// GenerateReleaseNotes generates release notes using the first provider that succeeds.
+func (s *fallbackService) GenerateReleaseNotes(ctx context.Context, changelog string, opts GenerateOptions) (string, error) {
+	return s.try(ctx, func(svc Service) (string, error) {
+		return svc.GenerateReleaseNotes(ctx, changelog, opts)
+	})
+}
+
+// GenerateMarketingBlurb generates a marketing blurb using the first provider that succeeds.
+func (s *fallbackService) GenerateMarketingBlurb(ctx context.Context, releaseNotes string, opts GenerateOptions) (string, error) {
+	return s.try(ctx, func(svc Service) (string, error) {
+		return svc.GenerateMarketingBlurb(ctx, releaseNotes, opts)
+	})
+}
+
+// SummarizeChanges summarizes changes using the first provider that succeeds.
+func (s *fallbackService) SummarizeChanges(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (string, error) {
+	return s.try(ctx, func(svc Service) (string, error) {
+		return svc.SummarizeChanges(ctx, changes, opts)
+	})
+}
+
+// GenerateStructuredNotes generates structured notes using the first provider
+// that returns valid output.
+func (s *fallbackService) GenerateStructuredNotes(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (*StructuredNotes, error) {
+	return tryProviders(ctx, s, func(svc Service) (*StructuredNotes, error) {
+		return svc.GenerateStructuredNotes(ctx, changes, opts)
+	})
+}
+
+// Translate translates text using the first provider that succeeds.
+func (s *fallbackService) Translate(ctx context.Context, text string, opts GenerateOptions) (string, error) {
+	return s.try(ctx, func(svc Service) (string, error) {
+		return svc.Translate(ctx, text, opts)
+	})
+}
+
+// ClassifyCommit classifies a commit using the first provider that succeeds.
+func (s *fallbackService) ClassifyCommit(ctx context.Context, commit UnclassifiedCommit, opts GenerateOptions) (*CommitClassification, error) {
+	return tryProviders(ctx, s, func(svc Service) (*CommitClassification, error) {
+		return svc.ClassifyCommit(ctx, commit, opts)
+	})
+}
+
+// GenerateMigrationGuide generates a migration guide using the first provider that succeeds.
+func (s *fallbackService) GenerateMigrationGuide(ctx context.Context, changes []BreakingChange, opts GenerateOptions) ([]MigrationGuideEntry, error) {
+	return tryProviders(ctx, s, func(svc Service) ([]MigrationGuideEntry, error) {
+		return svc.GenerateMigrationGuide(ctx, changes, opts)
+	})
+}
+
+// IsAvailable returns true if any provider is available.
+func (s *fallbackService) IsAvailable() bool {
+	for _, p := range s.providers {
+		if p.Service != nil && p.Service.IsAvailable() {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheIdentity identifies the providers of the chain, in order.
+func (s *fallbackService) cacheIdentity() string {
+	ids := make([]string, 0, len(s.providers))
+	for _, p := range s.providers {
+		id := p.Name
+		if ci, ok := p.Service.(cacheIdentifier); ok {
+			id = ci.cacheIdentity()
+		}
+		ids = append(ids, id)
+	}
+	return strings.Join(ids, ",")
+}
+
+// try calls each provider in order and returns the first successful result.
+func (s *fallbackService) try(ctx context.Context, call func(Service) (string, error)) (string, error) {
+	return tryProviders(ctx, s, call)
+}
+
+// tryProviders calls each provider of s in order and returns the first
+// successful result.
+func tryProviders[T any](ctx context.Context, s *fallbackService, call func(Service) (T, error)) (T, error) {
+	var zero T
+	trace, _ := ctx.Value(fallbackTraceKey{}).(*FallbackTrace)
+
+	var errs []error
+	for _, p := range s.providers {
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+
+		if p.Service == nil || !p.Service.IsAvailable() {
+			trace.record(ProviderAttempt{Provider: p.Name, Err: ErrProviderUnavailable})
+			errs = append(errs, fmt.Errorf("%s: %w", p.Name, ErrProviderUnavailable))
+			continue
+		}
+
+		start := time.Now()
+		result, err := call(p.Service)
+		trace.record(ProviderAttempt{Provider: p.Name, Err: err, Duration: time.Since(start)})
+		if err == nil {
+			return result, nil
+		}
+		if stderrors.Is(err, ErrPromptCaptured) {
+			return result, err
+		}
+
+		s.logger.Warn("AI provider failed, trying next provider",
+			"provider", p.Name,
+			"error", err)
+		errs = append(errs, fmt.Errorf("%s: %w", p.Name, err))
+	}
+
+	if len(errs) == 0 {
+		return zero, errors.AI("fallback", "no AI providers configured")
+	}
+	return zero, errors.AIWrap(stderrors.Join(errs...), "fallback", "all AI providers failed")
+}
@@ -0,0 +1,153 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+)
+
+// stubService is a Service returning a fixed result or error.
+type stubService struct {
+	result    string
+	err       error
+	available bool
+	calls     int
+}
+
+func (s *stubService) GenerateChangelog(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (string, error) {
+	s.calls++
+	return s.result, s.err
+}
+
+func (s *stubService) GenerateReleaseNotes(ctx context.Context, changelog string, opts GenerateOptions) (string, error) {
+	s.calls++
+	return s.result, s.err
+}
+
+func (s *stubService) GenerateMarketingBlurb(ctx context.Context, releaseNotes string, opts GenerateOptions) (string, error) {
+	s.calls++
+	return s.result, s.err
+}
+
+func (s *stubService) SummarizeChanges(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (string, error) {
+	s.calls++
+	return s.result, s.err
+}
+
+func (s *stubService) GenerateStructuredNotes(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (*StructuredNotes, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &StructuredNotes{Title: "Release", Summary: s.result}, nil
+}
+
+func (s *stubService) Translate(ctx context.Context, text string, opts GenerateOptions) (string, error) {
+	s.calls++
+	return s.result, s.err
+}
+
+func (s *stubService) ClassifyCommit(ctx context.Context, commit UnclassifiedCommit, opts GenerateOptions) (*CommitClassification, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &CommitClassification{Type: git.CommitType(s.result)}, nil
+}
+
+func (s *stubService) GenerateMigrationGuide(ctx context.Context, changes []BreakingChange, opts GenerateOptions) ([]MigrationGuideEntry, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return []MigrationGuideEntry{{Hash: changes[0].Hash, Change: s.result, Migration: s.result}}, nil
+}
+
+func (s *stubService) IsAvailable() bool {
+	return s.available
+}
+
+func TestFallbackService_FailsOver(t *testing.T) {
+	anthropic := &stubService{err: stderrors.New("circuit breaker is open"), available: true}
+	openai := &stubService{available: false}
+	ollama := &stubService{result: "summary from ollama", available: true}
+
+	svc := NewFallbackService(
+		Provider{Name: "anthropic", Service: anthropic},
+		Provider{Name: "openai", Service: openai},
+		Provider{Name: "ollama", Service: ollama},
+	)
+
+	ctx, trace := WithFallbackTrace(context.Background())
+	got, err := svc.SummarizeChanges(ctx, &git.CategorizedChanges{}, DefaultGenerateOptions())
+	if err != nil {
+		t.Fatalf("SummarizeChanges() error = %v", err)
+	}
+	if got != "summary from ollama" {
+		t.Errorf("SummarizeChanges() = %q, want the ollama result", got)
+	}
+	if openai.calls != 0 {
+		t.Error("unavailable providers should not be called")
+	}
+
+	if trace.Provider() != "ollama" {
+		t.Errorf("Provider() = %q, want ollama", trace.Provider())
+	}
+	fallbacks := trace.Fallbacks()
+	if len(fallbacks) != 2 || fallbacks[0].Provider != "anthropic" || fallbacks[1].Provider != "openai" {
+		t.Fatalf("Fallbacks() = %+v, want anthropic and openai", fallbacks)
+	}
+	if !stderrors.Is(fallbacks[1].Err, ErrProviderUnavailable) {
+		t.Errorf("openai fallback error = %v, want ErrProviderUnavailable", fallbacks[1].Err)
+	}
+}
+
+func TestFallbackService_GenerateStructuredNotes(t *testing.T) {
+	svc := NewFallbackService(
+		Provider{Name: "openai", Service: &stubService{err: ErrInvalidStructuredOutput, available: true}},
+		Provider{Name: "anthropic", Service: &stubService{result: "summary from anthropic", available: true}},
+	)
+
+	ctx, trace := WithFallbackTrace(context.Background())
+	notes, err := svc.GenerateStructuredNotes(ctx, &git.CategorizedChanges{}, DefaultGenerateOptions())
+	if err != nil {
+		t.Fatalf("GenerateStructuredNotes() error = %v", err)
+	}
+	if notes.Summary != "summary from anthropic" || trace.Provider() != "anthropic" {
+		t.Errorf("notes = %+v from %q, want the anthropic result", notes, trace.Provider())
+	}
+}
+
+func TestFallbackService_AllProvidersFail(t *testing.T) {
+	svc := NewFallbackService(
+		Provider{Name: "openai", Service: &stubService{err: stderrors.New("429 too many requests"), available: true}},
+		Provider{Name: "ollama", Service: &stubService{err: stderrors.New("connection refused"), available: true}},
+	)
+
+	_, err := svc.GenerateReleaseNotes(context.Background(), "changelog", DefaultGenerateOptions())
+	if err == nil {
+		t.Fatal("GenerateReleaseNotes() should fail when every provider fails")
+	}
+	for _, want := range []string{"openai: 429 too many requests", "ollama: connection refused"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should mention %q", err, want)
+		}
+	}
+}
+
+func TestFallbackService_IsAvailable(t *testing.T) {
+	if NewFallbackService().IsAvailable() {
+		t.Error("an empty chain should not be available")
+	}
+	svc := NewFallbackService(
+		Provider{Name: "openai", Service: &stubService{}},
+		Provider{Name: "ollama", Service: &stubService{available: true}},
+	)
+	if !svc.IsAvailable() {
+		t.Error("the chain should be available if any provider is")
+	}
+}
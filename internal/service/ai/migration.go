@@ -0,0 +1,141 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"strings"
+
+	"github.com/felixgeelhaar/release-pilot/internal/errors"
+)
+
+// ErrInvalidMigrationGuide is returned when the model keeps producing an
+// invalid migration guide after all repair attempts.
+var ErrInvalidMigrationGuide = stderrors.New("AI output is not a valid migration guide")
+
+// BreakingChange is a breaking commit to write migration guidance for.
+type BreakingChange struct {
+	Hash    string `json:"hash"`
+	Scope   string `json:"scope,omitempty"`
+	Subject string `json:"subject"`
+	// Description is the BREAKING CHANGE footer of the commit.
+	Description string `json:"description,omitempty"`
+	// Diff is the diff of the public API files the commit touched.
+	Diff string `json:"diff,omitempty"`
+}
+
+// MigrationGuideEntry is the model's upgrade guidance for one breaking change.
+type MigrationGuideEntry struct {
+	Hash      string `json:"hash"`
+	Change    string `json:"change"`
+	Before    string `json:"before,omitempty"`
+	After     string `json:"after,omitempty"`
+	Migration string `json:"migration"`
+}
+
+// migrationGuideResponse is the JSON shape the model is asked to produce.
+type migrationGuideResponse struct {
+	Entries []MigrationGuideEntry `json:"entries"`
+}
+
+// ParseMigrationGuide decodes a model response and checks that there is one
+// complete entry for each breaking change. Markdown code fences around the
+// JSON are tolerated. The returned problems are suitable for a repair prompt.
+func ParseMigrationGuide(raw string, changes []BreakingChange) ([]MigrationGuideEntry, []string) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(stripCodeFence(raw))))
+	dec.DisallowUnknownFields()
+
+	var resp migrationGuideResponse
+	if err := dec.Decode(&resp); err != nil {
+		return nil, []string{"response is not a valid JSON object of the schema: " + err.Error()}
+	}
+	if dec.More() {
+		return nil, []string{"response must contain exactly one JSON object"}
+	}
+
+	byHash := make(map[string]MigrationGuideEntry, len(resp.Entries))
+	var problems []string
+	for i, e := range resp.Entries {
+		e.Hash = strings.TrimSpace(e.Hash)
+		e.Change = strings.TrimSpace(e.Change)
+		e.Migration = strings.TrimSpace(e.Migration)
+		switch {
+		case e.Change == "":
+			problems = append(problems, fmt.Sprintf("entries[%d].change must not be empty", i))
+		case e.Migration == "":
+			problems = append(problems, fmt.Sprintf("entries[%d].migration must not be empty", i))
+		}
+		byHash[e.Hash] = e
+	}
+
+	entries := make([]MigrationGuideEntry, 0, len(changes))
+	for _, c := range changes {
+		e, ok := byHash[c.Hash]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("missing an entry for commit %s", c.Hash))
+			continue
+		}
+		entries = append(entries, e)
+		delete(byHash, c.Hash)
+	}
+	for hash := range byHash {
+		problems = append(problems, fmt.Sprintf("entry for unknown commit %q", hash))
+	}
+
+	if len(problems) > 0 {
+		return nil, problems
+	}
+	return entries, nil
+}
+
+// generateMigrationGuide asks the model for migration guidance for the
+// breaking changes and sends a repair prompt while the output is invalid.
+// This is shared across all AI service implementations.
+func generateMigrationGuide(ctx context.Context, complete completeFunc, prompts promptTemplates, changes []BreakingChange, opts GenerateOptions) ([]MigrationGuideEntry, error) {
+	if len(changes) == 0 {
+		return nil, errors.AI("GenerateMigrationGuide", "no breaking changes provided")
+	}
+
+	var content strings.Builder
+	for _, c := range changes {
+		fmt.Fprintf(&content, "Commit %s", c.Hash)
+		if c.Scope != "" {
+			fmt.Fprintf(&content, " (%s)", c.Scope)
+		}
+		fmt.Fprintf(&content, ": %s\n", c.Subject)
+		if c.Description != "" {
+			fmt.Fprintf(&content, "BREAKING CHANGE: %s\n", c.Description)
+		}
+		if c.Diff != "" {
+			fmt.Fprintf(&content, "Public API diff:\n```diff\n%s\n```\n", strings.TrimRight(c.Diff, "\n"))
+		}
+		content.WriteByte('\n')
+	}
+
+	systemPrompt := prompts.migrationSystem
+	userPrompt := buildUserPrompt(prompts.migrationUser, strings.TrimSpace(content.String()), opts)
+
+	prompt := userPrompt
+	var problems []string
+	for attempt := 0; attempt <= maxStructuredRepairs; attempt++ {
+		raw, err := complete(ctx, systemPrompt, prompt)
+		if err != nil {
+			return nil, err
+		}
+
+		var entries []MigrationGuideEntry
+		entries, problems = ParseMigrationGuide(raw, changes)
+		if entries != nil {
+			return entries, nil
+		}
+		prompt = buildRepairPrompt(userPrompt, raw, problems)
+	}
+
+	return nil, errors.AIWrap(
+		fmt.Errorf("%w: %s", ErrInvalidMigrationGuide, strings.Join(problems, "; ")),
+		"GenerateMigrationGuide",
+		fmt.Sprintf("invalid output after %d repair attempts", maxStructuredRepairs))
+}
@@ -0,0 +1,110 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"testing"
+)
+
+func TestParseMigrationGuide(t *testing.T) {
+	changes := []BreakingChange{{Hash: "abc1234", Subject: "rename Execute to Run"}}
+
+	tests := []struct {
+		name        string
+		raw         string
+		want        []MigrationGuideEntry
+		wantProblem string
+	}{
+		{
+			name: "valid",
+			raw:  "```json\n{\"entries\": [{\"hash\": \"abc1234\", \"change\": \" Run replaces Execute \", \"before\": \"c.Execute()\", \"after\": \"c.Run()\", \"migration\": \"Rename the calls.\"}]}\n```",
+			want: []MigrationGuideEntry{{Hash: "abc1234", Change: "Run replaces Execute", Before: "c.Execute()", After: "c.Run()", Migration: "Rename the calls."}},
+		},
+		{name: "missing entry", raw: `{"entries": []}`, wantProblem: "missing an entry for commit abc1234"},
+		{
+			name:        "unknown commit",
+			raw:         `{"entries": [{"hash": "abc1234", "change": "x", "migration": "y"}, {"hash": "fff0000", "change": "x", "migration": "y"}]}`,
+			wantProblem: `entry for unknown commit "fff0000"`,
+		},
+		{name: "empty migration", raw: `{"entries": [{"hash": "abc1234", "change": "x", "migration": ""}]}`, wantProblem: "entries[0].migration must not be empty"},
+		{name: "not json", raw: "Rename Execute to Run.", wantProblem: "not a valid JSON object"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, problems := ParseMigrationGuide(tt.raw, changes)
+			if tt.want != nil {
+				if len(got) != len(tt.want) || got[0] != tt.want[0] {
+					t.Errorf("ParseMigrationGuide() = %+v, %v, want %+v", got, problems, tt.want)
+				}
+				return
+			}
+			if got != nil || len(problems) == 0 || !strings.Contains(strings.Join(problems, "; "), tt.wantProblem) {
+				t.Errorf("ParseMigrationGuide() = %+v, %v, want problem %q", got, problems, tt.wantProblem)
+			}
+		})
+	}
+}
+
+func TestGenerateMigrationGuide(t *testing.T) {
+	var prompts []string
+	responses := []string{
+		`{"entries": []}`,
+		`{"entries": [{"hash": "abc1234", "change": "Run replaces Execute", "migration": "Rename the calls."}]}`,
+	}
+	complete := func(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+		prompts = append(prompts, userPrompt)
+		r := responses[0]
+		responses = responses[1:]
+		return r, nil
+	}
+
+	changes := []BreakingChange{{
+		Hash:        "abc1234",
+		Scope:       "api",
+		Subject:     "rename Execute to Run",
+		Description: "Execute was renamed to Run",
+		Diff:        "-func (c *Client) Execute() error\n+func (c *Client) Run() error",
+	}}
+	got, err := generateMigrationGuide(context.Background(), complete, newDefaultPromptTemplates(), changes, DefaultGenerateOptions())
+	if err != nil {
+		t.Fatalf("generateMigrationGuide() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Change != "Run replaces Execute" {
+		t.Errorf("generateMigrationGuide() = %+v", got)
+	}
+	if len(prompts) != 2 {
+		t.Fatalf("sent %d prompts, want a repair prompt after the invalid response", len(prompts))
+	}
+	for _, want := range []string{"Commit abc1234 (api): rename Execute to Run", "BREAKING CHANGE: Execute was renamed to Run", "+func (c *Client) Run() error"} {
+		if !strings.Contains(prompts[0], want) {
+			t.Errorf("prompt does not include %q: %q", want, prompts[0])
+		}
+	}
+	if !strings.Contains(prompts[1], "missing an entry for commit abc1234") {
+		t.Errorf("repair prompt does not list the problem: %q", prompts[1])
+	}
+}
+
+func TestGenerateMigrationGuide_GivesUp(t *testing.T) {
+	calls := 0
+	complete := func(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+		calls++
+		return "not json", nil
+	}
+
+	changes := []BreakingChange{{Hash: "abc1234", Subject: "drop Go 1.21"}}
+	_, err := generateMigrationGuide(context.Background(), complete, newDefaultPromptTemplates(), changes, DefaultGenerateOptions())
+	if !stderrors.Is(err, ErrInvalidMigrationGuide) {
+		t.Errorf("generateMigrationGuide() error = %v, want ErrInvalidMigrationGuide", err)
+	}
+	if calls != maxStructuredRepairs+1 {
+		t.Errorf("calls = %d, want %d", calls, maxStructuredRepairs+1)
+	}
+
+	if _, err := generateMigrationGuide(context.Background(), complete, newDefaultPromptTemplates(), nil, DefaultGenerateOptions()); err == nil {
+		t.Error("generateMigrationGuide() without breaking changes should fail")
+	}
+}
@@ -99,9 +99,13 @@ func (s *ollamaService) GenerateChangelog(ctx context.Context, changes *git.Cate
 		return "", nil
 	}
 
-	changesText := formatChangesForPrompt(changes)
-	userPrompt := buildUserPrompt(s.prompts.changelogUser, changesText, opts)
-	systemPrompt := buildSystemPrompt(s.prompts.changelogSystem, opts)
+	prompts := contextPrompts(ctx, s.prompts)
+	systemPrompt := buildSystemPrompt(prompts.changelogSystem, opts)
+	changesText, err := s.chunker(ctx).changesContent(ctx, changes, systemPrompt, prompts.changelogUser, opts)
+	if err != nil {
+		return "", err
+	}
+	userPrompt := buildUserPrompt(prompts.changelogUser, changesText, opts)
 
 	return s.complete(ctx, systemPrompt, userPrompt)
 }
@@ -112,8 +116,9 @@ func (s *ollamaService) GenerateReleaseNotes(ctx context.Context, changelog stri
 		return "", nil
 	}
 
-	userPrompt := buildUserPrompt(s.prompts.releaseNotesUser, changelog, opts)
-	systemPrompt := buildSystemPrompt(s.prompts.releaseNotesSystem, opts)
+	prompts := contextPrompts(ctx, s.prompts)
+	userPrompt := buildUserPrompt(prompts.releaseNotesUser, changelog, opts)
+	systemPrompt := buildSystemPrompt(prompts.releaseNotesSystem, opts)
 
 	return s.complete(ctx, systemPrompt, userPrompt)
 }
@@ -124,8 +129,9 @@ func (s *ollamaService) GenerateMarketingBlurb(ctx context.Context, releaseNotes
 		return "", nil
 	}
 
-	userPrompt := buildUserPrompt(s.prompts.marketingUser, releaseNotes, opts)
-	systemPrompt := buildSystemPrompt(s.prompts.marketingSystem, opts)
+	prompts := contextPrompts(ctx, s.prompts)
+	userPrompt := buildUserPrompt(prompts.marketingUser, releaseNotes, opts)
+	systemPrompt := buildSystemPrompt(prompts.marketingSystem, opts)
 
 	return s.complete(ctx, systemPrompt, userPrompt)
 }
@@ -136,13 +142,48 @@ func (s *ollamaService) SummarizeChanges(ctx context.Context, changes *git.Categ
 		return "", nil
 	}
 
-	changesText := formatChangesForPrompt(changes)
-	userPrompt := buildUserPrompt(s.prompts.summaryUser, changesText, opts)
-	systemPrompt := buildSystemPrompt(s.prompts.summarySystem, opts)
+	prompts := contextPrompts(ctx, s.prompts)
+	systemPrompt := buildSystemPrompt(prompts.summarySystem, opts)
+	changesText, err := s.chunker(ctx).changesContent(ctx, changes, systemPrompt, prompts.summaryUser, opts)
+	if err != nil {
+		return "", err
+	}
+	userPrompt := buildUserPrompt(prompts.summaryUser, changesText, opts)
 
 	return s.complete(ctx, systemPrompt, userPrompt)
 }
 
+// GenerateStructuredNotes generates schema-validated release notes using Ollama.
+func (s *ollamaService) GenerateStructuredNotes(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (*StructuredNotes, error) {
+	return generateStructuredNotes(ctx, s.chunker(ctx), changes, opts)
+}
+
+// Translate translates release notes into opts.Language using Ollama.
+func (s *ollamaService) Translate(ctx context.Context, text string, opts GenerateOptions) (string, error) {
+	return translate(ctx, s.complete, contextPrompts(ctx, s.prompts), text, opts)
+}
+
+// ClassifyCommit infers the conventional commit type of a commit using Ollama.
+func (s *ollamaService) ClassifyCommit(ctx context.Context, commit UnclassifiedCommit, opts GenerateOptions) (*CommitClassification, error) {
+	return classifyCommit(ctx, s.complete, contextPrompts(ctx, s.prompts), commit, opts)
+}
+
+// GenerateMigrationGuide writes migration guidance for breaking changes using Ollama.
+func (s *ollamaService) GenerateMigrationGuide(ctx context.Context, changes []BreakingChange, opts GenerateOptions) ([]MigrationGuideEntry, error) {
+	return generateMigrationGuide(ctx, s.complete, contextPrompts(ctx, s.prompts), changes, opts)
+}
+
+// chunker returns the chunker fitting changes into the model's context
+// window, using the prompts of ctx.
+func (s *ollamaService) chunker(ctx context.Context) chunker {
+	return newChunker(s.config, s.complete, contextPrompts(ctx, s.prompts))
+}
+
+// cacheIdentity identifies the model settings and prompts responses depend on.
+func (s *ollamaService) cacheIdentity() string {
+	return providerIdentity(s.config, s.prompts)
+}
+
 // IsAvailable returns true if the Ollama service is available.
 func (s *ollamaService) IsAvailable() bool {
 	return s.client != nil
@@ -180,6 +221,10 @@ func (s *ollamaService) CheckConnection(ctx context.Context) error {
 
 // complete sends a completion request to Ollama using Fortify resilience patterns.
 func (s *ollamaService) complete(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+	if capturePrompt(ctx, systemPrompt, userPrompt) {
+		return "", ErrPromptCaptured
+	}
+
 	result, err := s.resilience.Execute(ctx, func(ctx context.Context) (string, error) {
 		resp, err := s.client.CreateChatCompletion(
 			ctx,
@@ -73,9 +73,13 @@ func (s *openAIService) GenerateChangelog(ctx context.Context, changes *git.Cate
 		return "", nil
 	}
 
-	changesText := formatChangesForPrompt(changes)
-	userPrompt := buildUserPrompt(s.prompts.changelogUser, changesText, opts)
-	systemPrompt := buildSystemPrompt(s.prompts.changelogSystem, opts)
+	prompts := contextPrompts(ctx, s.prompts)
+	systemPrompt := buildSystemPrompt(prompts.changelogSystem, opts)
+	changesText, err := s.chunker(ctx).changesContent(ctx, changes, systemPrompt, prompts.changelogUser, opts)
+	if err != nil {
+		return "", err
+	}
+	userPrompt := buildUserPrompt(prompts.changelogUser, changesText, opts)
 
 	return s.complete(ctx, systemPrompt, userPrompt)
 }
@@ -86,8 +90,9 @@ func (s *openAIService) GenerateReleaseNotes(ctx context.Context, changelog stri
 		return "", nil
 	}
 
-	userPrompt := buildUserPrompt(s.prompts.releaseNotesUser, changelog, opts)
-	systemPrompt := buildSystemPrompt(s.prompts.releaseNotesSystem, opts)
+	prompts := contextPrompts(ctx, s.prompts)
+	userPrompt := buildUserPrompt(prompts.releaseNotesUser, changelog, opts)
+	systemPrompt := buildSystemPrompt(prompts.releaseNotesSystem, opts)
 
 	return s.complete(ctx, systemPrompt, userPrompt)
 }
@@ -98,8 +103,9 @@ func (s *openAIService) GenerateMarketingBlurb(ctx context.Context, releaseNotes
 		return "", nil
 	}
 
-	userPrompt := buildUserPrompt(s.prompts.marketingUser, releaseNotes, opts)
-	systemPrompt := buildSystemPrompt(s.prompts.marketingSystem, opts)
+	prompts := contextPrompts(ctx, s.prompts)
+	userPrompt := buildUserPrompt(prompts.marketingUser, releaseNotes, opts)
+	systemPrompt := buildSystemPrompt(prompts.marketingSystem, opts)
 
 	return s.complete(ctx, systemPrompt, userPrompt)
 }
@@ -110,13 +116,48 @@ func (s *openAIService) SummarizeChanges(ctx context.Context, changes *git.Categ
 		return "", nil
 	}
 
-	changesText := formatChangesForPrompt(changes)
-	userPrompt := buildUserPrompt(s.prompts.summaryUser, changesText, opts)
-	systemPrompt := buildSystemPrompt(s.prompts.summarySystem, opts)
+	prompts := contextPrompts(ctx, s.prompts)
+	systemPrompt := buildSystemPrompt(prompts.summarySystem, opts)
+	changesText, err := s.chunker(ctx).changesContent(ctx, changes, systemPrompt, prompts.summaryUser, opts)
+	if err != nil {
+		return "", err
+	}
+	userPrompt := buildUserPrompt(prompts.summaryUser, changesText, opts)
 
 	return s.complete(ctx, systemPrompt, userPrompt)
 }
 
+// GenerateStructuredNotes generates schema-validated release notes.
+func (s *openAIService) GenerateStructuredNotes(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (*StructuredNotes, error) {
+	return generateStructuredNotes(ctx, s.chunker(ctx), changes, opts)
+}
+
+// Translate translates release notes into opts.Language using OpenAI.
+func (s *openAIService) Translate(ctx context.Context, text string, opts GenerateOptions) (string, error) {
+	return translate(ctx, s.complete, contextPrompts(ctx, s.prompts), text, opts)
+}
+
+// ClassifyCommit infers the conventional commit type of a commit using OpenAI.
+func (s *openAIService) ClassifyCommit(ctx context.Context, commit UnclassifiedCommit, opts GenerateOptions) (*CommitClassification, error) {
+	return classifyCommit(ctx, s.complete, contextPrompts(ctx, s.prompts), commit, opts)
+}
+
+// GenerateMigrationGuide writes migration guidance for breaking changes using OpenAI.
+func (s *openAIService) GenerateMigrationGuide(ctx context.Context, changes []BreakingChange, opts GenerateOptions) ([]MigrationGuideEntry, error) {
+	return generateMigrationGuide(ctx, s.complete, contextPrompts(ctx, s.prompts), changes, opts)
+}
+
+// chunker returns the chunker fitting changes into the model's context
+// window, using the prompts of ctx.
+func (s *openAIService) chunker(ctx context.Context) chunker {
+	return newChunker(s.config, s.complete, contextPrompts(ctx, s.prompts))
+}
+
+// cacheIdentity identifies the model settings and prompts responses depend on.
+func (s *openAIService) cacheIdentity() string {
+	return providerIdentity(s.config, s.prompts)
+}
+
 // IsAvailable returns true if the AI service is available.
 func (s *openAIService) IsAvailable() bool {
 	return s.client != nil && s.config.APIKey != ""
@@ -124,6 +165,10 @@ func (s *openAIService) IsAvailable() bool {
 
 // complete sends a completion request to OpenAI using Fortify resilience patterns.
 func (s *openAIService) complete(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+	if capturePrompt(ctx, systemPrompt, userPrompt) {
+		return "", ErrPromptCaptured
+	}
+
 	result, err := s.resilience.Execute(ctx, func(ctx context.Context) (string, error) {
 		resp, err := s.client.CreateChatCompletion(
 			ctx,
@@ -184,6 +229,28 @@ func (s *noopService) SummarizeChanges(ctx context.Context, changes *git.Categor
 	return "", nil
 }
 
+// GenerateStructuredNotes returns an error when AI is not available, so
+// callers fall back to template notes.
+func (s *noopService) GenerateStructuredNotes(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (*StructuredNotes, error) {
+	return nil, errors.AI("GenerateStructuredNotes", "AI service is not configured")
+}
+
+// Translate returns an error when AI is not available, since an
+// untranslated text must not pass for a translation.
+func (s *noopService) Translate(ctx context.Context, text string, opts GenerateOptions) (string, error) {
+	return "", errors.AI("Translate", "AI service is not configured")
+}
+
+// ClassifyCommit returns an error when AI is not available.
+func (s *noopService) ClassifyCommit(ctx context.Context, commit UnclassifiedCommit, opts GenerateOptions) (*CommitClassification, error) {
+	return nil, errors.AI("ClassifyCommit", "AI service is not configured")
+}
+
+// GenerateMigrationGuide returns an error when AI is not available.
+func (s *noopService) GenerateMigrationGuide(ctx context.Context, changes []BreakingChange, opts GenerateOptions) ([]MigrationGuideEntry, error) {
+	return nil, errors.AI("GenerateMigrationGuide", "AI service is not configured")
+}
+
 // IsAvailable returns false for the noop service.
 func (s *noopService) IsAvailable() bool {
 	return false
@@ -0,0 +1,132 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+)
+
+// Prompt names. Each prompt has a system and a user template; in a prompt
+// library they are the files <name>.system.tmpl and <name>.user.tmpl.
+const (
+	PromptChangelog    = "changelog"
+	PromptReleaseNotes = "release_notes"
+	PromptMarketing    = "marketing"
+	PromptSummary      = "summary"
+	PromptStructured   = "structured"
+	PromptChunk        = "chunk"
+	PromptTranslate    = "translate"
+	PromptClassify     = "classify"
+	PromptMigration    = "migration"
+)
+
+// Placeholders in user templates that are filled in per request.
+const (
+	// ContentPlaceholder is replaced with the request content, e.g. the
+	// formatted changes.
+	ContentPlaceholder = "{{CONTENT}}"
+	// PartPlaceholder is replaced with the chunk position, e.g. "2 of 5".
+	PartPlaceholder = "{{PART}}"
+	// LanguagePlaceholder is replaced with the target language of a
+	// translation.
+	LanguagePlaceholder = "{{LANGUAGE}}"
+)
+
+// PromptNames returns the names of all prompts.
+func PromptNames() []string {
+	return []string{
+		PromptChangelog, PromptReleaseNotes, PromptMarketing, PromptSummary,
+		PromptStructured, PromptChunk, PromptTranslate, PromptClassify, PromptMigration,
+	}
+}
+
+// Prompt is the system and user template of one prompt. An empty field
+// keeps the configured template.
+type Prompt struct {
+	System string `json:"system,omitempty"`
+	User   string `json:"user,omitempty"`
+}
+
+type promptsKey struct{}
+
+// WithPrompts returns a context whose calls use the given prompts, keyed by
+// prompt name, instead of the configured ones.
+func WithPrompts(ctx context.Context, prompts map[string]Prompt) context.Context {
+	return context.WithValue(ctx, promptsKey{}, prompts)
+}
+
+// promptsFrom returns the prompts of a context, or nil if it has none.
+func promptsFrom(ctx context.Context) map[string]Prompt {
+	prompts, _ := ctx.Value(promptsKey{}).(map[string]Prompt)
+	return prompts
+}
+
+// contextPrompts returns base with the prompts of ctx applied.
+func contextPrompts(ctx context.Context, base promptTemplates) promptTemplates {
+	for name, p := range promptsFrom(ctx) {
+		system, user := base.templates(name)
+		if system == nil {
+			continue
+		}
+		if p.System != "" {
+			*system = p.System
+		}
+		if p.User != "" {
+			*user = p.User
+		}
+	}
+	return base
+}
+
+// ErrPromptCaptured is returned by calls made with a prompt capture
+// context: the request was recorded instead of being sent.
+var ErrPromptCaptured = stderrors.New("prompt captured, provider not called")
+
+// CapturedPrompt is a fully rendered request that was not sent.
+type CapturedPrompt struct {
+	System string `json:"system"`
+	User   string `json:"user"`
+}
+
+// PromptCapture records the requests of calls made with its context, so
+// the exact prompts can be inspected without calling a provider.
+type PromptCapture struct {
+	mu      sync.Mutex
+	prompts []CapturedPrompt
+}
+
+type promptCaptureKey struct{}
+
+// WithPromptCapture returns a context whose calls record their requests
+// and fail with ErrPromptCaptured instead of calling the provider, so a
+// call stops at its first request. The response cache is bypassed.
+func WithPromptCapture(ctx context.Context) (context.Context, *PromptCapture) {
+	capture := &PromptCapture{}
+	return context.WithValue(ctx, promptCaptureKey{}, capture), capture
+}
+
+// Prompts returns the captured requests in order.
+func (c *PromptCapture) Prompts() []CapturedPrompt {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]CapturedPrompt(nil), c.prompts...)
+}
+
+// capturing reports whether calls made with ctx capture their prompts.
+func capturing(ctx context.Context) bool {
+	return ctx.Value(promptCaptureKey{}) != nil
+}
+
+// capturePrompt records a request if ctx captures prompts, and reports
+// whether it did.
+func capturePrompt(ctx context.Context, systemPrompt, userPrompt string) bool {
+	capture, _ := ctx.Value(promptCaptureKey{}).(*PromptCapture)
+	if capture == nil {
+		return false
+	}
+	capture.mu.Lock()
+	defer capture.mu.Unlock()
+	capture.prompts = append(capture.prompts, CapturedPrompt{System: systemPrompt, User: userPrompt})
+	return true
+}
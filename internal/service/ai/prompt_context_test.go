@@ -0,0 +1,103 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"testing"
+)
+
+func TestContextPrompts(t *testing.T) {
+	base := newDefaultPromptTemplates()
+	ctx := WithPrompts(context.Background(), map[string]Prompt{
+		PromptSummary: {User: "Summarize {{CONTENT}}"},
+		"unknown":     {System: "ignored"},
+	})
+
+	got := contextPrompts(ctx, base)
+	if got.summaryUser != "Summarize {{CONTENT}}" {
+		t.Errorf("summaryUser = %q", got.summaryUser)
+	}
+	if got.summarySystem != defaultSummarySystemPrompt {
+		t.Error("an empty system template should keep the configured one")
+	}
+	if base.summaryUser != defaultSummaryUserPrompt {
+		t.Error("contextPrompts should not modify the base templates")
+	}
+	if contextPrompts(context.Background(), base) != base {
+		t.Error("a context without prompts should keep the base templates")
+	}
+
+	for _, name := range PromptNames() {
+		if system, user := base.templates(name); system == nil || user == nil {
+			t.Errorf("templates(%q) = nil", name)
+		}
+	}
+}
+
+func TestPromptCapture_DoesNotCallProvider(t *testing.T) {
+	svc, err := NewOpenAIService(ServiceConfig{
+		Provider: "openai",
+		APIKey:   "sk-abcdefghijklmnopqrstuvwx",
+		BaseURL:  "http://127.0.0.1:1",
+	})
+	if err != nil {
+		t.Fatalf("NewOpenAIService() error = %v", err)
+	}
+
+	ctx := WithPrompts(context.Background(), map[string]Prompt{
+		PromptSummary: {System: "You summarize releases of the search service."},
+	})
+	ctx, capture := WithPromptCapture(ctx)
+	_, err = svc.SummarizeChanges(ctx, manyChanges(2, "search"), DefaultGenerateOptions())
+	if !stderrors.Is(err, ErrPromptCaptured) {
+		t.Fatalf("SummarizeChanges() error = %v, want ErrPromptCaptured", err)
+	}
+
+	prompts := capture.Prompts()
+	if len(prompts) != 1 {
+		t.Fatalf("captured %d prompts, want 1", len(prompts))
+	}
+	if !strings.HasPrefix(prompts[0].System, "You summarize releases of the search service.") {
+		t.Errorf("System = %q, want the context prompt with the option instructions", prompts[0].System)
+	}
+	if !strings.Contains(prompts[0].User, "search") {
+		t.Errorf("User = %q, want the formatted changes", prompts[0].User)
+	}
+}
+
+func TestPromptCapture_StopsFallbackAndBypassesCache(t *testing.T) {
+	first := &stubService{err: ErrPromptCaptured, available: true}
+	second := &stubService{result: "summary", available: true}
+	cache := newTestCache(t)
+	svc := NewCachingService(NewFallbackService(
+		Provider{Name: "openai", Service: first},
+		Provider{Name: "ollama", Service: second},
+	), cache)
+
+	ctx, _ := WithPromptCapture(context.Background())
+	if _, err := svc.SummarizeChanges(ctx, manyChanges(2, "search"), DefaultGenerateOptions()); !stderrors.Is(err, ErrPromptCaptured) {
+		t.Fatalf("SummarizeChanges() error = %v, want ErrPromptCaptured", err)
+	}
+	if second.calls != 0 {
+		t.Error("a captured prompt should not fall back to the next provider")
+	}
+	if stats, _ := cache.Stats(); stats.Hits+stats.Misses != 0 {
+		t.Errorf("Stats() = %+v, want the cache bypassed", stats)
+	}
+}
+
+func TestCachingService_KeyCoversContextPrompts(t *testing.T) {
+	provider := &stubService{result: "summary", available: true}
+	svc := NewCachingService(NewFallbackService(Provider{Name: "openai", Service: provider}), newTestCache(t))
+	changes := manyChanges(2, "search")
+	concise := WithPrompts(context.Background(), map[string]Prompt{PromptSummary: {User: "Briefly: {{CONTENT}}"}})
+
+	_, _ = svc.SummarizeChanges(context.Background(), changes, DefaultGenerateOptions())
+	_, _ = svc.SummarizeChanges(concise, changes, DefaultGenerateOptions())
+	_, _ = svc.SummarizeChanges(concise, changes, DefaultGenerateOptions())
+	if provider.calls != 2 {
+		t.Errorf("provider called %d times, want 2 (default and concise prompts)", provider.calls)
+	}
+}
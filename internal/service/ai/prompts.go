@@ -18,6 +18,16 @@ type promptTemplates struct {
 	marketingUser      string
 	summarySystem      string
 	summaryUser        string
+	structuredSystem   string
+	structuredUser     string
+	chunkSystem        string
+	chunkUser          string
+	translateSystem    string
+	translateUser      string
+	classifySystem     string
+	classifyUser       string
+	migrationSystem    string
+	migrationUser      string
 }
 
 // newDefaultPromptTemplates creates prompt templates with default values.
@@ -31,6 +41,16 @@ func newDefaultPromptTemplates() promptTemplates {
 		marketingUser:      defaultMarketingUserPrompt,
 		summarySystem:      defaultSummarySystemPrompt,
 		summaryUser:        defaultSummaryUserPrompt,
+		structuredSystem:   defaultStructuredSystemPrompt,
+		structuredUser:     defaultStructuredUserPrompt,
+		chunkSystem:        defaultChunkSystemPrompt,
+		chunkUser:          defaultChunkUserPrompt,
+		translateSystem:    defaultTranslateSystemPrompt,
+		translateUser:      defaultTranslateUserPrompt,
+		classifySystem:     defaultClassifySystemPrompt,
+		classifyUser:       defaultClassifyUserPrompt,
+		migrationSystem:    defaultMigrationSystemPrompt,
+		migrationUser:      defaultMigrationUserPrompt,
 	}
 }
 
@@ -56,6 +76,33 @@ func (p *promptTemplates) applyCustomPrompts(custom CustomPrompts) {
 	}
 }
 
+// templates returns the system and user template of a prompt by name, or
+// nil if there is no such prompt.
+func (p *promptTemplates) templates(name string) (system, user *string) {
+	switch name {
+	case PromptChangelog:
+		return &p.changelogSystem, &p.changelogUser
+	case PromptReleaseNotes:
+		return &p.releaseNotesSystem, &p.releaseNotesUser
+	case PromptMarketing:
+		return &p.marketingSystem, &p.marketingUser
+	case PromptSummary:
+		return &p.summarySystem, &p.summaryUser
+	case PromptStructured:
+		return &p.structuredSystem, &p.structuredUser
+	case PromptChunk:
+		return &p.chunkSystem, &p.chunkUser
+	case PromptTranslate:
+		return &p.translateSystem, &p.translateUser
+	case PromptClassify:
+		return &p.classifySystem, &p.classifyUser
+	case PromptMigration:
+		return &p.migrationSystem, &p.migrationUser
+	default:
+		return nil, nil
+	}
+}
+
 // buildSystemPrompt builds the system prompt with options.
 // This is shared across all AI service implementations.
 func buildSystemPrompt(template string, opts GenerateOptions) string {
@@ -129,7 +176,7 @@ func getAudienceInstruction(audience Audience) string {
 // buildUserPrompt builds the user prompt with content and options.
 // This is shared across all AI service implementations.
 func buildUserPrompt(template, content string, opts GenerateOptions) string {
-	prompt := strings.ReplaceAll(template, "{{CONTENT}}", content)
+	prompt := strings.ReplaceAll(template, ContentPlaceholder, content)
 
 	if opts.ProductName != "" {
 		prompt = strings.ReplaceAll(prompt, "{{PRODUCT_NAME}}", opts.ProductName)
@@ -271,3 +318,61 @@ Be brief but comprehensive. Highlight the most important changes first.`
 const defaultSummaryUserPrompt = `Summarize the following changes for {{PRODUCT_NAME}} in 2-3 sentences:
 
 {{CONTENT}}`
+
+const defaultStructuredSystemPrompt = `You are a technical writer creating release notes for software releases.
+Respond with a single JSON object and nothing else: no markdown fences, no commentary.
+The object must match this JSON Schema:
+
+` + StructuredNotesSchema + `
+
+Write a one-paragraph summary, up to five highlights, and sections grouping the changes.
+For every breaking change, add a breaking_changes entry explaining what changed and how to migrate.`
+
+const defaultStructuredUserPrompt = `Create structured release notes for {{PRODUCT_NAME}} version {{VERSION}} based on these changes:
+
+{{CONTENT}}`
+
+const defaultChunkSystemPrompt = `You are a technical writer condensing the changes of a large software release.
+You receive one part of the changes. Condense it into a short bullet list under the same headings
+(BREAKING CHANGES, NEW FEATURES, BUG FIXES, ...). Merge closely related changes and keep scopes in parentheses.
+Never drop a breaking change, and do not add anything that is not in the changes.`
+
+const defaultChunkUserPrompt = `Condense part {{PART}} of the changes for {{PRODUCT_NAME}}:
+
+{{CONTENT}}`
+
+const defaultTranslateSystemPrompt = `You are a professional translator localizing software release notes.
+Translate the text into {{LANGUAGE}}. Preserve the Markdown structure, links, version numbers,
+commit hashes, issue references, code spans and product names exactly.
+Keep technical terms that are customarily left in English. Do not add, remove or summarize content.
+Respond with the translation only.`
+
+const defaultTranslateUserPrompt = `Translate these release notes for {{PRODUCT_NAME}} into {{LANGUAGE}}:
+
+{{CONTENT}}`
+
+const defaultClassifySystemPrompt = `You classify git commits that do not follow the Conventional Commits format.
+Infer the conventional commit type (one of feat, fix, docs, style, refactor, perf, test, build, ci, chore, revert),
+an optional scope naming the affected component, and whether the commit is a breaking change for users.
+Use the commit message and the changed files. Only mark a commit as breaking if it clearly removes or
+incompatibly changes existing behavior.
+
+Respond with a single JSON object and nothing else, for example:
+{"type": "feat", "scope": "cli", "breaking": false}`
+
+const defaultClassifyUserPrompt = `Classify this commit of {{PRODUCT_NAME}}:
+
+{{CONTENT}}`
+
+const defaultMigrationSystemPrompt = `You are a technical writer creating a migration guide for a major software release.
+For every breaking commit, explain what changed, show short before and after code or configuration
+examples where the public API diff makes them clear, and list the steps users take to migrate.
+Base the guidance only on the commit subject, its BREAKING CHANGE description and the diff.
+Leave before and after empty rather than inventing code.
+
+Respond with a single JSON object and nothing else, with one entry per commit, for example:
+{"entries": [{"hash": "abc1234", "change": "Run replaces Execute", "before": "client.Execute(ctx)", "after": "client.Run(ctx)", "migration": "Rename calls to Execute to Run."}]}`
+
+const defaultMigrationUserPrompt = `Write the migration guide for the next major release of {{PRODUCT_NAME}} from these breaking changes:
+
+{{CONTENT}}`
@@ -23,6 +23,22 @@ type Service interface {
 	// SummarizeChanges generates a summary of changes.
 	SummarizeChanges(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (string, error)
 
+	// GenerateStructuredNotes generates release notes as schema-validated JSON,
+	// retrying with a repair prompt when the model output is invalid.
+	GenerateStructuredNotes(ctx context.Context, changes *git.CategorizedChanges, opts GenerateOptions) (*StructuredNotes, error)
+
+	// Translate translates release notes into opts.Language, preserving
+	// their Markdown structure.
+	Translate(ctx context.Context, text string, opts GenerateOptions) (string, error)
+
+	// ClassifyCommit infers the conventional commit type, scope and
+	// breaking-ness of a commit that does not follow the format.
+	ClassifyCommit(ctx context.Context, commit UnclassifiedCommit, opts GenerateOptions) (*CommitClassification, error)
+
+	// GenerateMigrationGuide writes before/after guidance and migration
+	// steps for each breaking change, as schema-validated JSON.
+	GenerateMigrationGuide(ctx context.Context, changes []BreakingChange, opts GenerateOptions) ([]MigrationGuideEntry, error)
+
 	// IsAvailable returns true if the AI service is available.
 	IsAvailable() bool
 }
@@ -98,6 +114,9 @@ type ServiceConfig struct {
 	Model string
 	// MaxTokens is the maximum tokens for responses.
 	MaxTokens int
+	// ContextWindow is the model's context window in tokens (0 = the
+	// provider's default). Larger changesets are summarized in chunks.
+	ContextWindow int
 	// Temperature controls randomness (0.0-2.0).
 	Temperature float64
 	// Timeout is the request timeout.
@@ -177,6 +196,13 @@ func WithMaxTokens(tokens int) ServiceOption {
 	}
 }
 
+// WithContextWindow sets the model's context window in tokens.
+func WithContextWindow(tokens int) ServiceOption {
+	return func(cfg *ServiceConfig) {
+		cfg.ContextWindow = tokens
+	}
+}
+
 // WithTemperature sets the temperature.
 func WithTemperature(temp float64) ServiceOption {
 	return func(cfg *ServiceConfig) {
@@ -0,0 +1,213 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/felixgeelhaar/release-pilot/internal/errors"
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+)
+
+// ErrInvalidStructuredOutput is returned when the model keeps producing
+// output that does not match StructuredNotesSchema after all repair attempts.
+var ErrInvalidStructuredOutput = stderrors.New("AI output does not match the release notes schema")
+
+// maxStructuredRepairs is the number of repair prompts sent after the first
+// invalid response before giving up.
+const maxStructuredRepairs = 2
+
+// StructuredNotes is the JSON shape the model is asked to produce in
+// structured generation mode. It mirrors communication.ReleaseNotes.
+type StructuredNotes struct {
+	Title           string              `json:"title"`
+	Summary         string              `json:"summary"`
+	Highlights      []string            `json:"highlights,omitempty"`
+	Sections        []StructuredSection `json:"sections,omitempty"`
+	BreakingChanges []MigrationNote     `json:"breaking_changes,omitempty"`
+}
+
+// StructuredSection is a titled list of release notes items.
+type StructuredSection struct {
+	Title string   `json:"title"`
+	Items []string `json:"items"`
+}
+
+// MigrationNote describes a breaking change and how to migrate.
+type MigrationNote struct {
+	Change    string `json:"change"`
+	Migration string `json:"migration"`
+}
+
+// StructuredNotesSchema is the JSON Schema included in the prompt and
+// enforced by ParseStructuredNotes.
+const StructuredNotesSchema = `{
+  "type": "object",
+  "additionalProperties": false,
+  "required": ["title", "summary"],
+  "properties": {
+    "title": {"type": "string", "minLength": 1},
+    "summary": {"type": "string", "minLength": 1},
+    "highlights": {"type": "array", "items": {"type": "string", "minLength": 1}},
+    "sections": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "additionalProperties": false,
+        "required": ["title", "items"],
+        "properties": {
+          "title": {"type": "string", "minLength": 1},
+          "items": {"type": "array", "minItems": 1, "items": {"type": "string", "minLength": 1}}
+        }
+      }
+    },
+    "breaking_changes": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "additionalProperties": false,
+        "required": ["change", "migration"],
+        "properties": {
+          "change": {"type": "string", "minLength": 1},
+          "migration": {"type": "string", "minLength": 1}
+        }
+      }
+    }
+  }
+}`
+
+// ParseStructuredNotes decodes a model response and validates it against
+// StructuredNotesSchema. Markdown code fences around the JSON are tolerated.
+// If the changes contain breaking commits, the notes must describe them.
+// The returned problems are suitable for a repair prompt.
+func ParseStructuredNotes(raw string, changes *git.CategorizedChanges) (*StructuredNotes, []string) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(stripCodeFence(raw))))
+	dec.DisallowUnknownFields()
+
+	var notes StructuredNotes
+	if err := dec.Decode(&notes); err != nil {
+		return nil, []string{"response is not a valid JSON object of the schema: " + err.Error()}
+	}
+	if dec.More() {
+		return nil, []string{"response must contain exactly one JSON object"}
+	}
+
+	var problems []string
+	if strings.TrimSpace(notes.Title) == "" {
+		problems = append(problems, "title is required")
+	}
+	if strings.TrimSpace(notes.Summary) == "" {
+		problems = append(problems, "summary is required")
+	}
+	for i, h := range notes.Highlights {
+		if strings.TrimSpace(h) == "" {
+			problems = append(problems, fmt.Sprintf("highlights[%d] must not be empty", i))
+		}
+	}
+	for i, s := range notes.Sections {
+		if strings.TrimSpace(s.Title) == "" {
+			problems = append(problems, fmt.Sprintf("sections[%d].title is required", i))
+		}
+		if len(s.Items) == 0 {
+			problems = append(problems, fmt.Sprintf("sections[%d].items must not be empty", i))
+		}
+		for j, item := range s.Items {
+			if strings.TrimSpace(item) == "" {
+				problems = append(problems, fmt.Sprintf("sections[%d].items[%d] must not be empty", i, j))
+			}
+		}
+	}
+	for i, b := range notes.BreakingChanges {
+		if strings.TrimSpace(b.Change) == "" {
+			problems = append(problems, fmt.Sprintf("breaking_changes[%d].change is required", i))
+		}
+		if strings.TrimSpace(b.Migration) == "" {
+			problems = append(problems, fmt.Sprintf("breaking_changes[%d].migration is required", i))
+		}
+	}
+	if changes != nil && len(changes.Breaking) > 0 && len(notes.BreakingChanges) == 0 {
+		problems = append(problems, "breaking_changes must describe the "+
+			strconv.Itoa(len(changes.Breaking))+" breaking change(s) with migration guidance")
+	}
+
+	if len(problems) > 0 {
+		return nil, problems
+	}
+	return &notes, nil
+}
+
+// stripCodeFence removes a surrounding markdown code fence, which models
+// often add despite being asked for raw JSON.
+func stripCodeFence(s string) string {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "```") {
+		return s
+	}
+	s = strings.TrimPrefix(s, "```")
+	if nl := strings.IndexByte(s, '\n'); nl >= 0 {
+		s = s[nl+1:] // drop the language tag, e.g. ```json
+	}
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "```"))
+}
+
+// completeFunc sends a system and user prompt to a provider.
+type completeFunc func(ctx context.Context, systemPrompt, userPrompt string) (string, error)
+
+// generateStructuredNotes asks the model for structured notes and sends a
+// repair prompt with the validation problems while the output is invalid.
+// This is shared across all AI service implementations.
+func generateStructuredNotes(ctx context.Context, c chunker, changes *git.CategorizedChanges, opts GenerateOptions) (*StructuredNotes, error) {
+	if changes == nil || changes.TotalCount() == 0 {
+		return nil, errors.AI("GenerateStructuredNotes", "no changes to generate notes from")
+	}
+
+	systemPrompt := buildSystemPrompt(c.prompts.structuredSystem, opts)
+	changesText, err := c.changesContent(ctx, changes, systemPrompt, c.prompts.structuredUser, opts)
+	if err != nil {
+		return nil, err
+	}
+	userPrompt := buildUserPrompt(c.prompts.structuredUser, changesText, opts)
+
+	prompt := userPrompt
+	var problems []string
+	for attempt := 0; attempt <= maxStructuredRepairs; attempt++ {
+		raw, err := c.complete(ctx, systemPrompt, prompt)
+		if err != nil {
+			return nil, err
+		}
+
+		var notes *StructuredNotes
+		notes, problems = ParseStructuredNotes(raw, changes)
+		if notes != nil {
+			return notes, nil
+		}
+		prompt = buildRepairPrompt(userPrompt, raw, problems)
+	}
+
+	return nil, errors.AIWrap(
+		fmt.Errorf("%w: %s", ErrInvalidStructuredOutput, strings.Join(problems, "; ")),
+		"GenerateStructuredNotes",
+		fmt.Sprintf("invalid output after %d repair attempts", maxStructuredRepairs))
+}
+
+// buildRepairPrompt repeats the original request and asks the model to fix
+// its previous response.
+func buildRepairPrompt(userPrompt, previous string, problems []string) string {
+	var b strings.Builder
+	b.WriteString(userPrompt)
+	b.WriteString("\n\nYour previous response did not match the required JSON schema:\n")
+	for _, p := range problems {
+		b.WriteString("- ")
+		b.WriteString(p)
+		b.WriteByte('\n')
+	}
+	b.WriteString("\nPrevious response:\n")
+	b.WriteString(previous)
+	b.WriteString("\n\nReturn the corrected JSON object only, without markdown fences or commentary.")
+	return b.String()
+}
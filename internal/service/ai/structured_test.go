@@ -0,0 +1,134 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
+)
+
+func TestParseStructuredNotes(t *testing.T) {
+	breaking := &git.CategorizedChanges{Breaking: []git.ConventionalCommit{{Description: "drop v1 api", Breaking: true}}}
+
+	tests := []struct {
+		name        string
+		raw         string
+		changes     *git.CategorizedChanges
+		wantProblem string
+	}{
+		{
+			name: "valid",
+			raw:  `{"title":"Release 2.0.0","summary":"Faster.","sections":[{"title":"Features","items":["search"]}]}`,
+		},
+		{
+			name: "code fence",
+			raw:  "```json\n{\"title\":\"Release 2.0.0\",\"summary\":\"Faster.\"}\n```",
+		},
+		{
+			name:        "not json",
+			raw:         "Here are your release notes!",
+			wantProblem: "not a valid JSON object",
+		},
+		{
+			name:        "unknown field",
+			raw:         `{"title":"Release","summary":"Faster.","footer":"bye"}`,
+			wantProblem: `unknown field "footer"`,
+		},
+		{
+			name:        "missing summary",
+			raw:         `{"title":"Release"}`,
+			wantProblem: "summary is required",
+		},
+		{
+			name:        "empty section",
+			raw:         `{"title":"Release","summary":"Faster.","sections":[{"title":"Fixes","items":[]}]}`,
+			wantProblem: "sections[0].items must not be empty",
+		},
+		{
+			name:        "migration without guidance",
+			raw:         `{"title":"Release","summary":"Faster.","breaking_changes":[{"change":"drop v1 api","migration":""}]}`,
+			changes:     breaking,
+			wantProblem: "breaking_changes[0].migration is required",
+		},
+		{
+			name:        "breaking changes omitted",
+			raw:         `{"title":"Release","summary":"Faster."}`,
+			changes:     breaking,
+			wantProblem: "breaking_changes must describe the 1 breaking change(s)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notes, problems := ParseStructuredNotes(tt.raw, tt.changes)
+			if tt.wantProblem == "" {
+				if notes == nil {
+					t.Fatalf("ParseStructuredNotes() problems = %v, want valid notes", problems)
+				}
+				return
+			}
+			if notes != nil {
+				t.Fatalf("ParseStructuredNotes() = %+v, want problem %q", notes, tt.wantProblem)
+			}
+			if !strings.Contains(strings.Join(problems, "\n"), tt.wantProblem) {
+				t.Errorf("problems = %v, want %q", problems, tt.wantProblem)
+			}
+		})
+	}
+}
+
+func TestGenerateStructuredNotes_Repairs(t *testing.T) {
+	feature := git.ConventionalCommit{Description: "add search"}
+	changes := &git.CategorizedChanges{Features: []git.ConventionalCommit{feature}, All: []git.ConventionalCommit{feature}}
+	responses := []string{
+		`{"title":"Release 1.1.0"}`,
+		`{"title":"Release 1.1.0","summary":"Search is here."}`,
+	}
+
+	var prompts []string
+	complete := func(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+		if !strings.Contains(systemPrompt, `"breaking_changes"`) {
+			t.Error("system prompt should include the schema")
+		}
+		prompts = append(prompts, userPrompt)
+		return responses[len(prompts)-1], nil
+	}
+
+	notes, err := generateStructuredNotes(context.Background(), newChunker(ServiceConfig{}, complete, newDefaultPromptTemplates()), changes, DefaultGenerateOptions())
+	if err != nil {
+		t.Fatalf("generateStructuredNotes() error = %v", err)
+	}
+	if notes.Summary != "Search is here." {
+		t.Errorf("Summary = %q", notes.Summary)
+	}
+	if len(prompts) != 2 {
+		t.Fatalf("made %d calls, want 2", len(prompts))
+	}
+	for _, want := range []string{"add search", "summary is required", `{"title":"Release 1.1.0"}`} {
+		if !strings.Contains(prompts[1], want) {
+			t.Errorf("repair prompt should contain %q:\n%s", want, prompts[1])
+		}
+	}
+}
+
+func TestGenerateStructuredNotes_GivesUp(t *testing.T) {
+	feature := git.ConventionalCommit{Description: "add search"}
+	changes := &git.CategorizedChanges{Features: []git.ConventionalCommit{feature}, All: []git.ConventionalCommit{feature}}
+
+	calls := 0
+	complete := func(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+		calls++
+		return "not json", nil
+	}
+
+	_, err := generateStructuredNotes(context.Background(), newChunker(ServiceConfig{}, complete, newDefaultPromptTemplates()), changes, DefaultGenerateOptions())
+	if !stderrors.Is(err, ErrInvalidStructuredOutput) {
+		t.Errorf("error = %v, want ErrInvalidStructuredOutput", err)
+	}
+	if calls != maxStructuredRepairs+1 {
+		t.Errorf("made %d calls, want %d", calls, maxStructuredRepairs+1)
+	}
+}
@@ -0,0 +1,42 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+
+	"github.com/felixgeelhaar/release-pilot/internal/errors"
+)
+
+// LanguageName returns the English name of a locale such as "de" or
+// "pt-BR", for use as GenerateOptions.Language. Unknown locales are
+// returned unchanged.
+func LanguageName(locale string) string {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return locale
+	}
+	if name := display.English.Tags().Name(tag); name != "" {
+		return name
+	}
+	return locale
+}
+
+// translate translates text into opts.Language with a provider's complete
+// function. Blank text is returned as is.
+func translate(ctx context.Context, complete completeFunc, prompts promptTemplates, text string, opts GenerateOptions) (string, error) {
+	if strings.TrimSpace(text) == "" {
+		return text, nil
+	}
+	if opts.Language == "" {
+		return "", errors.AI("Translate", "target language is required")
+	}
+
+	systemPrompt := strings.ReplaceAll(prompts.translateSystem, LanguagePlaceholder, opts.Language)
+	userPrompt := buildUserPrompt(strings.ReplaceAll(prompts.translateUser, LanguagePlaceholder, opts.Language), text, opts)
+
+	return complete(ctx, systemPrompt, userPrompt)
+}
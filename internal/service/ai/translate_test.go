@@ -0,0 +1,74 @@
+// Package ai provides AI-powered content generation for ReleasePilot.
+package ai
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestLanguageName(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{"en", "English"},
+		{"de", "German"},
+		{"fr", "French"},
+		{"ja", "Japanese"},
+		{"pt-BR", "Brazilian Portuguese"},
+		{"not a locale", "not a locale"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			if got := LanguageName(tt.locale); got != tt.want {
+				t.Errorf("LanguageName(%q) = %q, want %q", tt.locale, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	var system, user string
+	complete := func(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+		system, user = systemPrompt, userPrompt
+		return "## Neue Funktionen\n\n- Suche", nil
+	}
+	opts := DefaultGenerateOptions()
+	opts.Language = "German"
+	opts.ProductName = "Acme"
+
+	got, err := translate(context.Background(), complete, newDefaultPromptTemplates(), "## New Features\n\n- Search", opts)
+	if err != nil {
+		t.Fatalf("translate() error = %v", err)
+	}
+	if got != "## Neue Funktionen\n\n- Suche" {
+		t.Errorf("translate() = %q", got)
+	}
+	if !strings.Contains(system, "into German") || strings.Contains(system, "{{LANGUAGE}}") {
+		t.Errorf("system prompt does not name the language: %q", system)
+	}
+	if !strings.Contains(user, "for Acme into German") || !strings.Contains(user, "- Search") {
+		t.Errorf("user prompt = %q", user)
+	}
+}
+
+func TestTranslate_SkipsBlankTextAndRequiresLanguage(t *testing.T) {
+	calls := 0
+	complete := func(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+		calls++
+		return "", nil
+	}
+	prompts := newDefaultPromptTemplates()
+
+	if got, err := translate(context.Background(), complete, prompts, "  ", GenerateOptions{Language: "German"}); err != nil || got != "  " {
+		t.Errorf("translate(blank) = %q, %v", got, err)
+	}
+	if _, err := translate(context.Background(), complete, prompts, "Search", GenerateOptions{}); err == nil {
+		t.Error("translate() without a language should fail")
+	}
+	if calls != 0 {
+		t.Errorf("complete called %d times, want 0", calls)
+	}
+}
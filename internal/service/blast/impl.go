@@ -60,6 +60,9 @@ var (
 	}
 )
 
+// pythonRequirementName extracts the distribution name from a PEP 508 requirement.
+var pythonRequirementName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*`)
+
 // serviceImpl implements the Service interface.
 type serviceImpl struct {
 	config *ServiceConfig
@@ -643,6 +646,7 @@ func (s *serviceImpl) detectPythonPackage(dir, relPath string) *Package {
 		if err := toml.Unmarshal(data, &pyproject); err == nil {
 			name := filepath.Base(dir)
 			version := ""
+			var deps []string
 
 			if project, ok := pyproject["project"].(map[string]any); ok {
 				if n, ok := project["name"].(string); ok {
@@ -651,13 +655,23 @@ func (s *serviceImpl) detectPythonPackage(dir, relPath string) *Package {
 				if v, ok := project["version"].(string); ok {
 					version = v
 				}
+				if requirements, ok := project["dependencies"].([]any); ok {
+					for _, r := range requirements {
+						if req, ok := r.(string); ok {
+							if dep := pythonRequirementName.FindString(strings.TrimSpace(req)); dep != "" {
+								deps = append(deps, dep)
+							}
+						}
+					}
+				}
 			}
 
 			return &Package{
-				Name:    name,
-				Path:    relPath,
-				Type:    PackageTypePython,
-				Version: version,
+				Name:         name,
+				Path:         relPath,
+				Type:         PackageTypePython,
+				Version:      version,
+				Dependencies: deps,
 			}
 		}
 	}
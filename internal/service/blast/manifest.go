@@ -0,0 +1,135 @@
+// Package blast provides blast radius analysis for monorepos.
+package blast
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ManifestFile returns the manifest file name for a package type,
+// or an empty string if the type has no supported manifest.
+func ManifestFile(pkgType PackageType) string {
+	switch pkgType {
+	case PackageTypeNPM:
+		return "package.json"
+	case PackageTypeGoModule:
+		return "go.mod"
+	case PackageTypeCargo:
+		return "Cargo.toml"
+	case PackageTypePython:
+		return "pyproject.toml"
+	default:
+		return ""
+	}
+}
+
+// PinsDependency reports whether the manifest of pkg references an internal
+// dependency by version. Dependencies referenced only by path or workspace
+// protocol (e.g., "workspace:*") are not pinned and need no version bump.
+func PinsDependency(repoRoot string, pkg *Package, dependency string) (bool, error) {
+	content, ok, err := readManifest(repoRoot, pkg)
+	if err != nil || !ok || dependency == "" {
+		return false, err
+	}
+
+	for _, re := range dependencyVersionPatterns(pkg.Type, dependency) {
+		if re.MatchString(content) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// UpdateDependencyVersion rewrites the version an internal dependency is pinned to
+// in the manifest of pkg. The manifest is edited in place so its formatting and
+// range operators (e.g., "^", "~", ">=") are preserved.
+// It returns false if the manifest does not reference the dependency by version.
+func UpdateDependencyVersion(repoRoot string, pkg *Package, dependency, version string) (bool, error) {
+	content, ok, err := readManifest(repoRoot, pkg)
+	if err != nil || !ok || dependency == "" {
+		return false, err
+	}
+
+	updated := rewriteDependencyVersion(pkg.Type, content, dependency, version)
+	if updated == content {
+		return false, nil
+	}
+
+	path := filepath.Join(repoRoot, pkg.Path, ManifestFile(pkg.Type))
+	if err := os.WriteFile(path, []byte(updated), 0o644); err != nil {
+		return false, fmt.Errorf("failed to write %s: %w", ManifestFile(pkg.Type), err)
+	}
+
+	return true, nil
+}
+
+// readManifest reads the manifest of pkg. It returns false if the package type
+// has no supported manifest or the manifest does not exist.
+func readManifest(repoRoot string, pkg *Package) (string, bool, error) {
+	manifest := ManifestFile(pkg.Type)
+	if manifest == "" {
+		return "", false, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(repoRoot, pkg.Path, manifest))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("failed to read %s: %w", manifest, err)
+	}
+	return string(data), true, nil
+}
+
+// rewriteDependencyVersion replaces the pinned version of dependency in manifest content.
+func rewriteDependencyVersion(pkgType PackageType, content, dependency, version string) string {
+	version = strings.TrimPrefix(version, "v")
+	if pkgType == PackageTypeGoModule {
+		version = "v" + version
+	}
+
+	for _, re := range dependencyVersionPatterns(pkgType, dependency) {
+		content = re.ReplaceAllString(content, "${1}"+version+"${2}")
+	}
+	return content
+}
+
+// dependencyVersionPatterns returns the patterns matching a version pin of
+// dependency in a manifest. The first group is the text before the version;
+// the optional second group is the text after it.
+func dependencyVersionPatterns(pkgType PackageType, dependency string) []*regexp.Regexp {
+	dep := regexp.QuoteMeta(dependency)
+
+	switch pkgType {
+	case PackageTypeNPM:
+		// "dep": "^1.2.3" (workspace:, file: and * references are not pinned)
+		return []*regexp.Regexp{
+			regexp.MustCompile(`("` + dep + `"\s*:\s*"(?:\^|~|>=|=)?)\d+\.\d+\.\d+[^"]*(")`),
+		}
+
+	case PackageTypeGoModule:
+		// require dep v1.2.3, either single-line or inside a require block
+		return []*regexp.Regexp{
+			regexp.MustCompile(`(?m)^(\s*(?:require\s+)?` + dep + `\s+)v\d+\.\d+\.\d+\S*`),
+		}
+
+	case PackageTypeCargo:
+		// dep = "1.2.3" or dep = { version = "1.2.3", path = "..." }
+		return []*regexp.Regexp{
+			regexp.MustCompile(`(?m)^(\s*` + dep + `\s*=\s*"(?:\^|~|=|>=)?)\d+[^"]*(")`),
+			regexp.MustCompile(`(?m)^(\s*` + dep + `\s*=\s*\{[^}\n]*version\s*=\s*"(?:\^|~|=|>=)?)\d+[^"]*(")`),
+		}
+
+	case PackageTypePython:
+		// "dep>=1.2.3" inside the project dependencies list
+		return []*regexp.Regexp{
+			regexp.MustCompile(`(?i)(["']` + dep + `(?:\[[^\]]*\])?\s*(?:==|>=|~=|===)\s*)\d+[^"',;\s]*`),
+		}
+
+	default:
+		return nil
+	}
+}
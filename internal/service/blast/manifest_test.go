@@ -0,0 +1,159 @@
+package blast
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRewriteDependencyVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		pkgType    PackageType
+		content    string
+		dependency string
+		want       string
+	}{
+		{
+			name:       "npm caret range",
+			pkgType:    PackageTypeNPM,
+			content:    `{"name": "web", "dependencies": {"@acme/api": "^1.2.0", "react": "^18.0.0"}}`,
+			dependency: "@acme/api",
+			want:       `{"name": "web", "dependencies": {"@acme/api": "^1.3.0", "react": "^18.0.0"}}`,
+		},
+		{
+			name:       "npm workspace reference is not pinned",
+			pkgType:    PackageTypeNPM,
+			content:    `{"dependencies": {"@acme/api": "workspace:*"}}`,
+			dependency: "@acme/api",
+			want:       `{"dependencies": {"@acme/api": "workspace:*"}}`,
+		},
+		{
+			name:       "go require block",
+			pkgType:    PackageTypeGoModule,
+			content:    "module example.com/web\n\nrequire (\n\texample.com/api v1.2.0\n\texample.com/apiclient v0.1.0\n)\n",
+			dependency: "example.com/api",
+			want:       "module example.com/web\n\nrequire (\n\texample.com/api v1.3.0\n\texample.com/apiclient v0.1.0\n)\n",
+		},
+		{
+			name:       "go single require",
+			pkgType:    PackageTypeGoModule,
+			content:    "module example.com/web\n\nrequire example.com/api v1.2.0 // indirect\n",
+			dependency: "example.com/api",
+			want:       "module example.com/web\n\nrequire example.com/api v1.3.0 // indirect\n",
+		},
+		{
+			name:       "cargo simple and inline table",
+			pkgType:    PackageTypeCargo,
+			content:    "[dependencies]\napi = \"1.2.0\"\napi-macros = { version = \"1.2.0\", path = \"../macros\" }\n",
+			dependency: "api",
+			want:       "[dependencies]\napi = \"1.3.0\"\napi-macros = { version = \"1.2.0\", path = \"../macros\" }\n",
+		},
+		{
+			name:       "cargo inline table",
+			pkgType:    PackageTypeCargo,
+			content:    "[dependencies]\napi = { path = \"../api\", version = \"~1.2\" }\n",
+			dependency: "api",
+			want:       "[dependencies]\napi = { path = \"../api\", version = \"~1.3.0\" }\n",
+		},
+		{
+			name:       "python requirement",
+			pkgType:    PackageTypePython,
+			content:    "[project]\ndependencies = [\"acme-api>=1.2.0\", \"requests==2.31.0\"]\n",
+			dependency: "acme-api",
+			want:       "[project]\ndependencies = [\"acme-api>=1.3.0\", \"requests==2.31.0\"]\n",
+		},
+		{
+			name:       "unsupported type is unchanged",
+			pkgType:    PackageTypeDirectory,
+			content:    "api 1.2.0",
+			dependency: "api",
+			want:       "api 1.2.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rewriteDependencyVersion(tt.pkgType, tt.content, tt.dependency, "1.3.0")
+			if got != tt.want {
+				t.Errorf("rewriteDependencyVersion() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateDependencyVersion(t *testing.T) {
+	tmpDir := t.TempDir()
+	pkgDir := filepath.Join(tmpDir, "packages", "web")
+	if err := os.MkdirAll(pkgDir, 0755); err != nil {
+		t.Fatalf("Failed to create package dir: %v", err)
+	}
+	manifest := filepath.Join(pkgDir, "package.json")
+	if err := os.WriteFile(manifest, []byte(`{"dependencies": {"api": "~1.0.0"}}`), 0644); err != nil {
+		t.Fatalf("Failed to write package.json: %v", err)
+	}
+
+	pkg := &Package{Name: "web", Path: "packages/web", Type: PackageTypeNPM}
+
+	changed, err := UpdateDependencyVersion(tmpDir, pkg, "api", "v1.1.0")
+	if err != nil {
+		t.Fatalf("UpdateDependencyVersion() error = %v", err)
+	}
+	if !changed {
+		t.Error("UpdateDependencyVersion() changed = false, want true")
+	}
+
+	data, err := os.ReadFile(manifest)
+	if err != nil {
+		t.Fatalf("Failed to read package.json: %v", err)
+	}
+	if string(data) != `{"dependencies": {"api": "~1.1.0"}}` {
+		t.Errorf("package.json = %s", data)
+	}
+
+	changed, err = UpdateDependencyVersion(tmpDir, pkg, "other", "1.1.0")
+	if err != nil {
+		t.Fatalf("UpdateDependencyVersion() error = %v", err)
+	}
+	if changed {
+		t.Error("UpdateDependencyVersion() changed = true for unknown dependency")
+	}
+}
+
+func TestPinsDependency(t *testing.T) {
+	tmpDir := t.TempDir()
+	write := func(dir, content string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Join(tmpDir, dir), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(tmpDir, dir, "package.json"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("pinned", `{"dependencies": {"api": "^1.2.0"}}`)
+	write("workspace", `{"dependencies": {"api": "workspace:*"}}`)
+
+	tests := []struct {
+		name string
+		pkg  *Package
+		want bool
+	}{
+		{"pinned", &Package{Path: "pinned", Type: PackageTypeNPM}, true},
+		{"workspace reference", &Package{Path: "workspace", Type: PackageTypeNPM}, false},
+		{"missing manifest", &Package{Path: "missing", Type: PackageTypeNPM}, false},
+		{"unsupported type", &Package{Path: "pinned", Type: PackageTypeDirectory}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PinsDependency(tmpDir, tt.pkg, "api")
+			if err != nil {
+				t.Fatalf("PinsDependency() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("PinsDependency() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -237,6 +237,120 @@ func (s *ServiceImpl) GetCommit(_ context.Context, hash string) (*Commit, error)
 	return s.convertCommit(commitObj), nil
 }
 
+// GetCommitFiles returns the paths of files changed by a commit.
+// For merge commits, changes are computed against the first parent.
+func (s *ServiceImpl) GetCommitFiles(_ context.Context, hash string) ([]string, error) {
+	const op = "git.GetCommitFiles"
+
+	commitObj, err := s.repo.CommitObject(plumbing.NewHash(hash))
+	if err != nil {
+		return nil, rperrors.GitWrap(err, op, "failed to get commit")
+	}
+
+	tree, err := commitObj.Tree()
+	if err != nil {
+		return nil, rperrors.GitWrap(err, op, "failed to get commit tree")
+	}
+
+	// Root commit: every file in the tree was added
+	if commitObj.NumParents() == 0 {
+		var files []string
+		err = tree.Files().ForEach(func(f *object.File) error {
+			files = append(files, f.Name)
+			return nil
+		})
+		if err != nil {
+			return nil, rperrors.GitWrap(err, op, "failed to list tree files")
+		}
+		return files, nil
+	}
+
+	parent, err := commitObj.Parent(0)
+	if err != nil {
+		return nil, rperrors.GitWrap(err, op, "failed to get parent commit")
+	}
+
+	parentTree, err := parent.Tree()
+	if err != nil {
+		return nil, rperrors.GitWrap(err, op, "failed to get parent tree")
+	}
+
+	changes, err := parentTree.Diff(tree)
+	if err != nil {
+		return nil, rperrors.GitWrap(err, op, "failed to compute diff")
+	}
+
+	files := make([]string, 0, len(changes))
+	for _, change := range changes {
+		// Deleted files only have a From side, added files only a To side
+		name := change.To.Name
+		if name == "" {
+			name = change.From.Name
+		}
+		files = append(files, name)
+		if change.From.Name != "" && change.From.Name != change.To.Name && change.To.Name != "" {
+			files = append(files, change.From.Name)
+		}
+	}
+
+	return files, nil
+}
+
+// GetCommitDiff returns the unified diff of a commit, limited to the given
+// paths unless paths is empty. For merge commits, the diff is computed
+// against the first parent.
+func (s *ServiceImpl) GetCommitDiff(ctx context.Context, hash string, paths []string) (string, error) {
+	const op = "git.GetCommitDiff"
+
+	commitObj, err := s.repo.CommitObject(plumbing.NewHash(hash))
+	if err != nil {
+		return "", rperrors.GitWrap(err, op, "failed to get commit")
+	}
+
+	tree, err := commitObj.Tree()
+	if err != nil {
+		return "", rperrors.GitWrap(err, op, "failed to get commit tree")
+	}
+
+	// Root commit: diff against the empty tree
+	var parentTree *object.Tree
+	if commitObj.NumParents() > 0 {
+		parent, err := commitObj.Parent(0)
+		if err != nil {
+			return "", rperrors.GitWrap(err, op, "failed to get parent commit")
+		}
+		parentTree, err = parent.Tree()
+		if err != nil {
+			return "", rperrors.GitWrap(err, op, "failed to get parent tree")
+		}
+	}
+
+	changes, err := object.DiffTreeWithOptions(ctx, parentTree, tree, nil)
+	if err != nil {
+		return "", rperrors.GitWrap(err, op, "failed to compute diff")
+	}
+
+	wanted := make(map[string]bool, len(paths))
+	for _, p := range paths {
+		wanted[p] = true
+	}
+	selected := make(object.Changes, 0, len(changes))
+	for _, change := range changes {
+		if len(wanted) == 0 || wanted[change.From.Name] || wanted[change.To.Name] {
+			selected = append(selected, change)
+		}
+	}
+	if len(selected) == 0 {
+		return "", nil
+	}
+
+	patch, err := selected.PatchContext(ctx)
+	if err != nil {
+		return "", rperrors.GitWrap(err, op, "failed to compute patch")
+	}
+	return patch.String(), nil
+}
+
 // GetCommitsSince returns all commits since the given reference.
 func (s *ServiceImpl) GetCommitsSince(ctx context.Context, ref string) ([]Commit, error) {
 	const op = "git.GetCommitsSince"
@@ -590,6 +704,39 @@ func (s *ServiceImpl) PushTag(ctx context.Context, name string, opts PushOptions
 	return nil
 }
 
+// DeleteRemoteTag deletes a tag from the remote.
+func (s *ServiceImpl) DeleteRemoteTag(ctx context.Context, name string, opts PushOptions) error {
+	const op = "git.DeleteRemoteTag"
+
+	if opts.DryRun {
+		return nil // Dry run, don't actually push
+	}
+
+	remote := opts.Remote
+	if remote == "" {
+		remote = s.cfg.DefaultRemote
+	}
+
+	refSpec := config.RefSpec(fmt.Sprintf(":refs/tags/%s", name))
+
+	err := s.repo.Push(&git.PushOptions{
+		RemoteName: remote,
+		RefSpecs:   []config.RefSpec{refSpec},
+		Auth:       s.auth,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		if s.cfg.UseCLIFallback && isGitCLIAvailable() {
+			if fallbackErr := s.deleteRemoteTagFallback(ctx, name, remote); fallbackErr != nil {
+				return rperrors.GitWrap(err, op, fmt.Sprintf("failed to delete remote tag %s (CLI fallback also failed: %v)", name, fallbackErr))
+			}
+			return nil
+		}
+		return rperrors.GitWrap(err, op, fmt.Sprintf("failed to delete remote tag %s", name))
+	}
+
+	return nil
+}
+
 // isGitCLIAvailable checks if the git CLI is available on the system.
 var isGitCLIAvailable = func() bool {
 	_, err := exec.LookPath("git")
@@ -619,6 +766,23 @@ func (s *ServiceImpl) pushTagFallback(ctx context.Context, name, remote string,
 	return nil
 }
 
+// deleteRemoteTagFallback uses git CLI to delete a remote tag when go-git fails.
+func (s *ServiceImpl) deleteRemoteTagFallback(ctx context.Context, name, remote string) error {
+	repoRoot, err := s.GetRepositoryRoot(ctx)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "push", remote, "--delete", fmt.Sprintf("refs/tags/%s", name))
+	cmd.Dir = repoRoot
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git push --delete failed: %w\noutput: %s", err, string(output))
+	}
+
+	return nil
+}
+
 // GetCurrentBranch returns the current branch name.
 func (s *ServiceImpl) GetCurrentBranch(_ context.Context) (string, error) {
 	const op = "git.GetCurrentBranch"
@@ -5,6 +5,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -260,6 +261,128 @@ func TestGetCommit(t *testing.T) {
 	})
 }
 
+// TestGetCommitFiles tests listing the files changed by a commit.
+func TestGetCommitFiles(t *testing.T) {
+	helper := newTestRepo(t)
+	rootHash := helper.makeCommit("Initial commit")
+
+	// Add a file in a subdirectory for the second commit
+	apiDir := filepath.Join(helper.repoDir, "services", "api")
+	if err := os.MkdirAll(apiDir, 0755); err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(apiDir, "main.go"), []byte("package main\n"), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	worktree, err := helper.repo.Worktree()
+	if err != nil {
+		t.Fatalf("failed to get worktree: %v", err)
+	}
+	if _, err := worktree.Add("services/api/main.go"); err != nil {
+		t.Fatalf("failed to stage file: %v", err)
+	}
+	apiHash, err := worktree.Commit("feat(api): add api", &git.CommitOptions{
+		Author: &object.Signature{Name: "Test Author", Email: "test@example.com", When: time.Now()},
+	})
+	if err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+
+	svc, err := NewService(WithRepoPath(helper.repoDir))
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+
+	ctx := context.Background()
+
+	t.Run("root commit lists all files", func(t *testing.T) {
+		files, err := svc.GetCommitFiles(ctx, rootHash)
+		if err != nil {
+			t.Fatalf("GetCommitFiles() error = %v", err)
+		}
+		if len(files) != 1 || files[0] != "test.txt" {
+			t.Errorf("GetCommitFiles() = %v, want [test.txt]", files)
+		}
+	})
+
+	t.Run("commit lists changed files only", func(t *testing.T) {
+		files, err := svc.GetCommitFiles(ctx, apiHash.String())
+		if err != nil {
+			t.Fatalf("GetCommitFiles() error = %v", err)
+		}
+		if len(files) != 1 || files[0] != "services/api/main.go" {
+			t.Errorf("GetCommitFiles() = %v, want [services/api/main.go]", files)
+		}
+	})
+
+	t.Run("error on invalid hash", func(t *testing.T) {
+		if _, err := svc.GetCommitFiles(ctx, "invalid"); err == nil {
+			t.Error("GetCommitFiles() should return error for invalid hash")
+		}
+	})
+}
+
+func TestGetCommitDiff(t *testing.T) {
+	helper := newTestRepo(t)
+	rootHash := helper.makeCommit("Initial commit")
+
+	worktree, err := helper.repo.Worktree()
+	if err != nil {
+		t.Fatalf("failed to get worktree: %v", err)
+	}
+	for name, content := range map[string]string{
+		"api.go":    "package api\n\nfunc Load(path string) error { return nil }\n",
+		"README.md": "# API\n",
+	} {
+		if err := os.WriteFile(filepath.Join(helper.repoDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+		if _, err := worktree.Add(name); err != nil {
+			t.Fatalf("failed to stage file: %v", err)
+		}
+	}
+	apiHash, err := worktree.Commit("feat!: change Load", &git.CommitOptions{
+		Author: &object.Signature{Name: "Test Author", Email: "test@example.com", When: time.Now()},
+	})
+	if err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+
+	svc, err := NewService(WithRepoPath(helper.repoDir))
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+
+	ctx := context.Background()
+
+	t.Run("limited to paths", func(t *testing.T) {
+		diff, err := svc.GetCommitDiff(ctx, apiHash.String(), []string{"api.go"})
+		if err != nil {
+			t.Fatalf("GetCommitDiff() error = %v", err)
+		}
+		if !strings.Contains(diff, "+func Load(path string) error") || strings.Contains(diff, "README.md") {
+			t.Errorf("GetCommitDiff() = %q, want the api.go diff only", diff)
+		}
+	})
+
+	t.Run("root commit", func(t *testing.T) {
+		diff, err := svc.GetCommitDiff(ctx, rootHash, nil)
+		if err != nil {
+			t.Fatalf("GetCommitDiff() error = %v", err)
+		}
+		if !strings.Contains(diff, "test.txt") {
+			t.Errorf("GetCommitDiff() = %q, want the added test.txt", diff)
+		}
+	})
+
+	t.Run("no matching paths", func(t *testing.T) {
+		diff, err := svc.GetCommitDiff(ctx, apiHash.String(), []string{"other.go"})
+		if err != nil || diff != "" {
+			t.Errorf("GetCommitDiff() = %q, %v, want an empty diff", diff, err)
+		}
+	})
+}
+
 // TestGetHeadCommit tests getting the HEAD commit.
 func TestGetHeadCommit(t *testing.T) {
 	helper := newTestRepo(t)
@@ -758,6 +881,29 @@ func TestPushTag(t *testing.T) {
 	})
 }
 
+// TestDeleteRemoteTag tests deleting remote tags (dry run only).
+func TestDeleteRemoteTag(t *testing.T) {
+	helper := newTestRepo(t)
+	helper.makeCommit("Initial commit")
+
+	svc, err := NewService(WithRepoPath(helper.repoDir))
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+
+	ctx := context.Background()
+
+	t.Run("dry run delete", func(t *testing.T) {
+		opts := DefaultPushOptions()
+		opts.DryRun = true
+
+		err := svc.DeleteRemoteTag(ctx, "v1.0.0", opts)
+		if err != nil {
+			t.Fatalf("DeleteRemoteTag() error = %v", err)
+		}
+	})
+}
+
 // TestGetRepositoryInfo tests getting repository information.
 func TestGetRepositoryInfo(t *testing.T) {
 	helper := newTestRepo(t)
@@ -23,6 +23,14 @@ type Service interface {
 	// GetCommit returns a specific commit by hash.
 	GetCommit(ctx context.Context, hash string) (*Commit, error)
 
+	// GetCommitFiles returns the paths of files changed by a commit,
+	// relative to the repository root.
+	GetCommitFiles(ctx context.Context, hash string) ([]string, error)
+
+	// GetCommitDiff returns the unified diff of a commit, limited to the
+	// given paths unless paths is empty.
+	GetCommitDiff(ctx context.Context, hash string, paths []string) (string, error)
+
 	// GetCommitsSince returns all commits since the given reference.
 	GetCommitsSince(ctx context.Context, ref string) ([]Commit, error)
 
@@ -61,6 +69,9 @@ type Service interface {
 	// PushTag pushes a tag to the remote.
 	PushTag(ctx context.Context, name string, opts PushOptions) error
 
+	// DeleteRemoteTag deletes a tag from the remote.
+	DeleteRemoteTag(ctx context.Context, name string, opts PushOptions) error
+
 	// Branch operations
 
 	// GetCurrentBranch returns the current branch name.
@@ -10,6 +10,7 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"sync"
 	"text/template"
@@ -18,6 +19,7 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
 	rperrors "github.com/felixgeelhaar/release-pilot/internal/errors"
 	"github.com/felixgeelhaar/release-pilot/internal/service/git"
 	"github.com/felixgeelhaar/release-pilot/internal/service/version"
@@ -516,6 +518,12 @@ func lenFunc(v any) int {
 		return len(val)
 	case map[string]any:
 		return len(val)
+	}
+
+	// Typed slices and maps, e.g. the commits of CategorizedChanges
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.String:
+		return rv.Len()
 	default:
 		return 0
 	}
@@ -587,6 +595,8 @@ type ReleaseNotesData struct {
 	Contributors []string
 	// RepositoryURL is the repository URL.
 	RepositoryURL string
+	// PluginOutputs are the outputs of the plugins run so far, by plugin name.
+	PluginOutputs map[string]PluginOutputData
 }
 
 // MarketingData contains data for marketing blurb templates.
@@ -603,4 +613,123 @@ type MarketingData struct {
 	ProductName string
 	// ReleaseURL is the URL to the release.
 	ReleaseURL string
+	// PluginOutputs are the outputs of the plugins run so far, by plugin name.
+	PluginOutputs map[string]PluginOutputData
+}
+
+// PluginOutputData contains the outputs and artifacts a plugin reported,
+// e.g. {{ .PluginOutputs.github.Outputs.release_url }} or
+// {{ .PluginOutputs.github.Artifact "app.tar.gz" }}.
+type PluginOutputData struct {
+	// Outputs are the values the plugin reported, by key.
+	Outputs map[string]any
+	// Artifacts are the artifacts the plugin produced or published.
+	Artifacts []PluginArtifactData
+}
+
+// PluginArtifactData contains one artifact of a plugin.
+type PluginArtifactData struct {
+	// Name is the artifact name.
+	Name string
+	// Path is the local path of the artifact, if it is a file.
+	Path string
+	// Type is the artifact type.
+	Type string
+	// Size is the artifact size in bytes.
+	Size int64
+	// URL is where the artifact was published, if anywhere.
+	URL string
+}
+
+// Artifact returns the URL of the named artifact, or its local path if it
+// was not published. It returns "" if the plugin reported no such artifact.
+func (o PluginOutputData) Artifact(name string) string {
+	for _, a := range o.Artifacts {
+		if a.Name == name {
+			if a.URL != "" {
+				return a.URL
+			}
+			return a.Path
+		}
+	}
+	return ""
+}
+
+// NewPluginOutputsData converts the plugin outputs recorded on a release to
+// template data.
+func NewPluginOutputsData(outputs map[string]release.PluginOutput) map[string]PluginOutputData {
+	if len(outputs) == 0 {
+		return nil
+	}
+	data := make(map[string]PluginOutputData, len(outputs))
+	for name, o := range outputs {
+		d := PluginOutputData{Outputs: o.Outputs}
+		for _, a := range o.Artifacts {
+			d.Artifacts = append(d.Artifacts, PluginArtifactData(a))
+		}
+		data[name] = d
+	}
+	return data
+}
+
+// MigrationGuideData contains data for migration guide templates.
+type MigrationGuideData struct {
+	// Version is the formatted release version.
+	Version string
+	// PreviousVersion is the formatted version users upgrade from.
+	PreviousVersion string
+	// Date is the release date.
+	Date time.Time
+	// CodeLanguage is the info string of the before/after code blocks.
+	CodeLanguage string
+	// Entries are the breaking changes, one per commit.
+	Entries []MigrationEntryData
+}
+
+// MigrationEntryData contains one breaking change of a migration guide.
+type MigrationEntryData struct {
+	// CommitHash is the abbreviated hash of the breaking commit.
+	CommitHash string
+	// Scope is the commit scope.
+	Scope string
+	// Change describes what changed.
+	Change string
+	// Before is the usage before the release.
+	Before string
+	// After is the usage after the release.
+	After string
+	// Migration explains how to migrate.
+	Migration string
+}
+
+// PromptData contains data for AI prompt templates. Content, Part and
+// Language hold placeholders the AI service fills in for each request, so a
+// user template writes {{.Content}} where the changes go.
+type PromptData struct {
+	// Content is the placeholder of the request content.
+	Content string
+	// Part is the placeholder of the chunk position, e.g. "2 of 5".
+	Part string
+	// Language is the placeholder of a translation's target language.
+	Language string
+	// Profile is the selected prompt profile.
+	Profile string
+	// Version is the formatted release version.
+	Version string
+	// PreviousVersion is the formatted version released before.
+	PreviousVersion string
+	// ReleaseType is the type of release (major, minor, patch).
+	ReleaseType string
+	// Date is the date the prompt is rendered.
+	Date time.Time
+	// Changes are the categorized changes of the release.
+	Changes *git.CategorizedChanges
+	// RepositoryName is the repository name.
+	RepositoryName string
+	// RepositoryPath is the repository path.
+	RepositoryPath string
+	// RepositoryURL is the repository URL.
+	RepositoryURL string
+	// Branch is the release branch.
+	Branch string
 }
@@ -6,6 +6,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/felixgeelhaar/release-pilot/internal/domain/release"
+	"github.com/felixgeelhaar/release-pilot/internal/service/git"
 	"github.com/felixgeelhaar/release-pilot/internal/service/version"
 )
 
@@ -523,6 +525,54 @@ func TestMarketingData_Fields(t *testing.T) {
 	}
 }
 
+func TestServiceImpl_RenderString_PluginOutputs(t *testing.T) {
+	svc, err := NewService()
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+
+	data := MarketingData{
+		PluginOutputs: NewPluginOutputsData(map[string]release.PluginOutput{
+			"github": {
+				Outputs: map[string]any{"release_url": "https://github.com/owner/repo/releases/tag/v1.2.0"},
+				Artifacts: []release.PluginArtifact{
+					{Name: "app.tar.gz", Path: "dist/app.tar.gz", URL: "https://github.com/owner/repo/releases/download/v1.2.0/app.tar.gz"},
+					{Name: "checksums.txt", Path: "dist/checksums.txt"},
+				},
+			},
+		}),
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{"output", `{{ .PluginOutputs.github.Outputs.release_url }}`, "https://github.com/owner/repo/releases/tag/v1.2.0"},
+		{"published artifact", `{{ .PluginOutputs.github.Artifact "app.tar.gz" }}`, "https://github.com/owner/repo/releases/download/v1.2.0/app.tar.gz"},
+		{"local artifact", `{{ .PluginOutputs.github.Artifact "checksums.txt" }}`, "dist/checksums.txt"},
+		{"missing artifact", `{{ .PluginOutputs.github.Artifact "app.zip" }}`, ""},
+		{"missing plugin", `{{ with .PluginOutputs.gitlab }}{{ .Outputs }}{{ end }}`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.RenderString(tt.template, data)
+			if err != nil {
+				t.Fatalf("RenderString() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RenderString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewPluginOutputsData_Empty(t *testing.T) {
+	if got := NewPluginOutputsData(nil); got != nil {
+		t.Errorf("NewPluginOutputsData(nil) = %v, want nil", got)
+	}
+}
+
 func TestIndentFunc(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -608,6 +658,7 @@ func TestLenFunc(t *testing.T) {
 		{"slice", []any{1, 2, 3}, 3},
 		{"empty slice", []any{}, 0},
 		{"map", map[string]any{"a": 1, "b": 2}, 2},
+		{"typed slice", []git.ConventionalCommit{{Type: "feat"}, {Type: "fix"}}, 2},
 		{"unsupported type", 123, 0},
 	}
 
@@ -706,3 +757,33 @@ func TestCoalesceFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestRender_MigrationTemplate(t *testing.T) {
+	svc, err := NewService()
+	if err != nil {
+		t.Fatalf("NewService failed: %v", err)
+	}
+
+	got, err := svc.Render("migration", MigrationGuideData{
+		Version:         "2.0.0",
+		PreviousVersion: "1.4.0",
+		CodeLanguage:    "go",
+		Entries: []MigrationEntryData{
+			{CommitHash: "abc1234", Scope: "api", Change: "Run replaces Execute", Before: "c.Execute()", After: "c.Run()", Migration: "Rename calls to Execute."},
+			{CommitHash: "def5678", Change: "Go 1.21 is no longer supported", Migration: "Upgrade to Go 1.22."},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	for _, want := range []string{
+		"## Migrating to 2.0.0\n\n2.0.0 contains breaking changes. Follow the steps below to upgrade from 1.4.0.\n",
+		"### api: Run replaces Execute (abc1234)\n\nBefore:\n\n```go\nc.Execute()\n```\n\nAfter:\n\n```go\nc.Run()\n```\n\nRename calls to Execute.\n",
+		"### Go 1.21 is no longer supported (def5678)\n\nUpgrade to Go 1.22.\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Render() = %q, want it to contain %q", got, want)
+		}
+	}
+}
@@ -32,6 +32,12 @@ func (m *mockGitService) IsClean(_ context.Context) (bool, error) { return true,
 func (m *mockGitService) GetCommit(_ context.Context, _ string) (*git.Commit, error) {
 	return nil, nil
 }
+func (m *mockGitService) GetCommitFiles(_ context.Context, _ string) ([]string, error) {
+	return nil, nil
+}
+func (m *mockGitService) GetCommitDiff(_ context.Context, _ string, _ []string) (string, error) {
+	return "", nil
+}
 func (m *mockGitService) GetCommitsSince(_ context.Context, _ string) ([]git.Commit, error) {
 	return nil, nil
 }
@@ -62,6 +68,9 @@ func (m *mockGitService) PushTag(_ context.Context, name string, _ git.PushOptio
 	m.pushTagName = name
 	return m.pushTagErr
 }
+func (m *mockGitService) DeleteRemoteTag(_ context.Context, _ string, _ git.PushOptions) error {
+	return nil
+}
 func (m *mockGitService) GetCurrentBranch(_ context.Context) (string, error)   { return "main", nil }
 func (m *mockGitService) GetDefaultBranch(_ context.Context) (string, error)   { return "main", nil }
 func (m *mockGitService) ListBranches(_ context.Context) ([]git.Branch, error) { return nil, nil }
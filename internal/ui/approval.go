@@ -40,6 +40,11 @@ type ReleaseSummary struct {
 	OtherCount     int
 	ReleaseNotes   string
 	Plugins        []string
+
+	// Fidelity summarizes the check of AI notes against the commits;
+	// FidelityIssues lists the statements a reviewer should double-check.
+	Fidelity       string
+	FidelityIssues []string
 }
 
 // ApprovalModel is the Bubble Tea model for the approval TUI.
@@ -285,6 +290,12 @@ func (m ApprovalModel) View() string {
 	b.WriteString(m.renderChangesOverview())
 	b.WriteString("\n")
 
+	// AI notes fidelity
+	if m.summary.Fidelity != "" {
+		b.WriteString(m.renderFidelity())
+		b.WriteString("\n")
+	}
+
 	// Plugins
 	if len(m.summary.Plugins) > 0 {
 		b.WriteString(m.renderPlugins())
@@ -391,6 +402,28 @@ func (m ApprovalModel) renderChangesOverview() string {
 	return b.String()
 }
 
+func (m ApprovalModel) renderFidelity() string {
+	var b strings.Builder
+
+	b.WriteString(m.styles.bold.Render("AI Notes Fidelity"))
+	b.WriteString("\n")
+
+	if len(m.summary.FidelityIssues) == 0 {
+		b.WriteString(m.styles.success.Render("  ✓ " + m.summary.Fidelity))
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	b.WriteString(m.styles.warning.Render("  ! " + m.summary.Fidelity))
+	b.WriteString("\n")
+	for _, issue := range m.summary.FidelityIssues {
+		b.WriteString(m.styles.subtle.Render("  - " + issue))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
 func (m ApprovalModel) renderPlugins() string {
 	var b strings.Builder
 
@@ -0,0 +1,80 @@
+// Package plugin provides the public interface for ReleasePilot plugins.
+package plugin
+
+import (
+	"context"
+	"fmt"
+)
+
+// Log levels of ExecuteEvent.
+const (
+	LogLevelDebug = "debug"
+	LogLevelInfo  = "info"
+	LogLevelWarn  = "warn"
+	LogLevelError = "error"
+)
+
+// ExecuteEvent is a log line, step or progress update reported while a
+// plugin executes. Long-running plugins, such as multi-arch image builds,
+// report events so the release shows what they are doing.
+type ExecuteEvent struct {
+	// Plugin is the name of the plugin, set by the host.
+	Plugin string `json:"plugin,omitempty"`
+	// Hook is the hook being executed, set by the host.
+	Hook Hook `json:"hook,omitempty"`
+	// Log is a line of plugin output.
+	Log string `json:"log,omitempty"`
+	// Level is the level of Log (debug, info, warn, error).
+	Level string `json:"level,omitempty"`
+	// Step is the name of a step the plugin started.
+	Step string `json:"step,omitempty"`
+	// Progress is the percentage of the work done, from 0 to 100.
+	Progress float64 `json:"progress,omitempty"`
+}
+
+// EventSink receives the events of an execution. It may be called from
+// several goroutines at once.
+type EventSink func(ExecuteEvent)
+
+type eventSinkKey struct{}
+
+// WithEventSink returns a context whose plugin executions report their
+// events to sink. On the host, it makes Execute stream the events of the
+// plugin; in a plugin, the SDK sets it to stream events to the host.
+func WithEventSink(ctx context.Context, sink EventSink) context.Context {
+	return context.WithValue(ctx, eventSinkKey{}, sink)
+}
+
+// EventSinkFrom returns the event sink of a context, or nil.
+func EventSinkFrom(ctx context.Context) EventSink {
+	sink, _ := ctx.Value(eventSinkKey{}).(EventSink)
+	return sink
+}
+
+// Report sends an event to the host. It does nothing if the host did not
+// ask for events, e.g. when it only supports unary execution.
+func Report(ctx context.Context, event ExecuteEvent) {
+	if sink := EventSinkFrom(ctx); sink != nil {
+		sink(event)
+	}
+}
+
+// Log reports a log line at the given level.
+func Log(ctx context.Context, level, message string) {
+	Report(ctx, ExecuteEvent{Log: message, Level: level})
+}
+
+// Logf reports a formatted log line at info level.
+func Logf(ctx context.Context, format string, args ...any) {
+	Log(ctx, LogLevelInfo, fmt.Sprintf(format, args...))
+}
+
+// StartStep reports that the plugin started a named step, e.g. "push image".
+func StartStep(ctx context.Context, name string) {
+	Report(ctx, ExecuteEvent{Step: name})
+}
+
+// ReportProgress reports the percentage of the work done, from 0 to 100.
+func ReportProgress(ctx context.Context, percent float64) {
+	Report(ctx, ExecuteEvent{Progress: min(max(percent, 0), 100)})
+}
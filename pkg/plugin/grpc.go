@@ -4,10 +4,16 @@ package plugin
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/go-plugin"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/felixgeelhaar/release-pilot/internal/plugin/proto"
 )
@@ -24,19 +30,21 @@ type GRPCPlugin struct {
 
 // GRPCServer returns the gRPC server for this plugin.
 func (p *GRPCPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
-	proto.RegisterPluginServer(s, &GRPCServer{Impl: p.Impl})
+	proto.RegisterPluginServer(s, &GRPCServer{Impl: p.Impl, broker: broker})
 	return nil
 }
 
 // GRPCClient returns the gRPC client for this plugin.
 func (p *GRPCPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
-	return &GRPCClient{client: proto.NewPluginClient(c)}, nil
+	return &GRPCClient{client: proto.NewPluginClient(c), broker: broker}, nil
 }
 
 // GRPCServer is the server-side implementation of the plugin gRPC interface.
 type GRPCServer struct {
 	proto.UnimplementedPluginServer
 	Impl Plugin
+	// broker connects to the Host service the host offers on execution
+	broker *plugin.GRPCBroker
 }
 
 // GetInfo returns plugin metadata.
@@ -60,6 +68,47 @@ func (s *GRPCServer) GetInfo(ctx context.Context, req *proto.Empty) (*proto.Plug
 
 // Execute runs the plugin for a given hook.
 func (s *GRPCServer) Execute(ctx context.Context, req *proto.ExecuteRequest) (*proto.ExecuteResponse, error) {
+	return s.execute(ctx, req), nil
+}
+
+// ExecuteStream runs the plugin for a given hook, sending the events the
+// plugin reports while it runs and the response as the last event.
+func (s *GRPCServer) ExecuteStream(req *proto.ExecuteRequest, stream proto.Plugin_ExecuteStreamServer) error {
+	// Plugins may report from several goroutines, but a stream must not be
+	// sent on concurrently. Events reported after Execute returned are dropped.
+	var (
+		mu      sync.Mutex
+		done    bool
+		sendErr error
+	)
+	ctx := WithEventSink(stream.Context(), func(e ExecuteEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		if done || sendErr != nil {
+			return
+		}
+		sendErr = stream.Send(&proto.ExecuteEvent{
+			Log:      e.Log,
+			Level:    e.Level,
+			Step:     e.Step,
+			Progress: e.Progress,
+		})
+	})
+
+	resp := s.execute(ctx, req)
+
+	mu.Lock()
+	defer mu.Unlock()
+	done = true
+	if sendErr != nil {
+		return sendErr
+	}
+	return stream.Send(&proto.ExecuteEvent{Response: resp})
+}
+
+// execute runs the plugin for a request and converts its response; plugin
+// errors are reported in the response.
+func (s *GRPCServer) execute(ctx context.Context, req *proto.ExecuteRequest) *proto.ExecuteResponse {
 	// Convert config from JSON
 	var config map[string]any
 	if req.Config != "" {
@@ -67,11 +116,14 @@ func (s *GRPCServer) Execute(ctx context.Context, req *proto.ExecuteRequest) (*p
 			return &proto.ExecuteResponse{
 				Success: false,
 				Error:   "invalid config JSON: " + err.Error(),
-			}, nil
+			}
 		}
 	}
 
-	// Convert context
+	// Convert context; the host leaves it out when there is no release yet
+	if req.Context == nil {
+		req.Context = &proto.ReleaseContext{}
+	}
 	releaseCtx := ReleaseContext{
 		Version:         req.Context.Version,
 		PreviousVersion: req.Context.PreviousVersion,
@@ -84,12 +136,40 @@ func (s *GRPCServer) Execute(ctx context.Context, req *proto.ExecuteRequest) (*p
 		CommitSHA:       req.Context.CommitSha,
 		Changelog:       req.Context.Changelog,
 		ReleaseNotes:    req.Context.ReleaseNotes,
+		MigrationGuide:  req.Context.MigrationGuide,
 		Environment:     req.Context.Environment,
 	}
 
 	if req.Context.Changes != nil {
 		releaseCtx.Changes = convertProtoChanges(req.Context.Changes)
 	}
+	if req.Context.Notes != nil {
+		releaseCtx.Notes = convertProtoNotes(req.Context.Notes)
+	}
+	for _, l := range req.Context.LocalizedNotes {
+		releaseCtx.LocalizedNotes = append(releaseCtx.LocalizedNotes, LocalizedNotes{
+			Locale:       l.Locale,
+			Changelog:    l.Changelog,
+			ReleaseNotes: l.ReleaseNotes,
+		})
+	}
+	if req.Context.PluginOutputs != "" {
+		if err := json.Unmarshal([]byte(req.Context.PluginOutputs), &releaseCtx.PluginOutputs); err != nil {
+			return &proto.ExecuteResponse{
+				Success: false,
+				Error:   "invalid plugin outputs JSON: " + err.Error(),
+			}
+		}
+	}
+
+	// Give the plugin the host's services, if the host offers them
+	if req.HostBrokerId != 0 && s.broker != nil {
+		conn, err := s.broker.Dial(req.HostBrokerId)
+		if err == nil {
+			defer conn.Close()
+			ctx = WithHost(ctx, &hostGRPCClient{client: proto.NewHostClient(conn)})
+		}
+	}
 
 	// Execute
 	resp, err := s.Impl.Execute(ctx, ExecuteRequest{
@@ -102,7 +182,7 @@ func (s *GRPCServer) Execute(ctx context.Context, req *proto.ExecuteRequest) (*p
 		return &proto.ExecuteResponse{
 			Success: false,
 			Error:   err.Error(),
-		}, nil
+		}
 	}
 
 	// Convert outputs to JSON
@@ -121,6 +201,7 @@ func (s *GRPCServer) Execute(ctx context.Context, req *proto.ExecuteRequest) (*p
 			Type:     a.Type,
 			Size:     a.Size,
 			Checksum: a.Checksum,
+			Url:      a.URL,
 		}
 	}
 
@@ -130,7 +211,7 @@ func (s *GRPCServer) Execute(ctx context.Context, req *proto.ExecuteRequest) (*p
 		Error:     resp.Error,
 		Outputs:   outputsJSON,
 		Artifacts: artifacts,
-	}, nil
+	}
 }
 
 // Validate validates the plugin configuration.
@@ -177,6 +258,11 @@ func (s *GRPCServer) Validate(ctx context.Context, req *proto.ValidateRequest) (
 // GRPCClient is the client-side implementation of the plugin gRPC interface.
 type GRPCClient struct {
 	client proto.PluginClient
+	// broker serves the Host service to the plugin on execution
+	broker *plugin.GRPCBroker
+	// unaryOnly is set once the plugin turned out not to support
+	// ExecuteStream, so later executions go straight to Execute.
+	unaryOnly atomic.Bool
 }
 
 // GetInfo returns plugin metadata.
@@ -205,8 +291,98 @@ func (c *GRPCClient) GetInfo() Info {
 	}
 }
 
-// Execute runs the plugin for the given hook.
+// Execute runs the plugin for the given hook. If ctx has an event sink
+// (WithEventSink), the execution is streamed and the plugin's events are
+// passed to the sink as they arrive; plugins that do not support streaming
+// are executed without events. If ctx has a Host (WithHost), the plugin can
+// call it while it executes.
 func (c *GRPCClient) Execute(ctx context.Context, req ExecuteRequest) (*ExecuteResponse, error) {
+	protoReq := toProtoExecuteRequest(req)
+
+	if host := HostFrom(ctx); host != nil && c.broker != nil {
+		id, stop := c.serveHost(host)
+		defer stop()
+		protoReq.HostBrokerId = id
+	}
+
+	if sink := EventSinkFrom(ctx); sink != nil && !c.unaryOnly.Load() {
+		resp, err := c.executeStream(ctx, protoReq, sink)
+		if status.Code(err) != codes.Unimplemented {
+			return resp, err
+		}
+		// Plugins built before ExecuteStream existed only serve Execute
+		c.unaryOnly.Store(true)
+	}
+
+	resp, err := c.client.Execute(ctx, protoReq)
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoExecuteResponse(resp), nil
+}
+
+// serveHost serves host to the plugin over the broker until stop is called,
+// and returns the broker ID the plugin dials.
+func (c *GRPCClient) serveHost(host Host) (id uint32, stop func()) {
+	var (
+		mu      sync.Mutex
+		server  *grpc.Server
+		stopped bool
+	)
+	id = c.broker.NextId()
+	go c.broker.AcceptAndServe(id, func(opts []grpc.ServerOption) *grpc.Server {
+		s := grpc.NewServer(opts...)
+		proto.RegisterHostServer(s, &hostGRPCServer{impl: host})
+
+		mu.Lock()
+		defer mu.Unlock()
+		server = s
+		if stopped {
+			// The execution ended before the server started
+			s.Stop()
+		}
+		return s
+	})
+
+	return id, func() {
+		mu.Lock()
+		defer mu.Unlock()
+		stopped = true
+		if server != nil {
+			server.Stop()
+		}
+	}
+}
+
+// executeStream runs the plugin with ExecuteStream, passing each event to
+// sink until the response arrives.
+func (c *GRPCClient) executeStream(ctx context.Context, req *proto.ExecuteRequest, sink EventSink) (*ExecuteResponse, error) {
+	stream, err := c.client.ExecuteStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil, fmt.Errorf("plugin ended the execution stream without a response")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if event.Response != nil {
+			return fromProtoExecuteResponse(event.Response), nil
+		}
+		sink(ExecuteEvent{
+			Log:      event.Log,
+			Level:    event.Level,
+			Step:     event.Step,
+			Progress: event.Progress,
+		})
+	}
+}
+
+// toProtoExecuteRequest converts an execution request for the wire.
+func toProtoExecuteRequest(req ExecuteRequest) *proto.ExecuteRequest {
 	configJSON, _ := json.Marshal(req.Config)
 
 	protoReq := &proto.ExecuteRequest{
@@ -228,19 +404,34 @@ func (c *GRPCClient) Execute(ctx context.Context, req ExecuteRequest) (*ExecuteR
 			CommitSha:       req.Context.CommitSHA,
 			Changelog:       req.Context.Changelog,
 			ReleaseNotes:    req.Context.ReleaseNotes,
+			MigrationGuide:  req.Context.MigrationGuide,
 			Environment:     req.Context.Environment,
 		}
 
 		if req.Context.Changes != nil {
 			protoReq.Context.Changes = convertChangesToProto(req.Context.Changes)
 		}
+		if req.Context.Notes != nil {
+			protoReq.Context.Notes = convertNotesToProto(req.Context.Notes)
+		}
+		for _, l := range req.Context.LocalizedNotes {
+			protoReq.Context.LocalizedNotes = append(protoReq.Context.LocalizedNotes, &proto.LocalizedNotes{
+				Locale:       l.Locale,
+				Changelog:    l.Changelog,
+				ReleaseNotes: l.ReleaseNotes,
+			})
+		}
+		if len(req.Context.PluginOutputs) > 0 {
+			outputsJSON, _ := json.Marshal(req.Context.PluginOutputs)
+			protoReq.Context.PluginOutputs = string(outputsJSON)
+		}
 	}
 
-	resp, err := c.client.Execute(ctx, protoReq)
-	if err != nil {
-		return nil, err
-	}
+	return protoReq
+}
 
+// fromProtoExecuteResponse converts an execution response from the wire.
+func fromProtoExecuteResponse(resp *proto.ExecuteResponse) *ExecuteResponse {
 	var outputs map[string]any
 	if resp.Outputs != "" {
 		json.Unmarshal([]byte(resp.Outputs), &outputs)
@@ -254,6 +445,7 @@ func (c *GRPCClient) Execute(ctx context.Context, req ExecuteRequest) (*ExecuteR
 			Type:     a.Type,
 			Size:     a.Size,
 			Checksum: a.Checksum,
+			URL:      a.Url,
 		}
 	}
 
@@ -263,7 +455,7 @@ func (c *GRPCClient) Execute(ctx context.Context, req ExecuteRequest) (*ExecuteR
 		Error:     resp.Error,
 		Outputs:   outputs,
 		Artifacts: artifacts,
-	}, nil
+	}
 }
 
 // Validate validates the plugin configuration.
@@ -324,6 +516,8 @@ func protoHookToHook(h proto.Hook) Hook {
 		return HookOnSuccess
 	case proto.Hook_HOOK_ON_ERROR:
 		return HookOnError
+	case proto.Hook_HOOK_ON_ROLLBACK:
+		return HookOnRollback
 	default:
 		return ""
 	}
@@ -359,6 +553,8 @@ func hookToProtoHook(h Hook) proto.Hook {
 		return proto.Hook_HOOK_ON_SUCCESS
 	case HookOnError:
 		return proto.Hook_HOOK_ON_ERROR
+	case HookOnRollback:
+		return proto.Hook_HOOK_ON_ROLLBACK
 	default:
 		return proto.Hook_HOOK_UNSPECIFIED
 	}
@@ -425,3 +621,33 @@ func convertCommitsToProto(commits []ConventionalCommit) []*proto.ConventionalCo
 	}
 	return result
 }
+
+func convertProtoNotes(n *proto.StructuredNotes) *StructuredNotes {
+	notes := &StructuredNotes{
+		Title:      n.Title,
+		Summary:    n.Summary,
+		Highlights: n.Highlights,
+	}
+	for _, s := range n.Sections {
+		notes.Sections = append(notes.Sections, NotesSection{Title: s.Title, Items: s.Items})
+	}
+	for _, m := range n.MigrationNotes {
+		notes.MigrationNotes = append(notes.MigrationNotes, MigrationNote{Change: m.Change, Migration: m.Migration})
+	}
+	return notes
+}
+
+func convertNotesToProto(n *StructuredNotes) *proto.StructuredNotes {
+	notes := &proto.StructuredNotes{
+		Title:      n.Title,
+		Summary:    n.Summary,
+		Highlights: n.Highlights,
+	}
+	for _, s := range n.Sections {
+		notes.Sections = append(notes.Sections, &proto.NotesSection{Title: s.Title, Items: s.Items})
+	}
+	for _, m := range n.MigrationNotes {
+		notes.MigrationNotes = append(notes.MigrationNotes, &proto.MigrationNote{Change: m.Change, Migration: m.Migration})
+	}
+	return notes
+}
@@ -2,9 +2,15 @@ package plugin
 
 import (
 	"context"
+	"net"
+	"reflect"
 	"testing"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 
 	"github.com/felixgeelhaar/release-pilot/internal/plugin/proto"
 )
@@ -29,6 +35,7 @@ func TestProtoHookToHook(t *testing.T) {
 		{"post_publish", proto.Hook_HOOK_POST_PUBLISH, HookPostPublish},
 		{"on_success", proto.Hook_HOOK_ON_SUCCESS, HookOnSuccess},
 		{"on_error", proto.Hook_HOOK_ON_ERROR, HookOnError},
+		{"on_rollback", proto.Hook_HOOK_ON_ROLLBACK, HookOnRollback},
 		{"unspecified", proto.Hook_HOOK_UNSPECIFIED, ""},
 	}
 
@@ -62,6 +69,7 @@ func TestHookToProtoHook(t *testing.T) {
 		{"post_publish", HookPostPublish, proto.Hook_HOOK_POST_PUBLISH},
 		{"on_success", HookOnSuccess, proto.Hook_HOOK_ON_SUCCESS},
 		{"on_error", HookOnError, proto.Hook_HOOK_ON_ERROR},
+		{"on_rollback", HookOnRollback, proto.Hook_HOOK_ON_ROLLBACK},
 		{"unknown", Hook("unknown"), proto.Hook_HOOK_UNSPECIFIED},
 	}
 
@@ -305,6 +313,7 @@ func TestGRPCClient_GetInfo_Timeout(t *testing.T) {
 type mockPluginClient struct {
 	proto.UnimplementedPluginServer
 	hangOnGetInfo bool
+	streamCalls   int
 }
 
 func (m *mockPluginClient) GetInfo(ctx context.Context, req *proto.Empty, opts ...grpc.CallOption) (*proto.PluginInfo, error) {
@@ -327,6 +336,12 @@ func (m *mockPluginClient) Validate(ctx context.Context, req *proto.ValidateRequ
 	return &proto.ValidateResponse{Valid: true}, nil
 }
 
+// ExecuteStream behaves like a plugin built before streaming existed.
+func (m *mockPluginClient) ExecuteStream(ctx context.Context, req *proto.ExecuteRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[proto.ExecuteEvent], error) {
+	m.streamCalls++
+	return nil, status.Error(codes.Unimplemented, "unknown method ExecuteStream")
+}
+
 func TestGRPCClient_Execute(t *testing.T) {
 	client := &GRPCClient{
 		client: &mockPluginClient{},
@@ -364,6 +379,84 @@ func TestGRPCClient_Execute(t *testing.T) {
 	}
 }
 
+// streamingPlugin reports a step, a log line and progress while it executes.
+type streamingPlugin struct {
+	mockPlugin
+}
+
+func (p *streamingPlugin) Execute(ctx context.Context, req ExecuteRequest) (*ExecuteResponse, error) {
+	StartStep(ctx, "build image")
+	Logf(ctx, "building %s", req.Context.Version)
+	ReportProgress(ctx, 50)
+	ReportProgress(ctx, 150)
+	return &ExecuteResponse{Success: true, Message: "built"}, nil
+}
+
+func TestGRPCClient_ExecuteStream(t *testing.T) {
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	proto.RegisterPluginServer(server, &GRPCServer{Impl: &streamingPlugin{}})
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := &GRPCClient{client: proto.NewPluginClient(conn)}
+
+	var events []ExecuteEvent
+	ctx := WithEventSink(context.Background(), func(e ExecuteEvent) { events = append(events, e) })
+	resp, err := client.Execute(ctx, ExecuteRequest{Hook: HookPrePublish, Context: ReleaseContext{Version: "1.2.0"}})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !resp.Success || resp.Message != "built" {
+		t.Errorf("response = %+v, want the plugin's response", resp)
+	}
+
+	want := []ExecuteEvent{
+		{Step: "build image"},
+		{Log: "building 1.2.0", Level: LogLevelInfo},
+		{Progress: 50},
+		{Progress: 100},
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("events = %+v, want %+v", events, want)
+	}
+
+	// Without a sink, the plugin runs unary and reports nothing
+	events = nil
+	if _, err := client.Execute(context.Background(), ExecuteRequest{Hook: HookPrePublish}); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("events without a sink = %+v, want none", events)
+	}
+}
+
+func TestGRPCClient_Execute_FallsBackToUnary(t *testing.T) {
+	mock := &mockPluginClient{}
+	client := &GRPCClient{client: mock}
+	ctx := WithEventSink(context.Background(), func(ExecuteEvent) {})
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Execute(ctx, ExecuteRequest{Hook: HookPrePublish})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if !resp.Success {
+			t.Error("Execute() should fall back to the unary response")
+		}
+	}
+	if mock.streamCalls != 1 {
+		t.Errorf("ExecuteStream calls = %d, want 1 (later executions go straight to Execute)", mock.streamCalls)
+	}
+}
+
 func TestGRPCClient_Validate(t *testing.T) {
 	client := &GRPCClient{
 		client: &mockPluginClient{},
@@ -425,6 +518,61 @@ func TestGRPCServer_Execute(t *testing.T) {
 	}
 }
 
+func TestGRPCServer_Execute_LocalizedNotes(t *testing.T) {
+	mockPlugin := &mockPlugin{}
+	server := &GRPCServer{Impl: mockPlugin}
+
+	_, err := server.Execute(context.Background(), &proto.ExecuteRequest{
+		Hook: proto.Hook_HOOK_POST_PUBLISH,
+		Context: &proto.ReleaseContext{
+			Version:      "1.0.0",
+			ReleaseNotes: "Search is here.",
+			LocalizedNotes: []*proto.LocalizedNotes{
+				{Locale: "de", Changelog: "- Suche", ReleaseNotes: "Die Suche ist da."},
+			},
+			MigrationGuide: "## Migrating to 1.0.0",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	got := mockPlugin.lastRequest.Context.LocalizedNotes
+	if len(got) != 1 || got[0] != (LocalizedNotes{Locale: "de", Changelog: "- Suche", ReleaseNotes: "Die Suche ist da."}) {
+		t.Errorf("LocalizedNotes = %+v", got)
+	}
+	if g := mockPlugin.lastRequest.Context.MigrationGuide; g != "## Migrating to 1.0.0" {
+		t.Errorf("MigrationGuide = %q", g)
+	}
+}
+
+func TestExecuteRequest_PluginOutputsRoundTrip(t *testing.T) {
+	mockPlugin := &mockPlugin{}
+	server := &GRPCServer{Impl: mockPlugin}
+
+	outputs := map[string]PluginOutput{
+		"github": {
+			Outputs:   map[string]any{"release_url": "https://github.com/owner/repo/releases/tag/v1.0.0"},
+			Artifacts: []Artifact{{Name: "app.zip", Path: "dist/app.zip", URL: "https://example.com/app.zip", Size: 10}},
+		},
+	}
+	req := toProtoExecuteRequest(ExecuteRequest{
+		Hook:    HookPostPublish,
+		Context: ReleaseContext{Version: "1.0.0", PluginOutputs: outputs},
+	})
+	if _, err := server.Execute(context.Background(), req); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	got := mockPlugin.lastRequest.Context
+	if url, _ := got.Output("github", "release_url"); url != "https://github.com/owner/repo/releases/tag/v1.0.0" {
+		t.Errorf("Output(github, release_url) = %v", url)
+	}
+	if a := got.PluginOutputs["github"].Artifacts; len(a) != 1 || a[0] != outputs["github"].Artifacts[0] {
+		t.Errorf("Artifacts = %+v", a)
+	}
+}
+
 func TestGRPCServer_Execute_InvalidJSON(t *testing.T) {
 	mockPlugin := &mockPlugin{}
 	server := &GRPCServer{Impl: mockPlugin}
@@ -489,7 +637,9 @@ func TestGRPCServer_Validate_InvalidJSON(t *testing.T) {
 }
 
 // mockPlugin is a test implementation of the Plugin interface
-type mockPlugin struct{}
+type mockPlugin struct {
+	lastRequest ExecuteRequest
+}
 
 func (m *mockPlugin) GetInfo() Info {
 	return Info{
@@ -500,6 +650,7 @@ func (m *mockPlugin) GetInfo() Info {
 }
 
 func (m *mockPlugin) Execute(ctx context.Context, req ExecuteRequest) (*ExecuteResponse, error) {
+	m.lastRequest = req
 	return &ExecuteResponse{
 		Success: true,
 		Message: "test success",
@@ -516,3 +667,20 @@ func (m *mockPlugin) Validate(ctx context.Context, config map[string]any) (*Vali
 		Errors: []ValidationError{},
 	}, nil
 }
+
+func TestConvertNotes_RoundTrip(t *testing.T) {
+	notes := &StructuredNotes{
+		Title:      "Release 2.0.0",
+		Summary:    "A major release.",
+		Highlights: []string{"New plugin API"},
+		Sections:   []NotesSection{{Title: "Features", Items: []string{"Typed release notes"}}},
+		MigrationNotes: []MigrationNote{
+			{Change: "ReleaseNotes is now structured", Migration: "Read ReleaseContext.Notes instead of parsing ReleaseNotes."},
+		},
+	}
+
+	got := convertProtoNotes(convertNotesToProto(notes))
+	if !reflect.DeepEqual(got, notes) {
+		t.Errorf("round trip = %+v, want %+v", got, notes)
+	}
+}
@@ -0,0 +1,369 @@
+// Package plugin provides the public interface for ReleasePilot plugins.
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/felixgeelhaar/release-pilot/internal/plugin/proto"
+)
+
+// Errors returned by Host.
+var (
+	// ErrHostUnavailable is returned when the host cannot provide a service,
+	// e.g. git queries outside a repository.
+	ErrHostUnavailable = errors.New("host service unavailable")
+	// ErrSecretNotAllowed is returned for secrets not granted to the plugin.
+	ErrSecretNotAllowed = errors.New("secret not allowed for plugin")
+	// ErrSecretNotFound is returned for granted secrets that are not set.
+	ErrSecretNotFound = errors.New("secret not found")
+)
+
+// Host is the API the host offers to plugins while they execute, so they do
+// not have to run git, render templates or read secrets themselves. Plugins
+// get it with HostFrom.
+type Host interface {
+	// GitRepository returns information about the repository being released.
+	GitRepository(ctx context.Context) (*GitRepository, error)
+
+	// GitCommits returns the commits after from up to to (HEAD if empty),
+	// newest first.
+	GitCommits(ctx context.Context, from, to string) ([]GitCommit, error)
+
+	// GitTags returns the version tags with the given prefix.
+	GitTags(ctx context.Context, prefix string) ([]GitTag, error)
+
+	// RenderTemplate renders a Go template with the host's template
+	// functions. data is passed to the host as JSON.
+	RenderTemplate(ctx context.Context, tmpl string, data any) (string, error)
+
+	// Log writes a structured log line to the host's log, attributed to the
+	// plugin.
+	Log(ctx context.Context, level, message string, fields map[string]any) error
+
+	// Secret returns a secret the plugin is allowed to read, as listed under
+	// secrets in its configuration.
+	Secret(ctx context.Context, name string) (string, error)
+}
+
+// GitRepository describes the repository being released.
+type GitRepository struct {
+	// Root is the repository root directory.
+	Root string `json:"root"`
+	// CurrentBranch is the checked out branch.
+	CurrentBranch string `json:"current_branch"`
+	// DefaultBranch is the default branch (main/master).
+	DefaultBranch string `json:"default_branch"`
+	// HeadCommit is the HEAD commit hash.
+	HeadCommit string `json:"head_commit"`
+	// RemoteURL is the URL of the origin remote.
+	RemoteURL string `json:"remote_url,omitempty"`
+	// IsDirty indicates if the working tree has uncommitted changes.
+	IsDirty bool `json:"is_dirty"`
+}
+
+// GitCommit is a commit of the repository being released.
+type GitCommit struct {
+	// Hash is the commit SHA.
+	Hash string `json:"hash"`
+	// Subject is the first line of the commit message.
+	Subject string `json:"subject"`
+	// Body is the rest of the commit message.
+	Body string `json:"body,omitempty"`
+	// AuthorName is the commit author's name.
+	AuthorName string `json:"author_name"`
+	// AuthorEmail is the commit author's email.
+	AuthorEmail string `json:"author_email"`
+	// Date is the commit date.
+	Date time.Time `json:"date"`
+}
+
+// GitTag is a tag of the repository being released.
+type GitTag struct {
+	// Name is the tag name.
+	Name string `json:"name"`
+	// Hash is the commit hash the tag points to.
+	Hash string `json:"hash"`
+	// Message is the tag message, for annotated tags.
+	Message string `json:"message,omitempty"`
+	// Date is the tag date.
+	Date time.Time `json:"date"`
+}
+
+type hostKey struct{}
+
+// WithHost returns a context whose plugin executions can call host. On the
+// host, it makes Execute offer host to the plugin; in a plugin, the SDK sets
+// it to the host's services.
+func WithHost(ctx context.Context, host Host) context.Context {
+	return context.WithValue(ctx, hostKey{}, host)
+}
+
+// HostFrom returns the host services of a context, or nil if the host does
+// not offer them, e.g. when it was built before they existed.
+func HostFrom(ctx context.Context) Host {
+	host, _ := ctx.Value(hostKey{}).(Host)
+	return host
+}
+
+// hostGRPCServer serves a Host to a plugin.
+type hostGRPCServer struct {
+	proto.UnimplementedHostServer
+	impl Host
+}
+
+// GitRepository returns information about the repository being released.
+func (s *hostGRPCServer) GitRepository(ctx context.Context, _ *proto.Empty) (*proto.GitRepository, error) {
+	repo, err := s.impl.GitRepository(ctx)
+	if err != nil {
+		return nil, toHostStatus(err)
+	}
+	return &proto.GitRepository{
+		Root:          repo.Root,
+		CurrentBranch: repo.CurrentBranch,
+		DefaultBranch: repo.DefaultBranch,
+		HeadCommit:    repo.HeadCommit,
+		RemoteUrl:     repo.RemoteURL,
+		IsDirty:       repo.IsDirty,
+	}, nil
+}
+
+// GitCommits returns the commits between two references.
+func (s *hostGRPCServer) GitCommits(ctx context.Context, req *proto.GitCommitsRequest) (*proto.GitCommitsResponse, error) {
+	commits, err := s.impl.GitCommits(ctx, req.From, req.To)
+	if err != nil {
+		return nil, toHostStatus(err)
+	}
+	resp := &proto.GitCommitsResponse{Commits: make([]*proto.GitCommit, len(commits))}
+	for i, c := range commits {
+		resp.Commits[i] = &proto.GitCommit{
+			Hash:        c.Hash,
+			Subject:     c.Subject,
+			Body:        c.Body,
+			AuthorName:  c.AuthorName,
+			AuthorEmail: c.AuthorEmail,
+			Date:        formatHostTime(c.Date),
+		}
+	}
+	return resp, nil
+}
+
+// GitTags returns the version tags with a prefix.
+func (s *hostGRPCServer) GitTags(ctx context.Context, req *proto.GitTagsRequest) (*proto.GitTagsResponse, error) {
+	tags, err := s.impl.GitTags(ctx, req.Prefix)
+	if err != nil {
+		return nil, toHostStatus(err)
+	}
+	resp := &proto.GitTagsResponse{Tags: make([]*proto.GitTag, len(tags))}
+	for i, t := range tags {
+		resp.Tags[i] = &proto.GitTag{
+			Name:    t.Name,
+			Hash:    t.Hash,
+			Message: t.Message,
+			Date:    formatHostTime(t.Date),
+		}
+	}
+	return resp, nil
+}
+
+// RenderTemplate renders a Go template.
+func (s *hostGRPCServer) RenderTemplate(ctx context.Context, req *proto.RenderTemplateRequest) (*proto.RenderTemplateResponse, error) {
+	var data any
+	if req.Data != "" {
+		if err := json.Unmarshal([]byte(req.Data), &data); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid template data JSON: %v", err)
+		}
+	}
+	output, err := s.impl.RenderTemplate(ctx, req.Template, data)
+	if err != nil {
+		return nil, toHostStatus(err)
+	}
+	return &proto.RenderTemplateResponse{Output: output}, nil
+}
+
+// Log writes a structured log line of the plugin.
+func (s *hostGRPCServer) Log(ctx context.Context, req *proto.LogRequest) (*proto.Empty, error) {
+	var fields map[string]any
+	if req.Fields != "" {
+		if err := json.Unmarshal([]byte(req.Fields), &fields); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid log fields JSON: %v", err)
+		}
+	}
+	if err := s.impl.Log(ctx, req.Level, req.Message, fields); err != nil {
+		return nil, toHostStatus(err)
+	}
+	return &proto.Empty{}, nil
+}
+
+// GetSecret returns a secret the plugin is allowed to read.
+func (s *hostGRPCServer) GetSecret(ctx context.Context, req *proto.GetSecretRequest) (*proto.GetSecretResponse, error) {
+	value, err := s.impl.Secret(ctx, req.Name)
+	if err != nil {
+		return nil, toHostStatus(err)
+	}
+	return &proto.GetSecretResponse{Value: value}, nil
+}
+
+// hostGRPCClient is the Host of a plugin, calling the host over gRPC.
+type hostGRPCClient struct {
+	client proto.HostClient
+}
+
+// GitRepository returns information about the repository being released.
+func (c *hostGRPCClient) GitRepository(ctx context.Context) (*GitRepository, error) {
+	resp, err := c.client.GitRepository(ctx, &proto.Empty{})
+	if err != nil {
+		return nil, fromHostStatus(err)
+	}
+	return &GitRepository{
+		Root:          resp.Root,
+		CurrentBranch: resp.CurrentBranch,
+		DefaultBranch: resp.DefaultBranch,
+		HeadCommit:    resp.HeadCommit,
+		RemoteURL:     resp.RemoteUrl,
+		IsDirty:       resp.IsDirty,
+	}, nil
+}
+
+// GitCommits returns the commits between two references.
+func (c *hostGRPCClient) GitCommits(ctx context.Context, from, to string) ([]GitCommit, error) {
+	resp, err := c.client.GitCommits(ctx, &proto.GitCommitsRequest{From: from, To: to})
+	if err != nil {
+		return nil, fromHostStatus(err)
+	}
+	commits := make([]GitCommit, len(resp.Commits))
+	for i, c := range resp.Commits {
+		commits[i] = GitCommit{
+			Hash:        c.Hash,
+			Subject:     c.Subject,
+			Body:        c.Body,
+			AuthorName:  c.AuthorName,
+			AuthorEmail: c.AuthorEmail,
+			Date:        parseHostTime(c.Date),
+		}
+	}
+	return commits, nil
+}
+
+// GitTags returns the version tags with a prefix.
+func (c *hostGRPCClient) GitTags(ctx context.Context, prefix string) ([]GitTag, error) {
+	resp, err := c.client.GitTags(ctx, &proto.GitTagsRequest{Prefix: prefix})
+	if err != nil {
+		return nil, fromHostStatus(err)
+	}
+	tags := make([]GitTag, len(resp.Tags))
+	for i, t := range resp.Tags {
+		tags[i] = GitTag{
+			Name:    t.Name,
+			Hash:    t.Hash,
+			Message: t.Message,
+			Date:    parseHostTime(t.Date),
+		}
+	}
+	return tags, nil
+}
+
+// RenderTemplate renders a Go template with the host's template functions.
+func (c *hostGRPCClient) RenderTemplate(ctx context.Context, tmpl string, data any) (string, error) {
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode template data: %w", err)
+	}
+	resp, err := c.client.RenderTemplate(ctx, &proto.RenderTemplateRequest{
+		Template: tmpl,
+		Data:     string(dataJSON),
+	})
+	if err != nil {
+		return "", fromHostStatus(err)
+	}
+	return resp.Output, nil
+}
+
+// Log writes a structured log line to the host's log.
+func (c *hostGRPCClient) Log(ctx context.Context, level, message string, fields map[string]any) error {
+	req := &proto.LogRequest{Level: level, Message: message}
+	if len(fields) > 0 {
+		fieldsJSON, err := json.Marshal(fields)
+		if err != nil {
+			return fmt.Errorf("failed to encode log fields: %w", err)
+		}
+		req.Fields = string(fieldsJSON)
+	}
+	if _, err := c.client.Log(ctx, req); err != nil {
+		return fromHostStatus(err)
+	}
+	return nil
+}
+
+// Secret returns a secret the plugin is allowed to read.
+func (c *hostGRPCClient) Secret(ctx context.Context, name string) (string, error) {
+	resp, err := c.client.GetSecret(ctx, &proto.GetSecretRequest{Name: name})
+	if err != nil {
+		return "", fromHostStatus(err)
+	}
+	return resp.Value, nil
+}
+
+// toHostStatus converts a Host error to a gRPC status, keeping the kind of
+// the Host errors so the plugin can tell them apart.
+func toHostStatus(err error) error {
+	switch {
+	case errors.Is(err, ErrSecretNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrSecretNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrHostUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Unknown, err.Error())
+	}
+}
+
+// fromHostStatus converts a gRPC status of the Host service back to an
+// error that matches the Host errors with errors.Is.
+func fromHostStatus(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch st.Code() {
+	case codes.PermissionDenied:
+		return &hostError{msg: st.Message(), kind: ErrSecretNotAllowed}
+	case codes.NotFound:
+		return &hostError{msg: st.Message(), kind: ErrSecretNotFound}
+	case codes.Unavailable, codes.Unimplemented:
+		return &hostError{msg: st.Message(), kind: ErrHostUnavailable}
+	default:
+		return errors.New(st.Message())
+	}
+}
+
+// hostError is an error the host returned, keeping its message as is.
+type hostError struct {
+	msg  string
+	kind error
+}
+
+func (e *hostError) Error() string { return e.msg }
+func (e *hostError) Unwrap() error { return e.kind }
+
+// formatHostTime formats a time for the wire, leaving zero times empty.
+func formatHostTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// parseHostTime parses a time from the wire, returning the zero time for
+// empty or malformed values.
+func parseHostTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339, s)
+	return t
+}
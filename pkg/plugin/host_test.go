@@ -0,0 +1,173 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	goplugin "github.com/hashicorp/go-plugin"
+)
+
+// stubHost is a Host with fixed answers.
+type stubHost struct {
+	secrets map[string]string
+	logs    []string
+}
+
+func (h *stubHost) GitRepository(context.Context) (*GitRepository, error) {
+	return &GitRepository{Root: "/repo", CurrentBranch: "main", HeadCommit: "abc123"}, nil
+}
+
+func (h *stubHost) GitCommits(_ context.Context, from, to string) ([]GitCommit, error) {
+	return []GitCommit{{Hash: "abc123", Subject: "feat: tap", AuthorName: "Jane", Date: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)}}, nil
+}
+
+func (h *stubHost) GitTags(_ context.Context, prefix string) ([]GitTag, error) {
+	return []GitTag{{Name: prefix + "1.2.0", Hash: "abc123"}}, nil
+}
+
+func (h *stubHost) RenderTemplate(_ context.Context, tmpl string, data any) (string, error) {
+	return strings.ReplaceAll(tmpl, "{{.Version}}", fmt.Sprint(data.(map[string]any)["Version"])), nil
+}
+
+func (h *stubHost) Log(_ context.Context, level, message string, fields map[string]any) error {
+	h.logs = append(h.logs, fmt.Sprintf("%s %s %v", level, message, fields))
+	return nil
+}
+
+func (h *stubHost) Secret(_ context.Context, name string) (string, error) {
+	value, ok := h.secrets[name]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrSecretNotAllowed, name)
+	}
+	return value, nil
+}
+
+// hostPlugin calls every host service and reports what it got.
+type hostPlugin struct {
+	mockPlugin
+}
+
+func (p *hostPlugin) Execute(ctx context.Context, req ExecuteRequest) (*ExecuteResponse, error) {
+	host := HostFrom(ctx)
+	if host == nil {
+		return &ExecuteResponse{Success: false, Error: "no host"}, nil
+	}
+
+	repo, err := host.GitRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := host.GitCommits(ctx, "v1.1.0", "")
+	if err != nil {
+		return nil, err
+	}
+	tags, err := host.GitTags(ctx, "v")
+	if err != nil {
+		return nil, err
+	}
+	rendered, err := host.RenderTemplate(ctx, "version {{.Version}}", map[string]any{"Version": req.Context.Version})
+	if err != nil {
+		return nil, err
+	}
+	if err := host.Log(ctx, LogLevelInfo, "updating tap", map[string]any{"tap": "acme/tap"}); err != nil {
+		return nil, err
+	}
+	token, err := host.Secret(ctx, "TAP_TOKEN")
+	if err != nil {
+		return nil, err
+	}
+	_, denied := host.Secret(ctx, "AWS_SECRET_ACCESS_KEY")
+
+	return &ExecuteResponse{
+		Success: true,
+		Outputs: map[string]any{
+			"branch":   repo.CurrentBranch,
+			"commit":   commits[0].Subject + " " + commits[0].Date.Format(time.RFC3339),
+			"tag":      tags[0].Name,
+			"rendered": rendered,
+			"token":    token,
+			"denied":   errors.Is(denied, ErrSecretNotAllowed),
+		},
+	}, nil
+}
+
+func TestGRPCClient_Execute_Host(t *testing.T) {
+	client, server := goplugin.TestPluginGRPCConn(t, false, map[string]goplugin.Plugin{
+		PluginName: &GRPCPlugin{Impl: &hostPlugin{}},
+	})
+	defer client.Close()
+	defer server.Stop()
+
+	raw, err := client.Dispense(PluginName)
+	if err != nil {
+		t.Fatalf("Dispense() error = %v", err)
+	}
+	p := raw.(Plugin)
+
+	host := &stubHost{secrets: map[string]string{"TAP_TOKEN": "s3cret"}}
+	resp, err := p.Execute(WithHost(context.Background(), host), ExecuteRequest{
+		Hook:    HookPostPublish,
+		Context: ReleaseContext{Version: "1.2.0"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("Execute() failed: %s", resp.Error)
+	}
+
+	want := map[string]any{
+		"branch":   "main",
+		"commit":   "feat: tap 2026-01-02T03:04:05Z",
+		"tag":      "v1.2.0",
+		"rendered": "version 1.2.0",
+		"token":    "s3cret",
+		"denied":   true,
+	}
+	for k, v := range want {
+		if resp.Outputs[k] != v {
+			t.Errorf("outputs[%q] = %v, want %v", k, resp.Outputs[k], v)
+		}
+	}
+	if len(host.logs) != 1 || host.logs[0] != "info updating tap map[tap:acme/tap]" {
+		t.Errorf("logs = %q, want the plugin's log line", host.logs)
+	}
+
+	// Without a host, the plugin gets none
+	resp, err = p.Execute(context.Background(), ExecuteRequest{Hook: HookPostPublish})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if resp.Error != "no host" {
+		t.Errorf("Execute() without host = %+v, want no host", resp)
+	}
+}
+
+func TestHostStatus_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"not allowed", fmt.Errorf("%w: TOKEN", ErrSecretNotAllowed), ErrSecretNotAllowed},
+		{"not found", fmt.Errorf("%w: TOKEN", ErrSecretNotFound), ErrSecretNotFound},
+		{"unavailable", fmt.Errorf("%w: git", ErrHostUnavailable), ErrHostUnavailable},
+		{"other", errors.New("bad template"), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fromHostStatus(toHostStatus(tt.err))
+			if err.Error() != tt.err.Error() {
+				t.Errorf("error = %q, want %q", err, tt.err)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("errors.Is(%v, %v) = false", err, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,10 @@ package plugin
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 )
 
 // Hook represents a point in the release workflow where plugins can execute.
@@ -38,6 +42,8 @@ const (
 	HookOnSuccess Hook = "on-success"
 	// HookOnError runs when release fails.
 	HookOnError Hook = "on-error"
+	// HookOnRollback runs when a published release is rolled back.
+	HookOnRollback Hook = "on-rollback"
 )
 
 // AllHooks returns all available hooks in execution order.
@@ -50,6 +56,7 @@ func AllHooks() []Hook {
 		HookPreApprove, HookPostApprove,
 		HookPrePublish, HookPostPublish,
 		HookOnSuccess, HookOnError,
+		HookOnRollback,
 	}
 }
 
@@ -132,12 +139,172 @@ type ReleaseContext struct {
 	Changelog string `json:"changelog,omitempty"`
 	// ReleaseNotes is the generated release notes.
 	ReleaseNotes string `json:"release_notes,omitempty"`
+	// Notes is the structured form of ReleaseNotes, if notes were generated.
+	Notes *StructuredNotes `json:"notes,omitempty"`
+	// LocalizedNotes holds translations of Changelog and ReleaseNotes, one
+	// per locale, if the notes were localized.
+	LocalizedNotes []LocalizedNotes `json:"localized_notes,omitempty"`
+	// MigrationGuide is the Markdown migration guide for the release's
+	// breaking changes, if one was generated.
+	MigrationGuide string `json:"migration_guide,omitempty"`
+	// PluginOutputs holds the outputs and artifacts reported by plugins that
+	// ran earlier in the release, keyed by plugin name; e.g. the release URL
+	// of the GitHub plugin.
+	PluginOutputs map[string]PluginOutput `json:"plugin_outputs,omitempty"`
 	// Changes contains the categorized changes.
 	Changes *CategorizedChanges `json:"changes,omitempty"`
 	// Environment contains filtered environment variables.
 	Environment map[string]string `json:"environment,omitempty"`
 }
 
+// StructuredNotes is the typed form of the release notes.
+type StructuredNotes struct {
+	// Title is the release notes title.
+	Title string `json:"title"`
+	// Summary is the release summary.
+	Summary string `json:"summary,omitempty"`
+	// Highlights lists the most important changes.
+	Highlights []string `json:"highlights,omitempty"`
+	// Sections groups the changes under titles.
+	Sections []NotesSection `json:"sections,omitempty"`
+	// MigrationNotes explains how to migrate for each breaking change.
+	MigrationNotes []MigrationNote `json:"migration_notes,omitempty"`
+}
+
+// NotesSection is a titled list of release notes items.
+type NotesSection struct {
+	// Title is the section title.
+	Title string `json:"title"`
+	// Items lists the section entries.
+	Items []string `json:"items,omitempty"`
+}
+
+// MigrationNote explains a breaking change and how to migrate to it.
+type MigrationNote struct {
+	// Change describes the breaking change.
+	Change string `json:"change"`
+	// Migration explains how to migrate.
+	Migration string `json:"migration"`
+}
+
+// LocalizedNotes is the changelog and release notes in one locale.
+type LocalizedNotes struct {
+	// Locale is a BCP 47 language tag (e.g., "de", "pt-BR").
+	Locale string `json:"locale"`
+	// Changelog is the translated changelog content.
+	Changelog string `json:"changelog,omitempty"`
+	// ReleaseNotes is the translated release notes.
+	ReleaseNotes string `json:"release_notes,omitempty"`
+}
+
+// NotesFor returns the notes in a locale, or nil if they were not
+// translated into it. Locales without an exact match fall back to the same
+// language, so "de-AT" matches notes in "de".
+func (c ReleaseContext) NotesFor(locale string) *LocalizedNotes {
+	if locale == "" {
+		return nil
+	}
+	for i := range c.LocalizedNotes {
+		if strings.EqualFold(c.LocalizedNotes[i].Locale, locale) {
+			return &c.LocalizedNotes[i]
+		}
+	}
+	for i := range c.LocalizedNotes {
+		if strings.EqualFold(baseLanguage(c.LocalizedNotes[i].Locale), baseLanguage(locale)) {
+			return &c.LocalizedNotes[i]
+		}
+	}
+	return nil
+}
+
+// Localize returns a copy of the context whose Changelog and ReleaseNotes
+// are in the given locale. The context is returned unchanged if the locale
+// is empty or the notes were not translated into it. Notes keeps the
+// structured form of the source notes.
+func (c ReleaseContext) Localize(locale string) ReleaseContext {
+	if n := c.NotesFor(locale); n != nil {
+		c.Changelog = n.Changelog
+		c.ReleaseNotes = n.ReleaseNotes
+	}
+	return c
+}
+
+// AppendMigrationGuide returns body followed by the migration guide, for
+// plugins that publish a release description. The body is returned
+// unchanged if no migration guide was generated.
+func (c ReleaseContext) AppendMigrationGuide(body string) string {
+	guide := strings.TrimSpace(c.MigrationGuide)
+	if guide == "" {
+		return body
+	}
+	if strings.TrimSpace(body) == "" {
+		return guide + "\n"
+	}
+	return strings.TrimRight(body, "\n") + "\n\n" + guide + "\n"
+}
+
+// PluginOutput is what a plugin reported for the release.
+type PluginOutput struct {
+	// Outputs contains the outputs of the plugin.
+	Outputs map[string]any `json:"outputs,omitempty"`
+	// Artifacts lists the artifacts the plugin created.
+	Artifacts []Artifact `json:"artifacts,omitempty"`
+}
+
+// Output returns an output reported by a plugin that ran earlier, or false
+// if it did not report it.
+func (c ReleaseContext) Output(plugin, key string) (any, bool) {
+	value, ok := c.PluginOutputs[plugin].Outputs[key]
+	return value, ok
+}
+
+// outputPlaceholder matches {{outputs.<plugin>.<key>}} and
+// {{artifacts.<plugin>.<name>}} placeholders.
+var outputPlaceholder = regexp.MustCompile(`\{\{\s*(outputs|artifacts)\.([\w-]+)\.([^}\s]+)\s*\}\}`)
+
+// ExpandOutputs replaces {{outputs.<plugin>.<key>}} placeholders in s with
+// the outputs of earlier plugins, and {{artifacts.<plugin>.<name>}} with the
+// URL of their artifacts, or the local path of unpublished ones, e.g. for
+// download URL templates.
+// Placeholders without a value are left unchanged.
+func (c ReleaseContext) ExpandOutputs(s string) string {
+	return outputPlaceholder.ReplaceAllStringFunc(s, func(match string) string {
+		m := outputPlaceholder.FindStringSubmatch(match)
+		kind, plugin, key := m[1], m[2], m[3]
+		if kind == "artifacts" {
+			for _, a := range c.PluginOutputs[plugin].Artifacts {
+				if a.Name == key {
+					if a.URL != "" {
+						return a.URL
+					}
+					return a.Path
+				}
+			}
+			return match
+		}
+		value, ok := c.Output(plugin, key)
+		if !ok {
+			return match
+		}
+		return formatOutput(value)
+	})
+}
+
+// formatOutput formats an output value for a template. Numbers decoded from
+// JSON are float64, so whole numbers are formatted without an exponent.
+func formatOutput(value any) string {
+	if f, ok := value.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
+
+// baseLanguage returns the language of a locale, e.g. "pt" for "pt-BR".
+func baseLanguage(locale string) string {
+	lang, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	return lang
+}
+
 // CategorizedChanges contains commits grouped by category.
 type CategorizedChanges struct {
 	// Features lists feature commits.
@@ -184,14 +351,16 @@ type ConventionalCommit struct {
 type Artifact struct {
 	// Name is the artifact name.
 	Name string `json:"name"`
-	// Path is the artifact path (local file or URL).
-	Path string `json:"path"`
+	// Path is the local path of the artifact, if it is a file.
+	Path string `json:"path,omitempty"`
 	// Type is the artifact type (file, url, etc.).
 	Type string `json:"type"`
 	// Size is the artifact size in bytes.
 	Size int64 `json:"size,omitempty"`
 	// Checksum is the artifact checksum.
 	Checksum string `json:"checksum,omitempty"`
+	// URL is where the artifact was published, e.g. a release asset download URL.
+	URL string `json:"url,omitempty"`
 }
 
 // ValidateResponse contains the result of configuration validation.
@@ -17,6 +17,7 @@ func TestAllHooks(t *testing.T) {
 		HookPreApprove, HookPostApprove,
 		HookPrePublish, HookPostPublish,
 		HookOnSuccess, HookOnError,
+		HookOnRollback,
 	}
 
 	if len(hooks) != len(expectedHooks) {
@@ -76,6 +77,7 @@ func TestHookConstants(t *testing.T) {
 		{HookPostPublish, "post-publish"},
 		{HookOnSuccess, "on-success"},
 		{HookOnError, "on-error"},
+		{HookOnRollback, "on-rollback"},
 	}
 
 	for _, tt := range tests {
@@ -410,3 +412,108 @@ func TestValidationError_Fields(t *testing.T) {
 		t.Errorf("ValidationError.Code = %v, want POSITIVE_INT", err.Code)
 	}
 }
+
+func TestReleaseContext_Localize(t *testing.T) {
+	ctx := ReleaseContext{
+		Changelog:    "- search",
+		ReleaseNotes: "Search is here.",
+		LocalizedNotes: []LocalizedNotes{
+			{Locale: "en", Changelog: "- search", ReleaseNotes: "Search is here."},
+			{Locale: "de", Changelog: "- Suche", ReleaseNotes: "Die Suche ist da."},
+			{Locale: "pt-BR", Changelog: "- busca", ReleaseNotes: "A busca chegou."},
+		},
+	}
+
+	tests := []struct {
+		locale    string
+		wantNotes string
+	}{
+		{"de", "Die Suche ist da."},
+		{"DE", "Die Suche ist da."},
+		{"de-AT", "Die Suche ist da."},
+		{"pt_BR", "A busca chegou."},
+		{"pt", "A busca chegou."},
+		{"ja", "Search is here."},
+		{"", "Search is here."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			got := ctx.Localize(tt.locale)
+			if got.ReleaseNotes != tt.wantNotes {
+				t.Errorf("Localize(%q).ReleaseNotes = %q, want %q", tt.locale, got.ReleaseNotes, tt.wantNotes)
+			}
+		})
+	}
+
+	if ctx.Localize("de").Changelog != "- Suche" {
+		t.Error("Localize should replace the changelog")
+	}
+	if ctx.ReleaseNotes != "Search is here." {
+		t.Error("Localize should not modify the original context")
+	}
+}
+
+func TestReleaseContext_AppendMigrationGuide(t *testing.T) {
+	tests := []struct {
+		name  string
+		guide string
+		body  string
+		want  string
+	}{
+		{"no guide", "", "Search is here.\n", "Search is here.\n"},
+		{"guide after body", "## Migrating to 2.0.0\n", "Search is here.\n", "Search is here.\n\n## Migrating to 2.0.0\n"},
+		{"guide only", "## Migrating to 2.0.0", "", "## Migrating to 2.0.0\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := ReleaseContext{MigrationGuide: tt.guide}
+			if got := ctx.AppendMigrationGuide(tt.body); got != tt.want {
+				t.Errorf("AppendMigrationGuide() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReleaseContext_ExpandOutputs(t *testing.T) {
+	c := ReleaseContext{
+		PluginOutputs: map[string]PluginOutput{
+			"github": {
+				Outputs: map[string]any{
+					"release_url": "https://github.com/owner/repo/releases/tag/v1.2.0",
+					"release_id":  float64(123456789),
+				},
+				Artifacts: []Artifact{
+					{Name: "app_darwin_arm64.tar.gz", Path: "dist/app_darwin_arm64.tar.gz", URL: "https://github.com/owner/repo/releases/download/v1.2.0/app_darwin_arm64.tar.gz"},
+					{Name: "checksums.txt", Path: "dist/checksums.txt"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"output", "Released: {{outputs.github.release_url}}", "Released: https://github.com/owner/repo/releases/tag/v1.2.0"},
+		{"whole number", "{{ outputs.github.release_id }}", "123456789"},
+		{"artifact", "{{artifacts.github.app_darwin_arm64.tar.gz}}", "https://github.com/owner/repo/releases/download/v1.2.0/app_darwin_arm64.tar.gz"},
+		{"unpublished artifact", "{{artifacts.github.checksums.txt}}", "dist/checksums.txt"},
+		{"missing output", "{{outputs.github.missing}}", "{{outputs.github.missing}}"},
+		{"missing plugin", "{{artifacts.gitlab.app.zip}}", "{{artifacts.gitlab.app.zip}}"},
+		{"other placeholders", "{{version}}", "{{version}}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.ExpandOutputs(tt.in); got != tt.want {
+				t.Errorf("ExpandOutputs(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+
+	if _, ok := (ReleaseContext{}).Output("github", "release_url"); ok {
+		t.Error("Output() on an empty context should report no value")
+	}
+}
@@ -0,0 +1,167 @@
+package plugintest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/pkg/plugin"
+)
+
+// ReleaseContext returns the synthetic release context hooks are executed
+// with: a minor release of example/project with a feature, a fix and notes.
+func ReleaseContext() plugin.ReleaseContext {
+	feature := plugin.ConventionalCommit{
+		Hash:        "3f2c8d1e9a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d",
+		Type:        "feat",
+		Scope:       "api",
+		Description: "add export endpoint",
+		Author:      "Jane Doe",
+		Date:        "2026-01-15T10:00:00Z",
+	}
+	fix := plugin.ConventionalCommit{
+		Hash:        "8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f",
+		Type:        "fix",
+		Description: "handle empty responses",
+		Issues:      []string{"#42"},
+		Author:      "John Roe",
+		Date:        "2026-01-14T09:30:00Z",
+	}
+
+	return plugin.ReleaseContext{
+		Version:         "1.3.0",
+		PreviousVersion: "1.2.4",
+		TagName:         "v1.3.0",
+		ReleaseType:     "minor",
+		RepositoryURL:   "https://github.com/example/project",
+		RepositoryOwner: "example",
+		RepositoryName:  "project",
+		Branch:          "main",
+		CommitSHA:       feature.Hash,
+		Changelog:       "## 1.3.0\n\n### Features\n\n- **api:** add export endpoint\n\n### Bug Fixes\n\n- handle empty responses (#42)\n",
+		ReleaseNotes:    "Version 1.3.0 adds an export endpoint and fixes empty responses.",
+		Changes: &plugin.CategorizedChanges{
+			Features: []plugin.ConventionalCommit{feature},
+			Fixes:    []plugin.ConventionalCommit{fix},
+		},
+		Notes: &plugin.StructuredNotes{
+			Title:      "Release 1.3.0",
+			Summary:    "Version 1.3.0 adds an export endpoint and fixes empty responses.",
+			Highlights: []string{"Export endpoint"},
+			Sections: []plugin.NotesSection{
+				{Title: "Features", Items: []string{"Add export endpoint"}},
+				{Title: "Bug Fixes", Items: []string{"Handle empty responses (#42)"}},
+			},
+		},
+		Environment: map[string]string{"CI": "true"},
+	}
+}
+
+// Host is a fake plugin.Host serving fixed repository data. It renders
+// templates with text/template and records log lines.
+type Host struct {
+	// Repository is returned by GitRepository.
+	Repository plugin.GitRepository
+	// Commits is returned by GitCommits.
+	Commits []plugin.GitCommit
+	// Tags are returned by GitTags if they have the requested prefix.
+	Tags []plugin.GitTag
+	// Secrets are the secrets the plugin may read.
+	Secrets map[string]string
+
+	mu   sync.Mutex
+	logs []string
+}
+
+// NewHost returns a Host for the repository of ReleaseContext.
+func NewHost() *Host {
+	rc := ReleaseContext()
+	date := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	return &Host{
+		Repository: plugin.GitRepository{
+			Root:          "/src/project",
+			CurrentBranch: rc.Branch,
+			DefaultBranch: rc.Branch,
+			HeadCommit:    rc.CommitSHA,
+			RemoteURL:     rc.RepositoryURL + ".git",
+		},
+		Commits: []plugin.GitCommit{{
+			Hash:        rc.CommitSHA,
+			Subject:     "feat(api): add export endpoint",
+			AuthorName:  "Jane Doe",
+			AuthorEmail: "jane@example.com",
+			Date:        date,
+		}},
+		Tags: []plugin.GitTag{
+			{Name: "v1.2.4", Hash: "1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b", Date: date.AddDate(0, -1, 0)},
+		},
+		Secrets: map[string]string{},
+	}
+}
+
+// GitRepository returns the configured repository.
+func (h *Host) GitRepository(context.Context) (*plugin.GitRepository, error) {
+	repo := h.Repository
+	return &repo, nil
+}
+
+// GitCommits returns the configured commits.
+func (h *Host) GitCommits(context.Context, string, string) ([]plugin.GitCommit, error) {
+	return h.Commits, nil
+}
+
+// GitTags returns the configured tags with the prefix.
+func (h *Host) GitTags(_ context.Context, prefix string) ([]plugin.GitTag, error) {
+	var tags []plugin.GitTag
+	for _, t := range h.Tags {
+		if strings.HasPrefix(t.Name, prefix) {
+			tags = append(tags, t)
+		}
+	}
+	return tags, nil
+}
+
+// RenderTemplate renders a Go template with text/template.
+func (h *Host) RenderTemplate(_ context.Context, tmpl string, data any) (string, error) {
+	t, err := template.New("plugin").Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Log records a log line.
+func (h *Host) Log(_ context.Context, level, message string, fields map[string]any) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	line := level + " " + message
+	if len(fields) > 0 {
+		line += fmt.Sprintf(" %v", fields)
+	}
+	h.logs = append(h.logs, line)
+	return nil
+}
+
+// Logs returns the recorded log lines as "<level> <message> [fields]".
+func (h *Host) Logs() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]string(nil), h.logs...)
+}
+
+// Secret returns a configured secret; others are not allowed.
+func (h *Host) Secret(_ context.Context, name string) (string, error) {
+	value, ok := h.Secrets[name]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", plugin.ErrSecretNotAllowed, name)
+	}
+	return value, nil
+}
@@ -0,0 +1,51 @@
+package plugintest
+
+import (
+	"fmt"
+	"io"
+	"os/exec"
+
+	"github.com/hashicorp/go-hclog"
+	goplugin "github.com/hashicorp/go-plugin"
+
+	"github.com/felixgeelhaar/release-pilot/pkg/plugin"
+)
+
+// Launch starts the plugin binary at path the way the host does and
+// returns the plugin together with a function that stops it. Plugin log
+// output is written to logOutput, or discarded if it is nil.
+func Launch(path string, logOutput io.Writer) (plugin.Plugin, func(), error) {
+	if logOutput == nil {
+		logOutput = io.Discard
+	}
+
+	client := goplugin.NewClient(&goplugin.ClientConfig{
+		HandshakeConfig:  plugin.Handshake,
+		Plugins:          plugin.PluginMap,
+		Cmd:              exec.Command(path),
+		AllowedProtocols: []goplugin.Protocol{goplugin.ProtocolGRPC},
+		Logger: hclog.New(&hclog.LoggerOptions{
+			Name:   "plugin",
+			Level:  hclog.Warn,
+			Output: logOutput,
+		}),
+	})
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return nil, nil, fmt.Errorf("failed to start plugin: %w", err)
+	}
+	raw, err := rpcClient.Dispense(plugin.PluginName)
+	if err != nil {
+		client.Kill()
+		return nil, nil, fmt.Errorf("failed to dispense plugin: %w", err)
+	}
+	p, ok := raw.(plugin.Plugin)
+	if !ok {
+		client.Kill()
+		return nil, nil, fmt.Errorf("plugin does not implement the Plugin interface")
+	}
+
+	return p, client.Kill, nil
+}
@@ -0,0 +1,486 @@
+// Package plugintest checks that a ReleasePilot plugin honors the contracts
+// the host relies on: well-formed metadata and configuration schema, dry-run
+// execution of every declared hook, timeouts, cancellation and the shape of
+// errors.
+//
+// Plugin authors run it from a Go test:
+//
+//	func TestConformance(t *testing.T) {
+//		plugintest.Test(t, &MyPlugin{}, plugintest.WithConfig(map[string]any{"channel": "#releases"}))
+//	}
+//
+// or against a built binary with 'release-pilot plugin test <path>'.
+package plugintest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"google.golang.org/grpc/status"
+
+	"github.com/felixgeelhaar/release-pilot/pkg/plugin"
+)
+
+// DefaultTimeout is the time each hook may take in dry-run mode. It matches
+// the host's default per-plugin timeout.
+const DefaultTimeout = 30 * time.Second
+
+// DefaultCancelGrace is the time a plugin may take to return once its
+// execution is canceled or times out.
+const DefaultCancelGrace = 2 * time.Second
+
+// cancelAfter is how long an execution runs before the cancellation and
+// timeout checks stop it.
+const cancelAfter = 50 * time.Millisecond
+
+// Status is the result of a check.
+type Status string
+
+// Check statuses.
+const (
+	StatusPass Status = "pass"
+	StatusFail Status = "fail"
+	StatusSkip Status = "skip"
+)
+
+// Check is the result of one conformance check.
+type Check struct {
+	// Name identifies the check, e.g. "info" or "hook post-publish".
+	Name string `json:"name"`
+	// Status is the result of the check.
+	Status Status `json:"status"`
+	// Message explains a failure or skip.
+	Message string `json:"message,omitempty"`
+	// Duration is how long the check took.
+	Duration time.Duration `json:"duration"`
+}
+
+// Report is the result of a conformance run.
+type Report struct {
+	// Plugin is the metadata the plugin reported.
+	Plugin plugin.Info `json:"plugin"`
+	// Checks lists the checks in the order they ran.
+	Checks []Check `json:"checks"`
+}
+
+// Failed returns the checks that failed.
+func (r *Report) Failed() []Check {
+	var failed []Check
+	for _, c := range r.Checks {
+		if c.Status == StatusFail {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
+
+// Passed reports whether no check failed.
+func (r *Report) Passed() bool {
+	return len(r.Failed()) == 0
+}
+
+// options configures a conformance run.
+type options struct {
+	config      map[string]any
+	releaseCtx  plugin.ReleaseContext
+	host        plugin.Host
+	timeout     time.Duration
+	cancelGrace time.Duration
+}
+
+// Option configures a conformance run.
+type Option func(*options)
+
+// WithConfig sets the plugin configuration the hooks are executed with.
+// It should be valid, as Validate must accept it.
+func WithConfig(config map[string]any) Option {
+	return func(o *options) {
+		o.config = config
+	}
+}
+
+// WithReleaseContext replaces the synthetic release context the hooks are
+// executed with.
+func WithReleaseContext(rc plugin.ReleaseContext) Option {
+	return func(o *options) {
+		o.releaseCtx = rc
+	}
+}
+
+// WithHost replaces the fake host services offered to the plugin.
+func WithHost(host plugin.Host) Option {
+	return func(o *options) {
+		o.host = host
+	}
+}
+
+// WithTimeout sets the time each hook may take (default: DefaultTimeout).
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
+
+// WithCancelGrace sets the time a plugin may take to return once canceled
+// (default: DefaultCancelGrace).
+func WithCancelGrace(d time.Duration) Option {
+	return func(o *options) {
+		o.cancelGrace = d
+	}
+}
+
+// Run runs the conformance checks against p and reports the result of
+// each. Checks that cannot run, e.g. hook checks of a plugin without
+// hooks, are skipped.
+func Run(ctx context.Context, p plugin.Plugin, opts ...Option) *Report {
+	o := options{
+		releaseCtx:  ReleaseContext(),
+		host:        NewHost(),
+		timeout:     DefaultTimeout,
+		cancelGrace: DefaultCancelGrace,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	ctx = plugin.WithHost(ctx, o.host)
+
+	r := &runner{plugin: p, opts: o}
+	report := &Report{}
+
+	report.Plugin = r.plugin.GetInfo()
+	hooks := report.Plugin.Hooks
+
+	report.Checks = append(report.Checks, timed("info", func() (Status, string) {
+		return checkInfo(report.Plugin)
+	}))
+	report.Checks = append(report.Checks, timed("config schema", func() (Status, string) {
+		if report.Plugin.ConfigSchema == "" {
+			return StatusSkip, "plugin declares no configuration schema"
+		}
+		if err := ValidateConfigSchema(report.Plugin.ConfigSchema); err != nil {
+			return StatusFail, err.Error()
+		}
+		return StatusPass, ""
+	}))
+	report.Checks = append(report.Checks, timed("validate", func() (Status, string) {
+		return r.checkValidate(ctx)
+	}))
+	for _, hook := range hooks {
+		report.Checks = append(report.Checks, timed("hook "+string(hook), func() (Status, string) {
+			return r.checkHook(ctx, hook)
+		}))
+	}
+
+	// The remaining checks execute a single hook; any declared one will do
+	var hook plugin.Hook
+	if len(hooks) > 0 {
+		hook = hooks[0]
+	}
+	report.Checks = append(report.Checks, timed("timeout", func() (Status, string) {
+		return r.checkStop(ctx, hook, func(ctx context.Context) (context.Context, context.CancelFunc) {
+			return context.WithTimeout(ctx, cancelAfter)
+		})
+	}))
+	report.Checks = append(report.Checks, timed("cancellation", func() (Status, string) {
+		return r.checkStop(ctx, hook, func(ctx context.Context) (context.Context, context.CancelFunc) {
+			ctx, cancel := context.WithCancel(ctx)
+			time.AfterFunc(cancelAfter, cancel)
+			return ctx, cancel
+		})
+	}))
+	report.Checks = append(report.Checks, timed("error shape", func() (Status, string) {
+		return r.checkErrorShape(ctx, hook, report.Plugin.ConfigSchema)
+	}))
+
+	return report
+}
+
+// Test runs the conformance checks against p in a Go test and fails t for
+// each failed check.
+func Test(t testing.TB, p plugin.Plugin, opts ...Option) *Report {
+	t.Helper()
+
+	report := Run(t.Context(), p, opts...)
+	for _, c := range report.Checks {
+		switch c.Status {
+		case StatusFail:
+			t.Errorf("%s: %s", c.Name, c.Message)
+		case StatusSkip:
+			t.Logf("%s: skipped: %s", c.Name, c.Message)
+		}
+	}
+	return report
+}
+
+// timed runs a check and records its duration.
+func timed(name string, check func() (Status, string)) Check {
+	start := time.Now()
+	result, message := check()
+	return Check{Name: name, Status: result, Message: message, Duration: time.Since(start)}
+}
+
+// runner executes the checks that call the plugin.
+type runner struct {
+	plugin plugin.Plugin
+	opts   options
+}
+
+// checkInfo checks the plugin metadata: the host looks plugins up by name,
+// compares versions and only calls declared hooks.
+func checkInfo(info plugin.Info) (Status, string) {
+	var problems []string
+
+	switch {
+	case info.Name == "":
+		problems = append(problems, "name is empty")
+	case len(info.Name) > 64:
+		problems = append(problems, "name is longer than 64 characters")
+	case strings.ContainsFunc(info.Name, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' && r != '_'
+	}):
+		problems = append(problems, fmt.Sprintf("name %q may only contain letters, digits, hyphens and underscores", info.Name))
+	}
+
+	if info.Version == "" {
+		problems = append(problems, "version is empty")
+	} else if _, err := semver.NewVersion(info.Version); err != nil {
+		problems = append(problems, fmt.Sprintf("version %q is not a semantic version", info.Version))
+	}
+
+	if info.Description == "" {
+		problems = append(problems, "description is empty")
+	}
+
+	if len(info.Hooks) == 0 {
+		problems = append(problems, "no hooks declared")
+	}
+	seen := make(map[plugin.Hook]bool, len(info.Hooks))
+	for _, h := range info.Hooks {
+		if !slices.Contains(plugin.AllHooks(), h) {
+			problems = append(problems, fmt.Sprintf("unknown hook %q", h))
+		}
+		if seen[h] {
+			problems = append(problems, fmt.Sprintf("hook %q declared twice", h))
+		}
+		seen[h] = true
+	}
+
+	if len(problems) > 0 {
+		return StatusFail, strings.Join(problems, "; ")
+	}
+	return StatusPass, ""
+}
+
+// checkValidate checks that the plugin accepts the configuration.
+func (r *runner) checkValidate(ctx context.Context) (Status, string) {
+	ctx, cancel := context.WithTimeout(ctx, r.opts.timeout)
+	defer cancel()
+
+	resp, err := r.plugin.Validate(ctx, r.opts.config)
+	if err != nil {
+		return StatusFail, fmt.Sprintf("Validate returned an error: %v", err)
+	}
+	if msg := validateResponseShape(resp); msg != "" {
+		return StatusFail, msg
+	}
+	if !resp.Valid {
+		return StatusFail, "configuration rejected: " + formatValidationErrors(resp.Errors)
+	}
+	return StatusPass, ""
+}
+
+// checkHook executes a hook in dry-run mode and checks that it succeeds in
+// time with a well-formed response.
+func (r *runner) checkHook(ctx context.Context, hook plugin.Hook) (Status, string) {
+	ctx, cancel := context.WithTimeout(ctx, r.opts.timeout)
+	defer cancel()
+
+	resp, err := r.plugin.Execute(ctx, r.request(hook, r.opts.config))
+	if ctx.Err() == context.DeadlineExceeded {
+		return StatusFail, fmt.Sprintf("dry run did not finish within %s", r.opts.timeout)
+	}
+	if err != nil {
+		return StatusFail, fmt.Sprintf("Execute returned an error: %v", err)
+	}
+	if msg := executeResponseShape(resp); msg != "" {
+		return StatusFail, msg
+	}
+	if resp != nil && !resp.Success {
+		return StatusFail, "dry run failed: " + resp.Error
+	}
+	return StatusPass, ""
+}
+
+// checkStop executes a hook with a context that stop ends shortly after
+// the execution started, and checks that the plugin returns within the
+// grace period and keeps serving afterwards.
+func (r *runner) checkStop(ctx context.Context, hook plugin.Hook, stop func(context.Context) (context.Context, context.CancelFunc)) (Status, string) {
+	if hook == "" {
+		return StatusSkip, "plugin declares no hooks"
+	}
+
+	execCtx, cancel := stop(ctx)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = r.plugin.Execute(execCtx, r.request(hook, r.opts.config))
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(cancelAfter + r.opts.cancelGrace):
+		return StatusFail, fmt.Sprintf("Execute did not return within %s of its context ending", r.opts.cancelGrace)
+	}
+
+	if info := r.plugin.GetInfo(); info.Name == "" {
+		return StatusFail, "plugin stopped responding after its context ended"
+	}
+	return StatusPass, ""
+}
+
+// checkErrorShape sends a configuration with values of the wrong type and
+// checks that the plugin reports the problem instead of failing the call.
+func (r *runner) checkErrorShape(ctx context.Context, hook plugin.Hook, schema string) (Status, string) {
+	config := mistypedConfig(schema)
+	if config == nil {
+		return StatusSkip, "configuration schema declares no typed properties"
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.opts.timeout)
+	defer cancel()
+
+	resp, err := r.plugin.Validate(ctx, config)
+	if err != nil {
+		return StatusFail, fmt.Sprintf("Validate returned an error for a mistyped configuration instead of validation errors: %v", err)
+	}
+	if msg := validateResponseShape(resp); msg != "" {
+		return StatusFail, msg
+	}
+
+	if hook == "" {
+		return StatusPass, ""
+	}
+	execResp, err := r.plugin.Execute(ctx, r.request(hook, config))
+	if err != nil && (isTransportError(err) || err.Error() == "") {
+		return StatusFail, fmt.Sprintf("Execute failed the call for a mistyped configuration: %v", err)
+	}
+	if msg := executeResponseShape(execResp); msg != "" {
+		return StatusFail, msg
+	}
+	return StatusPass, ""
+}
+
+// request returns a dry-run execution request of a hook.
+func (r *runner) request(hook plugin.Hook, config map[string]any) plugin.ExecuteRequest {
+	return plugin.ExecuteRequest{
+		Hook:    hook,
+		Config:  config,
+		Context: r.opts.releaseCtx,
+		DryRun:  true,
+	}
+}
+
+// validateResponseShape returns what is wrong with a validation response,
+// or an empty string.
+func validateResponseShape(resp *plugin.ValidateResponse) string {
+	if resp == nil {
+		return "Validate returned no response"
+	}
+	if resp.Valid && len(resp.Errors) > 0 {
+		return "Validate reported a valid configuration with errors: " + formatValidationErrors(resp.Errors)
+	}
+	if !resp.Valid && len(resp.Errors) == 0 {
+		return "Validate rejected the configuration without errors"
+	}
+	for _, e := range resp.Errors {
+		if e.Message == "" {
+			return fmt.Sprintf("validation error of field %q has no message", e.Field)
+		}
+	}
+	return ""
+}
+
+// executeResponseShape returns what is wrong with an execution response,
+// or an empty string. The host treats no response as success.
+func executeResponseShape(resp *plugin.ExecuteResponse) string {
+	if resp == nil {
+		return ""
+	}
+	if !resp.Success && resp.Error == "" {
+		return "failed response has no error message"
+	}
+	if resp.Success && resp.Error != "" {
+		return "successful response has an error message: " + resp.Error
+	}
+	for i, a := range resp.Artifacts {
+		if a.Name == "" {
+			return fmt.Sprintf("artifact %d has no name", i)
+		}
+	}
+	if _, err := json.Marshal(resp.Outputs); err != nil {
+		return fmt.Sprintf("outputs cannot be encoded as JSON: %v", err)
+	}
+	return ""
+}
+
+// isTransportError reports whether err failed the call itself rather than
+// being returned by the plugin. Over gRPC, plugin errors arrive as failed
+// responses, so an error status means the plugin crashed or hung up.
+func isTransportError(err error) bool {
+	_, ok := status.FromError(err)
+	return ok
+}
+
+// formatValidationErrors joins validation errors for a message.
+func formatValidationErrors(errs []plugin.ValidationError) string {
+	parts := make([]string, len(errs))
+	for i, e := range errs {
+		if e.Field != "" {
+			parts[i] = e.Field + ": " + e.Message
+		} else {
+			parts[i] = e.Message
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// mistypedConfig returns a configuration whose properties have values of
+// the wrong type for the schema, or nil if the schema types none.
+func mistypedConfig(schema string) map[string]any {
+	var s struct {
+		Properties map[string]struct {
+			Type any `json:"type"`
+		} `json:"properties"`
+	}
+	if json.Unmarshal([]byte(schema), &s) != nil {
+		return nil
+	}
+
+	config := make(map[string]any)
+	for _, name := range slices.Sorted(maps.Keys(s.Properties)) {
+		typ, _ := s.Properties[name].Type.(string)
+		switch typ {
+		case "string":
+			config[name] = 12345
+		case "boolean", "integer", "number":
+			config[name] = "not-a-" + typ
+		case "array":
+			config[name] = map[string]any{"not": "an array"}
+		case "object":
+			config[name] = []any{"not", "an", "object"}
+		}
+	}
+	if len(config) == 0 {
+		return nil
+	}
+	return config
+}
@@ -0,0 +1,210 @@
+package plugintest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/felixgeelhaar/release-pilot/pkg/plugin"
+)
+
+// fakePlugin is a configurable plugin under test.
+type fakePlugin struct {
+	info     plugin.Info
+	execute  func(ctx context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error)
+	validate func(ctx context.Context, config map[string]any) (*plugin.ValidateResponse, error)
+}
+
+func (p *fakePlugin) GetInfo() plugin.Info { return p.info }
+
+func (p *fakePlugin) Execute(ctx context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
+	if p.execute != nil {
+		return p.execute(ctx, req)
+	}
+	return &plugin.ExecuteResponse{Success: true, Message: "dry run"}, nil
+}
+
+func (p *fakePlugin) Validate(ctx context.Context, config map[string]any) (*plugin.ValidateResponse, error) {
+	if p.validate != nil {
+		return p.validate(ctx, config)
+	}
+	vb := plugin.NewValidationBuilder()
+	if _, ok := config["channel"].(string); config["channel"] != nil && !ok {
+		vb.AddTypeError("channel", "string")
+	}
+	return vb.Build(), nil
+}
+
+func conformantPlugin() *fakePlugin {
+	return &fakePlugin{info: plugin.Info{
+		Name:         "notify",
+		Version:      "1.0.0",
+		Description:  "Send notifications",
+		Hooks:        []plugin.Hook{plugin.HookPostPublish, plugin.HookOnError},
+		ConfigSchema: `{"type": "object", "properties": {"channel": {"type": "string"}}}`,
+	}}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(p *fakePlugin)
+		failed   string
+		contains string
+	}{
+		{
+			name:   "conformant",
+			modify: func(*fakePlugin) {},
+		},
+		{
+			name:     "invalid name",
+			modify:   func(p *fakePlugin) { p.info.Name = "my plugin" },
+			failed:   "info",
+			contains: "may only contain letters",
+		},
+		{
+			name:     "unknown hook",
+			modify:   func(p *fakePlugin) { p.info.Hooks = append(p.info.Hooks, "post-deploy") },
+			failed:   "info",
+			contains: `unknown hook "post-deploy"`,
+		},
+		{
+			name: "invalid schema",
+			modify: func(p *fakePlugin) {
+				p.info.ConfigSchema = `{"type": "object", "properties": {"channel": {"type": "text"}}}`
+			},
+			failed:   "config schema",
+			contains: `/properties/channel/type: unknown type "text"`,
+		},
+		{
+			name: "failed dry run",
+			modify: func(p *fakePlugin) {
+				p.execute = func(context.Context, plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
+					return &plugin.ExecuteResponse{Success: false, Error: "token missing"}, nil
+				}
+			},
+			failed:   "hook post-publish",
+			contains: "dry run failed: token missing",
+		},
+		{
+			name: "failure without message",
+			modify: func(p *fakePlugin) {
+				p.execute = func(context.Context, plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
+					return &plugin.ExecuteResponse{Success: false}, nil
+				}
+			},
+			failed:   "hook on-error",
+			contains: "failed response has no error message",
+		},
+		{
+			name: "rejection without errors",
+			modify: func(p *fakePlugin) {
+				p.validate = func(context.Context, map[string]any) (*plugin.ValidateResponse, error) {
+					return &plugin.ValidateResponse{Valid: false}, nil
+				}
+			},
+			failed:   "validate",
+			contains: "rejected the configuration without errors",
+		},
+		{
+			name: "mistyped config fails the call",
+			modify: func(p *fakePlugin) {
+				p.validate = func(_ context.Context, config map[string]any) (*plugin.ValidateResponse, error) {
+					if _, ok := config["channel"].(int); ok {
+						return nil, errors.New("interface conversion")
+					}
+					return &plugin.ValidateResponse{Valid: true}, nil
+				}
+			},
+			failed:   "error shape",
+			contains: "instead of validation errors",
+		},
+		{
+			name: "ignores cancellation",
+			modify: func(p *fakePlugin) {
+				p.execute = func(ctx context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
+					// Only the cancellation check runs without a deadline
+					if _, ok := ctx.Deadline(); !ok {
+						time.Sleep(300 * time.Millisecond)
+					}
+					return &plugin.ExecuteResponse{Success: true}, nil
+				}
+			},
+			failed:   "cancellation",
+			contains: "did not return within",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := conformantPlugin()
+			tt.modify(p)
+
+			report := Run(context.Background(), p, WithCancelGrace(100*time.Millisecond), WithTimeout(time.Second))
+
+			failed := report.Failed()
+			if tt.failed == "" {
+				if len(failed) > 0 {
+					t.Fatalf("Run() failed checks = %+v, want none", failed)
+				}
+				return
+			}
+			for _, c := range failed {
+				if c.Name == tt.failed {
+					if !strings.Contains(c.Message, tt.contains) {
+						t.Errorf("%s message = %q, want it to contain %q", c.Name, c.Message, tt.contains)
+					}
+					return
+				}
+			}
+			t.Errorf("Run() failed checks = %+v, want %q to fail", failed, tt.failed)
+		})
+	}
+}
+
+func TestRun_Checks(t *testing.T) {
+	report := Run(context.Background(), conformantPlugin())
+
+	var names []string
+	for _, c := range report.Checks {
+		names = append(names, c.Name+":"+string(c.Status))
+	}
+	want := []string{
+		"info:pass", "config schema:pass", "validate:pass",
+		"hook post-publish:pass", "hook on-error:pass",
+		"timeout:pass", "cancellation:pass", "error shape:pass",
+	}
+	if strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Errorf("checks = %v, want %v", names, want)
+	}
+	if report.Plugin.Name != "notify" || !report.Passed() {
+		t.Errorf("report = %+v, want a passing report of notify", report)
+	}
+}
+
+func TestRun_SyntheticContextAndHost(t *testing.T) {
+	var got []plugin.ExecuteRequest
+	var host plugin.Host
+	p := conformantPlugin()
+	p.execute = func(ctx context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
+		got, host = append(got, req), plugin.HostFrom(ctx)
+		return &plugin.ExecuteResponse{Success: true}, nil
+	}
+
+	Test(t, p, WithConfig(map[string]any{"channel": "#releases"}))
+
+	if len(got) == 0 {
+		t.Fatal("plugin was not executed")
+	}
+	if first := got[0]; !first.DryRun || first.Context.Version != "1.3.0" || first.Config["channel"] != "#releases" {
+		t.Errorf("request = %+v, want a dry run of the synthetic release with the config", first)
+	}
+	if host == nil {
+		t.Fatal("plugin got no host")
+	}
+	if _, err := host.Secret(context.Background(), "AWS_SECRET_ACCESS_KEY"); !errors.Is(err, plugin.ErrSecretNotAllowed) {
+		t.Errorf("Secret() error = %v, want ErrSecretNotAllowed", err)
+	}
+}
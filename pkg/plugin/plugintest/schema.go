@@ -0,0 +1,243 @@
+package plugintest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// schemaTypes are the JSON Schema primitive types.
+var schemaTypes = []string{"null", "boolean", "object", "array", "number", "string", "integer"}
+
+// Keywords by the kind of value they take.
+var (
+	// subschemaKeywords take a schema.
+	subschemaKeywords = []string{
+		"additionalProperties", "additionalItems", "not", "if", "then", "else",
+		"contains", "propertyNames", "unevaluatedProperties", "unevaluatedItems",
+	}
+	// schemaMapKeywords take an object whose values are schemas.
+	schemaMapKeywords = []string{"properties", "patternProperties", "$defs", "definitions", "dependentSchemas"}
+	// schemaListKeywords take a non-empty array of schemas.
+	schemaListKeywords = []string{"allOf", "anyOf", "oneOf", "prefixItems"}
+	// countKeywords take a non-negative integer.
+	countKeywords = []string{"minLength", "maxLength", "minItems", "maxItems", "minProperties", "maxProperties", "minContains", "maxContains"}
+	// numberKeywords take a number.
+	numberKeywords = []string{"minimum", "maximum", "multipleOf"}
+	// stringKeywords take a string.
+	stringKeywords = []string{"title", "description", "format", "$id", "$schema", "$ref", "$comment", "$anchor", "contentEncoding", "contentMediaType"}
+	// boolKeywords take a boolean.
+	boolKeywords = []string{"uniqueItems", "readOnly", "writeOnly", "deprecated"}
+)
+
+// ValidateConfigSchema checks that schema is a well-formed JSON Schema
+// object: valid JSON, and every keyword the host and tooling read has a
+// value of the right kind. Unknown keywords are allowed, as JSON Schema
+// permits them. It does not resolve $ref.
+func ValidateConfigSchema(schema string) error {
+	var root any
+	if err := json.Unmarshal([]byte(schema), &root); err != nil {
+		return fmt.Errorf("configuration schema is not valid JSON: %w", err)
+	}
+	obj, ok := root.(map[string]any)
+	if !ok {
+		return errors.New("configuration schema must be a JSON object")
+	}
+	if t, ok := obj["type"].(string); ok && t != "object" {
+		return fmt.Errorf("configuration schema must describe an object, not %q", t)
+	}
+
+	var problems []string
+	checkSchema("", root, &problems)
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid configuration schema: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// checkSchema checks the schema at a JSON pointer and records its problems.
+func checkSchema(path string, schema any, problems *[]string) {
+	report := func(keyword, format string, args ...any) {
+		*problems = append(*problems, fmt.Sprintf("%s/%s: %s", path, keyword, fmt.Sprintf(format, args...)))
+	}
+
+	if _, ok := schema.(bool); ok {
+		return
+	}
+	obj, ok := schema.(map[string]any)
+	if !ok {
+		*problems = append(*problems, fmt.Sprintf("%s: schema must be an object or a boolean", pathOrRoot(path)))
+		return
+	}
+
+	if t, ok := obj["type"]; ok {
+		checkType(t, func(format string, args ...any) { report("type", format, args...) })
+	}
+
+	for _, kw := range subschemaKeywords {
+		if v, ok := obj[kw]; ok {
+			checkSchema(path+"/"+kw, v, problems)
+		}
+	}
+	if v, ok := obj["items"]; ok {
+		// Draft 7 also allows an array of schemas
+		if list, isList := v.([]any); isList {
+			for i, s := range list {
+				checkSchema(fmt.Sprintf("%s/items/%d", path, i), s, problems)
+			}
+		} else {
+			checkSchema(path+"/items", v, problems)
+		}
+	}
+	for _, kw := range schemaMapKeywords {
+		v, ok := obj[kw]
+		if !ok {
+			continue
+		}
+		m, isMap := v.(map[string]any)
+		if !isMap {
+			report(kw, "must be an object")
+			continue
+		}
+		for _, name := range slices.Sorted(maps.Keys(m)) {
+			if kw == "patternProperties" {
+				if _, err := regexp.Compile(name); err != nil {
+					report(kw, "invalid pattern %q", name)
+				}
+			}
+			checkSchema(path+"/"+kw+"/"+escapePointer(name), m[name], problems)
+		}
+	}
+	for _, kw := range schemaListKeywords {
+		v, ok := obj[kw]
+		if !ok {
+			continue
+		}
+		list, isList := v.([]any)
+		if !isList || len(list) == 0 {
+			report(kw, "must be a non-empty array")
+			continue
+		}
+		for i, s := range list {
+			checkSchema(fmt.Sprintf("%s/%s/%d", path, kw, i), s, problems)
+		}
+	}
+
+	if v, ok := obj["required"]; ok {
+		checkUniqueStrings(v, func(format string, args ...any) { report("required", format, args...) })
+	}
+	if v, ok := obj["enum"]; ok {
+		if _, isList := v.([]any); !isList {
+			report("enum", "must be an array")
+		}
+	}
+	if v, ok := obj["pattern"]; ok {
+		if p, isString := v.(string); !isString {
+			report("pattern", "must be a string")
+		} else if _, err := regexp.Compile(p); err != nil {
+			report("pattern", "invalid pattern %q", p)
+		}
+	}
+	for _, kw := range countKeywords {
+		if v, ok := obj[kw]; ok {
+			if n, isNumber := v.(float64); !isNumber || n < 0 || n != float64(int64(n)) {
+				report(kw, "must be a non-negative integer")
+			}
+		}
+	}
+	for _, kw := range numberKeywords {
+		if v, ok := obj[kw]; ok {
+			if _, isNumber := v.(float64); !isNumber {
+				report(kw, "must be a number")
+			}
+		}
+	}
+	if n, ok := obj["multipleOf"].(float64); ok && n <= 0 {
+		report("multipleOf", "must be greater than 0")
+	}
+	for _, kw := range []string{"exclusiveMinimum", "exclusiveMaximum"} {
+		// Draft 4 used booleans, later drafts numbers
+		if v, ok := obj[kw]; ok {
+			switch v.(type) {
+			case float64, bool:
+			default:
+				report(kw, "must be a number")
+			}
+		}
+	}
+	for _, kw := range stringKeywords {
+		if v, ok := obj[kw]; ok {
+			if _, isString := v.(string); !isString {
+				report(kw, "must be a string")
+			}
+		}
+	}
+	for _, kw := range boolKeywords {
+		if v, ok := obj[kw]; ok {
+			if _, isBool := v.(bool); !isBool {
+				report(kw, "must be a boolean")
+			}
+		}
+	}
+}
+
+// checkType checks the value of a type keyword: a type name or a
+// non-empty array of distinct type names.
+func checkType(t any, report func(format string, args ...any)) {
+	switch t := t.(type) {
+	case string:
+		if !slices.Contains(schemaTypes, t) {
+			report("unknown type %q", t)
+		}
+	case []any:
+		if len(t) == 0 {
+			report("must not be empty")
+		}
+		checkUniqueStrings(t, report)
+		for _, v := range t {
+			if s, ok := v.(string); ok && !slices.Contains(schemaTypes, s) {
+				report("unknown type %q", s)
+			}
+		}
+	default:
+		report("must be a string or an array of strings")
+	}
+}
+
+// checkUniqueStrings checks that v is an array of distinct strings.
+func checkUniqueStrings(v any, report func(format string, args ...any)) {
+	list, ok := v.([]any)
+	if !ok {
+		report("must be an array of strings")
+		return
+	}
+	seen := make(map[string]bool, len(list))
+	for _, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			report("must be an array of strings")
+			return
+		}
+		if seen[s] {
+			report("duplicate entry %q", s)
+		}
+		seen[s] = true
+	}
+}
+
+// escapePointer escapes a JSON pointer token.
+func escapePointer(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
+
+// pathOrRoot returns a JSON pointer, or "(root)" for the empty one.
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
@@ -0,0 +1,56 @@
+package plugintest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateConfigSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			schema: `{
+				"type": "object",
+				"properties": {
+					"tap": {"type": "string", "pattern": "^[a-z]+/[a-z-]+$"},
+					"create_pr": {"type": "boolean", "default": false},
+					"dependencies": {"type": "array", "items": {"type": "string"}, "uniqueItems": true},
+					"retries": {"type": ["integer", "null"], "minimum": 0, "maxLength": 3}
+				},
+				"required": ["tap"],
+				"additionalProperties": false
+			}`,
+		},
+		{name: "invalid JSON", schema: `{"type": "object",}`, wantErr: "not valid JSON"},
+		{name: "not an object", schema: `["string"]`, wantErr: "must be a JSON object"},
+		{name: "not an object schema", schema: `{"type": "string"}`, wantErr: `must describe an object, not "string"`},
+		{name: "unknown type", schema: `{"properties": {"a": {"type": "text"}}}`, wantErr: `/properties/a/type: unknown type "text"`},
+		{name: "required not strings", schema: `{"required": "tap"}`, wantErr: "/required: must be an array of strings"},
+		{name: "duplicate required", schema: `{"required": ["tap", "tap"]}`, wantErr: `/required: duplicate entry "tap"`},
+		{name: "properties not object", schema: `{"properties": []}`, wantErr: "/properties: must be an object"},
+		{name: "property not schema", schema: `{"properties": {"a": "string"}}`, wantErr: "/properties/a: schema must be an object or a boolean"},
+		{name: "invalid pattern", schema: `{"properties": {"a": {"pattern": "("}}}`, wantErr: `/properties/a/pattern: invalid pattern "("`},
+		{name: "negative count", schema: `{"properties": {"a": {"minLength": -1}}}`, wantErr: "/properties/a/minLength: must be a non-negative integer"},
+		{name: "empty anyOf", schema: `{"anyOf": []}`, wantErr: "/anyOf: must be a non-empty array"},
+		{name: "nested items", schema: `{"properties": {"a": {"items": {"type": 1}}}}`, wantErr: "/properties/a/items/type: must be a string or an array of strings"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateConfigSchema(tt.schema)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateConfigSchema() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateConfigSchema() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
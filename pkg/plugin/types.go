@@ -28,6 +28,7 @@ const (
 	HookProto_HOOK_POST_PUBLISH HookProto = 12
 	HookProto_HOOK_ON_SUCCESS   HookProto = 13
 	HookProto_HOOK_ON_ERROR     HookProto = 14
+	HookProto_HOOK_ON_ROLLBACK  HookProto = 15
 )
 
 // Empty is an empty message.
@@ -200,9 +200,9 @@ func (p *ChocolateyPlugin) buildAndPush(ctx context.Context, cfg *Config, releas
 	version := strings.TrimPrefix(releaseCtx.Version, "v")
 	tag := releaseCtx.TagName
 
-	// Resolve download URLs
-	url32 := p.resolveURL(cfg.DownloadURL32, version, tag)
-	url64 := p.resolveURL(cfg.DownloadURL64, version, tag)
+	// Resolve download URLs, which may refer to outputs of earlier plugins
+	url32 := releaseCtx.ExpandOutputs(p.resolveURL(cfg.DownloadURL32, version, tag))
+	url64 := releaseCtx.ExpandOutputs(p.resolveURL(cfg.DownloadURL64, version, tag))
 
 	if dryRun {
 		return &plugin.ExecuteResponse{
@@ -44,6 +44,8 @@ type Config struct {
 	NoCache bool `json:"no_cache"`
 	// Target is the target build stage.
 	Target string `json:"target,omitempty"`
+	// RollbackTag is the tag pointed back at the previous version on rollback (default: "latest").
+	RollbackTag string `json:"rollback_tag,omitempty"`
 }
 
 // GetInfo returns plugin metadata.
@@ -55,6 +57,7 @@ func (p *DockerPlugin) GetInfo() plugin.Info {
 		Author:      "ReleasePilot Team",
 		Hooks: []plugin.Hook{
 			plugin.HookPostPublish,
+			plugin.HookOnRollback,
 		},
 		ConfigSchema: `{
 			"type": "object",
@@ -72,7 +75,8 @@ func (p *DockerPlugin) GetInfo() plugin.Info {
 				"labels": {"type": "object", "description": "Image labels"},
 				"cache_from": {"type": "array", "items": {"type": "string"}, "description": "Cache source images"},
 				"no_cache": {"type": "boolean", "description": "Disable build cache"},
-				"target": {"type": "string", "description": "Target build stage"}
+				"target": {"type": "string", "description": "Target build stage"},
+				"rollback_tag": {"type": "string", "description": "Tag pointed back at the previous version on rollback", "default": "latest"}
 			},
 			"required": ["image"]
 		}`,
@@ -86,6 +90,8 @@ func (p *DockerPlugin) Execute(ctx context.Context, req plugin.ExecuteRequest) (
 	switch req.Hook {
 	case plugin.HookPostPublish:
 		return p.buildAndPush(ctx, cfg, req.Context, req.DryRun)
+	case plugin.HookOnRollback:
+		return p.retagPrevious(ctx, cfg, req.Context, req.DryRun)
 	default:
 		return &plugin.ExecuteResponse{
 			Success: true,
@@ -130,11 +136,7 @@ func (p *DockerPlugin) buildAndPush(ctx context.Context, cfg *Config, releaseCtx
 	// Build full image names
 	imageNames := make([]string, 0, len(resolvedTags))
 	for _, tag := range resolvedTags {
-		imageName := cfg.Image
-		if cfg.Registry != "" && cfg.Registry != "docker.io" {
-			imageName = fmt.Sprintf("%s/%s", cfg.Registry, cfg.Image)
-		}
-		imageNames = append(imageNames, fmt.Sprintf("%s:%s", imageName, tag))
+		imageNames = append(imageNames, p.imageRef(cfg, tag))
 	}
 
 	if dryRun {
@@ -190,6 +192,81 @@ func (p *DockerPlugin) buildAndPush(ctx context.Context, cfg *Config, releaseCtx
 	}, nil
 }
 
+// retagPrevious points the rollback tag (usually "latest") back at the image
+// of the previous version. The rolled back version's own tag is left alone,
+// since registries do not reliably support deleting tags.
+func (p *DockerPlugin) retagPrevious(ctx context.Context, cfg *Config, releaseCtx plugin.ReleaseContext, dryRun bool) (*plugin.ExecuteResponse, error) {
+	if cfg.Image == "" {
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   "Docker image name is required",
+		}, nil
+	}
+
+	previous := strings.TrimPrefix(releaseCtx.PreviousVersion, "v")
+	if previous == "" {
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("no previous version to point %s back at", cfg.RollbackTag),
+		}, nil
+	}
+
+	source := p.imageRef(cfg, previous)
+	target := p.imageRef(cfg, cfg.RollbackTag)
+
+	if dryRun {
+		return &plugin.ExecuteResponse{
+			Success: true,
+			Message: fmt.Sprintf("Would retag %s as %s", source, target),
+			Outputs: map[string]any{
+				"image":  cfg.Image,
+				"source": source,
+				"target": target,
+			},
+		}, nil
+	}
+
+	if cfg.Username != "" && cfg.Password != "" {
+		if err := p.dockerLogin(ctx, cfg); err != nil {
+			return &plugin.ExecuteResponse{
+				Success: false,
+				Error:   fmt.Sprintf("failed to login to registry: %v", err),
+			}, nil
+		}
+	}
+
+	// imagetools copies the manifest list in the registry, so multi-arch
+	// images are retagged without pulling them.
+	cmd := exec.CommandContext(ctx, "docker", "buildx", "imagetools", "create", "--tag", target, source)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("failed to retag %s as %s: %v", source, target, err),
+		}, nil
+	}
+
+	return &plugin.ExecuteResponse{
+		Success: true,
+		Message: fmt.Sprintf("Retagged %s as %s", source, target),
+		Outputs: map[string]any{
+			"image":  cfg.Image,
+			"source": source,
+			"target": target,
+		},
+	}, nil
+}
+
+// imageRef returns the full image reference for a tag.
+func (p *DockerPlugin) imageRef(cfg *Config, tag string) string {
+	imageName := cfg.Image
+	if cfg.Registry != "" && cfg.Registry != "docker.io" {
+		imageName = fmt.Sprintf("%s/%s", cfg.Registry, cfg.Image)
+	}
+	return fmt.Sprintf("%s:%s", imageName, tag)
+}
+
 // dockerLogin authenticates with the container registry.
 func (p *DockerPlugin) dockerLogin(ctx context.Context, cfg *Config) error {
 	registry := cfg.Registry
@@ -285,20 +362,21 @@ func (p *DockerPlugin) parseConfig(raw map[string]any) *Config {
 	parser := plugin.NewConfigParser(raw)
 
 	return &Config{
-		Registry:   parser.GetStringDefault("registry", "docker.io"),
-		Image:      parser.GetString("image"),
-		Tags:       parser.GetStringSlice("tags"),
-		Dockerfile: parser.GetStringDefault("dockerfile", "Dockerfile"),
-		Context:    parser.GetStringDefault("context", "."),
-		BuildArgs:  parser.GetStringMap("build_args"),
-		Platforms:  parser.GetStringSlice("platforms"),
-		Username:   parser.GetString("username", "DOCKER_USERNAME"),
-		Password:   parser.GetString("password", "DOCKER_PASSWORD", "DOCKER_TOKEN"),
-		Push:       parser.GetBoolDefault("push", true),
-		Labels:     parser.GetStringMap("labels"),
-		CacheFrom:  parser.GetStringSlice("cache_from"),
-		NoCache:    parser.GetBool("no_cache"),
-		Target:     parser.GetString("target"),
+		Registry:    parser.GetStringDefault("registry", "docker.io"),
+		Image:       parser.GetString("image"),
+		Tags:        parser.GetStringSlice("tags"),
+		Dockerfile:  parser.GetStringDefault("dockerfile", "Dockerfile"),
+		Context:     parser.GetStringDefault("context", "."),
+		BuildArgs:   parser.GetStringMap("build_args"),
+		Platforms:   parser.GetStringSlice("platforms"),
+		Username:    parser.GetString("username", "DOCKER_USERNAME"),
+		Password:    parser.GetString("password", "DOCKER_PASSWORD", "DOCKER_TOKEN"),
+		Push:        parser.GetBoolDefault("push", true),
+		Labels:      parser.GetStringMap("labels"),
+		CacheFrom:   parser.GetStringSlice("cache_from"),
+		NoCache:     parser.GetBool("no_cache"),
+		Target:      parser.GetString("target"),
+		RollbackTag: parser.GetStringDefault("rollback_tag", "latest"),
 	}
 }
 
@@ -20,8 +20,8 @@ func TestGetInfo(t *testing.T) {
 		t.Errorf("expected version '1.0.0', got %s", info.Version)
 	}
 
-	if len(info.Hooks) != 1 || info.Hooks[0] != plugin.HookPostPublish {
-		t.Errorf("expected HookPostPublish, got %v", info.Hooks)
+	if len(info.Hooks) != 2 || info.Hooks[0] != plugin.HookPostPublish || info.Hooks[1] != plugin.HookOnRollback {
+		t.Errorf("expected HookPostPublish and HookOnRollback, got %v", info.Hooks)
 	}
 }
 
@@ -207,6 +207,79 @@ func TestExecuteUnhandledHook(t *testing.T) {
 	}
 }
 
+func TestExecuteRollbackDryRun(t *testing.T) {
+	p := &DockerPlugin{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name            string
+		config          map[string]any
+		previousVersion string
+		wantSource      string
+		wantTarget      string
+		wantErr         bool
+	}{
+		{
+			name:            "retags latest",
+			config:          map[string]any{"image": "user/app"},
+			previousVersion: "v1.0.0",
+			wantSource:      "user/app:1.0.0",
+			wantTarget:      "user/app:latest",
+		},
+		{
+			name:            "custom tag and registry",
+			config:          map[string]any{"image": "user/app", "registry": "ghcr.io", "rollback_tag": "stable"},
+			previousVersion: "1.0.0",
+			wantSource:      "ghcr.io/user/app:1.0.0",
+			wantTarget:      "ghcr.io/user/app:stable",
+		},
+		{
+			name:    "no previous version",
+			config:  map[string]any{"image": "user/app"},
+			wantErr: true,
+		},
+		{
+			name:            "missing image",
+			config:          map[string]any{},
+			previousVersion: "1.0.0",
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := p.Execute(ctx, plugin.ExecuteRequest{
+				Hook:   plugin.HookOnRollback,
+				Config: tt.config,
+				Context: plugin.ReleaseContext{
+					Version:         "1.1.0",
+					PreviousVersion: tt.previousVersion,
+				},
+				DryRun: true,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantErr {
+				if resp.Success {
+					t.Errorf("expected failure, got %+v", resp)
+				}
+				return
+			}
+			if !resp.Success {
+				t.Fatalf("expected success, got error: %s", resp.Error)
+			}
+			if resp.Outputs["source"] != tt.wantSource {
+				t.Errorf("expected source %s, got %v", tt.wantSource, resp.Outputs["source"])
+			}
+			if resp.Outputs["target"] != tt.wantTarget {
+				t.Errorf("expected target %s, got %v", tt.wantTarget, resp.Outputs["target"])
+			}
+		})
+	}
+}
+
 func TestTagResolution(t *testing.T) {
 	p := &DockerPlugin{}
 	ctx := context.Background()
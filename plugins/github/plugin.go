@@ -4,6 +4,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 
 	"github.com/google/go-github/v60/github"
@@ -33,8 +34,18 @@ type Config struct {
 	Assets []string `json:"assets,omitempty"`
 	// DiscussionCategory creates a discussion for the release.
 	DiscussionCategory string `json:"discussion_category,omitempty"`
+	// RollbackAction is what happens to the release on rollback: "delete" or "draft".
+	RollbackAction string `json:"rollback_action,omitempty"`
+	// Locale selects the localized release notes to use (e.g., "de").
+	Locale string `json:"locale,omitempty"`
 }
 
+// Rollback actions for the on-rollback hook.
+const (
+	rollbackActionDelete = "delete"
+	rollbackActionDraft  = "draft"
+)
+
 // GetInfo returns plugin metadata.
 func (p *GitHubPlugin) GetInfo() plugin.Info {
 	return plugin.Info{
@@ -46,6 +57,7 @@ func (p *GitHubPlugin) GetInfo() plugin.Info {
 			plugin.HookPostPublish,
 			plugin.HookOnSuccess,
 			plugin.HookOnError,
+			plugin.HookOnRollback,
 		},
 		ConfigSchema: `{
 			"type": "object",
@@ -57,7 +69,9 @@ func (p *GitHubPlugin) GetInfo() plugin.Info {
 				"prerelease": {"type": "boolean", "description": "Mark as prerelease", "default": false},
 				"generate_release_notes": {"type": "boolean", "description": "Use GitHub's auto-generated notes", "default": false},
 				"assets": {"type": "array", "items": {"type": "string"}, "description": "Files to upload"},
-				"discussion_category": {"type": "string", "description": "Discussion category name"}
+				"discussion_category": {"type": "string", "description": "Discussion category name"},
+				"rollback_action": {"type": "string", "enum": ["delete", "draft"], "description": "On rollback, delete the release or turn it back into a draft", "default": "delete"},
+				"locale": {"type": "string", "description": "Locale of the localized release notes to use (e.g., de)"}
 			}
 		}`,
 	}
@@ -66,6 +80,7 @@ func (p *GitHubPlugin) GetInfo() plugin.Info {
 // Execute runs the plugin for a given hook.
 func (p *GitHubPlugin) Execute(ctx context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
 	cfg := p.parseConfig(req.Config)
+	req.Context = req.Context.Localize(cfg.Locale)
 
 	switch req.Hook {
 	case plugin.HookPostPublish:
@@ -80,6 +95,8 @@ func (p *GitHubPlugin) Execute(ctx context.Context, req plugin.ExecuteRequest) (
 			Success: true,
 			Message: "Release failed notification acknowledged",
 		}, nil
+	case plugin.HookOnRollback:
+		return p.rollbackRelease(ctx, cfg, req.Context, req.DryRun)
 	default:
 		return &plugin.ExecuteResponse{
 			Success: true,
@@ -99,17 +116,7 @@ func (p *GitHubPlugin) createRelease(ctx context.Context, cfg *Config, releaseCt
 		}, nil
 	}
 
-	// Get owner/repo
-	owner := cfg.Owner
-	repo := cfg.Repo
-
-	if owner == "" {
-		owner = releaseCtx.RepositoryOwner
-	}
-	if repo == "" {
-		repo = releaseCtx.RepositoryName
-	}
-
+	owner, repo := p.resolveRepository(cfg, releaseCtx)
 	if owner == "" || repo == "" {
 		return &plugin.ExecuteResponse{
 			Success: false,
@@ -124,6 +131,7 @@ func (p *GitHubPlugin) createRelease(ctx context.Context, cfg *Config, releaseCt
 	if body == "" {
 		body = releaseCtx.Changelog
 	}
+	body = releaseCtx.AppendMigrationGuide(body)
 
 	release := &github.RepositoryRelease{
 		TagName:              &tagName,
@@ -187,6 +195,106 @@ func (p *GitHubPlugin) createRelease(ctx context.Context, cfg *Config, releaseCt
 	}, nil
 }
 
+// rollbackRelease deletes the GitHub release for the rolled back tag, or turns
+// it back into a draft. A missing release counts as already rolled back.
+func (p *GitHubPlugin) rollbackRelease(ctx context.Context, cfg *Config, releaseCtx plugin.ReleaseContext, dryRun bool) (*plugin.ExecuteResponse, error) {
+	action := cfg.RollbackAction
+	if action == "" {
+		action = rollbackActionDelete
+	}
+	if action != rollbackActionDelete && action != rollbackActionDraft {
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("unsupported rollback_action %q (use %q or %q)", action, rollbackActionDelete, rollbackActionDraft),
+		}, nil
+	}
+
+	owner, repo := p.resolveRepository(cfg, releaseCtx)
+	if owner == "" || repo == "" {
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   "repository owner and name are required",
+		}, nil
+	}
+
+	tagName := releaseCtx.TagName
+	if dryRun {
+		return &plugin.ExecuteResponse{
+			Success: true,
+			Message: fmt.Sprintf("Would %s GitHub release %s for %s/%s", action, tagName, owner, repo),
+			Outputs: map[string]any{
+				"tag_name": tagName,
+				"action":   action,
+			},
+		}, nil
+	}
+
+	client, err := p.getClient(ctx, cfg)
+	if err != nil {
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("failed to create GitHub client: %v", err),
+		}, nil
+	}
+
+	existing, resp, err := client.Repositories.GetReleaseByTag(ctx, owner, repo, tagName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return &plugin.ExecuteResponse{
+				Success: true,
+				Message: fmt.Sprintf("No GitHub release for %s, nothing to roll back", tagName),
+			}, nil
+		}
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("failed to find release %s: %v", tagName, err),
+		}, nil
+	}
+
+	if action == rollbackActionDraft {
+		draft := true
+		if _, _, err := client.Repositories.EditRelease(ctx, owner, repo, existing.GetID(), &github.RepositoryRelease{Draft: &draft}); err != nil {
+			return &plugin.ExecuteResponse{
+				Success: false,
+				Error:   fmt.Sprintf("failed to mark release %s as draft: %v", tagName, err),
+			}, nil
+		}
+		return &plugin.ExecuteResponse{
+			Success: true,
+			Message: fmt.Sprintf("Marked GitHub release %s as draft", tagName),
+			Outputs: map[string]any{"release_id": existing.GetID(), "action": action},
+		}, nil
+	}
+
+	if _, err := client.Repositories.DeleteRelease(ctx, owner, repo, existing.GetID()); err != nil {
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("failed to delete release %s: %v", tagName, err),
+		}, nil
+	}
+
+	return &plugin.ExecuteResponse{
+		Success: true,
+		Message: fmt.Sprintf("Deleted GitHub release %s", tagName),
+		Outputs: map[string]any{"release_id": existing.GetID(), "action": action},
+	}, nil
+}
+
+// resolveRepository returns the repository owner and name, preferring the
+// plugin configuration over the release context.
+func (p *GitHubPlugin) resolveRepository(cfg *Config, releaseCtx plugin.ReleaseContext) (string, string) {
+	owner := cfg.Owner
+	repo := cfg.Repo
+
+	if owner == "" {
+		owner = releaseCtx.RepositoryOwner
+	}
+	if repo == "" {
+		repo = releaseCtx.RepositoryName
+	}
+	return owner, repo
+}
+
 // uploadAsset uploads a release asset.
 func (p *GitHubPlugin) uploadAsset(ctx context.Context, client *github.Client, owner, repo string, releaseID int64, assetPath string) (*plugin.Artifact, error) {
 	// Validate and sanitize the asset path to prevent path traversal
@@ -237,9 +345,10 @@ func (p *GitHubPlugin) uploadAsset(ctx context.Context, client *github.Client, o
 
 	return &plugin.Artifact{
 		Name: name,
-		Path: asset.GetBrowserDownloadURL(),
+		Path: assetPath,
 		Type: "url",
 		Size: fileInfo.Size(),
+		URL:  asset.GetBrowserDownloadURL(),
 	}, nil
 }
 
@@ -276,6 +385,8 @@ func (p *GitHubPlugin) parseConfig(raw map[string]any) *Config {
 		GenerateReleaseNotes: parser.GetBool("generate_release_notes"),
 		Assets:               parser.GetStringSlice("assets"),
 		DiscussionCategory:   parser.GetString("discussion_category"),
+		RollbackAction:       parser.GetString("rollback_action"),
+		Locale:               parser.GetString("locale"),
 	}
 }
 
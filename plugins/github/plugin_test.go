@@ -41,7 +41,7 @@ func TestGitHubPlugin_GetInfo(t *testing.T) {
 	}
 
 	// Check hooks
-	expectedHooks := []plugin.Hook{plugin.HookPostPublish, plugin.HookOnSuccess, plugin.HookOnError}
+	expectedHooks := []plugin.Hook{plugin.HookPostPublish, plugin.HookOnSuccess, plugin.HookOnError, plugin.HookOnRollback}
 	if len(info.Hooks) != len(expectedHooks) {
 		t.Errorf("GetInfo().Hooks len = %d, want %d", len(info.Hooks), len(expectedHooks))
 	}
@@ -191,6 +191,74 @@ func TestGitHubPlugin_Execute_PostPublish_DryRun(t *testing.T) {
 	}
 }
 
+// TestGitHubPlugin_Execute_OnRollback tests the on-rollback hook.
+func TestGitHubPlugin_Execute_OnRollback(t *testing.T) {
+	p := &GitHubPlugin{}
+
+	tests := []struct {
+		name        string
+		config      map[string]any
+		dryRun      bool
+		wantSuccess bool
+		wantAction  string
+		wantError   string
+	}{
+		{
+			name:        "dry run deletes by default",
+			config:      map[string]any{"owner": "test-owner", "repo": "test-repo"},
+			dryRun:      true,
+			wantSuccess: true,
+			wantAction:  "delete",
+		},
+		{
+			name:        "dry run draft",
+			config:      map[string]any{"owner": "test-owner", "repo": "test-repo", "rollback_action": "draft"},
+			dryRun:      true,
+			wantSuccess: true,
+			wantAction:  "draft",
+		},
+		{
+			name:      "unsupported action",
+			config:    map[string]any{"owner": "test-owner", "repo": "test-repo", "rollback_action": "archive"},
+			dryRun:    true,
+			wantError: "unsupported rollback_action",
+		},
+		{
+			name:      "missing repository",
+			config:    map[string]any{},
+			dryRun:    true,
+			wantError: "repository owner and name are required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := p.Execute(context.Background(), plugin.ExecuteRequest{
+				Hook:    plugin.HookOnRollback,
+				Config:  tt.config,
+				Context: plugin.ReleaseContext{Version: "1.0.0", TagName: "v1.0.0"},
+				DryRun:  tt.dryRun,
+			})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			if tt.wantError != "" {
+				if resp.Success || !strings.Contains(resp.Error, tt.wantError) {
+					t.Errorf("Execute() = %+v, want error containing %q", resp, tt.wantError)
+				}
+				return
+			}
+			if resp.Success != tt.wantSuccess {
+				t.Errorf("Execute() Success = %v, Error = %s", resp.Success, resp.Error)
+			}
+			if resp.Outputs["action"] != tt.wantAction {
+				t.Errorf("Outputs[action] = %v, want %v", resp.Outputs["action"], tt.wantAction)
+			}
+		})
+	}
+}
+
 // TestGitHubPlugin_Execute_PostPublish_NoToken tests missing token error.
 func TestGitHubPlugin_Execute_PostPublish_NoToken(t *testing.T) {
 	p := &GitHubPlugin{}
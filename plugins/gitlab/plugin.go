@@ -156,6 +156,7 @@ func (p *GitLabPlugin) createRelease(ctx context.Context, cfg *Config, releaseCt
 		if description == "" {
 			description = releaseCtx.Changelog
 		}
+		description = releaseCtx.AppendMigrationGuide(description)
 	}
 
 	ref := cfg.Ref
@@ -165,12 +165,13 @@ func (p *HomebrewPlugin) publishFormula(ctx context.Context, cfg *Config, releas
 		formulaName = releaseCtx.RepositoryName
 	}
 
-	// Generate download URLs
+	// Generate download URLs, which may refer to outputs of earlier plugins
+	// such as {{artifacts.github.app_{{os}}_{{arch}}.tar.gz}}
 	version := strings.TrimPrefix(releaseCtx.Version, "v")
 	tag := releaseCtx.TagName
 
-	urlX86_64 := p.resolveURL(cfg.DownloadURLTemplate, version, tag, "darwin", "amd64")
-	urlArm64 := p.resolveURL(cfg.DownloadURLTemplate, version, tag, "darwin", "arm64")
+	urlX86_64 := releaseCtx.ExpandOutputs(p.resolveURL(cfg.DownloadURLTemplate, version, tag, "darwin", "amd64"))
+	urlArm64 := releaseCtx.ExpandOutputs(p.resolveURL(cfg.DownloadURLTemplate, version, tag, "darwin", "arm64"))
 
 	if dryRun {
 		return &plugin.ExecuteResponse{
@@ -65,6 +65,7 @@ func (p *JiraPlugin) GetInfo() plugin.Info {
 			plugin.HookPostPublish,
 			plugin.HookOnSuccess,
 			plugin.HookOnError,
+			plugin.HookOnRollback,
 		},
 		ConfigSchema: `{
 			"type": "object",
@@ -108,6 +109,8 @@ func (p *JiraPlugin) Execute(ctx context.Context, req plugin.ExecuteRequest) (*p
 			Success: true,
 			Message: "Release failed - Jira integration acknowledged",
 		}, nil
+	case plugin.HookOnRollback:
+		return p.handleRollback(ctx, cfg, req.Context, req.DryRun)
 	default:
 		return &plugin.ExecuteResponse{
 			Success: true,
@@ -265,6 +268,68 @@ func (p *JiraPlugin) handlePostPublish(ctx context.Context, cfg *Config, release
 	}, nil
 }
 
+// handleRollback handles the OnRollback hook - mark the version as unreleased.
+// Issue associations and transitions are left as they are.
+func (p *JiraPlugin) handleRollback(ctx context.Context, cfg *Config, releaseCtx plugin.ReleaseContext, dryRun bool) (*plugin.ExecuteResponse, error) {
+	client, err := p.getClient(cfg)
+	if err != nil {
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("failed to create Jira client: %v", err),
+		}, nil
+	}
+
+	versionName := cfg.VersionName
+	if versionName == "" {
+		versionName = releaseCtx.Version
+	}
+
+	if dryRun {
+		return &plugin.ExecuteResponse{
+			Success: true,
+			Message: fmt.Sprintf("Would mark version '%s' in project %s as unreleased", versionName, cfg.ProjectKey),
+			Outputs: map[string]any{
+				"version_name": versionName,
+				"project_key":  cfg.ProjectKey,
+			},
+		}, nil
+	}
+
+	versions, err := client.Project.ListProjectVersions(ctx, cfg.ProjectKey)
+	if err != nil {
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("failed to list project versions: %v", err),
+		}, nil
+	}
+
+	for _, v := range versions {
+		if v.Name != versionName {
+			continue
+		}
+		if err := p.unreleaseVersion(ctx, client, v.ID); err != nil {
+			return &plugin.ExecuteResponse{
+				Success: false,
+				Error:   fmt.Sprintf("failed to unrelease version: %v", err),
+			}, nil
+		}
+		return &plugin.ExecuteResponse{
+			Success: true,
+			Message: fmt.Sprintf("Marked version '%s' as unreleased", versionName),
+			Outputs: map[string]any{
+				"version_name": versionName,
+				"version_id":   v.ID,
+				"project_key":  cfg.ProjectKey,
+			},
+		}, nil
+	}
+
+	return &plugin.ExecuteResponse{
+		Success: true,
+		Message: fmt.Sprintf("Version '%s' not found in Jira, nothing to roll back", versionName),
+	}, nil
+}
+
 // extractIssueKeys extracts Jira issue keys from commit messages.
 func (p *JiraPlugin) extractIssueKeys(cfg *Config, changes *plugin.CategorizedChanges) []string {
 	pattern := cfg.IssuePattern
@@ -367,6 +432,16 @@ func (p *JiraPlugin) releaseVersion(ctx context.Context, client *jira.Client, ve
 	return err
 }
 
+// unreleaseVersion marks a version as unreleased.
+func (p *JiraPlugin) unreleaseVersion(ctx context.Context, client *jira.Client, versionID string) error {
+	released := false
+
+	_, err := client.Project.UpdateVersion(ctx, versionID, &project.UpdateVersionInput{
+		Released: &released,
+	})
+	return err
+}
+
 // associateIssueWithVersion adds a fix version to an issue.
 func (p *JiraPlugin) associateIssueWithVersion(ctx context.Context, client *jira.Client, issueKey, versionName string) error {
 	// Use jirasdk's Issue.Update with fixVersions field
@@ -23,8 +23,8 @@ func TestGetInfo(t *testing.T) {
 	if info.Description == "" {
 		t.Error("Description should not be empty")
 	}
-	if len(info.Hooks) != 4 {
-		t.Errorf("Expected 4 hooks, got %d", len(info.Hooks))
+	if len(info.Hooks) != 5 {
+		t.Errorf("Expected 5 hooks, got %d", len(info.Hooks))
 	}
 
 	// Check hooks
@@ -33,6 +33,7 @@ func TestGetInfo(t *testing.T) {
 		plugin.HookPostPublish: true,
 		plugin.HookOnSuccess:   true,
 		plugin.HookOnError:     true,
+		plugin.HookOnRollback:  true,
 	}
 	for _, hook := range info.Hooks {
 		if !expectedHooks[hook] {
@@ -558,6 +559,40 @@ func TestExecute_PostPlan(t *testing.T) {
 	}
 }
 
+func TestExecute_OnRollback_DryRun(t *testing.T) {
+	p := &JiraPlugin{}
+	ctx := context.Background()
+
+	req := plugin.ExecuteRequest{
+		Hook:   plugin.HookOnRollback,
+		DryRun: true,
+		Config: map[string]any{
+			"base_url":    "https://company.atlassian.net",
+			"project_key": "PROJ",
+			"username":    "user@example.com",
+			"token":       "test-token",
+		},
+		Context: plugin.ReleaseContext{
+			Version: "1.1.0",
+		},
+	}
+
+	resp, err := p.Execute(ctx, req)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if !resp.Success {
+		t.Errorf("Expected success, got error: %s", resp.Error)
+	}
+	if !containsString(resp.Message, "as unreleased") {
+		t.Errorf("Message = %v, expected to contain 'as unreleased'", resp.Message)
+	}
+	if resp.Outputs["version_name"] != "1.1.0" {
+		t.Errorf("version_name = %v, want 1.1.0", resp.Outputs["version_name"])
+	}
+}
+
 func TestExecute_PostPublish_DryRun(t *testing.T) {
 	p := &JiraPlugin{}
 	ctx := context.Background()
@@ -68,6 +68,8 @@ type Config struct {
 	TitleTemplate string `json:"title_template,omitempty"`
 	// NotifySubscribers sends email notifications to subscribers.
 	NotifySubscribers bool `json:"notify_subscribers"`
+	// Locale selects the localized release notes to use (e.g., "de").
+	Locale string `json:"locale,omitempty"`
 }
 
 // GraphQLRequest represents a GraphQL request payload.
@@ -139,6 +141,7 @@ func (p *LaunchNotesPlugin) GetInfo() plugin.Info {
 				"categories": {"type": "array", "items": {"type": "string"}, "description": "Category IDs to associate"},
 				"change_types": {"type": "array", "items": {"type": "string"}, "description": "Change type IDs (new, improved, fixed)"},
 				"include_changelog": {"type": "boolean", "description": "Include full changelog in content", "default": true},
+				"locale": {"type": "string", "description": "Locale of the localized release notes to use (e.g., de)"},
 				"title_template": {"type": "string", "description": "Title template", "default": "Release {{version}}"},
 				"notify_subscribers": {"type": "boolean", "description": "Send email notifications", "default": false}
 			},
@@ -150,6 +153,7 @@ func (p *LaunchNotesPlugin) GetInfo() plugin.Info {
 // Execute runs the plugin for a given hook.
 func (p *LaunchNotesPlugin) Execute(ctx context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
 	cfg := p.parseConfig(req.Config)
+	req.Context = req.Context.Localize(cfg.Locale)
 
 	switch req.Hook {
 	case plugin.HookPostPublish, plugin.HookOnSuccess:
@@ -404,6 +408,7 @@ func (p *LaunchNotesPlugin) parseConfig(raw map[string]any) *Config {
 		IncludeChangelog:  parser.GetBoolDefault("include_changelog", true),
 		TitleTemplate:     parser.GetStringDefault("title_template", "Release {{version}}"),
 		NotifySubscribers: parser.GetBool("notify_subscribers"),
+		Locale:            parser.GetString("locale"),
 	}
 }
 
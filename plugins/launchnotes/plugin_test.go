@@ -49,6 +49,7 @@ func TestParseConfig(t *testing.T) {
 				"include_changelog":  true,
 				"title_template":     "v{{version}}",
 				"notify_subscribers": true,
+				"locale":             "de",
 			},
 			expected: &Config{
 				APIToken:          "test-token",
@@ -60,6 +61,7 @@ func TestParseConfig(t *testing.T) {
 				IncludeChangelog:  true,
 				TitleTemplate:     "v{{version}}",
 				NotifySubscribers: true,
+				Locale:            "de",
 			},
 		},
 		{
@@ -104,6 +106,9 @@ func TestParseConfig(t *testing.T) {
 			if cfg.NotifySubscribers != tt.expected.NotifySubscribers {
 				t.Errorf("NotifySubscribers: expected %v, got %v", tt.expected.NotifySubscribers, cfg.NotifySubscribers)
 			}
+			if cfg.Locale != tt.expected.Locale {
+				t.Errorf("Locale: expected %s, got %s", tt.expected.Locale, cfg.Locale)
+			}
 		})
 	}
 }
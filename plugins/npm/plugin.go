@@ -45,8 +45,19 @@ type Config struct {
 	PackageDir string `json:"package_dir,omitempty"`
 	// UpdateVersion updates package.json version before publishing.
 	UpdateVersion bool `json:"update_version"`
+	// RollbackAction is what happens on rollback: "deprecate" or "dist-tag".
+	RollbackAction string `json:"rollback_action,omitempty"`
+	// DeprecateMessage is the deprecation message used on rollback.
+	DeprecateMessage string `json:"deprecate_message,omitempty"`
 }
 
+// Rollback actions for the on-rollback hook. npm only allows unpublishing
+// within 72 hours, so versions are deprecated or the dist-tag is moved back.
+const (
+	rollbackActionDeprecate = "deprecate"
+	rollbackActionDistTag   = "dist-tag"
+)
+
 // PackageJSON represents a package.json file.
 type PackageJSON struct {
 	Name    string `json:"name"`
@@ -64,6 +75,7 @@ func (p *NpmPlugin) GetInfo() plugin.Info {
 		Hooks: []plugin.Hook{
 			plugin.HookPrePublish,
 			plugin.HookPostPublish,
+			plugin.HookOnRollback,
 		},
 		ConfigSchema: `{
 			"type": "object",
@@ -74,7 +86,9 @@ func (p *NpmPlugin) GetInfo() plugin.Info {
 				"otp": {"type": "string", "description": "OTP for 2FA"},
 				"dry_run": {"type": "boolean", "description": "Perform dry-run", "default": false},
 				"package_dir": {"type": "string", "description": "Directory containing package.json"},
-				"update_version": {"type": "boolean", "description": "Update package.json version", "default": true}
+				"update_version": {"type": "boolean", "description": "Update package.json version", "default": true},
+				"rollback_action": {"type": "string", "enum": ["deprecate", "dist-tag"], "description": "On rollback, deprecate the version or move the dist-tag back to the previous version", "default": "deprecate"},
+				"deprecate_message": {"type": "string", "description": "Deprecation message used on rollback"}
 			}
 		}`,
 	}
@@ -97,6 +111,9 @@ func (p *NpmPlugin) Execute(ctx context.Context, req plugin.ExecuteRequest) (*pl
 	case plugin.HookPostPublish:
 		return p.publishPackage(ctx, cfg, req.Context, req.DryRun || cfg.DryRun)
 
+	case plugin.HookOnRollback:
+		return p.rollbackPackage(ctx, cfg, req.Context, req.DryRun || cfg.DryRun)
+
 	default:
 		return &plugin.ExecuteResponse{
 			Success: true,
@@ -432,6 +449,124 @@ func (p *NpmPlugin) publishPackage(ctx context.Context, cfg *Config, releaseCtx
 	}, nil
 }
 
+// rollbackPackage deprecates the rolled back version, or points the dist-tag
+// back at the previous version.
+func (p *NpmPlugin) rollbackPackage(ctx context.Context, cfg *Config, releaseCtx plugin.ReleaseContext, dryRun bool) (*plugin.ExecuteResponse, error) {
+	if err := p.validateConfig(cfg); err != nil {
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("configuration validation failed: %v", err),
+		}, nil
+	}
+
+	action := cfg.RollbackAction
+	if action == "" {
+		action = rollbackActionDeprecate
+	}
+
+	packageDir, err := validatePackageDir(cfg.PackageDir)
+	if err != nil {
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("invalid package directory: %v", err),
+		}, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(packageDir, "package.json"))
+	if err != nil {
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("failed to read package.json: %v", err),
+		}, nil
+	}
+
+	var pkg PackageJSON
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("failed to parse package.json: %v", err),
+		}, nil
+	}
+
+	if pkg.Private {
+		return &plugin.ExecuteResponse{
+			Success: true,
+			Message: "Package is private, nothing to roll back",
+		}, nil
+	}
+
+	var args []string
+	switch action {
+	case rollbackActionDeprecate:
+		message := cfg.DeprecateMessage
+		if message == "" {
+			message = fmt.Sprintf("%s was rolled back", releaseCtx.Version)
+		}
+		args = []string{"deprecate", fmt.Sprintf("%s@%s", pkg.Name, releaseCtx.Version), message}
+	case rollbackActionDistTag:
+		if releaseCtx.PreviousVersion == "" {
+			return &plugin.ExecuteResponse{
+				Success: false,
+				Error:   "no previous version to move the dist-tag back to",
+			}, nil
+		}
+		args = []string{"dist-tag", "add", fmt.Sprintf("%s@%s", pkg.Name, releaseCtx.PreviousVersion), cfg.Tag}
+	default:
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("unsupported rollback_action %q (use %q or %q)", action, rollbackActionDeprecate, rollbackActionDistTag),
+		}, nil
+	}
+
+	if cfg.Registry != "" {
+		args = append(args, "--registry", cfg.Registry)
+	}
+	cmdStr := fmt.Sprintf("npm %s", strings.Join(args, " "))
+	if cfg.OTP != "" {
+		args = append(args, "--otp", cfg.OTP)
+	}
+
+	if dryRun {
+		return &plugin.ExecuteResponse{
+			Success: true,
+			Message: fmt.Sprintf("Would run: %s (in %s)", cmdStr, packageDir),
+			Outputs: map[string]any{
+				"package": pkg.Name,
+				"version": releaseCtx.Version,
+				"action":  action,
+				"command": cmdStr,
+			},
+		}, nil
+	}
+
+	cmd := exec.CommandContext(ctx, "npm", args...)
+	cmd.Dir = packageDir
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return &plugin.ExecuteResponse{
+			Success: false,
+			Error:   fmt.Sprintf("npm %s failed: %v\nstderr: %s", action, err, stderr.String()),
+		}, nil
+	}
+
+	message := fmt.Sprintf("Deprecated %s@%s", pkg.Name, releaseCtx.Version)
+	if action == rollbackActionDistTag {
+		message = fmt.Sprintf("Moved dist-tag %s of %s back to %s", cfg.Tag, pkg.Name, releaseCtx.PreviousVersion)
+	}
+	return &plugin.ExecuteResponse{
+		Success: true,
+		Message: message,
+		Outputs: map[string]any{
+			"package": pkg.Name,
+			"version": releaseCtx.Version,
+			"action":  action,
+		},
+	}, nil
+}
+
 // parseConfig parses the plugin configuration using the shared ConfigParser.
 func (p *NpmPlugin) parseConfig(raw map[string]any) *Config {
 	parser := plugin.NewConfigParser(raw)
@@ -442,13 +577,15 @@ func (p *NpmPlugin) parseConfig(raw map[string]any) *Config {
 	}
 
 	return &Config{
-		Registry:      parser.GetString("registry"),
-		Tag:           tag,
-		Access:        parser.GetString("access"),
-		OTP:           parser.GetString("otp"),
-		DryRun:        parser.GetBool("dry_run"),
-		PackageDir:    parser.GetString("package_dir"),
-		UpdateVersion: parser.GetBoolDefault("update_version", true),
+		Registry:         parser.GetString("registry"),
+		Tag:              tag,
+		Access:           parser.GetString("access"),
+		OTP:              parser.GetString("otp"),
+		DryRun:           parser.GetBool("dry_run"),
+		PackageDir:       parser.GetString("package_dir"),
+		UpdateVersion:    parser.GetBoolDefault("update_version", true),
+		RollbackAction:   parser.GetString("rollback_action"),
+		DeprecateMessage: parser.GetString("deprecate_message"),
 	}
 }
 
@@ -458,6 +595,7 @@ func (p *NpmPlugin) Validate(_ context.Context, config map[string]any) (*plugin.
 
 	// Check access level if provided
 	vb.ValidateEnum(config, "access", []string{"public", "restricted"})
+	vb.ValidateEnum(config, "rollback_action", []string{rollbackActionDeprecate, rollbackActionDistTag})
 
 	// Verify npm is available
 	if _, err := exec.LookPath("npm"); err != nil {
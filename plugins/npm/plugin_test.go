@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/felixgeelhaar/release-pilot/pkg/plugin"
@@ -495,7 +496,7 @@ func TestNpmPlugin_GetInfo(t *testing.T) {
 		t.Errorf("GetInfo().Author = %q, want %q", info.Author, "ReleasePilot Team")
 	}
 
-	expectedHooks := []plugin.Hook{plugin.HookPrePublish, plugin.HookPostPublish}
+	expectedHooks := []plugin.Hook{plugin.HookPrePublish, plugin.HookPostPublish, plugin.HookOnRollback}
 	if len(info.Hooks) != len(expectedHooks) {
 		t.Errorf("GetInfo().Hooks len = %d, want %d", len(info.Hooks), len(expectedHooks))
 	}
@@ -688,6 +689,88 @@ func TestNpmPlugin_Execute_PostPublish_PrivatePackage(t *testing.T) {
 	}
 }
 
+func TestNpmPlugin_Execute_OnRollback_DryRun(t *testing.T) {
+	p := &NpmPlugin{}
+
+	cwd, _ := os.Getwd()
+	tmpDir, err := os.MkdirTemp(cwd, "npm-rollback-test-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	data, _ := json.Marshal(map[string]any{"name": "test-package", "version": "1.1.0"})
+	if err := os.WriteFile(filepath.Join(tmpDir, "package.json"), data, 0644); err != nil {
+		t.Fatalf("failed to create package.json: %v", err)
+	}
+	relDir := filepath.Base(tmpDir)
+
+	tests := []struct {
+		name            string
+		config          map[string]any
+		previousVersion string
+		wantCommand     string
+		wantError       string
+	}{
+		{
+			name:        "deprecate by default",
+			config:      map[string]any{"package_dir": relDir},
+			wantCommand: "npm deprecate test-package@1.1.0 1.1.0 was rolled back",
+		},
+		{
+			name:        "custom deprecation message",
+			config:      map[string]any{"package_dir": relDir, "deprecate_message": "use 1.0.0"},
+			wantCommand: "npm deprecate test-package@1.1.0 use 1.0.0",
+		},
+		{
+			name:            "move dist-tag back",
+			config:          map[string]any{"package_dir": relDir, "rollback_action": "dist-tag"},
+			previousVersion: "1.0.0",
+			wantCommand:     "npm dist-tag add test-package@1.0.0 latest",
+		},
+		{
+			name:      "dist-tag without previous version",
+			config:    map[string]any{"package_dir": relDir, "rollback_action": "dist-tag"},
+			wantError: "no previous version",
+		},
+		{
+			name:      "unsupported action",
+			config:    map[string]any{"package_dir": relDir, "rollback_action": "unpublish"},
+			wantError: "unsupported rollback_action",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := p.Execute(context.Background(), plugin.ExecuteRequest{
+				Hook:   plugin.HookOnRollback,
+				Config: tt.config,
+				Context: plugin.ReleaseContext{
+					Version:         "1.1.0",
+					PreviousVersion: tt.previousVersion,
+				},
+				DryRun: true,
+			})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			if tt.wantError != "" {
+				if resp.Success || !strings.Contains(resp.Error, tt.wantError) {
+					t.Errorf("Execute() = %+v, want error containing %q", resp, tt.wantError)
+				}
+				return
+			}
+			if !resp.Success {
+				t.Fatalf("Execute() Success = false, Error = %s", resp.Error)
+			}
+			if resp.Outputs["command"] != tt.wantCommand {
+				t.Errorf("Outputs[command] = %v, want %v", resp.Outputs["command"], tt.wantCommand)
+			}
+		})
+	}
+}
+
 func TestNpmPlugin_Execute_UnhandledHook(t *testing.T) {
 	p := &NpmPlugin{}
 
@@ -68,6 +68,8 @@ type Config struct {
 	IncludeChangelog bool `json:"include_changelog"`
 	// Mentions is a list of users/groups to mention.
 	Mentions []string `json:"mentions,omitempty"`
+	// Locale selects the localized release notes to use (e.g., "de").
+	Locale string `json:"locale,omitempty"`
 }
 
 // SlackMessage represents a Slack message payload.
@@ -123,6 +125,7 @@ func (p *SlackPlugin) GetInfo() plugin.Info {
 				"notify_on_success": {"type": "boolean", "description": "Notify on success", "default": true},
 				"notify_on_error": {"type": "boolean", "description": "Notify on error", "default": true},
 				"include_changelog": {"type": "boolean", "description": "Include changelog", "default": false},
+				"locale": {"type": "string", "description": "Locale of the localized release notes to use (e.g., de)"},
 				"mentions": {"type": "array", "items": {"type": "string"}, "description": "Users/groups to mention"}
 			},
 			"required": ["webhook"]
@@ -133,6 +136,7 @@ func (p *SlackPlugin) GetInfo() plugin.Info {
 // Execute runs the plugin for a given hook.
 func (p *SlackPlugin) Execute(ctx context.Context, req plugin.ExecuteRequest) (*plugin.ExecuteResponse, error) {
 	cfg := p.parseConfig(req.Config)
+	req.Context = req.Context.Localize(cfg.Locale)
 
 	switch req.Hook {
 	case plugin.HookPostPublish, plugin.HookOnSuccess:
@@ -329,6 +333,7 @@ func (p *SlackPlugin) parseConfig(raw map[string]any) *Config {
 		NotifyOnError:    parser.GetBoolDefault("notify_on_error", true),
 		IncludeChangelog: parser.GetBool("include_changelog"),
 		Mentions:         parser.GetStringSlice("mentions"),
+		Locale:           parser.GetString("locale"),
 	}
 }
 
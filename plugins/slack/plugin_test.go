@@ -759,3 +759,40 @@ func findSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+func TestSlackPlugin_Execute_Localized(t *testing.T) {
+	var receivedPayload SlackMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &receivedPayload)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	p := &SlackPlugin{}
+	resp, err := p.Execute(context.Background(), plugin.ExecuteRequest{
+		Hook: plugin.HookPostPublish,
+		Config: map[string]any{
+			"webhook":           server.URL,
+			"include_changelog": true,
+			"locale":            "ja",
+		},
+		Context: plugin.ReleaseContext{
+			Version:      "1.0.0",
+			TagName:      "v1.0.0",
+			ReleaseNotes: "Search is here.",
+			LocalizedNotes: []plugin.LocalizedNotes{
+				{Locale: "ja", ReleaseNotes: "検索機能が追加されました。"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("Execute() failed: %s", resp.Error)
+	}
+	if len(receivedPayload.Attachments) != 1 || receivedPayload.Attachments[0].Text != "検索機能が追加されました。" {
+		t.Errorf("Slack message = %+v, want the Japanese notes", receivedPayload.Attachments)
+	}
+}